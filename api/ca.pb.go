@@ -8,6 +8,7 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import google_protobuf "github.com/gogo/protobuf/types"
 import _ "github.com/docker/swarmkit/protobuf/plugin"
 
 import github_com_docker_swarmkit_api_deepcopy "github.com/docker/swarmkit/api/deepcopy"
@@ -44,6 +45,9 @@ func (*NodeCertificateStatusRequest) Descriptor() ([]byte, []int) { return fileD
 type NodeCertificateStatusResponse struct {
 	Status      *IssuanceStatus `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
 	Certificate *Certificate    `protobuf:"bytes,2,opt,name=certificate" json:"certificate,omitempty"`
+	// RenewAfter is the time after which the node should renew the issued
+	// certificate.  It is only set once the certificate has been issued.
+	RenewAfter *google_protobuf.Timestamp `protobuf:"bytes,3,opt,name=renew_after,json=renewAfter" json:"renew_after,omitempty"`
 }
 
 func (m *NodeCertificateStatusResponse) Reset()                    { *m = NodeCertificateStatusResponse{} }
@@ -60,6 +64,11 @@ type IssueNodeCertificateRequest struct {
 	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	// Availability allows a user to control the current scheduling status of a node
 	Availability NodeSpec_Availability `protobuf:"varint,4,opt,name=availability,proto3,enum=docker.swarmkit.v1.NodeSpec_Availability" json:"availability,omitempty"`
+	// Attestation is an optional hardware attestation, such as a TPM quote,
+	// binding the CSR's key to the node's hardware. It is checked by the
+	// CA's attestation verifier, if one is configured, before the
+	// certificate is signed.
+	Attestation []byte `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation,omitempty"`
 }
 
 func (m *IssueNodeCertificateRequest) Reset()                    { *m = IssueNodeCertificateRequest{} }
@@ -76,6 +85,10 @@ func (*IssueNodeCertificateResponse) ProtoMessage()               {}
 func (*IssueNodeCertificateResponse) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{3} }
 
 type GetRootCACertificateRequest struct {
+	// IfNoneMatch is the hash of a root CA certificate bundle the client
+	// already has.  If it matches the current bundle, the certificate is
+	// omitted from the response and NotModified is set.
+	IfNoneMatch string `protobuf:"bytes,1,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
 }
 
 func (m *GetRootCACertificateRequest) Reset()                    { *m = GetRootCACertificateRequest{} }
@@ -84,6 +97,11 @@ func (*GetRootCACertificateRequest) Descriptor() ([]byte, []int) { return fileDe
 
 type GetRootCACertificateResponse struct {
 	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Hash is the digest of the current root CA certificate bundle.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// NotModified is set if the request's IfNoneMatch matches Hash, in
+	// which case Certificate is empty.
+	NotModified bool `protobuf:"varint,3,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
 }
 
 func (m *GetRootCACertificateResponse) Reset()                    { *m = GetRootCACertificateResponse{} }
@@ -106,6 +124,50 @@ func (m *GetUnlockKeyResponse) Reset()                    { *m = GetUnlockKeyRes
 func (*GetUnlockKeyResponse) ProtoMessage()               {}
 func (*GetUnlockKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptorCa, []int{7} }
 
+type GetNodeCertificateChainRequest struct {
+	NodeID string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (m *GetNodeCertificateChainRequest) Reset()      { *m = GetNodeCertificateChainRequest{} }
+func (*GetNodeCertificateChainRequest) ProtoMessage() {}
+func (*GetNodeCertificateChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorCa, []int{8}
+}
+
+type GetNodeCertificateChainResponse struct {
+	// Certificate is the node's PEM-encoded leaf certificate.
+	Certificate []byte `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// Intermediates is the PEM-encoded bundle of the intermediate CA
+	// certificates the node presents with its leaf certificate, if any.
+	Intermediates []byte `protobuf:"bytes,2,opt,name=intermediates,proto3" json:"intermediates,omitempty"`
+}
+
+func (m *GetNodeCertificateChainResponse) Reset()      { *m = GetNodeCertificateChainResponse{} }
+func (*GetNodeCertificateChainResponse) ProtoMessage() {}
+func (*GetNodeCertificateChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorCa, []int{9}
+}
+
+type GetNodeIssuanceHistoryRequest struct {
+	NodeID string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (m *GetNodeIssuanceHistoryRequest) Reset()      { *m = GetNodeIssuanceHistoryRequest{} }
+func (*GetNodeIssuanceHistoryRequest) ProtoMessage() {}
+func (*GetNodeIssuanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorCa, []int{10}
+}
+
+type GetNodeIssuanceHistoryResponse struct {
+	History []*IssuanceRecord `protobuf:"bytes,1,rep,name=history" json:"history,omitempty"`
+}
+
+func (m *GetNodeIssuanceHistoryResponse) Reset()      { *m = GetNodeIssuanceHistoryResponse{} }
+func (*GetNodeIssuanceHistoryResponse) ProtoMessage() {}
+func (*GetNodeIssuanceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorCa, []int{11}
+}
+
 func init() {
 	proto.RegisterType((*NodeCertificateStatusRequest)(nil), "docker.swarmkit.v1.NodeCertificateStatusRequest")
 	proto.RegisterType((*NodeCertificateStatusResponse)(nil), "docker.swarmkit.v1.NodeCertificateStatusResponse")
@@ -115,6 +177,10 @@ func init() {
 	proto.RegisterType((*GetRootCACertificateResponse)(nil), "docker.swarmkit.v1.GetRootCACertificateResponse")
 	proto.RegisterType((*GetUnlockKeyRequest)(nil), "docker.swarmkit.v1.GetUnlockKeyRequest")
 	proto.RegisterType((*GetUnlockKeyResponse)(nil), "docker.swarmkit.v1.GetUnlockKeyResponse")
+	proto.RegisterType((*GetNodeCertificateChainRequest)(nil), "docker.swarmkit.v1.GetNodeCertificateChainRequest")
+	proto.RegisterType((*GetNodeCertificateChainResponse)(nil), "docker.swarmkit.v1.GetNodeCertificateChainResponse")
+	proto.RegisterType((*GetNodeIssuanceHistoryRequest)(nil), "docker.swarmkit.v1.GetNodeIssuanceHistoryRequest")
+	proto.RegisterType((*GetNodeIssuanceHistoryResponse)(nil), "docker.swarmkit.v1.GetNodeIssuanceHistoryResponse")
 }
 
 type authenticatedWrapperCAServer struct {
@@ -142,6 +208,22 @@ func (p *authenticatedWrapperCAServer) GetUnlockKey(ctx context.Context, r *GetU
 	return p.local.GetUnlockKey(ctx, r)
 }
 
+func (p *authenticatedWrapperCAServer) GetNodeCertificateChain(ctx context.Context, r *GetNodeCertificateChainRequest) (*GetNodeCertificateChainResponse, error) {
+
+	if err := p.authorize(ctx, []string{"swarm-manager"}); err != nil {
+		return nil, err
+	}
+	return p.local.GetNodeCertificateChain(ctx, r)
+}
+
+func (p *authenticatedWrapperCAServer) GetNodeIssuanceHistory(ctx context.Context, r *GetNodeIssuanceHistoryRequest) (*GetNodeIssuanceHistoryResponse, error) {
+
+	if err := p.authorize(ctx, []string{"swarm-manager"}); err != nil {
+		return nil, err
+	}
+	return p.local.GetNodeIssuanceHistory(ctx, r)
+}
+
 type authenticatedWrapperNodeCAServer struct {
 	local     NodeCAServer
 	authorize func(context.Context, []string) error
@@ -200,6 +282,10 @@ func (m *NodeCertificateStatusResponse) CopyFrom(src interface{}) {
 		m.Certificate = &Certificate{}
 		github_com_docker_swarmkit_api_deepcopy.Copy(m.Certificate, o.Certificate)
 	}
+	if o.RenewAfter != nil {
+		m.RenewAfter = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.RenewAfter, o.RenewAfter)
+	}
 }
 
 func (m *IssueNodeCertificateRequest) Copy() *IssueNodeCertificateRequest {
@@ -219,6 +305,10 @@ func (m *IssueNodeCertificateRequest) CopyFrom(src interface{}) {
 		m.CSR = make([]byte, len(o.CSR))
 		copy(m.CSR, o.CSR)
 	}
+	if o.Attestation != nil {
+		m.Attestation = make([]byte, len(o.Attestation))
+		copy(m.Attestation, o.Attestation)
+	}
 }
 
 func (m *IssueNodeCertificateResponse) Copy() *IssueNodeCertificateResponse {
@@ -245,7 +335,12 @@ func (m *GetRootCACertificateRequest) Copy() *GetRootCACertificateRequest {
 	return o
 }
 
-func (m *GetRootCACertificateRequest) CopyFrom(src interface{}) {}
+func (m *GetRootCACertificateRequest) CopyFrom(src interface{}) {
+
+	o := src.(*GetRootCACertificateRequest)
+	*m = *o
+}
+
 func (m *GetRootCACertificateResponse) Copy() *GetRootCACertificateResponse {
 	if m == nil {
 		return nil
@@ -295,6 +390,82 @@ func (m *GetUnlockKeyResponse) CopyFrom(src interface{}) {
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Version, &o.Version)
 }
 
+func (m *GetNodeCertificateChainRequest) Copy() *GetNodeCertificateChainRequest {
+	if m == nil {
+		return nil
+	}
+	o := &GetNodeCertificateChainRequest{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *GetNodeCertificateChainRequest) CopyFrom(src interface{}) {
+
+	o := src.(*GetNodeCertificateChainRequest)
+	*m = *o
+}
+
+func (m *GetNodeCertificateChainResponse) Copy() *GetNodeCertificateChainResponse {
+	if m == nil {
+		return nil
+	}
+	o := &GetNodeCertificateChainResponse{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *GetNodeCertificateChainResponse) CopyFrom(src interface{}) {
+
+	o := src.(*GetNodeCertificateChainResponse)
+	*m = *o
+	if o.Certificate != nil {
+		m.Certificate = make([]byte, len(o.Certificate))
+		copy(m.Certificate, o.Certificate)
+	}
+	if o.Intermediates != nil {
+		m.Intermediates = make([]byte, len(o.Intermediates))
+		copy(m.Intermediates, o.Intermediates)
+	}
+}
+
+func (m *GetNodeIssuanceHistoryRequest) Copy() *GetNodeIssuanceHistoryRequest {
+	if m == nil {
+		return nil
+	}
+	o := &GetNodeIssuanceHistoryRequest{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *GetNodeIssuanceHistoryRequest) CopyFrom(src interface{}) {
+
+	o := src.(*GetNodeIssuanceHistoryRequest)
+	*m = *o
+}
+
+func (m *GetNodeIssuanceHistoryResponse) Copy() *GetNodeIssuanceHistoryResponse {
+	if m == nil {
+		return nil
+	}
+	o := &GetNodeIssuanceHistoryResponse{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *GetNodeIssuanceHistoryResponse) CopyFrom(src interface{}) {
+
+	o := src.(*GetNodeIssuanceHistoryResponse)
+	*m = *o
+	if o.History != nil {
+		m.History = make([]*IssuanceRecord, len(o.History))
+		for i := range m.History {
+			m.History[i] = &IssuanceRecord{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.History[i], o.History[i])
+		}
+	}
+
+}
+
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
 var _ grpc.ClientConn
@@ -310,6 +481,12 @@ type CAClient interface {
 	// GetUnlockKey returns the current unlock key for the cluster for the role of the client
 	// asking.
 	GetUnlockKey(ctx context.Context, in *GetUnlockKeyRequest, opts ...grpc.CallOption) (*GetUnlockKeyResponse, error)
+	// GetNodeCertificateChain returns the certificate chain a node presents:
+	// its current certificate, and the intermediates recorded with it.
+	GetNodeCertificateChain(ctx context.Context, in *GetNodeCertificateChainRequest, opts ...grpc.CallOption) (*GetNodeCertificateChainResponse, error)
+	// GetNodeIssuanceHistory returns the most recent certificates issued to
+	// a node, oldest first.
+	GetNodeIssuanceHistory(ctx context.Context, in *GetNodeIssuanceHistoryRequest, opts ...grpc.CallOption) (*GetNodeIssuanceHistoryResponse, error)
 }
 
 type cAClient struct {
@@ -338,6 +515,24 @@ func (c *cAClient) GetUnlockKey(ctx context.Context, in *GetUnlockKeyRequest, op
 	return out, nil
 }
 
+func (c *cAClient) GetNodeCertificateChain(ctx context.Context, in *GetNodeCertificateChainRequest, opts ...grpc.CallOption) (*GetNodeCertificateChainResponse, error) {
+	out := new(GetNodeCertificateChainResponse)
+	err := grpc.Invoke(ctx, "/docker.swarmkit.v1.CA/GetNodeCertificateChain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cAClient) GetNodeIssuanceHistory(ctx context.Context, in *GetNodeIssuanceHistoryRequest, opts ...grpc.CallOption) (*GetNodeIssuanceHistoryResponse, error) {
+	out := new(GetNodeIssuanceHistoryResponse)
+	err := grpc.Invoke(ctx, "/docker.swarmkit.v1.CA/GetNodeIssuanceHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for CA service
 
 type CAServer interface {
@@ -345,6 +540,12 @@ type CAServer interface {
 	// GetUnlockKey returns the current unlock key for the cluster for the role of the client
 	// asking.
 	GetUnlockKey(context.Context, *GetUnlockKeyRequest) (*GetUnlockKeyResponse, error)
+	// GetNodeCertificateChain returns the certificate chain a node presents:
+	// its current certificate, and the intermediates recorded with it.
+	GetNodeCertificateChain(context.Context, *GetNodeCertificateChainRequest) (*GetNodeCertificateChainResponse, error)
+	// GetNodeIssuanceHistory returns the most recent certificates issued to
+	// a node, oldest first.
+	GetNodeIssuanceHistory(context.Context, *GetNodeIssuanceHistoryRequest) (*GetNodeIssuanceHistoryResponse, error)
 }
 
 func RegisterCAServer(s *grpc.Server, srv CAServer) {
@@ -387,6 +588,42 @@ func _CA_GetUnlockKey_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CA_GetNodeCertificateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeCertificateChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAServer).GetNodeCertificateChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/docker.swarmkit.v1.CA/GetNodeCertificateChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAServer).GetNodeCertificateChain(ctx, req.(*GetNodeCertificateChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CA_GetNodeIssuanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeIssuanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CAServer).GetNodeIssuanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/docker.swarmkit.v1.CA/GetNodeIssuanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CAServer).GetNodeIssuanceHistory(ctx, req.(*GetNodeIssuanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CA_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "docker.swarmkit.v1.CA",
 	HandlerType: (*CAServer)(nil),
@@ -399,6 +636,14 @@ var _CA_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUnlockKey",
 			Handler:    _CA_GetUnlockKey_Handler,
 		},
+		{
+			MethodName: "GetNodeCertificateChain",
+			Handler:    _CA_GetNodeCertificateChain_Handler,
+		},
+		{
+			MethodName: "GetNodeIssuanceHistory",
+			Handler:    _CA_GetNodeIssuanceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ca.proto",
@@ -560,6 +805,16 @@ func (m *NodeCertificateStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.RenewAfter != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(m.RenewAfter.Size()))
+		n3, err := m.RenewAfter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
 	return i, nil
 }
 
@@ -600,6 +855,12 @@ func (m *IssueNodeCertificateRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCa(dAtA, i, uint64(m.Availability))
 	}
+	if len(m.Attestation) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.Attestation)))
+		i += copy(dAtA[i:], m.Attestation)
+	}
 	return i, nil
 }
 
@@ -647,6 +908,12 @@ func (m *GetRootCACertificateRequest) MarshalTo(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IfNoneMatch) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.IfNoneMatch)))
+		i += copy(dAtA[i:], m.IfNoneMatch)
+	}
 	return i, nil
 }
 
@@ -671,6 +938,22 @@ func (m *GetRootCACertificateResponse) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCa(dAtA, i, uint64(len(m.Certificate)))
 		i += copy(dAtA[i:], m.Certificate)
 	}
+	if len(m.Hash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.Hash)))
+		i += copy(dAtA[i:], m.Hash)
+	}
+	if m.NotModified {
+		dAtA[i] = 0x18
+		i++
+		if m.NotModified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -716,11 +999,119 @@ func (m *GetUnlockKeyResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintCa(dAtA, i, uint64(m.Version.Size()))
-	n3, err := m.Version.MarshalTo(dAtA[i:])
+	n4, err := m.Version.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n3
+	i += n4
+	return i, nil
+}
+
+func (m *GetNodeCertificateChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeCertificateChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
+	return i, nil
+}
+
+func (m *GetNodeCertificateChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeCertificateChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Certificate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.Certificate)))
+		i += copy(dAtA[i:], m.Certificate)
+	}
+	if len(m.Intermediates) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.Intermediates)))
+		i += copy(dAtA[i:], m.Intermediates)
+	}
+	return i, nil
+}
+
+func (m *GetNodeIssuanceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeIssuanceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCa(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
+	return i, nil
+}
+
+func (m *GetNodeIssuanceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNodeIssuanceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, msg := range m.History {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCa(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -894,19 +1285,89 @@ func (p *raftProxyCAServer) GetUnlockKey(ctx context.Context, r *GetUnlockKeyReq
 	return resp, err
 }
 
-type raftProxyNodeCAServer struct {
-	local                       NodeCAServer
-	connSelector                raftselector.ConnProvider
-	localCtxMods, remoteCtxMods []func(context.Context) (context.Context, error)
-}
+func (p *raftProxyCAServer) GetNodeCertificateChain(ctx context.Context, r *GetNodeCertificateChainRequest) (*GetNodeCertificateChainResponse, error) {
 
-func NewRaftProxyNodeCAServer(local NodeCAServer, connSelector raftselector.ConnProvider, localCtxMod, remoteCtxMod func(context.Context) (context.Context, error)) NodeCAServer {
-	redirectChecker := func(ctx context.Context) (context.Context, error) {
-		s, ok := transport.StreamFromContext(ctx)
-		if !ok {
-			return ctx, grpc.Errorf(codes.InvalidArgument, "remote addr is not found in context")
+	conn, err := p.connSelector.LeaderConn(ctx)
+	if err != nil {
+		if err == raftselector.ErrIsLeader {
+			ctx, err = p.runCtxMods(ctx, p.localCtxMods)
+			if err != nil {
+				return nil, err
+			}
+			return p.local.GetNodeCertificateChain(ctx, r)
 		}
-		addr := s.ServerTransport().RemoteAddr().String()
+		return nil, err
+	}
+	modCtx, err := p.runCtxMods(ctx, p.remoteCtxMods)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := NewCAClient(conn).GetNodeCertificateChain(modCtx, r)
+	if err != nil {
+		if !strings.Contains(err.Error(), "is closing") && !strings.Contains(err.Error(), "the connection is unavailable") && !strings.Contains(err.Error(), "connection error") {
+			return resp, err
+		}
+		conn, err := p.pollNewLeaderConn(ctx)
+		if err != nil {
+			if err == raftselector.ErrIsLeader {
+				return p.local.GetNodeCertificateChain(ctx, r)
+			}
+			return nil, err
+		}
+		return NewCAClient(conn).GetNodeCertificateChain(modCtx, r)
+	}
+	return resp, err
+}
+
+func (p *raftProxyCAServer) GetNodeIssuanceHistory(ctx context.Context, r *GetNodeIssuanceHistoryRequest) (*GetNodeIssuanceHistoryResponse, error) {
+
+	conn, err := p.connSelector.LeaderConn(ctx)
+	if err != nil {
+		if err == raftselector.ErrIsLeader {
+			ctx, err = p.runCtxMods(ctx, p.localCtxMods)
+			if err != nil {
+				return nil, err
+			}
+			return p.local.GetNodeIssuanceHistory(ctx, r)
+		}
+		return nil, err
+	}
+	modCtx, err := p.runCtxMods(ctx, p.remoteCtxMods)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := NewCAClient(conn).GetNodeIssuanceHistory(modCtx, r)
+	if err != nil {
+		if !strings.Contains(err.Error(), "is closing") && !strings.Contains(err.Error(), "the connection is unavailable") && !strings.Contains(err.Error(), "connection error") {
+			return resp, err
+		}
+		conn, err := p.pollNewLeaderConn(ctx)
+		if err != nil {
+			if err == raftselector.ErrIsLeader {
+				return p.local.GetNodeIssuanceHistory(ctx, r)
+			}
+			return nil, err
+		}
+		return NewCAClient(conn).GetNodeIssuanceHistory(modCtx, r)
+	}
+	return resp, err
+}
+
+type raftProxyNodeCAServer struct {
+	local                       NodeCAServer
+	connSelector                raftselector.ConnProvider
+	localCtxMods, remoteCtxMods []func(context.Context) (context.Context, error)
+}
+
+func NewRaftProxyNodeCAServer(local NodeCAServer, connSelector raftselector.ConnProvider, localCtxMod, remoteCtxMod func(context.Context) (context.Context, error)) NodeCAServer {
+	redirectChecker := func(ctx context.Context) (context.Context, error) {
+		s, ok := transport.StreamFromContext(ctx)
+		if !ok {
+			return ctx, grpc.Errorf(codes.InvalidArgument, "remote addr is not found in context")
+		}
+		addr := s.ServerTransport().RemoteAddr().String()
 		md, ok := metadata.FromContext(ctx)
 		if ok && len(md["redirect"]) != 0 {
 			return ctx, grpc.Errorf(codes.ResourceExhausted, "more than one redirect to leader from: %s", md["redirect"])
@@ -1057,6 +1518,10 @@ func (m *NodeCertificateStatusResponse) Size() (n int) {
 		l = m.Certificate.Size()
 		n += 1 + l + sovCa(uint64(l))
 	}
+	if m.RenewAfter != nil {
+		l = m.RenewAfter.Size()
+		n += 1 + l + sovCa(uint64(l))
+	}
 	return n
 }
 
@@ -1077,6 +1542,10 @@ func (m *IssueNodeCertificateRequest) Size() (n int) {
 	if m.Availability != 0 {
 		n += 1 + sovCa(uint64(m.Availability))
 	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
 	return n
 }
 
@@ -1096,6 +1565,10 @@ func (m *IssueNodeCertificateResponse) Size() (n int) {
 func (m *GetRootCACertificateRequest) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.IfNoneMatch)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
 	return n
 }
 
@@ -1106,6 +1579,13 @@ func (m *GetRootCACertificateResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCa(uint64(l))
 	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
+	if m.NotModified {
+		n += 2
+	}
 	return n
 }
 
@@ -1127,6 +1607,52 @@ func (m *GetUnlockKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetNodeCertificateChainRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNodeCertificateChainResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
+	l = len(m.Intermediates)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNodeIssuanceHistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovCa(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNodeIssuanceHistoryResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovCa(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCa(x uint64) (n int) {
 	for {
 		n++
@@ -1157,6 +1683,7 @@ func (this *NodeCertificateStatusResponse) String() string {
 	s := strings.Join([]string{`&NodeCertificateStatusResponse{`,
 		`Status:` + strings.Replace(fmt.Sprintf("%v", this.Status), "IssuanceStatus", "IssuanceStatus", 1) + `,`,
 		`Certificate:` + strings.Replace(fmt.Sprintf("%v", this.Certificate), "Certificate", "Certificate", 1) + `,`,
+		`RenewAfter:` + strings.Replace(fmt.Sprintf("%v", this.RenewAfter), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1170,6 +1697,7 @@ func (this *IssueNodeCertificateRequest) String() string {
 		`CSR:` + fmt.Sprintf("%v", this.CSR) + `,`,
 		`Token:` + fmt.Sprintf("%v", this.Token) + `,`,
 		`Availability:` + fmt.Sprintf("%v", this.Availability) + `,`,
+		`Attestation:` + fmt.Sprintf("%v", this.Attestation) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1190,6 +1718,7 @@ func (this *GetRootCACertificateRequest) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&GetRootCACertificateRequest{`,
+		`IfNoneMatch:` + fmt.Sprintf("%v", this.IfNoneMatch) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1200,6 +1729,8 @@ func (this *GetRootCACertificateResponse) String() string {
 	}
 	s := strings.Join([]string{`&GetRootCACertificateResponse{`,
 		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`NotModified:` + fmt.Sprintf("%v", this.NotModified) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1224,6 +1755,47 @@ func (this *GetUnlockKeyResponse) String() string {
 	}, "")
 	return s
 }
+func (this *GetNodeCertificateChainRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNodeCertificateChainRequest{`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNodeCertificateChainResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNodeCertificateChainResponse{`,
+		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
+		`Intermediates:` + fmt.Sprintf("%v", this.Intermediates) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNodeIssuanceHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNodeIssuanceHistoryRequest{`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetNodeIssuanceHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetNodeIssuanceHistoryResponse{`,
+		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "IssuanceRecord", "IssuanceRecord", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringCa(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1406,6 +1978,39 @@ func (m *NodeCertificateStatusResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenewAfter == nil {
+				m.RenewAfter = &google_protobuf.Timestamp{}
+			}
+			if err := m.RenewAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCa(dAtA[iNdEx:])
@@ -1554,6 +2159,37 @@ func (m *IssueNodeCertificateRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCa(dAtA[iNdEx:])
@@ -1702,6 +2338,35 @@ func (m *GetRootCACertificateRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GetRootCACertificateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNoneMatch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IfNoneMatch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCa(dAtA[iNdEx:])
@@ -1783,19 +2448,68 @@ func (m *GetRootCACertificateResponse) Unmarshal(dAtA []byte) error {
 				m.Certificate = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCa(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCa
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotModified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotModified = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -1965,6 +2679,357 @@ func (m *GetUnlockKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetNodeCertificateChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeCertificateChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeCertificateChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNodeCertificateChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeCertificateChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeCertificateChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intermediates", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intermediates = append(m.Intermediates[:0], dAtA[iNdEx:postIndex]...)
+			if m.Intermediates == nil {
+				m.Intermediates = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNodeIssuanceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeIssuanceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeIssuanceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNodeIssuanceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCa
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNodeIssuanceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNodeIssuanceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCa
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCa
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &IssuanceRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCa(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCa
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCa(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2073,44 +3138,59 @@ var (
 func init() { proto.RegisterFile("ca.proto", fileDescriptorCa) }
 
 var fileDescriptorCa = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xee, 0xba, 0x25, 0x6d, 0x27, 0xa1, 0x45, 0xdb, 0x56, 0x32, 0x69, 0xea, 0x54, 0xe6, 0xd0,
-	0x72, 0xc0, 0x6d, 0x03, 0x27, 0xb8, 0x90, 0x04, 0xa9, 0x8a, 0x50, 0x11, 0xda, 0x08, 0xae, 0x95,
-	0xe3, 0x2c, 0xc1, 0x8a, 0xe3, 0x35, 0xde, 0x75, 0x20, 0x37, 0x24, 0x10, 0x6f, 0x80, 0xe0, 0xc4,
-	0x23, 0xf0, 0x1c, 0x11, 0x27, 0x24, 0x2e, 0x9c, 0x22, 0xea, 0x07, 0xe0, 0x19, 0x90, 0xd7, 0x36,
-	0xcd, 0x8f, 0x13, 0xca, 0xc9, 0xbb, 0xb3, 0xdf, 0xf7, 0xcd, 0xcc, 0xb7, 0xe3, 0x85, 0x35, 0xcb,
-	0x34, 0x3c, 0x9f, 0x09, 0x86, 0x71, 0x9b, 0x59, 0x5d, 0xea, 0x1b, 0xfc, 0xb5, 0xe9, 0xf7, 0xba,
-	0xb6, 0x30, 0xfa, 0x27, 0xc5, 0xbc, 0x18, 0x78, 0x94, 0xc7, 0x80, 0x62, 0x9e, 0x7b, 0xd4, 0x4a,
-	0x37, 0xdb, 0x1d, 0xd6, 0x61, 0x72, 0x79, 0x14, 0xad, 0x92, 0xe8, 0x96, 0xe7, 0x04, 0x1d, 0xdb,
-	0x3d, 0x8a, 0x3f, 0x71, 0x50, 0xaf, 0x43, 0xe9, 0x09, 0x6b, 0xd3, 0x3a, 0xf5, 0x85, 0xfd, 0xc2,
-	0xb6, 0x4c, 0x41, 0x9b, 0xc2, 0x14, 0x01, 0x27, 0xf4, 0x55, 0x40, 0xb9, 0xc0, 0xb7, 0x60, 0xd5,
-	0x65, 0x6d, 0x7a, 0x6e, 0xb7, 0x55, 0xb4, 0x8f, 0x0e, 0xd7, 0x6b, 0x10, 0x8e, 0xca, 0xb9, 0x88,
-	0xd2, 0x78, 0x44, 0x72, 0xd1, 0x51, 0xa3, 0xad, 0x7f, 0x41, 0xb0, 0x37, 0x47, 0x85, 0x7b, 0xcc,
-	0xe5, 0x14, 0xdf, 0x87, 0x1c, 0x97, 0x11, 0xa9, 0x92, 0xaf, 0xe8, 0xc6, 0x6c, 0x43, 0x46, 0x83,
-	0xf3, 0xc0, 0x74, 0xad, 0x94, 0x9b, 0x30, 0x70, 0x15, 0xf2, 0xd6, 0xa5, 0xb0, 0xaa, 0x48, 0x81,
-	0x72, 0x96, 0xc0, 0x58, 0x7e, 0x32, 0xce, 0xd1, 0x7f, 0x20, 0xd8, 0x8d, 0xd4, 0xe9, 0x54, 0x95,
-	0x69, 0x97, 0xf7, 0x60, 0xc5, 0x67, 0x0e, 0x95, 0xc5, 0x6d, 0x54, 0x4a, 0x59, 0xda, 0x11, 0x93,
-	0x30, 0x87, 0xd6, 0x14, 0x15, 0x11, 0x89, 0xc6, 0x37, 0x61, 0xd9, 0xe2, 0xbe, 0x2c, 0xa8, 0x50,
-	0x5b, 0x0d, 0x47, 0xe5, 0xe5, 0x7a, 0x93, 0x90, 0x28, 0x86, 0xb7, 0xe1, 0x9a, 0x60, 0x5d, 0xea,
-	0xaa, 0xcb, 0x91, 0x69, 0x24, 0xde, 0xe0, 0x33, 0x28, 0x98, 0x7d, 0xd3, 0x76, 0xcc, 0x96, 0xed,
-	0xd8, 0x62, 0xa0, 0xae, 0xc8, 0x74, 0xb7, 0xe7, 0xa5, 0x6b, 0x7a, 0xd4, 0x32, 0xaa, 0x63, 0x04,
-	0x32, 0x41, 0xd7, 0x3f, 0x22, 0x28, 0x65, 0x77, 0x95, 0xb8, 0x7e, 0x95, 0xcb, 0xc3, 0x4f, 0x61,
-	0x53, 0x82, 0x7a, 0xb4, 0xd7, 0xa2, 0x3e, 0x7f, 0x69, 0x7b, 0xb2, 0xa3, 0x8d, 0xca, 0xc1, 0xc2,
-	0xba, 0xce, 0xfe, 0xc2, 0xc9, 0x46, 0xc4, 0xbf, 0xdc, 0xeb, 0x7b, 0xb0, 0x7b, 0x4a, 0x05, 0x61,
-	0x4c, 0xd4, 0xab, 0xb3, 0x66, 0xeb, 0x0f, 0xa1, 0x94, 0x7d, 0x9c, 0x54, 0xbd, 0x3f, 0x79, 0xdf,
-	0x51, 0xe5, 0x85, 0xc9, 0xeb, 0xdc, 0x81, 0xad, 0x53, 0x2a, 0x9e, 0xb9, 0x0e, 0xb3, 0xba, 0x8f,
-	0xe9, 0x20, 0x15, 0xf6, 0x61, 0x7b, 0x32, 0x9c, 0x08, 0xee, 0x01, 0x04, 0x32, 0x78, 0xde, 0xa5,
-	0x83, 0x44, 0x6f, 0x3d, 0x48, 0x61, 0xf8, 0x01, 0xac, 0xf6, 0xa9, 0xcf, 0x6d, 0xe6, 0x26, 0xb3,
-	0xb5, 0x9b, 0xd5, 0xf8, 0xf3, 0x18, 0x52, 0x5b, 0x19, 0x8e, 0xca, 0x4b, 0x24, 0x65, 0x54, 0xde,
-	0x2b, 0xa0, 0xd4, 0xab, 0xf8, 0x1d, 0x92, 0xb9, 0x67, 0x9a, 0xc2, 0x47, 0x59, 0x5a, 0x0b, 0xdc,
-	0x29, 0x1e, 0x5f, 0x9d, 0x10, 0xb7, 0xa7, 0xaf, 0x7d, 0xfb, 0xfa, 0xfb, 0xb3, 0xa2, 0xdc, 0x40,
-	0xf8, 0x0d, 0x14, 0xc6, 0x0d, 0xc0, 0x07, 0x73, 0xb4, 0xa6, 0x9d, 0x2b, 0x1e, 0xfe, 0x1b, 0x98,
-	0x24, 0xdb, 0x91, 0xc9, 0x36, 0xe1, 0xba, 0x44, 0xde, 0xe9, 0x99, 0xae, 0xd9, 0xa1, 0x7e, 0xe5,
-	0x93, 0x02, 0x72, 0xae, 0x12, 0x2b, 0xb2, 0xa6, 0x32, 0xdb, 0x8a, 0x05, 0x7f, 0x65, 0xb6, 0x15,
-	0x8b, 0x06, 0x7e, 0xcc, 0x8a, 0x0f, 0x08, 0x76, 0x32, 0x9f, 0x24, 0x7c, 0x3c, 0x6f, 0xac, 0xe7,
-	0xbd, 0x81, 0xc5, 0x93, 0xff, 0x60, 0x4c, 0x17, 0x52, 0x53, 0x87, 0x17, 0xda, 0xd2, 0xcf, 0x0b,
-	0x6d, 0xe9, 0x6d, 0xa8, 0xa1, 0x61, 0xa8, 0xa1, 0xef, 0xa1, 0x86, 0x7e, 0x85, 0x1a, 0x6a, 0xe5,
-	0xe4, 0x0b, 0x7c, 0xf7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x69, 0xad, 0xed, 0x8f, 0xe6, 0x05,
-	0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0x4e, 0x3b, 0xde, 0xfc, 0x94, 0x93, 0x2c, 0xea, 0x4d, 0xc0, 0x4c, 0x12, 0x3b, 0x0c, 0x48,
+	0xbb, 0x1c, 0x18, 0x27, 0x5e, 0x4e, 0x2c, 0x17, 0xdb, 0x8b, 0x42, 0x84, 0xb2, 0x42, 0x1d, 0xe0,
+	0x88, 0xd5, 0x99, 0x29, 0xdb, 0x2d, 0x7b, 0xa6, 0x87, 0xe9, 0x76, 0x82, 0x6f, 0x48, 0x48, 0xbc,
+	0x01, 0x7f, 0x2f, 0xc1, 0x9d, 0x37, 0x88, 0x38, 0x71, 0x44, 0x42, 0x8a, 0x58, 0x3f, 0x00, 0x37,
+	0xee, 0x68, 0x7a, 0x66, 0x76, 0x9d, 0xec, 0x8c, 0x37, 0x3e, 0x65, 0xba, 0x52, 0xdf, 0x57, 0x5f,
+	0x55, 0x7f, 0xdd, 0x6d, 0x58, 0x73, 0xb9, 0x13, 0x46, 0x52, 0x4b, 0x4a, 0x3d, 0xe9, 0x0e, 0x31,
+	0x72, 0xd4, 0x25, 0x8f, 0xfc, 0xa1, 0xd0, 0xce, 0xc5, 0x91, 0x55, 0xd1, 0x93, 0x10, 0x55, 0x92,
+	0x60, 0x55, 0x54, 0x88, 0x6e, 0xb6, 0xd8, 0xee, 0xcb, 0xbe, 0x34, 0x9f, 0x8d, 0xf8, 0x2b, 0x8d,
+	0xd6, 0xfb, 0x52, 0xf6, 0x47, 0xd8, 0x30, 0xab, 0xf3, 0x71, 0xaf, 0xa1, 0x85, 0x8f, 0x4a, 0x73,
+	0x3f, 0x4c, 0x13, 0x1e, 0x84, 0xa3, 0x71, 0x5f, 0x04, 0x8d, 0xe4, 0x4f, 0x12, 0xb4, 0x3b, 0xb0,
+	0xf7, 0x4c, 0x7a, 0xd8, 0xc1, 0x48, 0x8b, 0x9e, 0x70, 0xb9, 0xc6, 0x33, 0xcd, 0xf5, 0x58, 0x31,
+	0xfc, 0x66, 0x8c, 0x4a, 0xd3, 0x77, 0x61, 0x35, 0x90, 0x1e, 0x76, 0x85, 0x57, 0x25, 0x07, 0xe4,
+	0xd1, 0x7a, 0x1b, 0xa6, 0xd7, 0xf5, 0x95, 0x18, 0x72, 0xf2, 0x94, 0xad, 0xc4, 0xff, 0x3a, 0xf1,
+	0xec, 0xbf, 0x09, 0xec, 0x17, 0xb0, 0xa8, 0x50, 0x06, 0x0a, 0xe9, 0x47, 0xb0, 0xa2, 0x4c, 0xc4,
+	0xb0, 0x54, 0x9a, 0xb6, 0xf3, 0x6a, 0xc7, 0xce, 0x89, 0x52, 0x63, 0x1e, 0xb8, 0x19, 0x36, 0x45,
+	0xd0, 0x16, 0x54, 0xdc, 0x97, 0xc4, 0xd5, 0x92, 0x21, 0xa8, 0xe7, 0x11, 0xcc, 0xd4, 0x67, 0xb3,
+	0x18, 0xfa, 0x04, 0x2a, 0x11, 0x06, 0x78, 0xd9, 0xe5, 0x3d, 0x8d, 0x51, 0x75, 0xd9, 0x50, 0x58,
+	0x4e, 0x32, 0x31, 0x27, 0x9b, 0x98, 0xf3, 0x45, 0x36, 0x31, 0x06, 0x26, 0xbd, 0x15, 0x67, 0xdb,
+	0xff, 0x11, 0xd8, 0x8d, 0xa5, 0xe1, 0xad, 0x16, 0xb3, 0x11, 0x7d, 0x08, 0xe5, 0x48, 0x8e, 0xd0,
+	0x74, 0xb6, 0xd5, 0xdc, 0xcb, 0x13, 0x16, 0x23, 0x99, 0x1c, 0x61, 0xbb, 0x54, 0x25, 0xcc, 0x64,
+	0xd3, 0xb7, 0x61, 0xd9, 0x55, 0x91, 0xe9, 0x66, 0xa3, 0xbd, 0x3a, 0xbd, 0xae, 0x2f, 0x77, 0xce,
+	0x18, 0x8b, 0x63, 0x74, 0x1b, 0xee, 0x69, 0x39, 0xc4, 0xc0, 0xe8, 0x5c, 0x67, 0xc9, 0x82, 0x9e,
+	0xc2, 0x06, 0xbf, 0xe0, 0x62, 0xc4, 0xcf, 0xc5, 0x48, 0xe8, 0x49, 0xb5, 0x6c, 0xca, 0xbd, 0x5f,
+	0x54, 0xee, 0x2c, 0x44, 0xd7, 0x69, 0xcd, 0x00, 0xd8, 0x0d, 0x38, 0x3d, 0x80, 0x0a, 0xd7, 0x3a,
+	0x6e, 0x57, 0x0b, 0x19, 0x54, 0xef, 0xc5, 0x3a, 0xd8, 0x6c, 0xc8, 0xfe, 0x91, 0xc0, 0x5e, 0x7e,
+	0xdf, 0xe9, 0xa6, 0xde, 0xc5, 0x1b, 0xf4, 0x73, 0xb8, 0x6f, 0x92, 0x7c, 0xf4, 0xcf, 0x31, 0x52,
+	0x03, 0x11, 0x9a, 0x9e, 0xb7, 0x9a, 0x0f, 0xe7, 0x2a, 0x3f, 0x7d, 0x91, 0xce, 0xb6, 0x62, 0xfc,
+	0xcb, 0xb5, 0xdd, 0x82, 0xdd, 0x63, 0xd4, 0x4c, 0x4a, 0xdd, 0x69, 0xe5, 0x6c, 0x87, 0x0d, 0x9b,
+	0xa2, 0xd7, 0x0d, 0x64, 0x80, 0x5d, 0x9f, 0x6b, 0x77, 0x90, 0x68, 0x63, 0x15, 0xd1, 0x7b, 0x26,
+	0x03, 0x3c, 0x8d, 0x43, 0xf6, 0x25, 0xec, 0xe5, 0x53, 0xa4, 0x9d, 0x1d, 0xdc, 0xb4, 0x1c, 0x49,
+	0x86, 0x33, 0xeb, 0x28, 0x0a, 0xe5, 0x01, 0x57, 0x03, 0xd3, 0xcb, 0x3a, 0x33, 0xdf, 0xf4, 0x1d,
+	0xd8, 0x08, 0xa4, 0xee, 0xfa, 0xd2, 0x13, 0x3d, 0x81, 0x9e, 0xd9, 0xbe, 0x35, 0x56, 0x09, 0xa4,
+	0x3e, 0x4d, 0x43, 0xf6, 0x0e, 0x3c, 0x38, 0x46, 0xfd, 0x65, 0x30, 0x92, 0xee, 0xf0, 0x33, 0x9c,
+	0xa4, 0x9a, 0xed, 0x08, 0xb6, 0x6f, 0x86, 0x53, 0x1d, 0xfb, 0x00, 0x63, 0x13, 0xec, 0x0e, 0x71,
+	0x92, 0xca, 0x58, 0x1f, 0x67, 0x69, 0xf4, 0x09, 0xac, 0x5e, 0x60, 0xa4, 0xe2, 0xfd, 0x4b, 0x4e,
+	0xc5, 0x6e, 0xde, 0x4c, 0xbf, 0x4a, 0x52, 0xda, 0xe5, 0xab, 0xeb, 0xfa, 0x12, 0xcb, 0x10, 0xf6,
+	0x27, 0x50, 0x3b, 0x46, 0x7d, 0x6b, 0x6f, 0x3b, 0x03, 0x2e, 0x82, 0x85, 0xce, 0xbe, 0x80, 0x7a,
+	0x21, 0xcd, 0x9d, 0xa7, 0xf9, 0x1e, 0x6c, 0x8a, 0x40, 0x63, 0xe4, 0xa3, 0x27, 0xb8, 0x46, 0x95,
+	0x1c, 0x0b, 0x76, 0x33, 0x68, 0x3f, 0x85, 0xfd, 0xb4, 0x54, 0x76, 0x53, 0x7c, 0x2a, 0x94, 0x96,
+	0xd1, 0x64, 0x21, 0xc1, 0x5f, 0x43, 0xad, 0x88, 0x25, 0xd5, 0xfb, 0x31, 0xac, 0x0e, 0x92, 0x50,
+	0x95, 0x1c, 0x2c, 0xbf, 0xee, 0xb6, 0x62, 0xe8, 0xca, 0xc8, 0x63, 0x19, 0xa4, 0xf9, 0x7b, 0x19,
+	0x4a, 0x9d, 0x16, 0xfd, 0x9e, 0xc0, 0x76, 0x9e, 0xc7, 0x68, 0x23, 0x8f, 0x6c, 0x8e, 0xa1, 0xad,
+	0xc3, 0xbb, 0x03, 0x92, 0x06, 0xec, 0xb5, 0x3f, 0x7e, 0xfb, 0xf7, 0x97, 0x52, 0xe9, 0x0d, 0x42,
+	0xbf, 0x85, 0x8d, 0x59, 0x63, 0xd1, 0x87, 0x05, 0x5c, 0xb7, 0x1d, 0x69, 0x3d, 0x7a, 0x7d, 0x62,
+	0x5a, 0x6c, 0xc7, 0x14, 0xbb, 0x0f, 0x9b, 0x26, 0xf3, 0x03, 0x9f, 0x07, 0xbc, 0x8f, 0x11, 0xfd,
+	0x95, 0xc0, 0x5b, 0x05, 0xc6, 0xa0, 0xcd, 0x02, 0xf2, 0x39, 0x66, 0xb4, 0x1e, 0x2f, 0x84, 0x99,
+	0xaf, 0xed, 0x27, 0x02, 0x6f, 0xe6, 0x7b, 0x80, 0x1e, 0xcd, 0x29, 0x93, 0xef, 0x3a, 0xab, 0xb9,
+	0x08, 0x64, 0xae, 0xb0, 0xe6, 0xcf, 0x25, 0x30, 0x76, 0x4d, 0xfd, 0x93, 0x77, 0xfb, 0xe6, 0xfb,
+	0x67, 0xce, 0xfb, 0x64, 0x1d, 0xde, 0x1d, 0xf0, 0x8a, 0x7f, 0x7e, 0x20, 0xb0, 0x93, 0xfb, 0xb2,
+	0xd3, 0xc3, 0xa2, 0xeb, 0xbb, 0xe8, 0xa7, 0x84, 0x75, 0xb4, 0x00, 0xe2, 0xb6, 0x90, 0x76, 0xf5,
+	0xea, 0x79, 0x6d, 0xe9, 0xaf, 0xe7, 0xb5, 0xa5, 0xef, 0xa6, 0x35, 0x72, 0x35, 0xad, 0x91, 0x3f,
+	0xa7, 0x35, 0xf2, 0xcf, 0xb4, 0x46, 0xce, 0x57, 0xcc, 0xf3, 0xfd, 0xf8, 0xff, 0x01, 0x00, 0x03,
+	0xa3, 0x24, 0x2d, 0x4e, 0x09, 0x00, 0x00,
 }
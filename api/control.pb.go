@@ -480,6 +480,44 @@ func (m *UpdateClusterResponse) Reset()                    { *m = UpdateClusterR
 func (*UpdateClusterResponse) ProtoMessage()               {}
 func (*UpdateClusterResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{38} }
 
+type ListJoinTokenInfoRequest struct {
+	ClusterID string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+}
+
+func (m *ListJoinTokenInfoRequest) Reset()      { *m = ListJoinTokenInfoRequest{} }
+func (*ListJoinTokenInfoRequest) ProtoMessage() {}
+func (*ListJoinTokenInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorControl, []int{39}
+}
+
+// JoinTokenInfo identifies a join token without revealing it. Join tokens
+// have no TTL and are not single-use: they admit any number of nodes until
+// they are rotated, so there is no expiry or usage state to report.
+type JoinTokenInfo struct {
+	// Role is the role of the nodes the token lets join the cluster.
+	Role NodeRole `protobuf:"varint,1,opt,name=role,proto3,enum=docker.swarmkit.v1.NodeRole" json:"role,omitempty"`
+	// CACertHash is the digest of the root CA certificate embedded in the
+	// token.
+	CACertHash string `protobuf:"bytes,2,opt,name=ca_cert_hash,json=caCertHash,proto3" json:"ca_cert_hash,omitempty"`
+	// Fingerprint is a digest of the token's secret, which can be compared
+	// with the fingerprint of a known token but not reversed.
+	Fingerprint string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+}
+
+func (m *JoinTokenInfo) Reset()                    { *m = JoinTokenInfo{} }
+func (*JoinTokenInfo) ProtoMessage()               {}
+func (*JoinTokenInfo) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{40} }
+
+type ListJoinTokenInfoResponse struct {
+	Tokens []*JoinTokenInfo `protobuf:"bytes,1,rep,name=tokens" json:"tokens,omitempty"`
+}
+
+func (m *ListJoinTokenInfoResponse) Reset()      { *m = ListJoinTokenInfoResponse{} }
+func (*ListJoinTokenInfoResponse) ProtoMessage() {}
+func (*ListJoinTokenInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorControl, []int{41}
+}
+
 // GetSecretRequest is the request to get a `Secret` object given a secret id.
 type GetSecretRequest struct {
 	SecretID string `protobuf:"bytes,1,opt,name=secret_id,json=secretId,proto3" json:"secret_id,omitempty"`
@@ -487,7 +525,7 @@ type GetSecretRequest struct {
 
 func (m *GetSecretRequest) Reset()                    { *m = GetSecretRequest{} }
 func (*GetSecretRequest) ProtoMessage()               {}
-func (*GetSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{39} }
+func (*GetSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{42} }
 
 // GetSecretResponse contains the Secret corresponding to the id in
 // `GetSecretRequest`, but the `Secret.Spec.Data` field in each `Secret`
@@ -498,7 +536,7 @@ type GetSecretResponse struct {
 
 func (m *GetSecretResponse) Reset()                    { *m = GetSecretResponse{} }
 func (*GetSecretResponse) ProtoMessage()               {}
-func (*GetSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{40} }
+func (*GetSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{43} }
 
 type UpdateSecretRequest struct {
 	// SecretID is the secret ID to update.
@@ -512,7 +550,7 @@ type UpdateSecretRequest struct {
 
 func (m *UpdateSecretRequest) Reset()                    { *m = UpdateSecretRequest{} }
 func (*UpdateSecretRequest) ProtoMessage()               {}
-func (*UpdateSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{41} }
+func (*UpdateSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{44} }
 
 type UpdateSecretResponse struct {
 	Secret *Secret `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
@@ -520,7 +558,7 @@ type UpdateSecretResponse struct {
 
 func (m *UpdateSecretResponse) Reset()                    { *m = UpdateSecretResponse{} }
 func (*UpdateSecretResponse) ProtoMessage()               {}
-func (*UpdateSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{42} }
+func (*UpdateSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{45} }
 
 // ListSecretRequest is the request to list all non-internal secrets in the secret store,
 // or all secrets filtered by (name or name prefix or id prefix) and labels.
@@ -530,7 +568,7 @@ type ListSecretsRequest struct {
 
 func (m *ListSecretsRequest) Reset()                    { *m = ListSecretsRequest{} }
 func (*ListSecretsRequest) ProtoMessage()               {}
-func (*ListSecretsRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{43} }
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{46} }
 
 type ListSecretsRequest_Filters struct {
 	Names        []string          `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
@@ -542,7 +580,7 @@ type ListSecretsRequest_Filters struct {
 func (m *ListSecretsRequest_Filters) Reset()      { *m = ListSecretsRequest_Filters{} }
 func (*ListSecretsRequest_Filters) ProtoMessage() {}
 func (*ListSecretsRequest_Filters) Descriptor() ([]byte, []int) {
-	return fileDescriptorControl, []int{43, 0}
+	return fileDescriptorControl, []int{46, 0}
 }
 
 // ListSecretResponse contains a list of all the secrets that match the name or
@@ -555,7 +593,7 @@ type ListSecretsResponse struct {
 
 func (m *ListSecretsResponse) Reset()                    { *m = ListSecretsResponse{} }
 func (*ListSecretsResponse) ProtoMessage()               {}
-func (*ListSecretsResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{44} }
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{47} }
 
 // CreateSecretRequest specifies a new secret (it will not update an existing
 // secret) to create.
@@ -565,7 +603,7 @@ type CreateSecretRequest struct {
 
 func (m *CreateSecretRequest) Reset()                    { *m = CreateSecretRequest{} }
 func (*CreateSecretRequest) ProtoMessage()               {}
-func (*CreateSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{45} }
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{48} }
 
 // CreateSecretResponse contains the newly created `Secret` corresponding to the
 // name in `CreateSecretRequest`.  The `Secret.Spec.Data` field should be nil instead
@@ -576,7 +614,7 @@ type CreateSecretResponse struct {
 
 func (m *CreateSecretResponse) Reset()                    { *m = CreateSecretResponse{} }
 func (*CreateSecretResponse) ProtoMessage()               {}
-func (*CreateSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{46} }
+func (*CreateSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{49} }
 
 // RemoveSecretRequest contains the ID of the secret that should be removed.  This
 // removes all versions of the secret.
@@ -586,7 +624,7 @@ type RemoveSecretRequest struct {
 
 func (m *RemoveSecretRequest) Reset()                    { *m = RemoveSecretRequest{} }
 func (*RemoveSecretRequest) ProtoMessage()               {}
-func (*RemoveSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{47} }
+func (*RemoveSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{50} }
 
 // RemoveSecretResponse is an empty object indicating the successful removal of
 // a secret.
@@ -595,7 +633,7 @@ type RemoveSecretResponse struct {
 
 func (m *RemoveSecretResponse) Reset()                    { *m = RemoveSecretResponse{} }
 func (*RemoveSecretResponse) ProtoMessage()               {}
-func (*RemoveSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{48} }
+func (*RemoveSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{51} }
 
 // GetConfigRequest is the request to get a `Config` object given a config id.
 type GetConfigRequest struct {
@@ -604,7 +642,7 @@ type GetConfigRequest struct {
 
 func (m *GetConfigRequest) Reset()                    { *m = GetConfigRequest{} }
 func (*GetConfigRequest) ProtoMessage()               {}
-func (*GetConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{49} }
+func (*GetConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{52} }
 
 // GetConfigResponse contains the Config corresponding to the id in
 // `GetConfigRequest`.
@@ -614,7 +652,7 @@ type GetConfigResponse struct {
 
 func (m *GetConfigResponse) Reset()                    { *m = GetConfigResponse{} }
 func (*GetConfigResponse) ProtoMessage()               {}
-func (*GetConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{50} }
+func (*GetConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{53} }
 
 type UpdateConfigRequest struct {
 	// ConfigID is the config ID to update.
@@ -628,7 +666,7 @@ type UpdateConfigRequest struct {
 
 func (m *UpdateConfigRequest) Reset()                    { *m = UpdateConfigRequest{} }
 func (*UpdateConfigRequest) ProtoMessage()               {}
-func (*UpdateConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{51} }
+func (*UpdateConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{54} }
 
 type UpdateConfigResponse struct {
 	Config *Config `protobuf:"bytes,1,opt,name=config" json:"config,omitempty"`
@@ -636,7 +674,7 @@ type UpdateConfigResponse struct {
 
 func (m *UpdateConfigResponse) Reset()                    { *m = UpdateConfigResponse{} }
 func (*UpdateConfigResponse) ProtoMessage()               {}
-func (*UpdateConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{52} }
+func (*UpdateConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{55} }
 
 // ListConfigRequest is the request to list all configs in the config store,
 // or all configs filtered by (name or name prefix or id prefix) and labels.
@@ -646,7 +684,7 @@ type ListConfigsRequest struct {
 
 func (m *ListConfigsRequest) Reset()                    { *m = ListConfigsRequest{} }
 func (*ListConfigsRequest) ProtoMessage()               {}
-func (*ListConfigsRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{53} }
+func (*ListConfigsRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{56} }
 
 type ListConfigsRequest_Filters struct {
 	Names        []string          `protobuf:"bytes,1,rep,name=names" json:"names,omitempty"`
@@ -658,7 +696,7 @@ type ListConfigsRequest_Filters struct {
 func (m *ListConfigsRequest_Filters) Reset()      { *m = ListConfigsRequest_Filters{} }
 func (*ListConfigsRequest_Filters) ProtoMessage() {}
 func (*ListConfigsRequest_Filters) Descriptor() ([]byte, []int) {
-	return fileDescriptorControl, []int{53, 0}
+	return fileDescriptorControl, []int{56, 0}
 }
 
 // ListConfigResponse contains a list of all the configs that match the name or
@@ -669,7 +707,7 @@ type ListConfigsResponse struct {
 
 func (m *ListConfigsResponse) Reset()                    { *m = ListConfigsResponse{} }
 func (*ListConfigsResponse) ProtoMessage()               {}
-func (*ListConfigsResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{54} }
+func (*ListConfigsResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{57} }
 
 // CreateConfigRequest specifies a new config (it will not update an existing
 // config) to create.
@@ -679,7 +717,7 @@ type CreateConfigRequest struct {
 
 func (m *CreateConfigRequest) Reset()                    { *m = CreateConfigRequest{} }
 func (*CreateConfigRequest) ProtoMessage()               {}
-func (*CreateConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{55} }
+func (*CreateConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{58} }
 
 // CreateConfigResponse contains the newly created `Config` corresponding to the
 // name in `CreateConfigRequest`.
@@ -689,7 +727,7 @@ type CreateConfigResponse struct {
 
 func (m *CreateConfigResponse) Reset()                    { *m = CreateConfigResponse{} }
 func (*CreateConfigResponse) ProtoMessage()               {}
-func (*CreateConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{56} }
+func (*CreateConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{59} }
 
 // RemoveConfigRequest contains the ID of the config that should be removed.  This
 // removes all versions of the config.
@@ -699,7 +737,7 @@ type RemoveConfigRequest struct {
 
 func (m *RemoveConfigRequest) Reset()                    { *m = RemoveConfigRequest{} }
 func (*RemoveConfigRequest) ProtoMessage()               {}
-func (*RemoveConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{57} }
+func (*RemoveConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{60} }
 
 // RemoveConfigResponse is an empty object indicating the successful removal of
 // a config.
@@ -708,7 +746,7 @@ type RemoveConfigResponse struct {
 
 func (m *RemoveConfigResponse) Reset()                    { *m = RemoveConfigResponse{} }
 func (*RemoveConfigResponse) ProtoMessage()               {}
-func (*RemoveConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{58} }
+func (*RemoveConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorControl, []int{61} }
 
 func init() {
 	proto.RegisterType((*GetNodeRequest)(nil), "docker.swarmkit.v1.GetNodeRequest")
@@ -755,6 +793,9 @@ func init() {
 	proto.RegisterType((*KeyRotation)(nil), "docker.swarmkit.v1.KeyRotation")
 	proto.RegisterType((*UpdateClusterRequest)(nil), "docker.swarmkit.v1.UpdateClusterRequest")
 	proto.RegisterType((*UpdateClusterResponse)(nil), "docker.swarmkit.v1.UpdateClusterResponse")
+	proto.RegisterType((*ListJoinTokenInfoRequest)(nil), "docker.swarmkit.v1.ListJoinTokenInfoRequest")
+	proto.RegisterType((*JoinTokenInfo)(nil), "docker.swarmkit.v1.JoinTokenInfo")
+	proto.RegisterType((*ListJoinTokenInfoResponse)(nil), "docker.swarmkit.v1.ListJoinTokenInfoResponse")
 	proto.RegisterType((*GetSecretRequest)(nil), "docker.swarmkit.v1.GetSecretRequest")
 	proto.RegisterType((*GetSecretResponse)(nil), "docker.swarmkit.v1.GetSecretResponse")
 	proto.RegisterType((*UpdateSecretRequest)(nil), "docker.swarmkit.v1.UpdateSecretRequest")
@@ -944,6 +985,14 @@ func (p *authenticatedWrapperControlServer) UpdateCluster(ctx context.Context, r
 	return p.local.UpdateCluster(ctx, r)
 }
 
+func (p *authenticatedWrapperControlServer) ListJoinTokenInfo(ctx context.Context, r *ListJoinTokenInfoRequest) (*ListJoinTokenInfoResponse, error) {
+
+	if err := p.authorize(ctx, []string{"swarm-manager"}); err != nil {
+		return nil, err
+	}
+	return p.local.ListJoinTokenInfo(ctx, r)
+}
+
 func (p *authenticatedWrapperControlServer) GetSecret(ctx context.Context, r *GetSecretRequest) (*GetSecretResponse, error) {
 
 	if err := p.authorize(ctx, []string{"swarm-manager"}); err != nil {
@@ -1942,6 +1991,59 @@ func (m *UpdateClusterResponse) CopyFrom(src interface{}) {
 	}
 }
 
+func (m *ListJoinTokenInfoRequest) Copy() *ListJoinTokenInfoRequest {
+	if m == nil {
+		return nil
+	}
+	o := &ListJoinTokenInfoRequest{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *ListJoinTokenInfoRequest) CopyFrom(src interface{}) {
+
+	o := src.(*ListJoinTokenInfoRequest)
+	*m = *o
+}
+
+func (m *JoinTokenInfo) Copy() *JoinTokenInfo {
+	if m == nil {
+		return nil
+	}
+	o := &JoinTokenInfo{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *JoinTokenInfo) CopyFrom(src interface{}) {
+
+	o := src.(*JoinTokenInfo)
+	*m = *o
+}
+
+func (m *ListJoinTokenInfoResponse) Copy() *ListJoinTokenInfoResponse {
+	if m == nil {
+		return nil
+	}
+	o := &ListJoinTokenInfoResponse{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *ListJoinTokenInfoResponse) CopyFrom(src interface{}) {
+
+	o := src.(*ListJoinTokenInfoResponse)
+	*m = *o
+	if o.Tokens != nil {
+		m.Tokens = make([]*JoinTokenInfo, len(o.Tokens))
+		for i := range m.Tokens {
+			m.Tokens[i] = &JoinTokenInfo{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.Tokens[i], o.Tokens[i])
+		}
+	}
+
+}
+
 func (m *GetSecretRequest) Copy() *GetSecretRequest {
 	if m == nil {
 		return nil
@@ -2409,6 +2511,11 @@ type ControlClient interface {
 	GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*GetClusterResponse, error)
 	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
 	UpdateCluster(ctx context.Context, in *UpdateClusterRequest, opts ...grpc.CallOption) (*UpdateClusterResponse, error)
+	// ListJoinTokenInfo returns information identifying the cluster's
+	// current join tokens, without revealing the tokens themselves.
+	// - Returns `NotFound` if the Cluster is not found.
+	// - Returns `InvalidArgument` if the `ListJoinTokenInfoRequest.ClusterID` is empty.
+	ListJoinTokenInfo(ctx context.Context, in *ListJoinTokenInfoRequest, opts ...grpc.CallOption) (*ListJoinTokenInfoResponse, error)
 	// GetSecret returns a `GetSecretResponse` with a `Secret` with the same
 	// id as `GetSecretRequest.SecretID`
 	// - Returns `NotFound` if the Secret with the given id is not found.
@@ -2648,6 +2755,15 @@ func (c *controlClient) UpdateCluster(ctx context.Context, in *UpdateClusterRequ
 	return out, nil
 }
 
+func (c *controlClient) ListJoinTokenInfo(ctx context.Context, in *ListJoinTokenInfoRequest, opts ...grpc.CallOption) (*ListJoinTokenInfoResponse, error) {
+	out := new(ListJoinTokenInfoResponse)
+	err := grpc.Invoke(ctx, "/docker.swarmkit.v1.Control/ListJoinTokenInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
 	out := new(GetSecretResponse)
 	err := grpc.Invoke(ctx, "/docker.swarmkit.v1.Control/GetSecret", in, out, c.cc, opts...)
@@ -2760,6 +2876,11 @@ type ControlServer interface {
 	GetCluster(context.Context, *GetClusterRequest) (*GetClusterResponse, error)
 	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
 	UpdateCluster(context.Context, *UpdateClusterRequest) (*UpdateClusterResponse, error)
+	// ListJoinTokenInfo returns information identifying the cluster's
+	// current join tokens, without revealing the tokens themselves.
+	// - Returns `NotFound` if the Cluster is not found.
+	// - Returns `InvalidArgument` if the `ListJoinTokenInfoRequest.ClusterID` is empty.
+	ListJoinTokenInfo(context.Context, *ListJoinTokenInfoRequest) (*ListJoinTokenInfoResponse, error)
 	// GetSecret returns a `GetSecretResponse` with a `Secret` with the same
 	// id as `GetSecretRequest.SecretID`
 	// - Returns `NotFound` if the Secret with the given id is not found.
@@ -3166,6 +3287,24 @@ func _Control_UpdateCluster_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_ListJoinTokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinTokenInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ListJoinTokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/docker.swarmkit.v1.Control/ListJoinTokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListJoinTokenInfo(ctx, req.(*ListJoinTokenInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSecretRequest)
 	if err := dec(in); err != nil {
@@ -3426,6 +3565,10 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCluster",
 			Handler:    _Control_UpdateCluster_Handler,
 		},
+		{
+			MethodName: "ListJoinTokenInfo",
+			Handler:    _Control_ListJoinTokenInfo_Handler,
+		},
 		{
 			MethodName: "GetSecret",
 			Handler:    _Control_GetSecret_Handler,
@@ -5131,6 +5274,95 @@ func (m *UpdateClusterResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ListJoinTokenInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJoinTokenInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.ClusterID)))
+		i += copy(dAtA[i:], m.ClusterID)
+	}
+	return i, nil
+}
+
+func (m *JoinTokenInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinTokenInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(m.Role))
+	}
+	if len(m.CACertHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.CACertHash)))
+		i += copy(dAtA[i:], m.CACertHash)
+	}
+	if len(m.Fingerprint) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintControl(dAtA, i, uint64(len(m.Fingerprint)))
+		i += copy(dAtA[i:], m.Fingerprint)
+	}
+	return i, nil
+}
+
+func (m *ListJoinTokenInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListJoinTokenInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintControl(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *GetSecretRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6616,6 +6848,41 @@ func (p *raftProxyControlServer) UpdateCluster(ctx context.Context, r *UpdateClu
 	return resp, err
 }
 
+func (p *raftProxyControlServer) ListJoinTokenInfo(ctx context.Context, r *ListJoinTokenInfoRequest) (*ListJoinTokenInfoResponse, error) {
+
+	conn, err := p.connSelector.LeaderConn(ctx)
+	if err != nil {
+		if err == raftselector.ErrIsLeader {
+			ctx, err = p.runCtxMods(ctx, p.localCtxMods)
+			if err != nil {
+				return nil, err
+			}
+			return p.local.ListJoinTokenInfo(ctx, r)
+		}
+		return nil, err
+	}
+	modCtx, err := p.runCtxMods(ctx, p.remoteCtxMods)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := NewControlClient(conn).ListJoinTokenInfo(modCtx, r)
+	if err != nil {
+		if !strings.Contains(err.Error(), "is closing") && !strings.Contains(err.Error(), "the connection is unavailable") && !strings.Contains(err.Error(), "connection error") {
+			return resp, err
+		}
+		conn, err := p.pollNewLeaderConn(ctx)
+		if err != nil {
+			if err == raftselector.ErrIsLeader {
+				return p.local.ListJoinTokenInfo(ctx, r)
+			}
+			return nil, err
+		}
+		return NewControlClient(conn).ListJoinTokenInfo(modCtx, r)
+	}
+	return resp, err
+}
+
 func (p *raftProxyControlServer) GetSecret(ctx context.Context, r *GetSecretRequest) (*GetSecretResponse, error) {
 
 	conn, err := p.connSelector.LeaderConn(ctx)
@@ -7606,6 +7873,45 @@ func (m *UpdateClusterResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListJoinTokenInfoRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ClusterID)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *JoinTokenInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovControl(uint64(m.Role))
+	}
+	l = len(m.CACertHash)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovControl(uint64(l))
+	}
+	return n
+}
+
+func (m *ListJoinTokenInfoResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovControl(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GetSecretRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -8418,6 +8724,38 @@ func (this *UpdateClusterResponse) String() string {
 	}, "")
 	return s
 }
+func (this *ListJoinTokenInfoRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListJoinTokenInfoRequest{`,
+		`ClusterID:` + fmt.Sprintf("%v", this.ClusterID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JoinTokenInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JoinTokenInfo{`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`CACertHash:` + fmt.Sprintf("%v", this.CACertHash) + `,`,
+		`Fingerprint:` + fmt.Sprintf("%v", this.Fingerprint) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListJoinTokenInfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListJoinTokenInfoResponse{`,
+		`Tokens:` + strings.Replace(fmt.Sprintf("%v", this.Tokens), "JoinTokenInfo", "JoinTokenInfo", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GetSecretRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -13680,6 +14018,293 @@ func (m *UpdateClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListJoinTokenInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJoinTokenInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJoinTokenInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JoinTokenInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinTokenInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinTokenInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= (NodeRole(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACertHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListJoinTokenInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListJoinTokenInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListJoinTokenInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthControl
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &JoinTokenInfo{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetSecretRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15992,136 +16617,143 @@ var (
 func init() { proto.RegisterFile("control.proto", fileDescriptorControl) }
 
 var fileDescriptorControl = []byte{
-	// 2096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x1b, 0xc9,
-	0x11, 0x36, 0x1f, 0x12, 0xa9, 0xa2, 0x44, 0x49, 0x2d, 0x39, 0x21, 0x68, 0x47, 0x32, 0xc6, 0xb1,
-	0x4d, 0x07, 0x0e, 0x95, 0xa5, 0xb3, 0x88, 0xb3, 0x41, 0x1e, 0x2b, 0xd1, 0xeb, 0x70, 0xb5, 0x2b,
-	0x1b, 0x23, 0x6b, 0x91, 0x1b, 0x41, 0x91, 0x2d, 0x65, 0x4c, 0x8a, 0xc3, 0xcc, 0x0c, 0xb5, 0x2b,
-	0xe4, 0x92, 0x0d, 0x36, 0x3f, 0x21, 0x40, 0xae, 0xb9, 0xe6, 0x90, 0x43, 0x4e, 0xfb, 0x13, 0x8c,
-	0x9c, 0x72, 0x0c, 0x10, 0x40, 0xc8, 0x12, 0x08, 0x90, 0x53, 0x7e, 0x43, 0xd0, 0xdd, 0xd5, 0xf3,
-	0x62, 0xcf, 0x0c, 0x5f, 0x80, 0x7c, 0x12, 0xa7, 0xe7, 0xab, 0xae, 0xea, 0xae, 0xaf, 0xbf, 0xe9,
-	0xae, 0x16, 0xac, 0xb5, 0xcd, 0xbe, 0x63, 0x99, 0xbd, 0xea, 0xc0, 0x32, 0x1d, 0x93, 0x90, 0x8e,
-	0xd9, 0xee, 0x52, 0xab, 0x6a, 0x7f, 0xde, 0xb2, 0x2e, 0xba, 0x86, 0x53, 0xbd, 0x7c, 0xaf, 0x5c,
-	0xb0, 0x07, 0xb4, 0x6d, 0x0b, 0x40, 0x79, 0xcd, 0x3c, 0x7d, 0x43, 0xdb, 0x8e, 0x7c, 0x2c, 0x38,
-	0x57, 0x03, 0x2a, 0x1f, 0xb6, 0xcf, 0xcd, 0x73, 0x93, 0xff, 0xdc, 0x63, 0xbf, 0xb0, 0x75, 0x6b,
-	0xd0, 0x1b, 0x9e, 0x1b, 0xfd, 0x3d, 0xf1, 0x47, 0x34, 0x6a, 0xef, 0x43, 0xf1, 0x05, 0x75, 0x8e,
-	0xcc, 0x0e, 0xd5, 0xe9, 0x6f, 0x86, 0xd4, 0x76, 0xc8, 0x7d, 0xc8, 0xf5, 0xcd, 0x0e, 0x6d, 0x1a,
-	0x9d, 0x52, 0xea, 0x5e, 0xaa, 0xb2, 0xb2, 0x0f, 0xa3, 0xeb, 0xdd, 0x65, 0x86, 0x68, 0xd4, 0xf5,
-	0x65, 0xf6, 0xaa, 0xd1, 0xd1, 0x7e, 0x0e, 0xeb, 0xae, 0x99, 0x3d, 0x30, 0xfb, 0x36, 0x25, 0x4f,
-	0x20, 0xcb, 0x5e, 0x72, 0xa3, 0x42, 0xad, 0x54, 0x1d, 0x1f, 0x40, 0x95, 0xe3, 0x39, 0x4a, 0xbb,
-	0xce, 0xc0, 0xc6, 0x27, 0x86, 0xcd, 0xbb, 0xb0, 0xa5, 0xeb, 0x8f, 0x20, 0x77, 0x66, 0xf4, 0x1c,
-	0x6a, 0xd9, 0xd8, 0xcb, 0x13, 0x55, 0x2f, 0x61, 0xb3, 0xea, 0x47, 0xc2, 0x46, 0x97, 0xc6, 0xe5,
-	0x2f, 0x33, 0x90, 0xc3, 0x46, 0xb2, 0x0d, 0x4b, 0xfd, 0xd6, 0x05, 0x65, 0x3d, 0x66, 0x2a, 0x2b,
-	0xba, 0x78, 0x20, 0x7b, 0x50, 0x30, 0x3a, 0xcd, 0x81, 0x45, 0xcf, 0x8c, 0x2f, 0xa8, 0x5d, 0x4a,
-	0xb3, 0x77, 0xfb, 0xc5, 0xd1, 0xf5, 0x2e, 0x34, 0xea, 0xaf, 0xb0, 0x55, 0x07, 0xa3, 0x23, 0x7f,
-	0x93, 0x57, 0xb0, 0xdc, 0x6b, 0x9d, 0xd2, 0x9e, 0x5d, 0xca, 0xdc, 0xcb, 0x54, 0x0a, 0xb5, 0x67,
-	0xd3, 0x44, 0x56, 0xfd, 0x84, 0x9b, 0x3e, 0xef, 0x3b, 0xd6, 0x95, 0x8e, 0xfd, 0x90, 0x06, 0x14,
-	0x2e, 0xe8, 0xc5, 0x29, 0xb5, 0xec, 0x5f, 0x1b, 0x03, 0xbb, 0x94, 0xbd, 0x97, 0xa9, 0x14, 0x6b,
-	0x8f, 0xa2, 0xa6, 0xed, 0x78, 0x40, 0xdb, 0xd5, 0x4f, 0x5d, 0xbc, 0xee, 0xb7, 0x25, 0x35, 0x58,
-	0xb2, 0xcc, 0x1e, 0xb5, 0x4b, 0x4b, 0xbc, 0x93, 0xbb, 0x91, 0x73, 0x6f, 0xf6, 0xa8, 0x2e, 0xa0,
-	0xe4, 0x3e, 0xac, 0xb1, 0xa9, 0xf0, 0xe6, 0x60, 0x99, 0xcf, 0xcf, 0x2a, 0x6b, 0x94, 0xa3, 0x2e,
-	0xff, 0x18, 0x0a, 0xbe, 0xd0, 0xc9, 0x06, 0x64, 0xba, 0xf4, 0x4a, 0xd0, 0x42, 0x67, 0x3f, 0xd9,
-	0xec, 0x5e, 0xb6, 0x7a, 0x43, 0x5a, 0x4a, 0xf3, 0x36, 0xf1, 0xf0, 0x41, 0xfa, 0x59, 0x4a, 0x3b,
-	0x80, 0x4d, 0xdf, 0x74, 0x20, 0x47, 0xaa, 0xb0, 0xc4, 0xb2, 0x2f, 0x92, 0x11, 0x47, 0x12, 0x01,
-	0xd3, 0xfe, 0x92, 0x82, 0xcd, 0x93, 0x41, 0xa7, 0xe5, 0xd0, 0x69, 0x19, 0x4a, 0x7e, 0x06, 0xab,
-	0x1c, 0x74, 0x49, 0x2d, 0xdb, 0x30, 0xfb, 0x3c, 0xc0, 0x42, 0xed, 0x8e, 0xca, 0xe3, 0x67, 0x02,
-	0xa2, 0x17, 0x98, 0x01, 0x3e, 0x90, 0x1f, 0x40, 0x96, 0x2d, 0xb7, 0x52, 0x86, 0xdb, 0xdd, 0x8d,
-	0xcb, 0x8b, 0xce, 0x91, 0xda, 0x3e, 0x10, 0x7f, 0xac, 0x33, 0x2d, 0x8b, 0x23, 0xd8, 0xd4, 0xe9,
-	0x85, 0x79, 0x39, 0xfd, 0x78, 0xb7, 0x61, 0xe9, 0xcc, 0xb4, 0xda, 0x22, 0x13, 0x79, 0x5d, 0x3c,
-	0x68, 0xdb, 0x40, 0xfc, 0xfd, 0x89, 0x98, 0x70, 0xd1, 0xbf, 0x6e, 0xd9, 0x5d, 0x9f, 0x0b, 0xa7,
-	0x65, 0x77, 0x43, 0x2e, 0x18, 0x82, 0xb9, 0x60, 0xaf, 0xdc, 0x45, 0x2f, 0xcc, 0xbc, 0xd1, 0xb1,
-	0x97, 0x71, 0xa3, 0xe3, 0x78, 0x8e, 0xd2, 0x9e, 0xc9, 0xd1, 0x4d, 0xed, 0xda, 0x1d, 0x87, 0xdf,
-	0xbb, 0xf6, 0x75, 0x56, 0x88, 0x08, 0x6b, 0x9c, 0x41, 0x44, 0xfc, 0x66, 0xe3, 0x22, 0xf2, 0xaf,
-	0x1b, 0x14, 0x11, 0x55, 0x64, 0x4a, 0x11, 0xd9, 0x83, 0x82, 0x4d, 0xad, 0x4b, 0xa3, 0xcd, 0xd8,
-	0x21, 0x44, 0x04, 0x43, 0x38, 0x16, 0xcd, 0x8d, 0xba, 0xad, 0x03, 0x42, 0x1a, 0x1d, 0x9b, 0x3c,
-	0x84, 0x3c, 0x72, 0x49, 0xa8, 0xc5, 0xca, 0x7e, 0x61, 0x74, 0xbd, 0x9b, 0x13, 0x64, 0xb2, 0xf5,
-	0x9c, 0x60, 0x93, 0x4d, 0xea, 0x50, 0xec, 0x50, 0xdb, 0xb0, 0x68, 0xa7, 0x69, 0x3b, 0x2d, 0x07,
-	0xf5, 0xa1, 0x58, 0xfb, 0x4e, 0x54, 0x8a, 0x8f, 0x19, 0x4a, 0x5f, 0x43, 0x23, 0xfe, 0xa4, 0x10,
-	0x99, 0xdc, 0xb8, 0xc8, 0x90, 0xbb, 0x00, 0xc3, 0x41, 0xd3, 0x31, 0x9b, 0x6c, 0xed, 0x94, 0xf2,
-	0x9c, 0xbe, 0xf9, 0xe1, 0xe0, 0xb5, 0x59, 0x6f, 0x39, 0x94, 0x94, 0x21, 0x6f, 0x0d, 0xfb, 0x8e,
-	0xc1, 0x66, 0x7f, 0x85, 0x5b, 0xbb, 0xcf, 0x0b, 0x90, 0x27, 0x9c, 0x68, 0x4f, 0x9e, 0x18, 0xdf,
-	0x62, 0xe5, 0x89, 0x13, 0x50, 0xc0, 0xb4, 0x43, 0xd8, 0x3e, 0xb0, 0x68, 0xcb, 0xa1, 0x38, 0xd9,
-	0x92, 0x82, 0x4f, 0x51, 0x3b, 0x04, 0xff, 0x76, 0x55, 0xdd, 0xa0, 0x85, 0x4f, 0x3e, 0x8e, 0xe0,
-	0x76, 0xa8, 0x33, 0x8c, 0xea, 0x7d, 0xc8, 0x61, 0x02, 0xb1, 0xc3, 0x3b, 0x31, 0x1d, 0xea, 0x12,
-	0xab, 0xbd, 0x81, 0xcd, 0x17, 0xd4, 0x09, 0x45, 0xf6, 0x04, 0xc0, 0xe3, 0x0b, 0xae, 0xb7, 0xb5,
-	0xd1, 0xf5, 0xee, 0x8a, 0x4b, 0x17, 0x7d, 0xc5, 0x65, 0x0b, 0x79, 0x04, 0xeb, 0x46, 0xdf, 0xa6,
-	0x96, 0xd3, 0xec, 0xd0, 0xb3, 0xd6, 0xb0, 0xe7, 0xd8, 0xa8, 0x2e, 0x45, 0xd1, 0x5c, 0xc7, 0x56,
-	0xed, 0x10, 0x88, 0xdf, 0xd7, 0x7c, 0x81, 0xff, 0x2d, 0x0d, 0xdb, 0x42, 0x48, 0xe7, 0x0a, 0xbe,
-	0x0e, 0xeb, 0x12, 0x3d, 0xc5, 0x37, 0xa0, 0x88, 0x36, 0xf2, 0x33, 0xf0, 0x34, 0xf0, 0x19, 0x98,
-	0x2c, 0x95, 0xe4, 0x53, 0xc8, 0x5b, 0x66, 0xaf, 0x77, 0xda, 0x6a, 0x77, 0x4b, 0xd9, 0x7b, 0xa9,
-	0x4a, 0xb1, 0xf6, 0x9e, 0xca, 0x50, 0x35, 0xc8, 0xaa, 0x8e, 0x86, 0xba, 0xdb, 0x85, 0xa6, 0x41,
-	0x5e, 0xb6, 0x92, 0x3c, 0x64, 0x8f, 0x5e, 0x1e, 0x3d, 0xdf, 0xb8, 0x45, 0x56, 0x21, 0xff, 0x4a,
-	0x7f, 0xfe, 0x59, 0xe3, 0xe5, 0xc9, 0xf1, 0x46, 0x8a, 0xb1, 0x27, 0xd4, 0xdd, 0x7c, 0x49, 0xa8,
-	0xc3, 0xb6, 0x10, 0xdc, 0x79, 0x72, 0xa0, 0x7d, 0x1b, 0x6e, 0x87, 0x7a, 0x41, 0xe5, 0xfe, 0x2a,
-	0x03, 0x5b, 0x6c, 0xfd, 0x61, 0xbb, 0x2b, 0xde, 0x8d, 0xb0, 0x78, 0xef, 0x45, 0x49, 0x64, 0xc8,
-	0x72, 0x5c, 0xbf, 0xff, 0x9c, 0x5e, 0xb8, 0x7e, 0x1f, 0x87, 0xf4, 0xfb, 0x27, 0x53, 0x06, 0xa7,
-	0x94, 0xf0, 0x31, 0x8d, 0xcc, 0x2a, 0x34, 0xd2, 0xaf, 0x82, 0x4b, 0x8b, 0x53, 0xc1, 0x97, 0xb0,
-	0x1d, 0x0c, 0x17, 0x49, 0xf3, 0x23, 0xc8, 0x63, 0x12, 0xa5, 0x16, 0xc6, 0xb2, 0xc6, 0x05, 0x7b,
-	0x8a, 0x78, 0x44, 0x9d, 0xcf, 0x4d, 0xab, 0x3b, 0x85, 0x22, 0xa2, 0x85, 0x4a, 0x11, 0xdd, 0xce,
-	0x3c, 0x4e, 0xf7, 0x45, 0x53, 0x1c, 0xa7, 0xa5, 0x95, 0xc4, 0x6a, 0x27, 0x5c, 0x11, 0x43, 0x91,
-	0x11, 0xc8, 0xb2, 0x99, 0xc6, 0xf9, 0xe2, 0xbf, 0x19, 0xc9, 0xd1, 0x86, 0x91, 0x3c, 0xed, 0x91,
-	0x1c, 0x6d, 0x19, 0xc9, 0x11, 0xd0, 0xe8, 0xa0, 0xf8, 0x2d, 0x28, 0xc6, 0x5f, 0xc9, 0x75, 0xb7,
-	0xf0, 0x30, 0xdd, 0xb5, 0x18, 0x8a, 0x54, 0xfb, 0x6f, 0x5a, 0xac, 0x45, 0x6c, 0x9f, 0x61, 0x2d,
-	0x86, 0x2c, 0xc7, 0xd7, 0xe2, 0x1f, 0x6e, 0x70, 0x2d, 0x46, 0x04, 0x37, 0xf3, 0x5a, 0x5c, 0xc0,
-	0x7a, 0xf3, 0x42, 0xf2, 0xd6, 0x1b, 0x26, 0x2a, 0x76, 0xbd, 0xc9, 0xcc, 0xb9, 0x60, 0xed, 0x43,
-	0x4e, 0xe9, 0x83, 0xde, 0xd0, 0x76, 0xa8, 0xe5, 0xd3, 0xe8, 0xb6, 0x68, 0x09, 0x69, 0x34, 0xe2,
-	0x18, 0x2f, 0x10, 0xe0, 0xd2, 0xd7, 0xed, 0xc2, 0xa3, 0x2f, 0x42, 0xe2, 0xe8, 0x2b, 0xad, 0x24,
-	0xd6, 0xe5, 0x12, 0xbe, 0x98, 0x81, 0x4b, 0x21, 0xcb, 0x77, 0x8b, 0x4b, 0x11, 0xc1, 0xdd, 0x24,
-	0x97, 0xbc, 0x90, 0x3c, 0x2e, 0x61, 0x36, 0x62, 0xb9, 0x24, 0x53, 0xe7, 0x82, 0xb5, 0x3f, 0xa6,
-	0xa0, 0x70, 0x48, 0xaf, 0x74, 0xd3, 0x69, 0x39, 0x6c, 0xeb, 0xf3, 0x3d, 0xd8, 0x64, 0x24, 0xa3,
-	0x56, 0xf3, 0x8d, 0x69, 0xf4, 0x9b, 0x8e, 0xd9, 0xa5, 0x7d, 0x1e, 0x5a, 0x5e, 0x5f, 0x17, 0x2f,
-	0x3e, 0x36, 0x8d, 0xfe, 0x6b, 0xd6, 0x4c, 0x9e, 0x00, 0xb9, 0x68, 0xf5, 0x5b, 0xe7, 0x41, 0xb0,
-	0xd8, 0x2c, 0x6e, 0xe0, 0x1b, 0x25, 0x7a, 0xd8, 0xef, 0x99, 0xed, 0x6e, 0x93, 0x8d, 0x3a, 0x13,
-	0x40, 0x9f, 0xf0, 0x17, 0x87, 0xf4, 0x4a, 0xfb, 0xbd, 0xbb, 0x1f, 0x9c, 0x87, 0xe7, 0x6c, 0x3f,
-	0x28, 0xd1, 0xd3, 0xec, 0x07, 0xd1, 0x66, 0x8a, 0xfd, 0x20, 0x7a, 0xf7, 0xed, 0x07, 0x3f, 0x64,
-	0xfb, 0x41, 0x31, 0xab, 0x7c, 0x3f, 0x18, 0x61, 0xe8, 0x9b, 0xfc, 0xfd, 0xec, 0xdb, 0xeb, 0xdd,
-	0x5b, 0xba, 0x6b, 0xe6, 0xed, 0xef, 0x16, 0xb4, 0x50, 0x7f, 0x0a, 0x1b, 0x7c, 0xc7, 0xde, 0xb6,
-	0xa8, 0x23, 0xe7, 0xf3, 0x31, 0xac, 0xd8, 0xbc, 0xc1, 0x9b, 0xce, 0xd5, 0xd1, 0xf5, 0x6e, 0x5e,
-	0xa0, 0x1a, 0x75, 0xf6, 0x9d, 0xe7, 0xbf, 0x3a, 0xda, 0x0b, 0x3c, 0x5c, 0x08, 0x73, 0x0c, 0xa5,
-	0x06, 0xcb, 0x02, 0x80, 0x91, 0x94, 0xd5, 0x7b, 0x06, 0x6e, 0x83, 0x48, 0xed, 0xeb, 0x14, 0x6c,
-	0xc9, 0x8d, 0xeb, 0x6c, 0xb1, 0x90, 0x7d, 0x28, 0x22, 0x74, 0x8a, 0xbc, 0xae, 0x09, 0x13, 0x99,
-	0xd6, 0x5a, 0x20, 0xad, 0x3b, 0xd1, 0x81, 0xfb, 0xb6, 0x27, 0x1f, 0x7b, 0xc7, 0x94, 0xb9, 0xa7,
-	0xe1, 0x3f, 0x69, 0x20, 0x62, 0x27, 0xc6, 0x1e, 0x5d, 0xd9, 0xfc, 0x65, 0x58, 0x36, 0xab, 0xd1,
-	0x3b, 0x4e, 0xbf, 0xe1, 0xb8, 0x6a, 0x7e, 0xb5, 0x78, 0xd5, 0xd4, 0x43, 0xaa, 0xf9, 0xc1, 0x74,
-	0xb1, 0xdd, 0x88, 0x68, 0x1e, 0xca, 0x63, 0x07, 0x46, 0x84, 0x29, 0xfb, 0x21, 0x3b, 0x24, 0xf1,
-	0x26, 0x94, 0xcc, 0xb8, 0x9c, 0x49, 0xa8, 0xd6, 0x80, 0x2d, 0x79, 0x62, 0xf7, 0x53, 0xb7, 0x16,
-	0xd8, 0xeb, 0x4e, 0xcc, 0xa5, 0x60, 0x57, 0x73, 0x70, 0xe9, 0x17, 0xb0, 0x25, 0x0f, 0x5d, 0x33,
-	0xae, 0xee, 0x6f, 0x79, 0x87, 0x3f, 0x7f, 0x34, 0x28, 0x1a, 0x07, 0x66, 0xff, 0xcc, 0x38, 0xf7,
-	0x75, 0xdb, 0xe6, 0x0d, 0xa1, 0x6e, 0x05, 0x8a, 0x75, 0x2b, 0x5e, 0xbb, 0xa2, 0x21, 0xcd, 0xbd,
-	0x11, 0x0a, 0x40, 0xdc, 0x08, 0xd1, 0x06, 0x91, 0x3e, 0xd1, 0x98, 0x35, 0x16, 0x26, 0x1a, 0x08,
-	0x9d, 0x46, 0x34, 0x84, 0xc9, 0x14, 0xa2, 0x21, 0x3c, 0xab, 0x44, 0x63, 0x01, 0xd3, 0x20, 0x45,
-	0x43, 0x34, 0xcf, 0x20, 0x1a, 0x41, 0xc3, 0x77, 0x4b, 0x34, 0xd4, 0xb1, 0xdd, 0xa4, 0x68, 0xb8,
-	0x11, 0x79, 0xa2, 0x21, 0x12, 0x11, 0x2b, 0x1a, 0x98, 0x33, 0x09, 0xf5, 0x44, 0x23, 0x48, 0xdd,
-	0x09, 0x44, 0x43, 0xc5, 0xa5, 0x60, 0x57, 0x73, 0x70, 0xc9, 0x15, 0x8d, 0x99, 0x57, 0xb7, 0x2b,
-	0x1a, 0xc1, 0x68, 0x6a, 0x5f, 0xde, 0x81, 0xdc, 0x81, 0xb8, 0xdb, 0x24, 0x06, 0xe4, 0xf0, 0xda,
-	0x90, 0x68, 0xaa, 0xa0, 0x82, 0x57, 0x91, 0xe5, 0xfb, 0xb1, 0x18, 0x14, 0xa5, 0xdb, 0x7f, 0xff,
-	0xeb, 0xff, 0xfe, 0x94, 0x5e, 0x87, 0x35, 0x0e, 0xfa, 0x3e, 0x6e, 0x1f, 0x89, 0x09, 0x2b, 0xee,
-	0xfd, 0x13, 0xf9, 0xee, 0x24, 0xb7, 0x75, 0xe5, 0x07, 0x09, 0xa8, 0x78, 0x87, 0x16, 0x80, 0x77,
-	0xfd, 0x43, 0x1e, 0x44, 0x17, 0xfc, 0xfc, 0x23, 0x7c, 0x98, 0x04, 0x4b, 0xf4, 0xe9, 0x5d, 0xef,
-	0xa8, 0x7d, 0x8e, 0x5d, 0x27, 0xa9, 0x7d, 0x2a, 0x6e, 0x89, 0x22, 0x7c, 0x8a, 0x1c, 0xbe, 0x6e,
-	0xd9, 0xdd, 0xc8, 0x1c, 0xfa, 0xae, 0x77, 0x22, 0x73, 0x18, 0xb8, 0xc8, 0x89, 0xcf, 0x21, 0x2f,
-	0xd2, 0x47, 0xe7, 0xd0, 0x7f, 0x59, 0x12, 0x9d, 0xc3, 0x40, 0xa5, 0x3f, 0x71, 0x3e, 0xf9, 0xf0,
-	0x62, 0xe6, 0xd3, 0x3f, 0xc2, 0x87, 0x49, 0xb0, 0x44, 0x9f, 0x5e, 0xed, 0x5c, 0xed, 0x73, 0xac,
-	0x8e, 0xaf, 0xf6, 0x39, 0x5e, 0x82, 0x8f, 0xf2, 0xf9, 0x05, 0xac, 0xfa, 0xeb, 0x7e, 0xe4, 0xd1,
-	0x84, 0x85, 0xcc, 0x72, 0x25, 0x19, 0x18, 0xef, 0xf9, 0xb7, 0xb0, 0x16, 0xb8, 0xe5, 0x20, 0xca,
-	0x1e, 0x55, 0xb7, 0x2a, 0xe5, 0xc7, 0x13, 0x20, 0x13, 0x9d, 0x07, 0x8a, 0xe4, 0x6a, 0xe7, 0xaa,
-	0xb2, 0xbc, 0xda, 0xb9, 0xb2, 0xe2, 0x1e, 0xe3, 0x3c, 0x50, 0x0b, 0x57, 0x3b, 0x57, 0x15, 0xdd,
-	0xd5, 0xce, 0xd5, 0x85, 0xf5, 0x58, 0x92, 0x61, 0xfd, 0x28, 0x92, 0x64, 0xc1, 0x9a, 0x63, 0x24,
-	0xc9, 0xc2, 0x05, 0xc4, 0x78, 0x92, 0xc9, 0x62, 0x57, 0x34, 0xc9, 0x42, 0x15, 0xba, 0x68, 0x92,
-	0x85, 0xeb, 0x66, 0x89, 0x24, 0x93, 0x03, 0x8e, 0x21, 0x59, 0x68, 0xcc, 0x8f, 0x27, 0x40, 0x4e,
-	0x98, 0xe7, 0x58, 0xe7, 0xaa, 0x22, 0x6f, 0x5c, 0x9e, 0x27, 0x74, 0x2e, 0xf2, 0x8c, 0xa7, 0xfd,
-	0xc8, 0x3c, 0x07, 0xeb, 0x28, 0x91, 0x79, 0x0e, 0x95, 0x1a, 0x12, 0xf2, 0x2c, 0x0b, 0x51, 0xd1,
-	0x79, 0x0e, 0x55, 0xcf, 0xa2, 0xf3, 0x1c, 0xae, 0x69, 0x25, 0xae, 0x67, 0x39, 0xe0, 0x98, 0xf5,
-	0x1c, 0x1a, 0xf3, 0xe3, 0x09, 0x90, 0x89, 0x1f, 0x27, 0xb7, 0x04, 0xa2, 0xfe, 0x38, 0x85, 0x0b,
-	0x2c, 0xe5, 0x07, 0x09, 0xa8, 0xc4, 0x79, 0xf6, 0xd7, 0x1b, 0xd4, 0xf3, 0xac, 0xa8, 0xa5, 0x94,
-	0x2b, 0xc9, 0xc0, 0x78, 0xcf, 0x43, 0x28, 0xf8, 0x4e, 0xcd, 0xe4, 0xe1, 0x64, 0x07, 0xfd, 0xf2,
-	0xa3, 0x44, 0x5c, 0xe2, 0x80, 0xfd, 0x87, 0x62, 0xf5, 0x80, 0x15, 0x27, 0xf0, 0x72, 0x25, 0x19,
-	0x98, 0xe8, 0xd9, 0x7f, 0x00, 0x56, 0x7b, 0x56, 0x1c, 0xb2, 0xcb, 0x95, 0x64, 0xe0, 0x24, 0xac,
-	0x12, 0x5b, 0xe8, 0x48, 0x56, 0x05, 0xf6, 0xe8, 0x91, 0xac, 0x0a, 0xee, 0xc3, 0x13, 0x59, 0x85,
-	0x3e, 0x63, 0x58, 0x15, 0x74, 0x5b, 0x49, 0x06, 0x4e, 0xc4, 0x2a, 0x3c, 0x56, 0x45, 0xb3, 0x2a,
-	0x78, 0x12, 0x8c, 0x66, 0x55, 0xe8, 0x7c, 0x96, 0xc8, 0xaa, 0xb8, 0x01, 0x2b, 0x8e, 0x68, 0x71,
-	0xac, 0x9a, 0x78, 0xaa, 0xfd, 0x27, 0xa4, 0x38, 0x56, 0x4d, 0xe0, 0x59, 0x75, 0xd8, 0x8a, 0xf0,
-	0xbc, 0x5f, 0x7a, 0xfb, 0xcd, 0xce, 0xad, 0x7f, 0x7e, 0xb3, 0x73, 0xeb, 0x77, 0xa3, 0x9d, 0xd4,
-	0xdb, 0xd1, 0x4e, 0xea, 0x1f, 0xa3, 0x9d, 0xd4, 0xbf, 0x47, 0x3b, 0xa9, 0xd3, 0x65, 0xfe, 0x6f,
-	0xa0, 0x4f, 0xff, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x16, 0x80, 0xb3, 0xa3, 0x7f, 0x2a, 0x00, 0x00,
+	// 2196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x23, 0x59,
+	0x15, 0x6e, 0x3f, 0x12, 0xdb, 0xc7, 0xb1, 0x93, 0xdc, 0xa4, 0xc1, 0x14, 0x4d, 0x12, 0xaa, 0x49,
+	0xe2, 0xa0, 0x1e, 0x67, 0xc6, 0xcd, 0x88, 0x9e, 0x41, 0x3c, 0x3a, 0x71, 0x4f, 0xb7, 0x27, 0x33,
+	0xe9, 0x56, 0xa5, 0xbb, 0xc5, 0xce, 0xaa, 0xd8, 0x37, 0x49, 0xb5, 0x9d, 0x2a, 0x53, 0x55, 0xce,
+	0x4c, 0xc4, 0x06, 0xd0, 0x20, 0x16, 0xac, 0x47, 0x62, 0xcb, 0x96, 0x05, 0x0b, 0x56, 0xf3, 0x13,
+	0x5a, 0xac, 0x58, 0x22, 0x21, 0x45, 0x8c, 0x25, 0x24, 0x56, 0xfc, 0x06, 0x74, 0x1f, 0xf5, 0xf4,
+	0x75, 0x55, 0xf9, 0x21, 0x65, 0x56, 0x71, 0xdd, 0xfa, 0xce, 0x3d, 0xe7, 0xde, 0xf3, 0x9d, 0xaf,
+	0xee, 0x23, 0x50, 0x6a, 0x1b, 0xba, 0x6d, 0x1a, 0xbd, 0x5a, 0xdf, 0x34, 0x6c, 0x03, 0xa1, 0x8e,
+	0xd1, 0xee, 0x62, 0xb3, 0x66, 0x7d, 0xa6, 0x9a, 0x97, 0x5d, 0xcd, 0xae, 0x5d, 0xbd, 0x27, 0x15,
+	0xad, 0x3e, 0x6e, 0x5b, 0x0c, 0x20, 0x95, 0x8c, 0xd3, 0x37, 0xb8, 0x6d, 0x3b, 0x8f, 0x45, 0xfb,
+	0xba, 0x8f, 0x9d, 0x87, 0xf5, 0x73, 0xe3, 0xdc, 0xa0, 0x3f, 0xf7, 0xc9, 0x2f, 0xde, 0xba, 0xd6,
+	0xef, 0x0d, 0xce, 0x35, 0x7d, 0x9f, 0xfd, 0x61, 0x8d, 0xf2, 0xfb, 0x50, 0x7e, 0x8a, 0xed, 0x63,
+	0xa3, 0x83, 0x15, 0xfc, 0xab, 0x01, 0xb6, 0x6c, 0x74, 0x1f, 0x72, 0xba, 0xd1, 0xc1, 0x2d, 0xad,
+	0x53, 0x49, 0x6d, 0xa5, 0xaa, 0x85, 0x03, 0x18, 0xde, 0x6c, 0x2e, 0x12, 0x44, 0xb3, 0xa1, 0x2c,
+	0x92, 0x57, 0xcd, 0x8e, 0xfc, 0x73, 0x58, 0x76, 0xcd, 0xac, 0xbe, 0xa1, 0x5b, 0x18, 0x3d, 0x80,
+	0x2c, 0x79, 0x49, 0x8d, 0x8a, 0xf5, 0x4a, 0x6d, 0x74, 0x00, 0x35, 0x8a, 0xa7, 0x28, 0xf9, 0x26,
+	0x03, 0x2b, 0x9f, 0x68, 0x16, 0xed, 0xc2, 0x72, 0x5c, 0x7f, 0x04, 0xb9, 0x33, 0xad, 0x67, 0x63,
+	0xd3, 0xe2, 0xbd, 0x3c, 0x10, 0xf5, 0x12, 0x36, 0xab, 0x7d, 0xc4, 0x6c, 0x14, 0xc7, 0x58, 0xfa,
+	0x6d, 0x06, 0x72, 0xbc, 0x11, 0xad, 0xc3, 0x82, 0xae, 0x5e, 0x62, 0xd2, 0x63, 0xa6, 0x5a, 0x50,
+	0xd8, 0x03, 0xda, 0x87, 0xa2, 0xd6, 0x69, 0xf5, 0x4d, 0x7c, 0xa6, 0x7d, 0x8e, 0xad, 0x4a, 0x9a,
+	0xbc, 0x3b, 0x28, 0x0f, 0x6f, 0x36, 0xa1, 0xd9, 0x78, 0xc1, 0x5b, 0x15, 0xd0, 0x3a, 0xce, 0x6f,
+	0xf4, 0x02, 0x16, 0x7b, 0xea, 0x29, 0xee, 0x59, 0x95, 0xcc, 0x56, 0xa6, 0x5a, 0xac, 0x3f, 0x9a,
+	0x24, 0xb2, 0xda, 0x27, 0xd4, 0xf4, 0x89, 0x6e, 0x9b, 0xd7, 0x0a, 0xef, 0x07, 0x35, 0xa1, 0x78,
+	0x89, 0x2f, 0x4f, 0xb1, 0x69, 0x5d, 0x68, 0x7d, 0xab, 0x92, 0xdd, 0xca, 0x54, 0xcb, 0xf5, 0xdd,
+	0x71, 0xd3, 0x76, 0xd2, 0xc7, 0xed, 0xda, 0xa7, 0x2e, 0x5e, 0xf1, 0xdb, 0xa2, 0x3a, 0x2c, 0x98,
+	0x46, 0x0f, 0x5b, 0x95, 0x05, 0xda, 0xc9, 0xbd, 0xb1, 0x73, 0x6f, 0xf4, 0xb0, 0xc2, 0xa0, 0xe8,
+	0x3e, 0x94, 0xc8, 0x54, 0x78, 0x73, 0xb0, 0x48, 0xe7, 0x67, 0x89, 0x34, 0x3a, 0xa3, 0x96, 0x3e,
+	0x80, 0xa2, 0x2f, 0x74, 0xb4, 0x02, 0x99, 0x2e, 0xbe, 0x66, 0xb4, 0x50, 0xc8, 0x4f, 0x32, 0xbb,
+	0x57, 0x6a, 0x6f, 0x80, 0x2b, 0x69, 0xda, 0xc6, 0x1e, 0x3e, 0x4c, 0x3f, 0x4a, 0xc9, 0x87, 0xb0,
+	0xea, 0x9b, 0x0e, 0xce, 0x91, 0x1a, 0x2c, 0x90, 0xec, 0xb3, 0x64, 0x44, 0x91, 0x84, 0xc1, 0xe4,
+	0xbf, 0xa4, 0x60, 0xf5, 0x55, 0xbf, 0xa3, 0xda, 0x78, 0x52, 0x86, 0xa2, 0x9f, 0xc1, 0x12, 0x05,
+	0x5d, 0x61, 0xd3, 0xd2, 0x0c, 0x9d, 0x06, 0x58, 0xac, 0x7f, 0x57, 0xe4, 0xf1, 0x35, 0x83, 0x28,
+	0x45, 0x62, 0xc0, 0x1f, 0xd0, 0xbb, 0x90, 0x25, 0xe5, 0x56, 0xc9, 0x50, 0xbb, 0x7b, 0x51, 0x79,
+	0x51, 0x28, 0x52, 0x3e, 0x00, 0xe4, 0x8f, 0x75, 0xaa, 0xb2, 0x38, 0x86, 0x55, 0x05, 0x5f, 0x1a,
+	0x57, 0x93, 0x8f, 0x77, 0x1d, 0x16, 0xce, 0x0c, 0xb3, 0xcd, 0x32, 0x91, 0x57, 0xd8, 0x83, 0xbc,
+	0x0e, 0xc8, 0xdf, 0x1f, 0x8b, 0x89, 0x17, 0xfd, 0x4b, 0xd5, 0xea, 0xfa, 0x5c, 0xd8, 0xaa, 0xd5,
+	0x0d, 0xb9, 0x20, 0x08, 0xe2, 0x82, 0xbc, 0x72, 0x8b, 0x9e, 0x99, 0x79, 0xa3, 0x23, 0x2f, 0xa3,
+	0x46, 0x47, 0xf1, 0x14, 0x25, 0x3f, 0x72, 0x46, 0x37, 0xb1, 0x6b, 0x77, 0x1c, 0x7e, 0xef, 0xf2,
+	0x57, 0x59, 0x26, 0x22, 0xa4, 0x71, 0x0a, 0x11, 0xf1, 0x9b, 0x8d, 0x8a, 0xc8, 0xbf, 0x6e, 0x51,
+	0x44, 0x44, 0x91, 0x09, 0x45, 0x64, 0x1f, 0x8a, 0x16, 0x36, 0xaf, 0xb4, 0x36, 0x61, 0x07, 0x13,
+	0x11, 0x1e, 0xc2, 0x09, 0x6b, 0x6e, 0x36, 0x2c, 0x05, 0x38, 0xa4, 0xd9, 0xb1, 0xd0, 0x0e, 0xe4,
+	0x39, 0x97, 0x98, 0x5a, 0x14, 0x0e, 0x8a, 0xc3, 0x9b, 0xcd, 0x1c, 0x23, 0x93, 0xa5, 0xe4, 0x18,
+	0x9b, 0x2c, 0xd4, 0x80, 0x72, 0x07, 0x5b, 0x9a, 0x89, 0x3b, 0x2d, 0xcb, 0x56, 0x6d, 0xae, 0x0f,
+	0xe5, 0xfa, 0xf7, 0xc6, 0xa5, 0xf8, 0x84, 0xa0, 0x94, 0x12, 0x37, 0xa2, 0x4f, 0x02, 0x91, 0xc9,
+	0x8d, 0x8a, 0x0c, 0xba, 0x07, 0x30, 0xe8, 0xb7, 0x6c, 0xa3, 0x45, 0x6a, 0xa7, 0x92, 0xa7, 0xf4,
+	0xcd, 0x0f, 0xfa, 0x2f, 0x8d, 0x86, 0x6a, 0x63, 0x24, 0x41, 0xde, 0x1c, 0xe8, 0xb6, 0x46, 0x66,
+	0xbf, 0x40, 0xad, 0xdd, 0xe7, 0x39, 0xc8, 0x13, 0x9f, 0x68, 0x4f, 0x9e, 0x08, 0xdf, 0x22, 0xe5,
+	0x89, 0x12, 0x90, 0xc1, 0xe4, 0x23, 0x58, 0x3f, 0x34, 0xb1, 0x6a, 0x63, 0x3e, 0xd9, 0x0e, 0x05,
+	0x1f, 0x72, 0xed, 0x60, 0xfc, 0xdb, 0x14, 0x75, 0xc3, 0x2d, 0x7c, 0xf2, 0x71, 0x0c, 0x77, 0x43,
+	0x9d, 0xf1, 0xa8, 0xde, 0x87, 0x1c, 0x4f, 0x60, 0x25, 0x35, 0x5e, 0xc4, 0x1c, 0x2b, 0x07, 0x2b,
+	0xbf, 0x81, 0xd5, 0xa7, 0xd8, 0x0e, 0x45, 0xf6, 0x00, 0xc0, 0xe3, 0x0b, 0xaf, 0xb7, 0xd2, 0xf0,
+	0x66, 0xb3, 0xe0, 0xd2, 0x45, 0x29, 0xb8, 0x6c, 0x41, 0xbb, 0xb0, 0xac, 0xe9, 0x16, 0x36, 0xed,
+	0x56, 0x07, 0x9f, 0xa9, 0x83, 0x9e, 0x6d, 0x71, 0x75, 0x29, 0xb3, 0xe6, 0x06, 0x6f, 0x95, 0x8f,
+	0x00, 0xf9, 0x7d, 0xcd, 0x16, 0xf8, 0xdf, 0xd2, 0xb0, 0xce, 0x84, 0x74, 0xa6, 0xe0, 0x1b, 0xb0,
+	0xec, 0xa0, 0x27, 0xf8, 0x06, 0x94, 0xb9, 0x0d, 0x7f, 0x46, 0x0f, 0x03, 0x9f, 0x81, 0x64, 0xa9,
+	0x44, 0x9f, 0x42, 0xde, 0x34, 0x7a, 0xbd, 0x53, 0xb5, 0xdd, 0xad, 0x64, 0xb7, 0x52, 0xd5, 0x72,
+	0xfd, 0x3d, 0x91, 0xa1, 0x68, 0x90, 0x35, 0x85, 0x1b, 0x2a, 0x6e, 0x17, 0xb2, 0x0c, 0x79, 0xa7,
+	0x15, 0xe5, 0x21, 0x7b, 0xfc, 0xfc, 0xf8, 0xc9, 0xca, 0x1d, 0xb4, 0x04, 0xf9, 0x17, 0xca, 0x93,
+	0xd7, 0xcd, 0xe7, 0xaf, 0x4e, 0x56, 0x52, 0x84, 0x3d, 0xa1, 0xee, 0x66, 0x4b, 0x42, 0x03, 0xd6,
+	0x99, 0xe0, 0xce, 0x92, 0x03, 0xf9, 0xdb, 0x70, 0x37, 0xd4, 0x0b, 0x57, 0xee, 0x2f, 0x32, 0xb0,
+	0x46, 0xea, 0x8f, 0xb7, 0xbb, 0xe2, 0xdd, 0x0c, 0x8b, 0xf7, 0xfe, 0x38, 0x89, 0x0c, 0x59, 0x8e,
+	0xea, 0xf7, 0x9f, 0xd3, 0x73, 0xd7, 0xef, 0x93, 0x90, 0x7e, 0xff, 0x64, 0xc2, 0xe0, 0x84, 0x12,
+	0x3e, 0xa2, 0x91, 0x59, 0x81, 0x46, 0xfa, 0x55, 0x70, 0x61, 0x7e, 0x2a, 0xf8, 0x1c, 0xd6, 0x83,
+	0xe1, 0x72, 0xd2, 0xfc, 0x18, 0xf2, 0x3c, 0x89, 0x8e, 0x16, 0x46, 0xb2, 0xc6, 0x05, 0x7b, 0x8a,
+	0x78, 0x8c, 0xed, 0xcf, 0x0c, 0xb3, 0x3b, 0x81, 0x22, 0x72, 0x0b, 0x91, 0x22, 0xba, 0x9d, 0x79,
+	0x9c, 0xd6, 0x59, 0x53, 0x14, 0xa7, 0x1d, 0x2b, 0x07, 0x2b, 0xbf, 0xa2, 0x8a, 0x18, 0x8a, 0x0c,
+	0x41, 0x96, 0xcc, 0x34, 0x9f, 0x2f, 0xfa, 0x9b, 0x90, 0x9c, 0xdb, 0x10, 0x92, 0xa7, 0x3d, 0x92,
+	0x73, 0x5b, 0x42, 0x72, 0x0e, 0x68, 0x76, 0xb8, 0xf8, 0xcd, 0x29, 0xc6, 0x5f, 0x3a, 0x75, 0x37,
+	0xf7, 0x30, 0xdd, 0x5a, 0x0c, 0x45, 0x2a, 0xff, 0x37, 0xcd, 0x6a, 0x91, 0xb7, 0x4f, 0x51, 0x8b,
+	0x21, 0xcb, 0xd1, 0x5a, 0xfc, 0xfd, 0x2d, 0xd6, 0xe2, 0x98, 0xe0, 0xa6, 0xae, 0xc5, 0x39, 0xd4,
+	0x9b, 0x17, 0x92, 0x57, 0x6f, 0x3c, 0x51, 0x91, 0xf5, 0xe6, 0x64, 0xce, 0x05, 0xcb, 0x8f, 0x29,
+	0xa5, 0x0f, 0x7b, 0x03, 0xcb, 0xc6, 0xa6, 0x4f, 0xa3, 0xdb, 0xac, 0x25, 0xa4, 0xd1, 0x1c, 0x47,
+	0x78, 0xc1, 0x01, 0x2e, 0x7d, 0xdd, 0x2e, 0x3c, 0xfa, 0x72, 0x48, 0x14, 0x7d, 0x1d, 0x2b, 0x07,
+	0xeb, 0x72, 0x89, 0xbf, 0x98, 0x82, 0x4b, 0x21, 0xcb, 0x6f, 0x16, 0x97, 0xc6, 0x04, 0x77, 0x9b,
+	0x5c, 0xf2, 0x42, 0xf2, 0xb8, 0xc4, 0xb3, 0x11, 0xc9, 0x25, 0x27, 0x75, 0x2e, 0x58, 0xfe, 0x32,
+	0x05, 0xc5, 0x23, 0x7c, 0xad, 0x18, 0xb6, 0x6a, 0x93, 0xa5, 0xcf, 0x0f, 0x61, 0x95, 0x90, 0x0c,
+	0x9b, 0xad, 0x37, 0x86, 0xa6, 0xb7, 0x6c, 0xa3, 0x8b, 0x75, 0x1a, 0x5a, 0x5e, 0x59, 0x66, 0x2f,
+	0x3e, 0x36, 0x34, 0xfd, 0x25, 0x69, 0x46, 0x0f, 0x00, 0x5d, 0xaa, 0xba, 0x7a, 0x1e, 0x04, 0xb3,
+	0xc5, 0xe2, 0x0a, 0x7f, 0x23, 0x44, 0x0f, 0xf4, 0x9e, 0xd1, 0xee, 0xb6, 0xc8, 0xa8, 0x33, 0x01,
+	0xf4, 0x2b, 0xfa, 0xe2, 0x08, 0x5f, 0xcb, 0xbf, 0x73, 0xd7, 0x83, 0xb3, 0xf0, 0x9c, 0xac, 0x07,
+	0x1d, 0xf4, 0x24, 0xeb, 0x41, 0x6e, 0x33, 0xc1, 0x7a, 0x90, 0x7b, 0xf7, 0xad, 0x07, 0x1f, 0x93,
+	0xf5, 0x20, 0x9b, 0xd5, 0x4a, 0x76, 0xbc, 0xa1, 0x6f, 0xf2, 0x0f, 0xb2, 0x6f, 0x6f, 0x36, 0xef,
+	0x28, 0xae, 0x99, 0xb7, 0xbe, 0x9b, 0x53, 0xa1, 0x3e, 0x83, 0x0a, 0x61, 0x8f, 0x9b, 0x93, 0xa6,
+	0x7e, 0x66, 0x4c, 0xa7, 0x1f, 0x5f, 0xa6, 0xa0, 0x14, 0xe8, 0x86, 0x1c, 0x9d, 0x98, 0x46, 0x8f,
+	0x7d, 0xab, 0xe2, 0x4e, 0xa3, 0x28, 0x12, 0xbd, 0x0b, 0x4b, 0x6d, 0xb5, 0xd5, 0x26, 0x3b, 0x8d,
+	0x0b, 0xd5, 0xba, 0xe0, 0xdf, 0x32, 0x5a, 0xb2, 0x87, 0x8f, 0x0f, 0xb1, 0x69, 0x3f, 0x53, 0xad,
+	0x0b, 0x05, 0xda, 0xaa, 0xf3, 0x1b, 0x6d, 0x41, 0xf1, 0x4c, 0xd3, 0xcf, 0xb1, 0xd9, 0x37, 0x35,
+	0xdd, 0xa6, 0xe9, 0x28, 0x28, 0xfe, 0x26, 0xf9, 0x35, 0x7c, 0x47, 0x30, 0x42, 0x3e, 0x6b, 0x1f,
+	0xc0, 0x22, 0xa5, 0xa8, 0x53, 0x22, 0xdf, 0x17, 0x05, 0x19, 0x34, 0xe5, 0x06, 0xf2, 0x4f, 0x61,
+	0x85, 0xee, 0x75, 0xda, 0x26, 0xb6, 0x9d, 0x19, 0xdb, 0x83, 0x82, 0x45, 0x1b, 0xbc, 0x09, 0x5b,
+	0x1a, 0xde, 0x6c, 0xe6, 0x19, 0xaa, 0xd9, 0x20, 0x2b, 0x24, 0xfa, 0xab, 0x23, 0x3f, 0xe5, 0xdb,
+	0x32, 0x66, 0xce, 0xc3, 0xa9, 0xc3, 0x22, 0x03, 0xf0, 0x1c, 0x4a, 0xe2, 0xd5, 0x16, 0xb5, 0xe1,
+	0x48, 0xf9, 0xab, 0x14, 0xac, 0x39, 0x4b, 0xfe, 0xe9, 0x62, 0x41, 0x07, 0x50, 0xe6, 0xd0, 0x09,
+	0x2a, 0xa2, 0xc4, 0x4c, 0xf8, 0x23, 0xaa, 0x07, 0x0a, 0x62, 0x63, 0x7c, 0xe0, 0xbe, 0x85, 0xdd,
+	0xc7, 0xde, 0x06, 0x6f, 0xe6, 0x69, 0xf8, 0x4f, 0x1a, 0x10, 0x5b, 0xc3, 0x92, 0x47, 0xf7, 0x83,
+	0xf3, 0x2c, 0xfc, 0xc1, 0xa9, 0x8d, 0x5f, 0xab, 0xfb, 0x0d, 0x47, 0xbf, 0x37, 0x5f, 0xcc, 0xff,
+	0x7b, 0xa3, 0x84, 0xbe, 0x37, 0x1f, 0x4e, 0x16, 0xdb, 0xad, 0x7c, 0x6e, 0x8e, 0x60, 0x2d, 0x10,
+	0x11, 0x4f, 0xd9, 0x8f, 0xc8, 0xf6, 0x92, 0x36, 0xf1, 0x4a, 0x8a, 0xca, 0x99, 0x03, 0x95, 0x9b,
+	0xb0, 0xe6, 0x9c, 0x75, 0xf8, 0xa9, 0x5b, 0x0f, 0xec, 0x12, 0x12, 0x73, 0x29, 0xd8, 0xd5, 0x0c,
+	0x5c, 0xfa, 0x05, 0xac, 0x39, 0xdb, 0xd5, 0x29, 0xab, 0xfb, 0x5b, 0xde, 0xb6, 0xd9, 0x1f, 0x0d,
+	0x17, 0x8d, 0x43, 0x43, 0x3f, 0xd3, 0xce, 0x7d, 0xdd, 0xb6, 0x69, 0x43, 0xa8, 0x5b, 0x86, 0x22,
+	0xdd, 0xb2, 0xd7, 0xae, 0x68, 0x38, 0xe6, 0xde, 0x08, 0x19, 0x20, 0x6a, 0x84, 0xdc, 0x86, 0x23,
+	0x7d, 0xa2, 0x31, 0x6d, 0x2c, 0x44, 0x34, 0x38, 0x74, 0x12, 0xd1, 0x60, 0x26, 0x13, 0x88, 0x06,
+	0xf3, 0x2c, 0x12, 0x8d, 0x39, 0x4c, 0x83, 0x23, 0x1a, 0xac, 0x79, 0x0a, 0xd1, 0x08, 0x1a, 0x7e,
+	0xb3, 0x44, 0x43, 0x1c, 0xdb, 0x6d, 0x8a, 0x86, 0x1b, 0x91, 0x27, 0x1a, 0x2c, 0x11, 0x91, 0xa2,
+	0xc1, 0x73, 0xe6, 0x40, 0x3d, 0xd1, 0x08, 0x52, 0x37, 0x81, 0x68, 0x88, 0xb8, 0x14, 0xec, 0x6a,
+	0x06, 0x2e, 0xb9, 0xa2, 0x31, 0x75, 0x75, 0xbb, 0xa2, 0x11, 0x8c, 0xa6, 0xfe, 0xc7, 0x7b, 0x90,
+	0x3b, 0x64, 0xb7, 0xc2, 0x48, 0x83, 0x1c, 0xbf, 0x70, 0x45, 0xb2, 0x28, 0xa8, 0xe0, 0x25, 0xae,
+	0x74, 0x3f, 0x12, 0xc3, 0x45, 0xe9, 0xee, 0xdf, 0xff, 0xfa, 0xbf, 0x3f, 0xa5, 0x97, 0xa1, 0x44,
+	0x41, 0xef, 0xf0, 0x85, 0x37, 0x32, 0xa0, 0xe0, 0xde, 0xdc, 0xa1, 0x1f, 0x24, 0xb9, 0xe7, 0x94,
+	0xb6, 0x63, 0x50, 0xd1, 0x0e, 0x4d, 0x00, 0xef, 0xe2, 0x0c, 0x6d, 0x8f, 0x3f, 0x2a, 0xf5, 0x8f,
+	0x70, 0x27, 0x0e, 0x16, 0xeb, 0xd3, 0xbb, 0x18, 0x13, 0xfb, 0x1c, 0xb9, 0x88, 0x93, 0x76, 0xe2,
+	0x60, 0xd1, 0x3e, 0x59, 0x0e, 0xc9, 0x05, 0xc2, 0xd8, 0x1c, 0xfa, 0x2e, 0xc6, 0xa4, 0xfb, 0x91,
+	0x98, 0x44, 0x39, 0x24, 0xd0, 0x88, 0x1c, 0xfa, 0xaf, 0x99, 0xa4, 0xed, 0x18, 0x54, 0xc2, 0xf9,
+	0xa4, 0xc3, 0x8b, 0x98, 0x4f, 0xff, 0x08, 0x77, 0xe2, 0x60, 0xb1, 0x3e, 0xbd, 0x5b, 0x07, 0xb1,
+	0xcf, 0x91, 0x1b, 0x10, 0x69, 0x27, 0x0e, 0x16, 0xed, 0xf3, 0x73, 0x58, 0xf2, 0x9f, 0x98, 0xa2,
+	0xdd, 0x84, 0x47, 0xc0, 0x52, 0x35, 0x1e, 0x18, 0xed, 0xf9, 0xd7, 0x50, 0x0a, 0xdc, 0x0f, 0x21,
+	0x61, 0x8f, 0xa2, 0xfb, 0x28, 0x69, 0x2f, 0x01, 0x32, 0xd6, 0x79, 0xe0, 0x7a, 0x41, 0xec, 0x5c,
+	0x74, 0xa1, 0x21, 0xed, 0x25, 0x40, 0xc6, 0x3a, 0x0f, 0xdc, 0x22, 0x88, 0x9d, 0x8b, 0xae, 0x2b,
+	0xa4, 0xbd, 0x04, 0xc8, 0x24, 0x24, 0xe3, 0x27, 0x6f, 0x63, 0x49, 0x16, 0x3c, 0xad, 0x95, 0x76,
+	0xe2, 0x60, 0x89, 0x48, 0xc6, 0xd1, 0x11, 0x24, 0x0b, 0x9d, 0x6d, 0x4a, 0xd5, 0x78, 0x60, 0x42,
+	0x92, 0x39, 0x03, 0x8e, 0x20, 0x59, 0x68, 0xcc, 0x7b, 0x09, 0x90, 0x09, 0xf3, 0x1c, 0xe9, 0x5c,
+	0x74, 0x3c, 0x2e, 0xed, 0x25, 0x40, 0x26, 0xc9, 0x33, 0x3f, 0xe1, 0x18, 0x9b, 0xe7, 0xe0, 0x09,
+	0x94, 0xb4, 0x13, 0x07, 0x4b, 0x94, 0x67, 0x8e, 0x8e, 0xc8, 0x73, 0xe8, 0xdc, 0x51, 0xaa, 0xc6,
+	0x03, 0x13, 0xd6, 0xb3, 0x33, 0xe0, 0x88, 0x7a, 0x0e, 0x8d, 0x79, 0x2f, 0x01, 0x32, 0xda, 0xf9,
+	0x1f, 0x52, 0xec, 0xf2, 0x3d, 0x78, 0x6a, 0x34, 0xf6, 0xdf, 0x34, 0x44, 0x67, 0x54, 0xd2, 0x3b,
+	0x09, 0xd1, 0xb1, 0x9f, 0x49, 0xf7, 0x30, 0x46, 0xfc, 0x99, 0x0c, 0x1f, 0xf5, 0x48, 0xdb, 0x31,
+	0xa8, 0xd8, 0x8c, 0xfb, 0x4f, 0x3e, 0xc4, 0x19, 0x17, 0x9c, 0xea, 0x48, 0xd5, 0x78, 0x60, 0xb4,
+	0xe7, 0x01, 0x14, 0x7d, 0xfb, 0x77, 0xb4, 0x93, 0xec, 0xc8, 0x41, 0xda, 0x8d, 0xc5, 0xc5, 0x0e,
+	0xd8, 0xbf, 0x3d, 0x17, 0x0f, 0x58, 0x70, 0x16, 0x20, 0x55, 0xe3, 0x81, 0xb1, 0x9e, 0xfd, 0x5b,
+	0x71, 0xb1, 0x67, 0xc1, 0x76, 0x5f, 0xaa, 0xc6, 0x03, 0x93, 0xb0, 0x8a, 0x2d, 0xe6, 0xc7, 0xb2,
+	0x2a, 0xb0, 0x5b, 0x90, 0xb6, 0x63, 0x50, 0x09, 0x59, 0xc5, 0x7d, 0x46, 0xb0, 0x2a, 0xe8, 0xb6,
+	0x1a, 0x0f, 0x4c, 0xc4, 0x2a, 0x06, 0x8e, 0x60, 0x55, 0x70, 0x4f, 0x2a, 0xed, 0xc6, 0xe2, 0x12,
+	0xb2, 0x2a, 0x6a, 0xc0, 0x82, 0xcd, 0xa2, 0x54, 0x8d, 0x07, 0x26, 0x64, 0x55, 0x94, 0x67, 0xc1,
+	0x7e, 0x50, 0xaa, 0xc6, 0x03, 0x23, 0x3d, 0x1f, 0x54, 0xde, 0x7e, 0xbd, 0x71, 0xe7, 0x9f, 0x5f,
+	0x6f, 0xdc, 0xf9, 0xcd, 0x70, 0x23, 0xf5, 0x76, 0xb8, 0x91, 0xfa, 0xc7, 0x70, 0x23, 0xf5, 0xef,
+	0xe1, 0x46, 0xea, 0x74, 0x91, 0xfe, 0x2b, 0xef, 0xc3, 0xff, 0x0f, 0x00, 0x3d, 0xf5, 0xcf, 0x96,
+	0x43, 0x2c, 0x00, 0x00,
 }
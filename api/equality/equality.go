@@ -45,7 +45,8 @@ func RootCAEqualStable(a, b *api.RootCA) bool {
 	if b.RootRotation != nil {
 		bRotationKey = b.RootRotation.CAKey
 	}
-	if subtle.ConstantTimeCompare(a.CAKey, b.CAKey) != 1 || subtle.ConstantTimeCompare(aRotationKey, bRotationKey) != 1 {
+	if subtle.ConstantTimeCompare(a.CAKey, b.CAKey) != 1 || subtle.ConstantTimeCompare(aRotationKey, bRotationKey) != 1 ||
+		subtle.ConstantTimeCompare(a.IntermediateCAKey, b.IntermediateCAKey) != 1 {
 		return false
 	}
 
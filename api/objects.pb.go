@@ -71,12 +71,30 @@ type Node struct {
 	// shows the privilege level that the CA would currently grant when
 	// issuing or renewing the node's certificate.
 	Role NodeRole `protobuf:"varint,9,opt,name=role,proto3,enum=docker.swarmkit.v1.NodeRole" json:"role,omitempty"`
+	// MembershipTransition records the node's most recent change of
+	// membership, if any.
+	MembershipTransition *MembershipTransition `protobuf:"bytes,10,opt,name=membership_transition,json=membershipTransition" json:"membership_transition,omitempty"`
+	// DeletedAt is set when the node has been soft-deleted. A soft-deleted
+	// node is a tombstone: it is hidden from normal reads of the store until
+	// it is purged.
+	DeletedAt *google_protobuf.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt" json:"deleted_at,omitempty"`
 }
 
 func (m *Node) Reset()                    { *m = Node{} }
 func (*Node) ProtoMessage()               {}
 func (*Node) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{1} }
 
+// MembershipTransition records a change of a node's membership.
+type MembershipTransition struct {
+	From      NodeSpec_Membership        `protobuf:"varint,1,opt,name=from,proto3,enum=docker.swarmkit.v1.NodeSpec_Membership" json:"from,omitempty"`
+	To        NodeSpec_Membership        `protobuf:"varint,2,opt,name=to,proto3,enum=docker.swarmkit.v1.NodeSpec_Membership" json:"to,omitempty"`
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp,omitempty"`
+}
+
+func (m *MembershipTransition) Reset()                    { *m = MembershipTransition{} }
+func (*MembershipTransition) ProtoMessage()               {}
+func (*MembershipTransition) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{2} }
+
 type Service struct {
 	ID   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Meta Meta        `protobuf:"bytes,2,opt,name=meta" json:"meta"`
@@ -102,7 +120,7 @@ type Service struct {
 
 func (m *Service) Reset()                    { *m = Service{} }
 func (*Service) ProtoMessage()               {}
-func (*Service) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{2} }
+func (*Service) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{3} }
 
 // Endpoint specified all the network parameters required to
 // correctly discover and load balance a service
@@ -119,7 +137,7 @@ type Endpoint struct {
 
 func (m *Endpoint) Reset()                    { *m = Endpoint{} }
 func (*Endpoint) ProtoMessage()               {}
-func (*Endpoint) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{3} }
+func (*Endpoint) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{4} }
 
 // VirtualIP specifies a set of networks this endpoint will be attached to
 // and the IP addresses the target service will be made available under.
@@ -139,7 +157,7 @@ type Endpoint_VirtualIP struct {
 
 func (m *Endpoint_VirtualIP) Reset()                    { *m = Endpoint_VirtualIP{} }
 func (*Endpoint_VirtualIP) ProtoMessage()               {}
-func (*Endpoint_VirtualIP) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{3, 0} }
+func (*Endpoint_VirtualIP) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{4, 0} }
 
 // Task specifies the parameters for implementing a Spec. A task is effectively
 // immutable and idempotent. Once it is dispatched to a node, it will not be
@@ -203,7 +221,7 @@ type Task struct {
 
 func (m *Task) Reset()                    { *m = Task{} }
 func (*Task) ProtoMessage()               {}
-func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{4} }
+func (*Task) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{5} }
 
 // NetworkAttachment specifies the network parameters of attachment to
 // a single network by an object such as task or node.
@@ -221,7 +239,7 @@ type NetworkAttachment struct {
 
 func (m *NetworkAttachment) Reset()                    { *m = NetworkAttachment{} }
 func (*NetworkAttachment) ProtoMessage()               {}
-func (*NetworkAttachment) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{5} }
+func (*NetworkAttachment) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{6} }
 
 type Network struct {
 	ID   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -236,7 +254,7 @@ type Network struct {
 
 func (m *Network) Reset()                    { *m = Network{} }
 func (*Network) ProtoMessage()               {}
-func (*Network) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{6} }
+func (*Network) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{7} }
 
 // Cluster provides global cluster settings.
 type Cluster struct {
@@ -262,11 +280,15 @@ type Cluster struct {
 	// If the key is empty, the node will be unlocked (will not require a key
 	// to start up from a shut down state).
 	UnlockKeys []*EncryptionKey `protobuf:"bytes,9,rep,name=unlock_keys,json=unlockKeys" json:"unlock_keys,omitempty"`
+	// RootRotationCursor records how far the root rotation reconciliation
+	// loop of a CA server that handed off to another's had got, so that the
+	// other can pick up where it left off.
+	RootRotationCursor *RootRotationCursor `protobuf:"bytes,10,opt,name=root_rotation_cursor,json=rootRotationCursor" json:"root_rotation_cursor,omitempty"`
 }
 
 func (m *Cluster) Reset()                    { *m = Cluster{} }
 func (*Cluster) ProtoMessage()               {}
-func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{7} }
+func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{8} }
 
 // Secret represents a secret that should be passed to a container or a node,
 // and is immutable.
@@ -282,7 +304,7 @@ type Secret struct {
 
 func (m *Secret) Reset()                    { *m = Secret{} }
 func (*Secret) ProtoMessage()               {}
-func (*Secret) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{8} }
+func (*Secret) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{9} }
 
 // Config represents a set of configuration files that should be passed to a
 // container.
@@ -296,7 +318,26 @@ type Config struct {
 
 func (m *Config) Reset()                    { *m = Config{} }
 func (*Config) ProtoMessage()               {}
-func (*Config) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{9} }
+func (*Config) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{10} }
+
+// ApprovedKey is a node public key approved in advance to join the cluster,
+// so that a node with the key can be issued a certificate without a join
+// token. The approval is consumed when the certificate is issued.
+type ApprovedKey struct {
+	// ID is the fingerprint of the key: the hex-encoded SHA-256 digest of
+	// its DER-encoded SubjectPublicKeyInfo.
+	ID   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Meta Meta   `protobuf:"bytes,2,opt,name=meta" json:"meta"`
+	// Annotations may record, for example, the host the key was approved
+	// for.
+	Annotations Annotations `protobuf:"bytes,3,opt,name=annotations" json:"annotations"`
+	// Role is the role the node joins with.
+	Role NodeRole `protobuf:"varint,4,opt,name=role,proto3,enum=docker.swarmkit.v1.NodeRole" json:"role,omitempty"`
+}
+
+func (m *ApprovedKey) Reset()                    { *m = ApprovedKey{} }
+func (*ApprovedKey) ProtoMessage()               {}
+func (*ApprovedKey) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{11} }
 
 // Resource is a top-level object with externally defined content and indexing.
 // SwarmKit can serve as a store for these objects without understanding their
@@ -316,7 +357,7 @@ type Resource struct {
 
 func (m *Resource) Reset()                    { *m = Resource{} }
 func (*Resource) ProtoMessage()               {}
-func (*Resource) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{10} }
+func (*Resource) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{12} }
 
 // Extension declares a type of "resource" object. This message provides some
 // metadata about the objects.
@@ -329,11 +370,12 @@ type Extension struct {
 
 func (m *Extension) Reset()                    { *m = Extension{} }
 func (*Extension) ProtoMessage()               {}
-func (*Extension) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{11} }
+func (*Extension) Descriptor() ([]byte, []int) { return fileDescriptorObjects, []int{13} }
 
 func init() {
 	proto.RegisterType((*Meta)(nil), "docker.swarmkit.v1.Meta")
 	proto.RegisterType((*Node)(nil), "docker.swarmkit.v1.Node")
+	proto.RegisterType((*MembershipTransition)(nil), "docker.swarmkit.v1.MembershipTransition")
 	proto.RegisterType((*Service)(nil), "docker.swarmkit.v1.Service")
 	proto.RegisterType((*Endpoint)(nil), "docker.swarmkit.v1.Endpoint")
 	proto.RegisterType((*Endpoint_VirtualIP)(nil), "docker.swarmkit.v1.Endpoint.VirtualIP")
@@ -343,6 +385,7 @@ func init() {
 	proto.RegisterType((*Cluster)(nil), "docker.swarmkit.v1.Cluster")
 	proto.RegisterType((*Secret)(nil), "docker.swarmkit.v1.Secret")
 	proto.RegisterType((*Config)(nil), "docker.swarmkit.v1.Config")
+	proto.RegisterType((*ApprovedKey)(nil), "docker.swarmkit.v1.ApprovedKey")
 	proto.RegisterType((*Resource)(nil), "docker.swarmkit.v1.Resource")
 	proto.RegisterType((*Extension)(nil), "docker.swarmkit.v1.Extension")
 }
@@ -400,6 +443,33 @@ func (m *Node) CopyFrom(src interface{}) {
 		github_com_docker_swarmkit_api_deepcopy.Copy(m.Attachment, o.Attachment)
 	}
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Certificate, &o.Certificate)
+	if o.MembershipTransition != nil {
+		m.MembershipTransition = &MembershipTransition{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.MembershipTransition, o.MembershipTransition)
+	}
+	if o.DeletedAt != nil {
+		m.DeletedAt = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.DeletedAt, o.DeletedAt)
+	}
+}
+
+func (m *MembershipTransition) Copy() *MembershipTransition {
+	if m == nil {
+		return nil
+	}
+	o := &MembershipTransition{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *MembershipTransition) CopyFrom(src interface{}) {
+
+	o := src.(*MembershipTransition)
+	*m = *o
+	if o.Timestamp != nil {
+		m.Timestamp = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.Timestamp, o.Timestamp)
+	}
 }
 
 func (m *Service) Copy() *Service {
@@ -623,6 +693,10 @@ func (m *Cluster) CopyFrom(src interface{}) {
 		}
 	}
 
+	if o.RootRotationCursor != nil {
+		m.RootRotationCursor = &RootRotationCursor{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.RootRotationCursor, o.RootRotationCursor)
+	}
 }
 
 func (m *Secret) Copy() *Secret {
@@ -659,6 +733,23 @@ func (m *Config) CopyFrom(src interface{}) {
 	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Spec, &o.Spec)
 }
 
+func (m *ApprovedKey) Copy() *ApprovedKey {
+	if m == nil {
+		return nil
+	}
+	o := &ApprovedKey{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *ApprovedKey) CopyFrom(src interface{}) {
+
+	o := src.(*ApprovedKey)
+	*m = *o
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Meta, &o.Meta)
+	github_com_docker_swarmkit_api_deepcopy.Copy(&m.Annotations, &o.Annotations)
+}
+
 func (m *Resource) Copy() *Resource {
 	if m == nil {
 		return nil
@@ -831,6 +922,64 @@ func (m *Node) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.Role))
 	}
+	if m.MembershipTransition != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.MembershipTransition.Size()))
+		n11, err := m.MembershipTransition.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.DeletedAt != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.DeletedAt.Size()))
+		n12, err := m.DeletedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
+}
+
+func (m *MembershipTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MembershipTransition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.From != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.From))
+	}
+	if m.To != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.To))
+	}
+	if m.Timestamp != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.Timestamp.Size()))
+		n13, err := m.Timestamp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
 	return i, nil
 }
 
@@ -858,68 +1007,68 @@ func (m *Service) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n11, err := m.Meta.MarshalTo(dAtA[i:])
+	n14, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n14
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
-	n12, err := m.Spec.MarshalTo(dAtA[i:])
+	n15, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n15
 	if m.Endpoint != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.Endpoint.Size()))
-		n13, err := m.Endpoint.MarshalTo(dAtA[i:])
+		n16, err := m.Endpoint.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n16
 	}
 	if m.UpdateStatus != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.UpdateStatus.Size()))
-		n14, err := m.UpdateStatus.MarshalTo(dAtA[i:])
+		n17, err := m.UpdateStatus.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n17
 	}
 	if m.PreviousSpec != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.PreviousSpec.Size()))
-		n15, err := m.PreviousSpec.MarshalTo(dAtA[i:])
+		n18, err := m.PreviousSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.SpecVersion != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.SpecVersion.Size()))
-		n16, err := m.SpecVersion.MarshalTo(dAtA[i:])
+		n19, err := m.SpecVersion.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n19
 	}
 	if m.PreviousSpecVersion != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.PreviousSpecVersion.Size()))
-		n17, err := m.PreviousSpecVersion.MarshalTo(dAtA[i:])
+		n20, err := m.PreviousSpecVersion.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n20
 	}
 	return i, nil
 }
@@ -943,11 +1092,11 @@ func (m *Endpoint) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
-		n18, err := m.Spec.MarshalTo(dAtA[i:])
+		n21, err := m.Spec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if len(m.Ports) > 0 {
 		for _, msg := range m.Ports {
@@ -1030,19 +1179,19 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n19, err := m.Meta.MarshalTo(dAtA[i:])
+	n22, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n22
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
-	n20, err := m.Spec.MarshalTo(dAtA[i:])
+	n23, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n23
 	if len(m.ServiceID) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -1063,27 +1212,27 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x3a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Annotations.Size()))
-	n21, err := m.Annotations.MarshalTo(dAtA[i:])
+	n24, err := m.Annotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n24
 	dAtA[i] = 0x42
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.ServiceAnnotations.Size()))
-	n22, err := m.ServiceAnnotations.MarshalTo(dAtA[i:])
+	n25, err := m.ServiceAnnotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n25
 	dAtA[i] = 0x4a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Status.Size()))
-	n23, err := m.Status.MarshalTo(dAtA[i:])
+	n26, err := m.Status.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n26
 	if m.DesiredState != 0 {
 		dAtA[i] = 0x50
 		i++
@@ -1105,31 +1254,31 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.Endpoint.Size()))
-		n24, err := m.Endpoint.MarshalTo(dAtA[i:])
+		n27, err := m.Endpoint.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n27
 	}
 	if m.LogDriver != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.LogDriver.Size()))
-		n25, err := m.LogDriver.MarshalTo(dAtA[i:])
+		n28, err := m.LogDriver.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n28
 	}
 	if m.SpecVersion != nil {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.SpecVersion.Size()))
-		n26, err := m.SpecVersion.MarshalTo(dAtA[i:])
+		n29, err := m.SpecVersion.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n29
 	}
 	return i, nil
 }
@@ -1153,11 +1302,11 @@ func (m *NetworkAttachment) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.Network.Size()))
-		n27, err := m.Network.MarshalTo(dAtA[i:])
+		n30, err := m.Network.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n30
 	}
 	if len(m.Addresses) > 0 {
 		for _, s := range m.Addresses {
@@ -1216,38 +1365,38 @@ func (m *Network) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n28, err := m.Meta.MarshalTo(dAtA[i:])
+	n31, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n31
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
-	n29, err := m.Spec.MarshalTo(dAtA[i:])
+	n32, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n32
 	if m.DriverState != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.DriverState.Size()))
-		n30, err := m.DriverState.MarshalTo(dAtA[i:])
+		n33, err := m.DriverState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n33
 	}
 	if m.IPAM != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.IPAM.Size()))
-		n31, err := m.IPAM.MarshalTo(dAtA[i:])
+		n34, err := m.IPAM.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n34
 	}
 	return i, nil
 }
@@ -1276,27 +1425,27 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n32, err := m.Meta.MarshalTo(dAtA[i:])
+	n35, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n35
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
-	n33, err := m.Spec.MarshalTo(dAtA[i:])
+	n36, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n36
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.RootCA.Size()))
-	n34, err := m.RootCA.MarshalTo(dAtA[i:])
+	n37, err := m.RootCA.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n37
 	if len(m.NetworkBootstrapKeys) > 0 {
 		for _, msg := range m.NetworkBootstrapKeys {
 			dAtA[i] = 0x2a
@@ -1334,11 +1483,11 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintObjects(dAtA, i, uint64(v.Size()))
-				n35, err := v.MarshalTo(dAtA[i:])
+				n38, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n35
+				i += n38
 			}
 		}
 	}
@@ -1354,6 +1503,16 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.RootRotationCursor != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.RootRotationCursor.Size()))
+		n39, err := m.RootRotationCursor.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
 	return i, nil
 }
 
@@ -1381,19 +1540,19 @@ func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n36, err := m.Meta.MarshalTo(dAtA[i:])
+	n40, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n36
+	i += n40
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
-	n37, err := m.Spec.MarshalTo(dAtA[i:])
+	n41, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n41
 	if m.Internal {
 		dAtA[i] = 0x20
 		i++
@@ -1431,19 +1590,64 @@ func (m *Config) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n38, err := m.Meta.MarshalTo(dAtA[i:])
+	n42, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n42
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Spec.Size()))
-	n39, err := m.Spec.MarshalTo(dAtA[i:])
+	n43, err := m.Spec.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n43
+	return i, nil
+}
+
+func (m *ApprovedKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovedKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
+	n44, err := m.Meta.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n44
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintObjects(dAtA, i, uint64(m.Annotations.Size()))
+	n45, err := m.Annotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n45
+	if m.Role != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintObjects(dAtA, i, uint64(m.Role))
+	}
 	return i, nil
 }
 
@@ -1471,19 +1675,19 @@ func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n40, err := m.Meta.MarshalTo(dAtA[i:])
+	n46, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n46
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Annotations.Size()))
-	n41, err := m.Annotations.MarshalTo(dAtA[i:])
+	n47, err := m.Annotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n47
 	if len(m.Kind) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -1494,11 +1698,11 @@ func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintObjects(dAtA, i, uint64(m.Payload.Size()))
-		n42, err := m.Payload.MarshalTo(dAtA[i:])
+		n48, err := m.Payload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n48
 	}
 	return i, nil
 }
@@ -1527,19 +1731,19 @@ func (m *Extension) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Meta.Size()))
-	n43, err := m.Meta.MarshalTo(dAtA[i:])
+	n49, err := m.Meta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n49
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintObjects(dAtA, i, uint64(m.Annotations.Size()))
-	n44, err := m.Annotations.MarshalTo(dAtA[i:])
+	n50, err := m.Annotations.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n50
 	if len(m.Description) > 0 {
 		dAtA[i] = 0x22
 		i++
@@ -1623,6 +1827,30 @@ func (m *Node) Size() (n int) {
 	if m.Role != 0 {
 		n += 1 + sovObjects(uint64(m.Role))
 	}
+	if m.MembershipTransition != nil {
+		l = m.MembershipTransition.Size()
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	if m.DeletedAt != nil {
+		l = m.DeletedAt.Size()
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	return n
+}
+
+func (m *MembershipTransition) Size() (n int) {
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovObjects(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovObjects(uint64(m.To))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovObjects(uint64(l))
+	}
 	return n
 }
 
@@ -1833,6 +2061,10 @@ func (m *Cluster) Size() (n int) {
 			n += 1 + l + sovObjects(uint64(l))
 		}
 	}
+	if m.RootRotationCursor != nil {
+		l = m.RootRotationCursor.Size()
+		n += 1 + l + sovObjects(uint64(l))
+	}
 	return n
 }
 
@@ -1867,6 +2099,23 @@ func (m *Config) Size() (n int) {
 	return n
 }
 
+func (m *ApprovedKey) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovObjects(uint64(l))
+	}
+	l = m.Meta.Size()
+	n += 1 + l + sovObjects(uint64(l))
+	l = m.Annotations.Size()
+	n += 1 + l + sovObjects(uint64(l))
+	if m.Role != 0 {
+		n += 1 + sovObjects(uint64(m.Role))
+	}
+	return n
+}
+
 func (m *Resource) Size() (n int) {
 	var l int
 	_ = l
@@ -3513,132 +3762,110 @@ func (indexer ConfigCustomIndexer) FromObject(obj interface{}) (bool, [][]byte,
 	return customIndexer("", &m.Spec.Annotations)
 }
 
-type ResourceCheckFunc func(t1, t2 *Resource) bool
+type ApprovedKeyCheckFunc func(t1, t2 *ApprovedKey) bool
 
-type EventCreateResource struct {
-	Resource *Resource
-	Checks   []ResourceCheckFunc
+type EventCreateApprovedKey struct {
+	ApprovedKey *ApprovedKey
+	Checks      []ApprovedKeyCheckFunc
 }
 
-func (e EventCreateResource) Matches(apiEvent github_com_docker_go_events.Event) bool {
-	typedEvent, ok := apiEvent.(EventCreateResource)
+func (e EventCreateApprovedKey) Matches(apiEvent github_com_docker_go_events.Event) bool {
+	typedEvent, ok := apiEvent.(EventCreateApprovedKey)
 	if !ok {
 		return false
 	}
 
 	for _, check := range e.Checks {
-		if !check(e.Resource, typedEvent.Resource) {
+		if !check(e.ApprovedKey, typedEvent.ApprovedKey) {
 			return false
 		}
 	}
 	return true
 }
 
-type EventUpdateResource struct {
-	Resource    *Resource
-	OldResource *Resource
-	Checks      []ResourceCheckFunc
+type EventUpdateApprovedKey struct {
+	ApprovedKey    *ApprovedKey
+	OldApprovedKey *ApprovedKey
+	Checks         []ApprovedKeyCheckFunc
 }
 
-func (e EventUpdateResource) Matches(apiEvent github_com_docker_go_events.Event) bool {
-	typedEvent, ok := apiEvent.(EventUpdateResource)
+func (e EventUpdateApprovedKey) Matches(apiEvent github_com_docker_go_events.Event) bool {
+	typedEvent, ok := apiEvent.(EventUpdateApprovedKey)
 	if !ok {
 		return false
 	}
 
 	for _, check := range e.Checks {
-		if !check(e.Resource, typedEvent.Resource) {
+		if !check(e.ApprovedKey, typedEvent.ApprovedKey) {
 			return false
 		}
 	}
 	return true
 }
 
-type EventDeleteResource struct {
-	Resource *Resource
-	Checks   []ResourceCheckFunc
+type EventDeleteApprovedKey struct {
+	ApprovedKey *ApprovedKey
+	Checks      []ApprovedKeyCheckFunc
 }
 
-func (e EventDeleteResource) Matches(apiEvent github_com_docker_go_events.Event) bool {
-	typedEvent, ok := apiEvent.(EventDeleteResource)
+func (e EventDeleteApprovedKey) Matches(apiEvent github_com_docker_go_events.Event) bool {
+	typedEvent, ok := apiEvent.(EventDeleteApprovedKey)
 	if !ok {
 		return false
 	}
 
 	for _, check := range e.Checks {
-		if !check(e.Resource, typedEvent.Resource) {
+		if !check(e.ApprovedKey, typedEvent.ApprovedKey) {
 			return false
 		}
 	}
 	return true
 }
-func (m *Resource) CopyStoreObject() StoreObject {
+func (m *ApprovedKey) CopyStoreObject() StoreObject {
 	return m.Copy()
 }
 
-func (m *Resource) GetMeta() Meta {
+func (m *ApprovedKey) GetMeta() Meta {
 	return m.Meta
 }
 
-func (m *Resource) SetMeta(meta Meta) {
+func (m *ApprovedKey) SetMeta(meta Meta) {
 	m.Meta = meta
 }
 
-func (m *Resource) GetID() string {
+func (m *ApprovedKey) GetID() string {
 	return m.ID
 }
 
-func (m *Resource) EventCreate() Event {
-	return EventCreateResource{Resource: m}
+func (m *ApprovedKey) EventCreate() Event {
+	return EventCreateApprovedKey{ApprovedKey: m}
 }
 
-func (m *Resource) EventUpdate(oldObject StoreObject) Event {
+func (m *ApprovedKey) EventUpdate(oldObject StoreObject) Event {
 	if oldObject != nil {
-		return EventUpdateResource{Resource: m, OldResource: oldObject.(*Resource)}
+		return EventUpdateApprovedKey{ApprovedKey: m, OldApprovedKey: oldObject.(*ApprovedKey)}
 	} else {
-		return EventUpdateResource{Resource: m}
+		return EventUpdateApprovedKey{ApprovedKey: m}
 	}
 }
 
-func (m *Resource) EventDelete() Event {
-	return EventDeleteResource{Resource: m}
+func (m *ApprovedKey) EventDelete() Event {
+	return EventDeleteApprovedKey{ApprovedKey: m}
 }
 
-func ResourceCheckID(v1, v2 *Resource) bool {
+func ApprovedKeyCheckID(v1, v2 *ApprovedKey) bool {
 	return v1.ID == v2.ID
 }
 
-func ResourceCheckIDPrefix(v1, v2 *Resource) bool {
+func ApprovedKeyCheckIDPrefix(v1, v2 *ApprovedKey) bool {
 	return strings.HasPrefix(v2.ID, v1.ID)
 }
 
-func ResourceCheckName(v1, v2 *Resource) bool {
-	return v1.Annotations.Name == v2.Annotations.Name
-}
-
-func ResourceCheckNamePrefix(v1, v2 *Resource) bool {
-	return strings.HasPrefix(v2.Annotations.Name, v1.Annotations.Name)
-}
-
-func ResourceCheckCustom(v1, v2 *Resource) bool {
-	return checkCustom(v1.Annotations, v2.Annotations)
-}
-
-func ResourceCheckCustomPrefix(v1, v2 *Resource) bool {
-	return checkCustomPrefix(v1.Annotations, v2.Annotations)
-}
-
-func ResourceCheckKind(v1, v2 *Resource) bool {
-	return v1.Kind == v2.Kind
-}
-
-func ConvertResourceWatch(action WatchActionKind, filters []*SelectBy, kind string) ([]Event, error) {
+func ConvertApprovedKeyWatch(action WatchActionKind, filters []*SelectBy) ([]Event, error) {
 	var (
-		m          Resource
-		checkFuncs []ResourceCheckFunc
+		m          ApprovedKey
+		checkFuncs []ApprovedKeyCheckFunc
 	)
-	m.Kind = kind
-	checkFuncs = append(checkFuncs, ResourceCheckKind)
 
 	for _, filter := range filters {
 		switch v := filter.By.(type) {
@@ -3647,27 +3874,226 @@ func ConvertResourceWatch(action WatchActionKind, filters []*SelectBy, kind stri
 				return nil, errConflictingFilters
 			}
 			m.ID = v.ID
-			checkFuncs = append(checkFuncs, ResourceCheckID)
+			checkFuncs = append(checkFuncs, ApprovedKeyCheckID)
 		case *SelectBy_IDPrefix:
 			if m.ID != "" {
 				return nil, errConflictingFilters
 			}
 			m.ID = v.IDPrefix
-			checkFuncs = append(checkFuncs, ResourceCheckIDPrefix)
-		case *SelectBy_Name:
-			if m.Annotations.Name != "" {
-				return nil, errConflictingFilters
-			}
-			m.Annotations.Name = v.Name
-			checkFuncs = append(checkFuncs, ResourceCheckName)
-		case *SelectBy_NamePrefix:
-			if m.Annotations.Name != "" {
-				return nil, errConflictingFilters
-			}
-			m.Annotations.Name = v.NamePrefix
-			checkFuncs = append(checkFuncs, ResourceCheckNamePrefix)
-		case *SelectBy_Custom:
-			if len(m.Annotations.Indices) != 0 {
+			checkFuncs = append(checkFuncs, ApprovedKeyCheckIDPrefix)
+		}
+	}
+	var events []Event
+	if (action & WatchActionKindCreate) != 0 {
+		events = append(events, EventCreateApprovedKey{ApprovedKey: &m, Checks: checkFuncs})
+	}
+	if (action & WatchActionKindUpdate) != 0 {
+		events = append(events, EventUpdateApprovedKey{ApprovedKey: &m, Checks: checkFuncs})
+	}
+	if (action & WatchActionKindRemove) != 0 {
+		events = append(events, EventDeleteApprovedKey{ApprovedKey: &m, Checks: checkFuncs})
+	}
+	if len(events) == 0 {
+		return nil, errUnrecognizedAction
+	}
+	return events, nil
+}
+
+type ApprovedKeyIndexerByID struct{}
+
+func (indexer ApprovedKeyIndexerByID) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+func (indexer ApprovedKeyIndexerByID) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
+func (indexer ApprovedKeyIndexerByID) FromObject(obj interface{}) (bool, []byte, error) {
+	m := obj.(*ApprovedKey)
+	return true, []byte(m.ID + "\x00"), nil
+}
+
+type ApprovedKeyIndexerByName struct{}
+
+func (indexer ApprovedKeyIndexerByName) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+func (indexer ApprovedKeyIndexerByName) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
+func (indexer ApprovedKeyIndexerByName) FromObject(obj interface{}) (bool, []byte, error) {
+	m := obj.(*ApprovedKey)
+	val := m.Annotations.Name
+	return true, []byte(strings.ToLower(val) + "\x00"), nil
+}
+
+type ApprovedKeyCustomIndexer struct{}
+
+func (indexer ApprovedKeyCustomIndexer) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+func (indexer ApprovedKeyCustomIndexer) PrefixFromArgs(args ...interface{}) ([]byte, error) {
+	return prefixFromArgs(args...)
+}
+func (indexer ApprovedKeyCustomIndexer) FromObject(obj interface{}) (bool, [][]byte, error) {
+	m := obj.(*ApprovedKey)
+	return customIndexer("", &m.Annotations)
+}
+
+type ResourceCheckFunc func(t1, t2 *Resource) bool
+
+type EventCreateResource struct {
+	Resource *Resource
+	Checks   []ResourceCheckFunc
+}
+
+func (e EventCreateResource) Matches(apiEvent github_com_docker_go_events.Event) bool {
+	typedEvent, ok := apiEvent.(EventCreateResource)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.Resource, typedEvent.Resource) {
+			return false
+		}
+	}
+	return true
+}
+
+type EventUpdateResource struct {
+	Resource    *Resource
+	OldResource *Resource
+	Checks      []ResourceCheckFunc
+}
+
+func (e EventUpdateResource) Matches(apiEvent github_com_docker_go_events.Event) bool {
+	typedEvent, ok := apiEvent.(EventUpdateResource)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.Resource, typedEvent.Resource) {
+			return false
+		}
+	}
+	return true
+}
+
+type EventDeleteResource struct {
+	Resource *Resource
+	Checks   []ResourceCheckFunc
+}
+
+func (e EventDeleteResource) Matches(apiEvent github_com_docker_go_events.Event) bool {
+	typedEvent, ok := apiEvent.(EventDeleteResource)
+	if !ok {
+		return false
+	}
+
+	for _, check := range e.Checks {
+		if !check(e.Resource, typedEvent.Resource) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Resource) CopyStoreObject() StoreObject {
+	return m.Copy()
+}
+
+func (m *Resource) GetMeta() Meta {
+	return m.Meta
+}
+
+func (m *Resource) SetMeta(meta Meta) {
+	m.Meta = meta
+}
+
+func (m *Resource) GetID() string {
+	return m.ID
+}
+
+func (m *Resource) EventCreate() Event {
+	return EventCreateResource{Resource: m}
+}
+
+func (m *Resource) EventUpdate(oldObject StoreObject) Event {
+	if oldObject != nil {
+		return EventUpdateResource{Resource: m, OldResource: oldObject.(*Resource)}
+	} else {
+		return EventUpdateResource{Resource: m}
+	}
+}
+
+func (m *Resource) EventDelete() Event {
+	return EventDeleteResource{Resource: m}
+}
+
+func ResourceCheckID(v1, v2 *Resource) bool {
+	return v1.ID == v2.ID
+}
+
+func ResourceCheckIDPrefix(v1, v2 *Resource) bool {
+	return strings.HasPrefix(v2.ID, v1.ID)
+}
+
+func ResourceCheckName(v1, v2 *Resource) bool {
+	return v1.Annotations.Name == v2.Annotations.Name
+}
+
+func ResourceCheckNamePrefix(v1, v2 *Resource) bool {
+	return strings.HasPrefix(v2.Annotations.Name, v1.Annotations.Name)
+}
+
+func ResourceCheckCustom(v1, v2 *Resource) bool {
+	return checkCustom(v1.Annotations, v2.Annotations)
+}
+
+func ResourceCheckCustomPrefix(v1, v2 *Resource) bool {
+	return checkCustomPrefix(v1.Annotations, v2.Annotations)
+}
+
+func ResourceCheckKind(v1, v2 *Resource) bool {
+	return v1.Kind == v2.Kind
+}
+
+func ConvertResourceWatch(action WatchActionKind, filters []*SelectBy, kind string) ([]Event, error) {
+	var (
+		m          Resource
+		checkFuncs []ResourceCheckFunc
+	)
+	m.Kind = kind
+	checkFuncs = append(checkFuncs, ResourceCheckKind)
+
+	for _, filter := range filters {
+		switch v := filter.By.(type) {
+		case *SelectBy_ID:
+			if m.ID != "" {
+				return nil, errConflictingFilters
+			}
+			m.ID = v.ID
+			checkFuncs = append(checkFuncs, ResourceCheckID)
+		case *SelectBy_IDPrefix:
+			if m.ID != "" {
+				return nil, errConflictingFilters
+			}
+			m.ID = v.IDPrefix
+			checkFuncs = append(checkFuncs, ResourceCheckIDPrefix)
+		case *SelectBy_Name:
+			if m.Annotations.Name != "" {
+				return nil, errConflictingFilters
+			}
+			m.Annotations.Name = v.Name
+			checkFuncs = append(checkFuncs, ResourceCheckName)
+		case *SelectBy_NamePrefix:
+			if m.Annotations.Name != "" {
+				return nil, errConflictingFilters
+			}
+			m.Annotations.Name = v.NamePrefix
+			checkFuncs = append(checkFuncs, ResourceCheckNamePrefix)
+		case *SelectBy_Custom:
+			if len(m.Annotations.Indices) != 0 {
 				return nil, errConflictingFilters
 			}
 			m.Annotations.Indices = []IndexEntry{{Key: v.Custom.Index, Val: v.Custom.Value}}
@@ -4018,6 +4444,15 @@ func NewStoreAction(c Event) (StoreAction, error) {
 	case EventDeleteConfig:
 		sa.Action = StoreActionKindRemove
 		sa.Target = &StoreAction_Config{Config: v.Config}
+	case EventCreateApprovedKey:
+		sa.Action = StoreActionKindCreate
+		sa.Target = &StoreAction_ApprovedKey{ApprovedKey: v.ApprovedKey}
+	case EventUpdateApprovedKey:
+		sa.Action = StoreActionKindUpdate
+		sa.Target = &StoreAction_ApprovedKey{ApprovedKey: v.ApprovedKey}
+	case EventDeleteApprovedKey:
+		sa.Action = StoreActionKindRemove
+		sa.Target = &StoreAction_ApprovedKey{ApprovedKey: v.ApprovedKey}
 	case EventCreateResource:
 		sa.Action = StoreActionKindCreate
 		sa.Target = &StoreAction_Resource{Resource: v.Resource}
@@ -4135,6 +4570,19 @@ func EventFromStoreAction(sa StoreAction, oldObject StoreObject) (Event, error)
 		case StoreActionKindRemove:
 			return EventDeleteConfig{Config: v.Config}, nil
 		}
+	case *StoreAction_ApprovedKey:
+		switch sa.Action {
+		case StoreActionKindCreate:
+			return EventCreateApprovedKey{ApprovedKey: v.ApprovedKey}, nil
+		case StoreActionKindUpdate:
+			if oldObject != nil {
+				return EventUpdateApprovedKey{ApprovedKey: v.ApprovedKey, OldApprovedKey: oldObject.(*ApprovedKey)}, nil
+			} else {
+				return EventUpdateApprovedKey{ApprovedKey: v.ApprovedKey}, nil
+			}
+		case StoreActionKindRemove:
+			return EventDeleteApprovedKey{ApprovedKey: v.ApprovedKey}, nil
+		}
 	case *StoreAction_Resource:
 		switch sa.Action {
 		case StoreActionKindCreate:
@@ -4237,6 +4685,16 @@ func WatchMessageEvent(c Event) *WatchMessage_Event {
 		}
 	case EventDeleteConfig:
 		return &WatchMessage_Event{Action: WatchActionKindRemove, Object: &Object{Object: &Object_Config{Config: v.Config}}}
+	case EventCreateApprovedKey:
+		return &WatchMessage_Event{Action: WatchActionKindCreate, Object: &Object{Object: &Object_ApprovedKey{ApprovedKey: v.ApprovedKey}}}
+	case EventUpdateApprovedKey:
+		if v.OldApprovedKey != nil {
+			return &WatchMessage_Event{Action: WatchActionKindUpdate, Object: &Object{Object: &Object_ApprovedKey{ApprovedKey: v.ApprovedKey}}, OldObject: &Object{Object: &Object_ApprovedKey{ApprovedKey: v.OldApprovedKey}}}
+		} else {
+			return &WatchMessage_Event{Action: WatchActionKindUpdate, Object: &Object{Object: &Object_ApprovedKey{ApprovedKey: v.ApprovedKey}}}
+		}
+	case EventDeleteApprovedKey:
+		return &WatchMessage_Event{Action: WatchActionKindRemove, Object: &Object{Object: &Object_ApprovedKey{ApprovedKey: v.ApprovedKey}}}
 	case EventCreateResource:
 		return &WatchMessage_Event{Action: WatchActionKindCreate, Object: &Object{Object: &Object_Resource{Resource: v.Resource}}}
 	case EventUpdateResource:
@@ -4283,6 +4741,8 @@ func ConvertWatchArgs(entries []*WatchRequest_WatchEntry) ([]Event, error) {
 			newEvents, err = ConvertSecretWatch(entry.Action, entry.Filters)
 		case "config":
 			newEvents, err = ConvertConfigWatch(entry.Action, entry.Filters)
+		case "approvedkey":
+			newEvents, err = ConvertApprovedKeyWatch(entry.Action, entry.Filters)
 		default:
 			newEvents, err = ConvertResourceWatch(entry.Action, entry.Filters, entry.Kind)
 		case "extension":
@@ -4322,6 +4782,20 @@ func (this *Node) String() string {
 		`Attachment:` + strings.Replace(fmt.Sprintf("%v", this.Attachment), "NetworkAttachment", "NetworkAttachment", 1) + `,`,
 		`Certificate:` + strings.Replace(strings.Replace(this.Certificate.String(), "Certificate", "Certificate", 1), `&`, ``, 1) + `,`,
 		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`MembershipTransition:` + strings.Replace(fmt.Sprintf("%v", this.MembershipTransition), "MembershipTransition", "MembershipTransition", 1) + `,`,
+		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MembershipTransition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MembershipTransition{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Timestamp:` + strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4438,6 +4912,7 @@ func (this *Cluster) String() string {
 		`EncryptionKeyLamportClock:` + fmt.Sprintf("%v", this.EncryptionKeyLamportClock) + `,`,
 		`BlacklistedCertificates:` + mapStringForBlacklistedCertificates + `,`,
 		`UnlockKeys:` + strings.Replace(fmt.Sprintf("%v", this.UnlockKeys), "EncryptionKey", "EncryptionKey", 1) + `,`,
+		`RootRotationCursor:` + strings.Replace(fmt.Sprintf("%v", this.RootRotationCursor), "RootRotationCursor", "RootRotationCursor", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4467,6 +4942,19 @@ func (this *Config) String() string {
 	}, "")
 	return s
 }
+func (this *ApprovedKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApprovedKey{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Meta:` + strings.Replace(strings.Replace(this.Meta.String(), "Meta", "Meta", 1), `&`, ``, 1) + `,`,
+		`Annotations:` + strings.Replace(strings.Replace(this.Annotations.String(), "Annotations", "Annotations", 1), `&`, ``, 1) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Resource) String() string {
 	if this == nil {
 		return "nil"
@@ -4833,7 +5321,238 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ManagerStatus", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagerStatus == nil {
+				m.ManagerStatus = &ManagerStatus{}
+			}
+			if err := m.ManagerStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attachment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attachment == nil {
+				m.Attachment = &NetworkAttachment{}
+			}
+			if err := m.Attachment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Certificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= (NodeRole(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MembershipTransition == nil {
+				m.MembershipTransition = &MembershipTransition{}
+			}
+			if err := m.MembershipTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletedAt == nil {
+				m.DeletedAt = &google_protobuf.Timestamp{}
+			}
+			if err := m.DeletedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowObjects
@@ -4843,30 +5562,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.From |= (NodeSpec_Membership(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthObjects
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ManagerStatus == nil {
-				m.ManagerStatus = &ManagerStatus{}
-			}
-			if err := m.ManagerStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attachment", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var msglen int
+			m.To = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowObjects
@@ -4876,28 +5581,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.To |= (NodeSpec_Membership(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthObjects
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Attachment == nil {
-				m.Attachment = &NetworkAttachment{}
-			}
-			if err := m.Attachment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4921,29 +5612,13 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Certificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Timestamp == nil {
+				m.Timestamp = &google_protobuf.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
-			}
-			m.Role = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowObjects
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Role |= (NodeRole(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjects(dAtA[iNdEx:])
@@ -6673,6 +7348,39 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootRotationCursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RootRotationCursor == nil {
+				m.RootRotationCursor = &RootRotationCursor{}
+			}
+			if err := m.RootRotationCursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipObjects(dAtA[iNdEx:])
@@ -6992,6 +7700,164 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApprovedKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObjects
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovedKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovedKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Meta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthObjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Annotations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= (NodeRole(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObjects(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthObjects
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Resource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7469,93 +8335,103 @@ var (
 func init() { proto.RegisterFile("objects.proto", fileDescriptorObjects) }
 
 var fileDescriptorObjects = []byte{
-	// 1405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x57, 0xc1, 0x6f, 0x1b, 0x45,
-	0x17, 0xef, 0xda, 0x1b, 0xdb, 0xfb, 0x9c, 0x58, 0xf9, 0xa6, 0xf9, 0xf2, 0x6d, 0xf3, 0x05, 0x3b,
-	0xb8, 0x02, 0x55, 0xa8, 0x72, 0x4a, 0x29, 0x28, 0x0d, 0x14, 0x6a, 0x27, 0x11, 0xb5, 0x4a, 0x69,
-	0x34, 0x2d, 0x2d, 0x37, 0x33, 0xd9, 0x9d, 0xba, 0x8b, 0xd7, 0x3b, 0xab, 0x9d, 0xb1, 0x8b, 0x6f,
-	0x88, 0x63, 0xfe, 0x81, 0xdc, 0x38, 0xf4, 0xc4, 0x1d, 0x2e, 0x5c, 0x38, 0xf7, 0xc8, 0x09, 0x71,
-	0x8a, 0xa8, 0xff, 0x0b, 0x24, 0x0e, 0x68, 0x66, 0x67, 0x9d, 0x4d, 0xbd, 0x4e, 0x5a, 0x54, 0x45,
-	0x9c, 0x3c, 0xb3, 0xf3, 0xfb, 0xbd, 0x79, 0xef, 0xcd, 0x6f, 0xde, 0x3c, 0xc3, 0x02, 0xdb, 0xfb,
-	0x9a, 0x3a, 0x82, 0x37, 0xc2, 0x88, 0x09, 0x86, 0x90, 0xcb, 0x9c, 0x1e, 0x8d, 0x1a, 0xfc, 0x09,
-	0x89, 0xfa, 0x3d, 0x4f, 0x34, 0x86, 0xef, 0xae, 0x94, 0xc5, 0x28, 0xa4, 0x1a, 0xb0, 0x52, 0xe6,
-	0x21, 0x75, 0x92, 0x49, 0xad, 0xcb, 0x58, 0xd7, 0xa7, 0xeb, 0x6a, 0xb6, 0x37, 0x78, 0xb4, 0x2e,
-	0xbc, 0x3e, 0xe5, 0x82, 0xf4, 0x43, 0x0d, 0x58, 0xea, 0xb2, 0x2e, 0x53, 0xc3, 0x75, 0x39, 0xd2,
-	0x5f, 0x2f, 0xbc, 0x48, 0x23, 0xc1, 0x48, 0x2f, 0x9d, 0x0f, 0xfd, 0x41, 0xd7, 0x0b, 0xd6, 0xe3,
-	0x9f, 0xf8, 0x63, 0xfd, 0x67, 0x03, 0xcc, 0x3b, 0x54, 0x10, 0xf4, 0x21, 0x14, 0x87, 0x34, 0xe2,
-	0x1e, 0x0b, 0x6c, 0x63, 0xcd, 0xb8, 0x54, 0xbe, 0xfa, 0xff, 0xc6, 0xb4, 0xbf, 0x8d, 0x07, 0x31,
-	0xa4, 0x65, 0x3e, 0x3b, 0xac, 0x9d, 0xc3, 0x09, 0x03, 0x5d, 0x07, 0x70, 0x22, 0x4a, 0x04, 0x75,
-	0x3b, 0x44, 0xd8, 0x39, 0xc5, 0x5f, 0x69, 0xc4, 0xae, 0x34, 0x12, 0x57, 0x1a, 0xf7, 0x93, 0x08,
-	0xb0, 0xa5, 0xd1, 0x4d, 0x21, 0xa9, 0x83, 0xd0, 0x4d, 0xa8, 0xf9, 0xd3, 0xa9, 0x1a, 0xdd, 0x14,
-	0xf5, 0x1f, 0x4d, 0x30, 0x3f, 0x67, 0x2e, 0x45, 0xcb, 0x90, 0xf3, 0x5c, 0xe5, 0xb6, 0xd5, 0x2a,
-	0x8c, 0x0f, 0x6b, 0xb9, 0xf6, 0x36, 0xce, 0x79, 0x2e, 0xba, 0x0a, 0x66, 0x9f, 0x0a, 0xa2, 0x1d,
-	0xb2, 0xb3, 0x02, 0x92, 0xb1, 0xeb, 0x68, 0x14, 0x16, 0x7d, 0x00, 0xa6, 0x3c, 0x06, 0xed, 0xc9,
-	0x6a, 0x16, 0x47, 0xee, 0x79, 0x2f, 0xa4, 0x4e, 0xc2, 0x93, 0x78, 0xb4, 0x03, 0x65, 0x97, 0x72,
-	0x27, 0xf2, 0x42, 0x21, 0x73, 0x68, 0x2a, 0xfa, 0xc5, 0x59, 0xf4, 0xed, 0x23, 0x28, 0x4e, 0xf3,
-	0xd0, 0x47, 0x50, 0xe0, 0x82, 0x88, 0x01, 0xb7, 0xe7, 0x94, 0x85, 0xea, 0x4c, 0x07, 0x14, 0x4a,
-	0xbb, 0xa0, 0x39, 0xe8, 0x16, 0x54, 0xfa, 0x24, 0x20, 0x5d, 0x1a, 0x75, 0xb4, 0x95, 0x82, 0xb2,
-	0xf2, 0x66, 0x66, 0xe8, 0x31, 0x32, 0x36, 0x84, 0x17, 0xfa, 0xe9, 0x29, 0xda, 0x01, 0x20, 0x42,
-	0x10, 0xe7, 0x71, 0x9f, 0x06, 0xc2, 0x2e, 0x2a, 0x2b, 0x6f, 0x65, 0xfa, 0x42, 0xc5, 0x13, 0x16,
-	0xf5, 0x9a, 0x13, 0x30, 0x4e, 0x11, 0xd1, 0xa7, 0x50, 0x76, 0x68, 0x24, 0xbc, 0x47, 0x9e, 0x43,
-	0x04, 0xb5, 0x4b, 0xca, 0x4e, 0x2d, 0xcb, 0xce, 0xd6, 0x11, 0x4c, 0x07, 0x95, 0x66, 0xa2, 0x2b,
-	0x60, 0x46, 0xcc, 0xa7, 0xb6, 0xb5, 0x66, 0x5c, 0xaa, 0xcc, 0x3e, 0x16, 0xcc, 0x7c, 0x8a, 0x15,
-	0x72, 0x73, 0x79, 0xff, 0xa0, 0x8e, 0x60, 0xb1, 0x64, 0x2c, 0x1a, 0x4a, 0x1a, 0xc6, 0x15, 0xe3,
-	0x4b, 0xe3, 0x2b, 0xa3, 0xfe, 0x57, 0x1e, 0x8a, 0xf7, 0x68, 0x34, 0xf4, 0x9c, 0xd7, 0x2b, 0x9c,
-	0xeb, 0xc7, 0x84, 0x93, 0x19, 0xa3, 0xde, 0x76, 0x4a, 0x3b, 0x1b, 0x50, 0xa2, 0x81, 0x1b, 0x32,
-	0x2f, 0x10, 0x5a, 0x38, 0x99, 0x01, 0xee, 0x68, 0x0c, 0x9e, 0xa0, 0xd1, 0x0e, 0x2c, 0xc4, 0xf7,
-	0xa1, 0x73, 0x4c, 0x35, 0x6b, 0x59, 0xf4, 0x2f, 0x14, 0x50, 0x1f, 0xf7, 0xfc, 0x20, 0x35, 0x43,
-	0xdb, 0xb0, 0x10, 0x46, 0x74, 0xe8, 0xb1, 0x01, 0xef, 0xa8, 0x20, 0x0a, 0x2f, 0x15, 0x04, 0x9e,
-	0x4f, 0x58, 0x72, 0x86, 0x3e, 0x86, 0x79, 0x49, 0xee, 0x24, 0x75, 0x04, 0x4e, 0xad, 0x23, 0x58,
-	0x95, 0x3c, 0x3d, 0x41, 0x77, 0xe1, 0xbf, 0xc7, 0xbc, 0x98, 0x18, 0x2a, 0x9f, 0x6e, 0xe8, 0x7c,
-	0xda, 0x13, 0xfd, 0x71, 0x13, 0xed, 0x1f, 0xd4, 0x2b, 0x30, 0x9f, 0x96, 0x40, 0xfd, 0xfb, 0x1c,
-	0x94, 0x92, 0x44, 0xa2, 0x6b, 0xfa, 0xcc, 0x8c, 0xd9, 0x59, 0x4b, 0xb0, 0x2a, 0xde, 0xf8, 0xb8,
-	0xae, 0xc1, 0x5c, 0xc8, 0x22, 0xc1, 0xed, 0xdc, 0x5a, 0x7e, 0xd6, 0x15, 0xdd, 0x65, 0x91, 0xd8,
-	0x62, 0xc1, 0x23, 0xaf, 0x8b, 0x63, 0x30, 0x7a, 0x08, 0xe5, 0xa1, 0x17, 0x89, 0x01, 0xf1, 0x3b,
-	0x5e, 0xc8, 0xed, 0xbc, 0xe2, 0xbe, 0x7d, 0xd2, 0x96, 0x8d, 0x07, 0x31, 0xbe, 0xbd, 0xdb, 0xaa,
-	0x8c, 0x0f, 0x6b, 0x30, 0x99, 0x72, 0x0c, 0xda, 0x54, 0x3b, 0xe4, 0x2b, 0x77, 0xc0, 0x9a, 0xac,
-	0xa0, 0xcb, 0x00, 0x41, 0x7c, 0x23, 0x3b, 0x13, 0x65, 0x2f, 0x8c, 0x0f, 0x6b, 0x96, 0xbe, 0xa7,
-	0xed, 0x6d, 0x6c, 0x69, 0x40, 0xdb, 0x45, 0x08, 0x4c, 0xe2, 0xba, 0x91, 0xd2, 0xb9, 0x85, 0xd5,
-	0xb8, 0xfe, 0x43, 0x01, 0xcc, 0xfb, 0x84, 0xf7, 0xce, 0xba, 0xaa, 0xca, 0x3d, 0xa7, 0x6e, 0xc6,
-	0x65, 0x00, 0x1e, 0xeb, 0x4d, 0x86, 0x63, 0x1e, 0x85, 0xa3, 0x55, 0x28, 0xc3, 0xd1, 0x80, 0x38,
-	0x1c, 0xee, 0x33, 0xa1, 0x2e, 0x81, 0x89, 0xd5, 0x18, 0x5d, 0x84, 0x62, 0xc0, 0x5c, 0x45, 0x2f,
-	0x28, 0x3a, 0x8c, 0x0f, 0x6b, 0x05, 0x59, 0x2b, 0xda, 0xdb, 0xb8, 0x20, 0x97, 0xda, 0xae, 0x2c,
-	0x53, 0x24, 0x08, 0x98, 0x20, 0xb2, 0x06, 0x73, 0x5d, 0xee, 0x32, 0xd5, 0xdf, 0x3c, 0x82, 0x25,
-	0x65, 0x2a, 0xc5, 0x44, 0x0f, 0xe0, 0x7c, 0xe2, 0x6f, 0xda, 0x60, 0xe9, 0x55, 0x0c, 0x22, 0x6d,
-	0x21, 0xb5, 0x92, 0x7a, 0x16, 0xac, 0xd9, 0xcf, 0x82, 0xca, 0x60, 0xd6, 0xb3, 0xd0, 0x82, 0x05,
-	0x97, 0x72, 0x2f, 0xa2, 0xae, 0x2a, 0x13, 0x54, 0xdd, 0xcc, 0xca, 0xd5, 0x37, 0x4e, 0x32, 0x42,
-	0xf1, 0xbc, 0xe6, 0xa8, 0x19, 0x6a, 0x42, 0x49, 0xeb, 0x86, 0xdb, 0x65, 0xa5, 0xdd, 0x97, 0x7c,
-	0x0e, 0x26, 0xb4, 0x63, 0x65, 0x6e, 0xfe, 0x95, 0xca, 0xdc, 0x75, 0x00, 0x9f, 0x75, 0x3b, 0x6e,
-	0xe4, 0x0d, 0x69, 0x64, 0x2f, 0xe8, 0x26, 0x21, 0x83, 0xbb, 0xad, 0x10, 0xd8, 0xf2, 0x59, 0x37,
-	0x1e, 0x4e, 0x15, 0xa5, 0xca, 0xab, 0x15, 0xa5, 0xcd, 0x95, 0xfd, 0x83, 0xfa, 0x32, 0x2c, 0xa5,
-	0x6b, 0xc8, 0x86, 0x71, 0xd3, 0xb8, 0x65, 0xec, 0x1a, 0xf5, 0xef, 0x0c, 0xf8, 0xcf, 0x54, 0xc0,
-	0xe8, 0x7d, 0x28, 0xea, 0x90, 0x4f, 0xea, 0xa4, 0x34, 0x0f, 0x27, 0x58, 0xb4, 0x0a, 0x96, 0xbc,
-	0x7f, 0x94, 0x73, 0x1a, 0x57, 0x16, 0x0b, 0x1f, 0x7d, 0x40, 0x36, 0x14, 0x89, 0xef, 0x11, 0xb9,
-	0x96, 0x57, 0x6b, 0xc9, 0xb4, 0xfe, 0x34, 0x07, 0x45, 0x6d, 0xec, 0xac, 0xdf, 0x33, 0xbd, 0xed,
-	0xd4, 0xad, 0xbd, 0x01, 0xf3, 0xf1, 0x51, 0x69, 0xb9, 0x99, 0xa7, 0x1e, 0x58, 0x39, 0xc6, 0xc7,
-	0x52, 0xbb, 0x01, 0xa6, 0x17, 0x92, 0xbe, 0x7e, 0xcb, 0x32, 0x77, 0x6e, 0xef, 0x36, 0xef, 0xdc,
-	0x0d, 0xe3, 0x5b, 0x53, 0x1a, 0x1f, 0xd6, 0x4c, 0xf9, 0x01, 0x2b, 0x5a, 0x66, 0xd5, 0xff, 0x69,
-	0x0e, 0x8a, 0x5b, 0xfe, 0x80, 0x0b, 0x1a, 0x9d, 0x75, 0x92, 0xf4, 0xb6, 0x53, 0x49, 0xda, 0x82,
-	0x62, 0xc4, 0x98, 0xe8, 0x38, 0xe4, 0xa4, 0xfc, 0x60, 0xc6, 0xc4, 0x56, 0xb3, 0x55, 0x91, 0x44,
-	0x59, 0xb8, 0xe2, 0x39, 0x2e, 0x48, 0xea, 0x16, 0x41, 0x0f, 0x61, 0x39, 0x29, 0xf7, 0x7b, 0x8c,
-	0x09, 0x2e, 0x22, 0x12, 0x76, 0x7a, 0x74, 0x24, 0x1b, 0x81, 0xfc, 0xac, 0xc6, 0x6f, 0x27, 0x70,
-	0xa2, 0x91, 0x4a, 0xde, 0x6d, 0x3a, 0xc2, 0x4b, 0xda, 0x40, 0x2b, 0xe1, 0xdf, 0xa6, 0x23, 0x8e,
-	0x3e, 0x81, 0x55, 0x3a, 0x81, 0x49, 0x8b, 0x1d, 0x9f, 0xf4, 0xe5, 0x43, 0xd6, 0x71, 0x7c, 0xe6,
-	0xf4, 0x54, 0x2d, 0x35, 0xf1, 0x05, 0x9a, 0x36, 0xf5, 0x59, 0x8c, 0xd8, 0x92, 0x00, 0xc4, 0xc1,
-	0xde, 0xf3, 0x89, 0xd3, 0xf3, 0x3d, 0x2e, 0x7b, 0xfb, 0x54, 0x2f, 0x27, 0xcb, 0xa1, 0xf4, 0x6d,
-	0xe3, 0x84, 0x6c, 0x35, 0x5a, 0x47, 0xdc, 0x54, 0x67, 0xc8, 0x77, 0x02, 0x11, 0x8d, 0xf0, 0xff,
-	0xf6, 0xb2, 0x57, 0x51, 0x0b, 0xca, 0x83, 0x40, 0x6e, 0x1f, 0xe7, 0xc0, 0x7a, 0xd9, 0x1c, 0x40,
-	0xcc, 0x92, 0x91, 0xaf, 0x0c, 0x61, 0xf5, 0xa4, 0xcd, 0xd1, 0x22, 0xe4, 0x7b, 0x74, 0x14, 0xeb,
-	0x07, 0xcb, 0x21, 0xba, 0x09, 0x73, 0x43, 0xe2, 0x0f, 0xa8, 0x56, 0xce, 0x3b, 0x59, 0xfb, 0x65,
-	0x9b, 0xc4, 0x31, 0x71, 0x33, 0xb7, 0x61, 0x64, 0xca, 0xf6, 0x17, 0x03, 0x0a, 0xf7, 0xa8, 0x13,
-	0x51, 0xf1, 0x5a, 0x55, 0xbb, 0x71, 0x4c, 0xb5, 0xd5, 0xec, 0x2e, 0x4f, 0xee, 0x3a, 0x25, 0xda,
-	0x15, 0x28, 0x79, 0x81, 0xa0, 0x51, 0x40, 0x7c, 0xa5, 0xda, 0x12, 0x9e, 0xcc, 0x33, 0x03, 0x78,
-	0x6a, 0x40, 0x21, 0x6e, 0x83, 0xce, 0x3a, 0x80, 0x78, 0xd7, 0x17, 0x03, 0xc8, 0x74, 0xf2, 0x4f,
-	0x03, 0x4a, 0x98, 0x72, 0x36, 0x88, 0x5e, 0xf3, 0x5f, 0x82, 0x17, 0xda, 0x8a, 0xfc, 0x3f, 0x6e,
-	0x2b, 0x10, 0x98, 0x3d, 0x2f, 0xd0, 0x0d, 0x10, 0x56, 0x63, 0xd4, 0x80, 0x62, 0x48, 0x46, 0x3e,
-	0x23, 0xae, 0x2e, 0x94, 0x4b, 0x53, 0xff, 0x9a, 0x9b, 0xc1, 0x08, 0x27, 0xa0, 0xcd, 0xa5, 0xfd,
-	0x83, 0xfa, 0x22, 0x54, 0xd2, 0x91, 0x3f, 0x36, 0xea, 0xbf, 0x19, 0x60, 0xed, 0x7c, 0x23, 0x68,
-	0xa0, 0x3a, 0xf0, 0x7f, 0x65, 0xf0, 0x6b, 0xd3, 0xff, 0xac, 0xad, 0x63, 0x7f, 0x9a, 0xb3, 0x0e,
-	0xb5, 0x65, 0x3f, 0x7b, 0x5e, 0x3d, 0xf7, 0xfb, 0xf3, 0xea, 0xb9, 0x6f, 0xc7, 0x55, 0xe3, 0xd9,
-	0xb8, 0x6a, 0xfc, 0x3a, 0xae, 0x1a, 0x7f, 0x8c, 0xab, 0xc6, 0x5e, 0x41, 0xe5, 0xe7, 0xbd, 0xbf,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x7d, 0xca, 0x6a, 0x9f, 0x11, 0x00, 0x00,
+	// 1562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x73, 0x1b, 0x49,
+	0x15, 0xce, 0x48, 0x63, 0x49, 0xf3, 0x64, 0xab, 0x4c, 0x47, 0x6b, 0x66, 0x8d, 0x91, 0x8c, 0xb6,
+	0x58, 0x52, 0xd4, 0x96, 0xbc, 0x98, 0x05, 0x1c, 0x2f, 0x81, 0x48, 0xb2, 0x8b, 0xa8, 0x42, 0x88,
+	0xab, 0x13, 0x92, 0x5c, 0x28, 0x31, 0x9e, 0x69, 0x2b, 0x83, 0x46, 0xd3, 0x53, 0xdd, 0x2d, 0x05,
+	0xdd, 0x28, 0x8e, 0x3e, 0x70, 0xf5, 0x8d, 0x43, 0x4e, 0xfc, 0x0b, 0x5c, 0x38, 0x52, 0xb9, 0x50,
+	0xc5, 0x89, 0xe2, 0xe4, 0x4a, 0xf4, 0x5f, 0x50, 0xc5, 0x81, 0xea, 0x9e, 0x1e, 0x79, 0x1c, 0x8d,
+	0xfc, 0x83, 0x4a, 0xb9, 0x72, 0x72, 0x77, 0xcf, 0xf7, 0xbd, 0x7e, 0xaf, 0xfb, 0xbd, 0xaf, 0x9f,
+	0x0c, 0x2b, 0xf4, 0xf0, 0x77, 0xc4, 0x15, 0xbc, 0x19, 0x31, 0x2a, 0x28, 0x42, 0x1e, 0x75, 0x07,
+	0x84, 0x35, 0xf9, 0x2b, 0x87, 0x0d, 0x07, 0xbe, 0x68, 0x8e, 0x7f, 0xb0, 0x5e, 0x16, 0x93, 0x88,
+	0x68, 0xc0, 0x7a, 0x99, 0x47, 0xc4, 0x4d, 0x26, 0xf5, 0x3e, 0xa5, 0xfd, 0x80, 0x6c, 0xa9, 0xd9,
+	0xe1, 0xe8, 0x68, 0x4b, 0xf8, 0x43, 0xc2, 0x85, 0x33, 0x8c, 0x34, 0xa0, 0xda, 0xa7, 0x7d, 0xaa,
+	0x86, 0x5b, 0x72, 0xa4, 0x57, 0x3f, 0x7d, 0x9f, 0xe6, 0x84, 0x13, 0xfd, 0xe9, 0x76, 0x14, 0x8c,
+	0xfa, 0x7e, 0xb8, 0x15, 0xff, 0x89, 0x17, 0x1b, 0x7f, 0x35, 0xc0, 0x7c, 0x44, 0x84, 0x83, 0xbe,
+	0x86, 0xe2, 0x98, 0x30, 0xee, 0xd3, 0xd0, 0x36, 0x36, 0x8d, 0x3b, 0xe5, 0xed, 0x6f, 0x35, 0xe7,
+	0xfd, 0x6d, 0x3e, 0x8b, 0x21, 0x6d, 0xf3, 0xcd, 0x69, 0xfd, 0x16, 0x4e, 0x18, 0xe8, 0x2e, 0x80,
+	0xcb, 0x88, 0x23, 0x88, 0xd7, 0x73, 0x84, 0x9d, 0x53, 0xfc, 0xf5, 0x66, 0xec, 0x4a, 0x33, 0x71,
+	0xa5, 0xf9, 0x34, 0x89, 0x00, 0x5b, 0x1a, 0xdd, 0x12, 0x92, 0x3a, 0x8a, 0xbc, 0x84, 0x9a, 0xbf,
+	0x9c, 0xaa, 0xd1, 0x2d, 0xd1, 0xf8, 0xc7, 0x12, 0x98, 0xbf, 0xa2, 0x1e, 0x41, 0x6b, 0x90, 0xf3,
+	0x3d, 0xe5, 0xb6, 0xd5, 0x2e, 0x4c, 0x4f, 0xeb, 0xb9, 0xee, 0x1e, 0xce, 0xf9, 0x1e, 0xda, 0x06,
+	0x73, 0x48, 0x84, 0xa3, 0x1d, 0xb2, 0xb3, 0x02, 0x92, 0xb1, 0xeb, 0x68, 0x14, 0x16, 0xfd, 0x18,
+	0x4c, 0x79, 0x0d, 0xda, 0x93, 0x8d, 0x2c, 0x8e, 0xdc, 0xf3, 0x49, 0x44, 0xdc, 0x84, 0x27, 0xf1,
+	0x68, 0x1f, 0xca, 0x1e, 0xe1, 0x2e, 0xf3, 0x23, 0x21, 0xcf, 0xd0, 0x54, 0xf4, 0xcf, 0x16, 0xd1,
+	0xf7, 0xce, 0xa0, 0x38, 0xcd, 0x43, 0x3f, 0x85, 0x02, 0x17, 0x8e, 0x18, 0x71, 0x7b, 0x49, 0x59,
+	0xa8, 0x2d, 0x74, 0x40, 0xa1, 0xb4, 0x0b, 0x9a, 0x83, 0x1e, 0x40, 0x65, 0xe8, 0x84, 0x4e, 0x9f,
+	0xb0, 0x9e, 0xb6, 0x52, 0x50, 0x56, 0xbe, 0x93, 0x19, 0x7a, 0x8c, 0x8c, 0x0d, 0xe1, 0x95, 0x61,
+	0x7a, 0x8a, 0xf6, 0x01, 0x1c, 0x21, 0x1c, 0xf7, 0xe5, 0x90, 0x84, 0xc2, 0x2e, 0x2a, 0x2b, 0xdf,
+	0xcd, 0xf4, 0x85, 0x88, 0x57, 0x94, 0x0d, 0x5a, 0x33, 0x30, 0x4e, 0x11, 0xd1, 0x2f, 0xa0, 0xec,
+	0x12, 0x26, 0xfc, 0x23, 0xdf, 0x75, 0x04, 0xb1, 0x4b, 0xca, 0x4e, 0x3d, 0xcb, 0x4e, 0xe7, 0x0c,
+	0xa6, 0x83, 0x4a, 0x33, 0xd1, 0x97, 0x60, 0x32, 0x1a, 0x10, 0xdb, 0xda, 0x34, 0xee, 0x54, 0x16,
+	0x5f, 0x0b, 0xa6, 0x01, 0xc1, 0x0a, 0x89, 0x7e, 0x03, 0x9f, 0x0c, 0xc9, 0xf0, 0x90, 0x30, 0xfe,
+	0xd2, 0x8f, 0x7a, 0x82, 0x39, 0x21, 0xf7, 0xd5, 0xd5, 0x80, 0x72, 0xe2, 0x4e, 0x76, 0x36, 0x24,
+	0x84, 0xa7, 0x33, 0x3c, 0xae, 0x0e, 0x33, 0x56, 0x65, 0xde, 0x7a, 0x24, 0x20, 0x3a, 0x6f, 0xcb,
+	0x97, 0xe7, 0xad, 0x46, 0xb7, 0xc4, 0xee, 0xda, 0xf1, 0x49, 0x03, 0xc1, 0x6a, 0xc9, 0x58, 0x35,
+	0x54, 0xd2, 0x1a, 0x5f, 0x1a, 0x2f, 0x8c, 0xdf, 0x1a, 0x8d, 0xbf, 0x1b, 0x50, 0xcd, 0xf2, 0x00,
+	0x7d, 0x0d, 0xe6, 0x11, 0xa3, 0x43, 0x05, 0xae, 0x6c, 0x7f, 0xef, 0xa2, 0x9c, 0x4c, 0x85, 0x80,
+	0x15, 0x09, 0xfd, 0x04, 0x72, 0x82, 0xda, 0xb9, 0xeb, 0x51, 0x73, 0x82, 0xa2, 0x1d, 0xb0, 0x66,
+	0x9a, 0x73, 0x95, 0xc2, 0x9c, 0x81, 0x1b, 0xff, 0xcd, 0x43, 0xf1, 0x09, 0x61, 0x63, 0xdf, 0xfd,
+	0xb0, 0xb5, 0x79, 0xf7, 0x5c, 0x6d, 0x66, 0xa6, 0x91, 0xde, 0x76, 0xae, 0x3c, 0x77, 0xa0, 0x44,
+	0x42, 0x2f, 0xa2, 0x7e, 0x28, 0x74, 0x6d, 0x66, 0xe6, 0xd0, 0xbe, 0xc6, 0xe0, 0x19, 0x1a, 0xed,
+	0xc3, 0x4a, 0x2c, 0x39, 0xbd, 0x73, 0x85, 0xb9, 0x99, 0x45, 0xff, 0xb5, 0x02, 0xea, 0x8a, 0x5a,
+	0x1e, 0xa5, 0x66, 0x68, 0x0f, 0x56, 0x22, 0x46, 0xc6, 0x3e, 0x1d, 0xf1, 0x9e, 0x0a, 0xa2, 0x70,
+	0xa5, 0x20, 0xf0, 0x72, 0xc2, 0x92, 0x33, 0xf4, 0x33, 0x58, 0x96, 0xe4, 0x5e, 0x22, 0xd5, 0x70,
+	0xa9, 0x54, 0x63, 0xf5, 0xaa, 0xe8, 0x09, 0x7a, 0x0c, 0x9f, 0x9c, 0xf3, 0x62, 0x66, 0xa8, 0x7c,
+	0xb9, 0xa1, 0xdb, 0x69, 0x4f, 0xf4, 0xe2, 0x2e, 0x3a, 0x3e, 0x69, 0x54, 0x60, 0x39, 0x9d, 0xcb,
+	0x8d, 0x3f, 0xe7, 0xa0, 0x94, 0x1c, 0x24, 0xfa, 0x4a, 0xdf, 0x99, 0xb1, 0xf8, 0xd4, 0x12, 0xac,
+	0x8a, 0x37, 0xbe, 0xae, 0xaf, 0x60, 0x29, 0xa2, 0x4c, 0x70, 0x3b, 0xb7, 0x99, 0x5f, 0xa4, 0x82,
+	0x07, 0x94, 0x89, 0x0e, 0x0d, 0x8f, 0xfc, 0x3e, 0x8e, 0xc1, 0xe8, 0x39, 0x94, 0xc7, 0x3e, 0x13,
+	0x23, 0x27, 0xe8, 0xf9, 0x11, 0xb7, 0xf3, 0x8a, 0xfb, 0xf9, 0x45, 0x5b, 0x36, 0x9f, 0xc5, 0xf8,
+	0xee, 0x41, 0xbb, 0x32, 0x3d, 0xad, 0xc3, 0x6c, 0xca, 0x31, 0x68, 0x53, 0xdd, 0x88, 0xaf, 0x3f,
+	0x02, 0x6b, 0xf6, 0x05, 0x7d, 0x01, 0x10, 0xc6, 0xa2, 0xd7, 0x9b, 0x65, 0xf6, 0xca, 0xf4, 0xb4,
+	0x6e, 0x69, 0x29, 0xec, 0xee, 0x61, 0x4b, 0x03, 0xba, 0x1e, 0x42, 0x60, 0x3a, 0x9e, 0xc7, 0x54,
+	0x9e, 0x5b, 0x58, 0x8d, 0x1b, 0x7f, 0x29, 0x80, 0xf9, 0xd4, 0xe1, 0x83, 0x9b, 0x7e, 0xb8, 0xe4,
+	0x9e, 0x73, 0x95, 0xf1, 0x05, 0x00, 0x8f, 0xf3, 0x4d, 0x86, 0x63, 0x9e, 0x85, 0xa3, 0xb3, 0x50,
+	0x86, 0xa3, 0x01, 0x71, 0x38, 0x3c, 0xa0, 0x42, 0x15, 0x81, 0x89, 0xd5, 0x18, 0x7d, 0x06, 0xc5,
+	0x90, 0x7a, 0x8a, 0x5e, 0x50, 0x74, 0x98, 0x9e, 0xd6, 0x0b, 0x52, 0x56, 0xba, 0x7b, 0xb8, 0x20,
+	0x3f, 0x75, 0x3d, 0xf9, 0x12, 0x38, 0x61, 0x48, 0x85, 0x23, 0x15, 0x8d, 0xdb, 0xc5, 0xc5, 0xd9,
+	0xdf, 0x3a, 0x83, 0x25, 0x2f, 0x41, 0x8a, 0x89, 0x9e, 0xc1, 0xed, 0xc4, 0xdf, 0xb4, 0xc1, 0xd2,
+	0x75, 0x0c, 0x22, 0x6d, 0x21, 0xf5, 0x25, 0xf5, 0xf2, 0x5a, 0x8b, 0x5f, 0x5e, 0x75, 0x82, 0x59,
+	0x2f, 0x6f, 0x1b, 0x56, 0x3c, 0xc2, 0x7d, 0x46, 0x3c, 0x25, 0x13, 0x44, 0x55, 0x66, 0x65, 0xfb,
+	0xdb, 0x17, 0x19, 0x21, 0x78, 0x59, 0x73, 0xd4, 0x0c, 0xb5, 0xa0, 0xa4, 0xf3, 0x86, 0xdb, 0xe5,
+	0xcd, 0xfc, 0xd5, 0x5f, 0xdc, 0x19, 0xed, 0x9c, 0xcc, 0x2d, 0x5f, 0x4b, 0xe6, 0xee, 0x02, 0x04,
+	0xb4, 0xdf, 0xf3, 0x98, 0x3f, 0x26, 0xcc, 0x5e, 0xd1, 0x72, 0x9f, 0xc1, 0xdd, 0x53, 0x08, 0x6c,
+	0x05, 0xb4, 0x1f, 0x0f, 0xe7, 0x44, 0xa9, 0x72, 0x3d, 0x51, 0xda, 0x5d, 0x3f, 0x3e, 0x69, 0xac,
+	0x41, 0x35, 0xad, 0x21, 0x3b, 0xc6, 0x7d, 0xe3, 0x81, 0x71, 0x60, 0x34, 0xfe, 0x68, 0xc0, 0x37,
+	0xe6, 0x02, 0x46, 0x3f, 0x82, 0xa2, 0x0e, 0xf9, 0xa2, 0x66, 0x55, 0xf3, 0x70, 0x82, 0x45, 0x1b,
+	0x60, 0xc9, 0xfa, 0x23, 0x9c, 0x93, 0x58, 0x59, 0x2c, 0x7c, 0xb6, 0x80, 0x6c, 0x28, 0x3a, 0x81,
+	0xef, 0x70, 0x12, 0x2b, 0x87, 0x85, 0x93, 0x69, 0xe3, 0x75, 0x0e, 0x8a, 0xda, 0xd8, 0x4d, 0xbf,
+	0x67, 0x7a, 0xdb, 0xb9, 0xaa, 0xbd, 0x07, 0xcb, 0xf1, 0x55, 0xe9, 0x74, 0x33, 0x2f, 0xbd, 0xb0,
+	0x72, 0x8c, 0x8f, 0x53, 0xed, 0x1e, 0x98, 0x7e, 0xe4, 0x0c, 0xed, 0xa5, 0xc5, 0x3b, 0x77, 0x0f,
+	0x5a, 0x8f, 0x1e, 0x47, 0x71, 0xd5, 0x94, 0xa6, 0xa7, 0x75, 0x53, 0x2e, 0x60, 0x45, 0xcb, 0x54,
+	0xfd, 0x3f, 0x15, 0xa0, 0xd8, 0x09, 0x46, 0x5c, 0x10, 0x76, 0xd3, 0x87, 0xa4, 0xb7, 0x9d, 0x3b,
+	0xa4, 0x0e, 0x14, 0x19, 0xa5, 0xa2, 0xe7, 0x3a, 0x17, 0x9d, 0x0f, 0xa6, 0x54, 0x74, 0x5a, 0xed,
+	0x8a, 0x24, 0x4a, 0xe1, 0x8a, 0xe7, 0xb8, 0x20, 0xa9, 0x1d, 0x07, 0x3d, 0x87, 0xb5, 0x44, 0xee,
+	0x0f, 0x29, 0x15, 0x5c, 0x30, 0x27, 0xea, 0x0d, 0xc8, 0x44, 0x36, 0x02, 0xf9, 0x45, 0xbd, 0xf5,
+	0x7e, 0xe8, 0xb2, 0x89, 0x3a, 0xbc, 0x87, 0x64, 0x82, 0xab, 0xda, 0x40, 0x3b, 0xe1, 0x3f, 0x24,
+	0x13, 0x8e, 0x7e, 0x0e, 0x1b, 0x64, 0x06, 0x93, 0x16, 0x7b, 0x81, 0x33, 0x94, 0x0f, 0x59, 0xcf,
+	0x0d, 0xa8, 0x3b, 0x50, 0x5a, 0x6a, 0xe2, 0x4f, 0x49, 0xda, 0xd4, 0x2f, 0x63, 0x44, 0x47, 0x02,
+	0x10, 0x07, 0xfb, 0x30, 0x70, 0xdc, 0x41, 0xe0, 0x73, 0xd9, 0x86, 0xa6, 0xda, 0x65, 0x29, 0x87,
+	0xd2, 0xb7, 0x9d, 0x0b, 0x4e, 0xab, 0xd9, 0x3e, 0xe3, 0xa6, 0x9a, 0x6f, 0xbe, 0x1f, 0x0a, 0x36,
+	0xc1, 0xdf, 0x3c, 0xcc, 0xfe, 0x8a, 0xda, 0x50, 0x1e, 0x85, 0x72, 0xfb, 0xf8, 0x0c, 0xac, 0xab,
+	0x9e, 0x01, 0xc4, 0x2c, 0x15, 0xf9, 0x0b, 0xa8, 0xaa, 0x7b, 0x61, 0x5a, 0x7c, 0x7b, 0xee, 0x88,
+	0x71, 0xca, 0x74, 0x37, 0xf3, 0xf9, 0xa2, 0x4b, 0xc2, 0x1a, 0xde, 0x51, 0x68, 0x8c, 0xd8, 0xdc,
+	0xda, 0xfa, 0x18, 0x36, 0x2e, 0x0a, 0x0b, 0xad, 0x42, 0x7e, 0x40, 0x26, 0x71, 0x66, 0x62, 0x39,
+	0x44, 0xf7, 0x61, 0x69, 0xec, 0x04, 0x23, 0xa2, 0x73, 0xf2, 0xfb, 0x59, 0x9b, 0x67, 0x9b, 0xc4,
+	0x31, 0x71, 0x37, 0xb7, 0x63, 0x64, 0x16, 0xc4, 0xdf, 0x0c, 0x28, 0x3c, 0x21, 0x2e, 0x23, 0xe2,
+	0x83, 0xd6, 0xc3, 0xce, 0xb9, 0x7a, 0xa8, 0x65, 0xf7, 0x8f, 0x72, 0xd7, 0xb9, 0x72, 0x58, 0x87,
+	0x92, 0x1f, 0x0a, 0xc2, 0x42, 0x27, 0x50, 0xf5, 0x50, 0xc2, 0xb3, 0x79, 0x66, 0x00, 0xaf, 0x0d,
+	0x28, 0xc4, 0x0d, 0xd6, 0x4d, 0x07, 0x10, 0xef, 0xfa, 0x7e, 0x00, 0x99, 0x4e, 0xbe, 0x35, 0xa0,
+	0xdc, 0x8a, 0x22, 0x46, 0xc7, 0xc4, 0x7b, 0x48, 0x26, 0x1f, 0xd4, 0xd3, 0xf7, 0x7a, 0x96, 0xfc,
+	0xff, 0xdd, 0xb3, 0x24, 0xbf, 0x5e, 0xcd, 0xab, 0xfe, 0x7a, 0xdd, 0x85, 0xe3, 0x93, 0x46, 0x01,
+	0x4c, 0x19, 0x6a, 0xe3, 0x3f, 0x06, 0x94, 0x30, 0xe1, 0x74, 0xc4, 0x5c, 0xf2, 0x71, 0xc6, 0x87,
+	0xc0, 0x1c, 0xf8, 0xa1, 0xee, 0x1e, 0xb1, 0x1a, 0xa3, 0x26, 0x14, 0x23, 0x67, 0x12, 0x50, 0xc7,
+	0xd3, 0xaf, 0x4c, 0x75, 0xee, 0xc7, 0x63, 0x2b, 0x9c, 0xe0, 0x04, 0xb4, 0x5b, 0x3d, 0x3e, 0x69,
+	0xac, 0x42, 0x25, 0x7d, 0xb9, 0x2f, 0x8d, 0xc6, 0xbf, 0x0c, 0xb0, 0xf6, 0x7f, 0x2f, 0x48, 0xa8,
+	0x7e, 0xbe, 0x7c, 0x94, 0xc1, 0x6f, 0xce, 0xff, 0xe7, 0xc7, 0x3a, 0xf7, 0x4f, 0x9d, 0xac, 0xbc,
+	0x6d, 0xdb, 0x6f, 0xde, 0xd5, 0x6e, 0xfd, 0xfb, 0x5d, 0xed, 0xd6, 0x1f, 0xa6, 0x35, 0xe3, 0xcd,
+	0xb4, 0x66, 0xfc, 0x73, 0x5a, 0x33, 0xde, 0x4e, 0x6b, 0xc6, 0x61, 0x41, 0x9d, 0xcf, 0x0f, 0xff,
+	0x37, 0x00, 0x5f, 0xf5, 0xa1, 0x0e, 0x3f, 0x14, 0x00, 0x00,
 }
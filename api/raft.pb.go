@@ -176,6 +176,7 @@ type StoreAction struct {
 	//	*StoreAction_Resource
 	//	*StoreAction_Extension
 	//	*StoreAction_Config
+	//	*StoreAction_ApprovedKey
 	Target isStoreAction_Target `protobuf_oneof:"target"`
 }
 
@@ -216,16 +217,20 @@ type StoreAction_Extension struct {
 type StoreAction_Config struct {
 	Config *Config `protobuf:"bytes,10,opt,name=config,oneof"`
 }
+type StoreAction_ApprovedKey struct {
+	ApprovedKey *ApprovedKey `protobuf:"bytes,11,opt,name=approved_key,json=approvedKey,oneof"`
+}
 
-func (*StoreAction_Node) isStoreAction_Target()      {}
-func (*StoreAction_Service) isStoreAction_Target()   {}
-func (*StoreAction_Task) isStoreAction_Target()      {}
-func (*StoreAction_Network) isStoreAction_Target()   {}
-func (*StoreAction_Cluster) isStoreAction_Target()   {}
-func (*StoreAction_Secret) isStoreAction_Target()    {}
-func (*StoreAction_Resource) isStoreAction_Target()  {}
-func (*StoreAction_Extension) isStoreAction_Target() {}
-func (*StoreAction_Config) isStoreAction_Target()    {}
+func (*StoreAction_Node) isStoreAction_Target()        {}
+func (*StoreAction_Service) isStoreAction_Target()     {}
+func (*StoreAction_Task) isStoreAction_Target()        {}
+func (*StoreAction_Network) isStoreAction_Target()     {}
+func (*StoreAction_Cluster) isStoreAction_Target()     {}
+func (*StoreAction_Secret) isStoreAction_Target()      {}
+func (*StoreAction_Resource) isStoreAction_Target()    {}
+func (*StoreAction_Extension) isStoreAction_Target()   {}
+func (*StoreAction_Config) isStoreAction_Target()      {}
+func (*StoreAction_ApprovedKey) isStoreAction_Target() {}
 
 func (m *StoreAction) GetTarget() isStoreAction_Target {
 	if m != nil {
@@ -297,6 +302,13 @@ func (m *StoreAction) GetConfig() *Config {
 	return nil
 }
 
+func (m *StoreAction) GetApprovedKey() *ApprovedKey {
+	if x, ok := m.GetTarget().(*StoreAction_ApprovedKey); ok {
+		return x.ApprovedKey
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*StoreAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _StoreAction_OneofMarshaler, _StoreAction_OneofUnmarshaler, _StoreAction_OneofSizer, []interface{}{
@@ -309,6 +321,7 @@ func (*StoreAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) e
 		(*StoreAction_Resource)(nil),
 		(*StoreAction_Extension)(nil),
 		(*StoreAction_Config)(nil),
+		(*StoreAction_ApprovedKey)(nil),
 	}
 }
 
@@ -361,6 +374,11 @@ func _StoreAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Config); err != nil {
 			return err
 		}
+	case *StoreAction_ApprovedKey:
+		_ = b.EncodeVarint(11<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ApprovedKey); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("StoreAction.Target has unexpected type %T", x)
@@ -443,6 +461,14 @@ func _StoreAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Bu
 		err := b.DecodeMessage(msg)
 		m.Target = &StoreAction_Config{msg}
 		return true, err
+	case 11: // target.approved_key
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ApprovedKey)
+		err := b.DecodeMessage(msg)
+		m.Target = &StoreAction_ApprovedKey{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -497,6 +523,11 @@ func _StoreAction_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(10<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *StoreAction_ApprovedKey:
+		s := proto.Size(x.ApprovedKey)
+		n += proto.SizeVarint(11<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -794,6 +825,12 @@ func (m *StoreAction) CopyFrom(src interface{}) {
 			}
 			github_com_docker_swarmkit_api_deepcopy.Copy(v.Config, o.GetConfig())
 			m.Target = &v
+		case *StoreAction_ApprovedKey:
+			v := StoreAction_ApprovedKey{
+				ApprovedKey: &ApprovedKey{},
+			}
+			github_com_docker_swarmkit_api_deepcopy.Copy(v.ApprovedKey, o.GetApprovedKey())
+			m.Target = &v
 		}
 	}
 
@@ -1460,6 +1497,20 @@ func (m *StoreAction_Config) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *StoreAction_ApprovedKey) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ApprovedKey != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaft(dAtA, i, uint64(m.ApprovedKey.Size()))
+		n16, err := m.ApprovedKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
 func encodeFixed64Raft(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	dAtA[offset+1] = uint8(v >> 8)
@@ -1982,6 +2033,15 @@ func (m *StoreAction_Config) Size() (n int) {
 	}
 	return n
 }
+func (m *StoreAction_ApprovedKey) Size() (n int) {
+	var l int
+	_ = l
+	if m.ApprovedKey != nil {
+		l = m.ApprovedKey.Size()
+		n += 1 + l + sovRaft(uint64(l))
+	}
+	return n
+}
 
 func sovRaft(x uint64) (n int) {
 	for {
@@ -2201,6 +2261,16 @@ func (this *StoreAction_Config) String() string {
 	}, "")
 	return s
 }
+func (this *StoreAction_ApprovedKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StoreAction_ApprovedKey{`,
+		`ApprovedKey:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedKey), "ApprovedKey", "ApprovedKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringRaft(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -3457,6 +3527,38 @@ func (m *StoreAction) Unmarshal(dAtA []byte) error {
 			}
 			m.Target = &StoreAction_Config{v}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApprovedKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Target = &StoreAction_ApprovedKey{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaft(dAtA[iNdEx:])
@@ -3586,65 +3688,66 @@ var (
 func init() { proto.RegisterFile("raft.proto", fileDescriptorRaft) }
 
 var fileDescriptorRaft = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x8c, 0x96, 0x4f, 0x6f, 0x1b, 0x45,
-	0x18, 0xc6, 0x77, 0xed, 0xad, 0x9d, 0xbc, 0x6e, 0xe2, 0x68, 0x42, 0xc2, 0x76, 0x29, 0x8e, 0xbb,
-	0x45, 0xaa, 0x5b, 0x91, 0xb5, 0x30, 0x48, 0xa0, 0x42, 0x0f, 0xb1, 0x63, 0xc9, 0xa6, 0xad, 0x53,
-	0x6d, 0x12, 0xe8, 0x2d, 0xac, 0x77, 0x27, 0xee, 0x62, 0x7b, 0xc7, 0xcc, 0x8c, 0x1d, 0xb8, 0xa0,
-	0x1e, 0x51, 0xae, 0x48, 0xc0, 0xa5, 0x27, 0x38, 0xf7, 0x03, 0xf0, 0x01, 0x50, 0xc4, 0x89, 0x1b,
-	0x9c, 0x22, 0xea, 0x0f, 0x00, 0x5f, 0x01, 0xcd, 0xec, 0xae, 0x1d, 0x9c, 0xb5, 0x9b, 0x8b, 0x3d,
-	0x9e, 0xf9, 0x3d, 0xef, 0xf3, 0xce, 0xbf, 0x77, 0x0c, 0x40, 0x9d, 0x63, 0x6e, 0x0d, 0x28, 0xe1,
-	0x04, 0x21, 0x8f, 0xb8, 0x5d, 0x4c, 0x2d, 0x76, 0xe2, 0xd0, 0x7e, 0xd7, 0xe7, 0xd6, 0xe8, 0x3d,
-	0x63, 0x85, 0xb4, 0xbf, 0xc4, 0x2e, 0x67, 0x21, 0x62, 0xe4, 0xf8, 0x37, 0x03, 0x1c, 0xff, 0xd8,
-	0xee, 0xf8, 0xfc, 0xd9, 0xb0, 0x6d, 0xb9, 0xa4, 0x5f, 0x76, 0x09, 0xc5, 0x84, 0x95, 0x31, 0x77,
-	0xbd, 0xb2, 0x08, 0x29, 0x3f, 0x06, 0xed, 0xf2, 0x34, 0xbc, 0xf1, 0x46, 0x87, 0x74, 0x88, 0x6c,
-	0x96, 0x45, 0x2b, 0xea, 0x5d, 0x1f, 0xf4, 0x86, 0x1d, 0x3f, 0x28, 0x87, 0x5f, 0x61, 0xa7, 0xf9,
-	0x52, 0x05, 0xb0, 0x9d, 0x63, 0xfe, 0x18, 0xf7, 0xdb, 0x98, 0xa2, 0xdb, 0x90, 0x15, 0x71, 0x8e,
-	0x7c, 0x4f, 0x57, 0x8b, 0x6a, 0x49, 0xab, 0xc2, 0xf8, 0x7c, 0x2b, 0x23, 0x80, 0xe6, 0xae, 0x9d,
-	0x11, 0x43, 0x4d, 0x4f, 0x40, 0x01, 0xf1, 0xb0, 0x80, 0x52, 0x45, 0xb5, 0xb4, 0x1c, 0x42, 0x2d,
-	0xe2, 0x61, 0x01, 0x89, 0xa1, 0xa6, 0x87, 0x10, 0x68, 0x8e, 0xe7, 0x51, 0x3d, 0x2d, 0x08, 0x5b,
-	0xb6, 0x51, 0x15, 0x32, 0x8c, 0x3b, 0x7c, 0xc8, 0x74, 0xad, 0xa8, 0x96, 0x72, 0x95, 0x77, 0xac,
-	0xcb, 0xeb, 0x60, 0x4d, 0xb3, 0xd9, 0x97, 0x6c, 0x55, 0x3b, 0x3b, 0xdf, 0x52, 0xec, 0x48, 0x69,
-	0xde, 0x82, 0xdc, 0xa7, 0xc4, 0x0f, 0x6c, 0xfc, 0xd5, 0x10, 0x33, 0x3e, 0xb1, 0x51, 0xa7, 0x36,
-	0xe6, 0x0f, 0x2a, 0x5c, 0x0f, 0x19, 0x36, 0x20, 0x01, 0xc3, 0x57, 0x9b, 0xd5, 0x47, 0x90, 0xed,
-	0x4b, 0x5b, 0xa6, 0xa7, 0x8a, 0xe9, 0x52, 0xae, 0x52, 0x58, 0x9c, 0x9d, 0x1d, 0xe3, 0xe8, 0x0e,
-	0xe4, 0x29, 0xee, 0x93, 0x11, 0xf6, 0x8e, 0xe2, 0x08, 0xe9, 0x62, 0xba, 0xa4, 0xd9, 0xab, 0x51,
-	0x77, 0x28, 0x60, 0x66, 0x15, 0xae, 0x3f, 0xc2, 0xce, 0x08, 0xc7, 0xc9, 0x57, 0x40, 0x13, 0xab,
-	0x25, 0x93, 0x7a, 0xbd, 0x9f, 0x64, 0xcd, 0x3c, 0xac, 0x44, 0x31, 0xc2, 0xc9, 0x99, 0x8f, 0xe0,
-	0xc6, 0x13, 0x4a, 0x5c, 0xcc, 0x58, 0xc8, 0x32, 0xe6, 0x74, 0x26, 0x0e, 0x77, 0xc5, 0xa4, 0x64,
-	0x4f, 0x64, 0x92, 0xb7, 0xc2, 0xe3, 0x62, 0xc5, 0x60, 0x3c, 0x7e, 0x5f, 0x7b, 0xfe, 0xa3, 0xa9,
-	0x98, 0x37, 0xc1, 0x48, 0x8a, 0x16, 0x79, 0x7d, 0x02, 0x1b, 0x36, 0x66, 0xa4, 0x37, 0xc2, 0x3b,
-	0x9e, 0x47, 0x05, 0x14, 0xf9, 0x5c, 0x65, 0x85, 0xcd, 0x77, 0x61, 0x73, 0x56, 0x1d, 0x6d, 0x50,
-	0xd2, 0x2e, 0xf6, 0x60, 0xbd, 0x19, 0x70, 0x4c, 0x03, 0xa7, 0x27, 0xe2, 0xc4, 0x4e, 0x9b, 0x90,
-	0x9a, 0x98, 0x64, 0xc6, 0xe7, 0x5b, 0xa9, 0xe6, 0xae, 0x9d, 0xf2, 0x3d, 0xf4, 0x00, 0x32, 0x8e,
-	0xcb, 0x7d, 0x12, 0x44, 0xbb, 0xb7, 0x95, 0xb4, 0x9a, 0xfb, 0x9c, 0x50, 0xbc, 0x23, 0xb1, 0xf8,
-	0x58, 0x85, 0x22, 0xf3, 0x37, 0x0d, 0x72, 0x17, 0x46, 0xd1, 0xc7, 0x93, 0x70, 0xc2, 0x6a, 0xb5,
-	0x72, 0xfb, 0x35, 0xe1, 0x1e, 0xfa, 0x81, 0x17, 0x07, 0x43, 0x56, 0xb4, 0xaf, 0x29, 0xb9, 0xe4,
-	0x7a, 0x92, 0x54, 0xdc, 0x96, 0x86, 0x12, 0xee, 0x29, 0xfa, 0x10, 0xb2, 0x0c, 0xd3, 0x91, 0xef,
-	0x62, 0x79, 0x5d, 0x72, 0x95, 0xb7, 0x12, 0xdd, 0x42, 0xa4, 0xa1, 0xd8, 0x31, 0x2d, 0x8c, 0xb8,
-	0xc3, 0xba, 0xd1, 0x75, 0x4a, 0x34, 0x3a, 0x70, 0x58, 0x57, 0x18, 0x09, 0x4e, 0x18, 0x05, 0x98,
-	0x9f, 0x10, 0xda, 0xd5, 0xaf, 0xcd, 0x37, 0x6a, 0x85, 0x88, 0x30, 0x8a, 0x68, 0x21, 0x74, 0x7b,
-	0x43, 0xc6, 0x31, 0xd5, 0x33, 0xf3, 0x85, 0xb5, 0x10, 0x11, 0xc2, 0x88, 0x46, 0x1f, 0x40, 0x86,
-	0x61, 0x97, 0x62, 0xae, 0x67, 0xa5, 0xce, 0x48, 0x9e, 0x99, 0x20, 0x1a, 0xe2, 0x92, 0xcb, 0x16,
-	0xba, 0x0f, 0x4b, 0x14, 0x33, 0x32, 0xa4, 0x2e, 0xd6, 0x97, 0xa4, 0xee, 0x66, 0xe2, 0xe5, 0x88,
-	0x98, 0x86, 0x62, 0x4f, 0x78, 0xf4, 0x00, 0x96, 0xf1, 0xd7, 0x1c, 0x07, 0x4c, 0x6c, 0xde, 0xb2,
-	0x14, 0xbf, 0x9d, 0x24, 0xae, 0xc7, 0x50, 0x43, 0xb1, 0xa7, 0x0a, 0x91, 0xb0, 0x4b, 0x82, 0x63,
-	0xbf, 0xa3, 0xc3, 0xfc, 0x84, 0x6b, 0x92, 0x10, 0x09, 0x87, 0x6c, 0x75, 0x09, 0x32, 0xdc, 0xa1,
-	0x1d, 0xcc, 0xef, 0xfd, 0xab, 0x42, 0x7e, 0xe6, 0x5c, 0xa0, 0x3b, 0x90, 0x3d, 0x6c, 0x3d, 0x6c,
-	0xed, 0x7d, 0xde, 0x5a, 0x53, 0x0c, 0xe3, 0xf4, 0x45, 0x71, 0x73, 0x86, 0x38, 0x0c, 0xba, 0x01,
-	0x39, 0x09, 0x50, 0x05, 0xd6, 0xf7, 0x0f, 0xf6, 0xec, 0xfa, 0xd1, 0x4e, 0xed, 0xa0, 0xb9, 0xd7,
-	0x3a, 0xaa, 0xd9, 0xf5, 0x9d, 0x83, 0xfa, 0x9a, 0x6a, 0xdc, 0x38, 0x7d, 0x51, 0xdc, 0x98, 0x11,
-	0xd5, 0x28, 0x76, 0x38, 0xbe, 0xa4, 0x39, 0x7c, 0xb2, 0x2b, 0x34, 0xa9, 0x44, 0xcd, 0xe1, 0xc0,
-	0x4b, 0xd2, 0xd8, 0xf5, 0xc7, 0x7b, 0x9f, 0xd5, 0xd7, 0xd2, 0x89, 0x1a, 0x5b, 0x16, 0x31, 0xe3,
-	0xcd, 0xef, 0x7e, 0x2e, 0x28, 0xbf, 0xfe, 0x52, 0x98, 0x9d, 0x5d, 0xe5, 0xfb, 0x14, 0x68, 0xe2,
-	0x86, 0xa2, 0x53, 0x15, 0xd0, 0xe5, 0xe2, 0x81, 0xb6, 0x93, 0x56, 0x70, 0x6e, 0xc9, 0x32, 0xac,
-	0xab, 0xe2, 0x51, 0x4d, 0xda, 0xf8, 0xfd, 0xe5, 0x3f, 0x3f, 0xa5, 0xf2, 0xb0, 0x22, 0xf9, 0xed,
-	0xbe, 0x13, 0x38, 0x1d, 0x4c, 0xd1, 0xb7, 0xb0, 0xfa, 0xff, 0x62, 0x83, 0xee, 0xce, 0x3b, 0x42,
-	0x97, 0xca, 0x99, 0x71, 0xef, 0x2a, 0xe8, 0x42, 0xff, 0xca, 0x9f, 0x2a, 0xac, 0x4e, 0x8b, 0x37,
-	0x7b, 0xe6, 0x0f, 0xd0, 0x17, 0xa0, 0x89, 0x67, 0x09, 0x25, 0x96, 0xa6, 0x0b, 0x8f, 0x9a, 0x51,
-	0x9c, 0x0f, 0x2c, 0x9e, 0xb4, 0x0b, 0xd7, 0xe4, 0xe3, 0x80, 0x12, 0x23, 0x5c, 0x7c, 0x7b, 0x8c,
-	0x5b, 0x0b, 0x88, 0x85, 0x26, 0x55, 0xfd, 0xec, 0x55, 0x41, 0xf9, 0xeb, 0x55, 0x41, 0x79, 0x3e,
-	0x2e, 0xa8, 0x67, 0xe3, 0x82, 0xfa, 0xc7, 0xb8, 0xa0, 0xfe, 0x3d, 0x2e, 0xa8, 0x4f, 0xd3, 0x4f,
-	0xb5, 0x76, 0x46, 0xfe, 0xaf, 0x78, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0x31, 0xfd,
-	0xe7, 0xef, 0x08, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x77, 0xed, 0x8d, 0x93, 0x3c, 0xce, 0x3f, 0x4d, 0x7e, 0xc9, 0x6f, 0xbb, 0x14, 0xc7,
+	0xdd, 0x22, 0x35, 0xad, 0xc8, 0x5a, 0x18, 0x24, 0x50, 0xa1, 0x07, 0xdb, 0xb1, 0x64, 0x93, 0xd6,
+	0xa9, 0x36, 0x09, 0xf4, 0x16, 0xd6, 0xbb, 0x13, 0x77, 0xb1, 0xbd, 0x63, 0x66, 0xc6, 0x0e, 0xb9,
+	0xa0, 0x1e, 0x51, 0xc4, 0x0d, 0x09, 0xb8, 0xf4, 0x04, 0xe7, 0xbe, 0x00, 0x5e, 0x41, 0xc4, 0x89,
+	0x1b, 0x9c, 0x22, 0xea, 0x17, 0x00, 0x6f, 0x01, 0xcd, 0xec, 0xae, 0x1d, 0x9c, 0xb5, 0x9b, 0x4b,
+	0x32, 0x3b, 0xf3, 0xf9, 0x3e, 0xdf, 0x67, 0xfe, 0x3d, 0x63, 0x00, 0xea, 0x9c, 0x70, 0xab, 0x47,
+	0x09, 0x27, 0x08, 0x79, 0xc4, 0x6d, 0x63, 0x6a, 0xb1, 0x53, 0x87, 0x76, 0xdb, 0x3e, 0xb7, 0x06,
+	0xef, 0x19, 0xcb, 0xa4, 0xf9, 0x25, 0x76, 0x39, 0x0b, 0x11, 0x23, 0xcb, 0xcf, 0x7a, 0x38, 0xfe,
+	0xd8, 0x69, 0xf9, 0xfc, 0x79, 0xbf, 0x69, 0xb9, 0xa4, 0x5b, 0x70, 0x09, 0xc5, 0x84, 0x15, 0x30,
+	0x77, 0xbd, 0x82, 0x08, 0x29, 0xff, 0xf4, 0x9a, 0x85, 0x71, 0x78, 0xe3, 0x7f, 0x2d, 0xd2, 0x22,
+	0xb2, 0x59, 0x10, 0xad, 0xa8, 0x77, 0xbd, 0xd7, 0xe9, 0xb7, 0xfc, 0xa0, 0x10, 0xfe, 0x0b, 0x3b,
+	0xcd, 0x57, 0x2a, 0x80, 0xed, 0x9c, 0xf0, 0x27, 0xb8, 0xdb, 0xc4, 0x14, 0xdd, 0x85, 0x79, 0x11,
+	0xe7, 0xd8, 0xf7, 0x74, 0x35, 0xaf, 0x6e, 0x6b, 0x65, 0x18, 0x5e, 0x6e, 0x65, 0x04, 0x50, 0xdf,
+	0xb5, 0x33, 0x62, 0xa8, 0xee, 0x09, 0x28, 0x20, 0x1e, 0x16, 0x50, 0x2a, 0xaf, 0x6e, 0x2f, 0x86,
+	0x50, 0x83, 0x78, 0x58, 0x40, 0x62, 0xa8, 0xee, 0x21, 0x04, 0x9a, 0xe3, 0x79, 0x54, 0x4f, 0x0b,
+	0xc2, 0x96, 0x6d, 0x54, 0x86, 0x0c, 0xe3, 0x0e, 0xef, 0x33, 0x5d, 0xcb, 0xab, 0xdb, 0xd9, 0xe2,
+	0x3b, 0xd6, 0xf5, 0x75, 0xb0, 0xc6, 0xd9, 0x1c, 0x48, 0xb6, 0xac, 0x5d, 0x5c, 0x6e, 0x29, 0x76,
+	0xa4, 0x34, 0xef, 0x40, 0xf6, 0x53, 0xe2, 0x07, 0x36, 0xfe, 0xaa, 0x8f, 0x19, 0x1f, 0xd9, 0xa8,
+	0x63, 0x1b, 0xf3, 0x07, 0x15, 0x96, 0x42, 0x86, 0xf5, 0x48, 0xc0, 0xf0, 0xcd, 0x66, 0xf5, 0x11,
+	0xcc, 0x77, 0xa5, 0x2d, 0xd3, 0x53, 0xf9, 0xf4, 0x76, 0xb6, 0x98, 0x9b, 0x9d, 0x9d, 0x1d, 0xe3,
+	0xe8, 0x1e, 0xac, 0x52, 0xdc, 0x25, 0x03, 0xec, 0x1d, 0xc7, 0x11, 0xd2, 0xf9, 0xf4, 0xb6, 0x66,
+	0xaf, 0x44, 0xdd, 0xa1, 0x80, 0x99, 0x65, 0x58, 0x7a, 0x8c, 0x9d, 0x01, 0x8e, 0x93, 0x2f, 0x82,
+	0x26, 0x56, 0x4b, 0x26, 0xf5, 0x66, 0x3f, 0xc9, 0x9a, 0xab, 0xb0, 0x1c, 0xc5, 0x08, 0x27, 0x67,
+	0x3e, 0x86, 0x5b, 0x4f, 0x29, 0x71, 0x31, 0x63, 0x21, 0xcb, 0x98, 0xd3, 0x1a, 0x39, 0xdc, 0x17,
+	0x93, 0x92, 0x3d, 0x91, 0xc9, 0xaa, 0x15, 0x1e, 0x17, 0x2b, 0x06, 0xe3, 0xf1, 0x87, 0xda, 0x8b,
+	0x1f, 0x4d, 0xc5, 0xbc, 0x0d, 0x46, 0x52, 0xb4, 0xc8, 0xeb, 0x13, 0xd8, 0xb0, 0x31, 0x23, 0x9d,
+	0x01, 0x2e, 0x79, 0x1e, 0x15, 0x50, 0xe4, 0x73, 0x93, 0x15, 0x36, 0xdf, 0x85, 0xcd, 0x49, 0x75,
+	0xb4, 0x41, 0x49, 0xbb, 0xd8, 0x81, 0xf5, 0x7a, 0xc0, 0x31, 0x0d, 0x9c, 0x8e, 0x88, 0x13, 0x3b,
+	0x6d, 0x42, 0x6a, 0x64, 0x92, 0x19, 0x5e, 0x6e, 0xa5, 0xea, 0xbb, 0x76, 0xca, 0xf7, 0xd0, 0x23,
+	0xc8, 0x38, 0x2e, 0xf7, 0x49, 0x10, 0xed, 0xde, 0x56, 0xd2, 0x6a, 0x1e, 0x70, 0x42, 0x71, 0x49,
+	0x62, 0xf1, 0xb1, 0x0a, 0x45, 0xe6, 0x77, 0x73, 0x90, 0xbd, 0x32, 0x8a, 0x3e, 0x1e, 0x85, 0x13,
+	0x56, 0x2b, 0xc5, 0xbb, 0x6f, 0x08, 0xb7, 0xe7, 0x07, 0x5e, 0x1c, 0x0c, 0x59, 0xd1, 0xbe, 0xa6,
+	0xe4, 0x92, 0xeb, 0x49, 0x52, 0x71, 0x5b, 0x6a, 0x4a, 0xb8, 0xa7, 0xe8, 0x43, 0x98, 0x67, 0x98,
+	0x0e, 0x7c, 0x17, 0xcb, 0xeb, 0x92, 0x2d, 0xbe, 0x95, 0xe8, 0x16, 0x22, 0x35, 0xc5, 0x8e, 0x69,
+	0x61, 0xc4, 0x1d, 0xd6, 0xd6, 0xb5, 0xe9, 0x46, 0x87, 0x0e, 0x6b, 0x0b, 0x23, 0xc1, 0x09, 0xa3,
+	0x00, 0xf3, 0x53, 0x42, 0xdb, 0xfa, 0xdc, 0x74, 0xa3, 0x46, 0x88, 0x08, 0xa3, 0x88, 0x16, 0x42,
+	0xb7, 0xd3, 0x67, 0x1c, 0x53, 0x3d, 0x33, 0x5d, 0x58, 0x09, 0x11, 0x21, 0x8c, 0x68, 0xf4, 0x01,
+	0x64, 0x18, 0x76, 0x29, 0xe6, 0xfa, 0xbc, 0xd4, 0x19, 0xc9, 0x33, 0x13, 0x44, 0x4d, 0x5c, 0x72,
+	0xd9, 0x42, 0x0f, 0x61, 0x81, 0x62, 0x46, 0xfa, 0xd4, 0xc5, 0xfa, 0x82, 0xd4, 0xdd, 0x4e, 0xbc,
+	0x1c, 0x11, 0x53, 0x53, 0xec, 0x11, 0x8f, 0x1e, 0xc1, 0x22, 0xfe, 0x9a, 0xe3, 0x80, 0x89, 0xcd,
+	0x5b, 0x94, 0xe2, 0xb7, 0x93, 0xc4, 0xd5, 0x18, 0xaa, 0x29, 0xf6, 0x58, 0x21, 0x12, 0x76, 0x49,
+	0x70, 0xe2, 0xb7, 0x74, 0x98, 0x9e, 0x70, 0x45, 0x12, 0x22, 0xe1, 0x90, 0x45, 0xbb, 0xb0, 0xe4,
+	0xf4, 0x7a, 0x54, 0xd6, 0x80, 0x36, 0x3e, 0xd3, 0xb3, 0x79, 0x75, 0xda, 0x19, 0x2c, 0x45, 0xdc,
+	0x1e, 0x3e, 0xab, 0x29, 0x76, 0xd6, 0x19, 0x7f, 0x96, 0x17, 0x20, 0xc3, 0x1d, 0xda, 0xc2, 0xfc,
+	0xc1, 0x3f, 0x2a, 0xac, 0x4e, 0x9c, 0x2e, 0x74, 0x0f, 0xe6, 0x8f, 0x1a, 0x7b, 0x8d, 0xfd, 0xcf,
+	0x1b, 0x6b, 0x8a, 0x61, 0x9c, 0xbf, 0xcc, 0x6f, 0x4e, 0x10, 0x47, 0x41, 0x3b, 0x20, 0xa7, 0x01,
+	0x2a, 0xc2, 0xfa, 0xc1, 0xe1, 0xbe, 0x5d, 0x3d, 0x2e, 0x55, 0x0e, 0xeb, 0xfb, 0x8d, 0xe3, 0x8a,
+	0x5d, 0x2d, 0x1d, 0x56, 0xd7, 0x54, 0xe3, 0xd6, 0xf9, 0xcb, 0xfc, 0xc6, 0x84, 0xa8, 0x42, 0xb1,
+	0xc3, 0xf1, 0x35, 0xcd, 0xd1, 0xd3, 0x5d, 0xa1, 0x49, 0x25, 0x6a, 0x8e, 0x7a, 0x5e, 0x92, 0xc6,
+	0xae, 0x3e, 0xd9, 0xff, 0xac, 0xba, 0x96, 0x4e, 0xd4, 0xd8, 0xb2, 0x14, 0x1a, 0xff, 0xff, 0xf6,
+	0xe7, 0x9c, 0xf2, 0xeb, 0x2f, 0xb9, 0xc9, 0xd9, 0x15, 0xbf, 0x4f, 0x81, 0x26, 0xee, 0x39, 0x3a,
+	0x57, 0x01, 0x5d, 0x2f, 0x41, 0x68, 0x27, 0x69, 0x2d, 0xa7, 0x16, 0x3e, 0xc3, 0xba, 0x29, 0x1e,
+	0x55, 0xb6, 0x8d, 0xdf, 0x5e, 0xfd, 0xfd, 0x53, 0x6a, 0x15, 0x96, 0x25, 0xbf, 0xd3, 0x75, 0x02,
+	0xa7, 0x85, 0x29, 0xfa, 0x06, 0x56, 0xfe, 0x5b, 0xb2, 0xd0, 0xfd, 0x69, 0x07, 0xf1, 0x5a, 0x51,
+	0x34, 0x1e, 0xdc, 0x04, 0x9d, 0xe9, 0x5f, 0xfc, 0x43, 0x85, 0x95, 0xf1, 0x13, 0xc0, 0x9e, 0xfb,
+	0x3d, 0xf4, 0x05, 0x68, 0xe2, 0x71, 0x43, 0x89, 0x87, 0xeb, 0xca, 0xd3, 0x68, 0xe4, 0xa7, 0x03,
+	0xb3, 0x27, 0xed, 0xc2, 0x9c, 0x7c, 0x62, 0x50, 0x62, 0x84, 0xab, 0x2f, 0x98, 0x71, 0x67, 0x06,
+	0x31, 0xd3, 0xa4, 0xac, 0x5f, 0xbc, 0xce, 0x29, 0x7f, 0xbe, 0xce, 0x29, 0x2f, 0x86, 0x39, 0xf5,
+	0x62, 0x98, 0x53, 0x7f, 0x1f, 0xe6, 0xd4, 0xbf, 0x86, 0x39, 0xf5, 0x59, 0xfa, 0x99, 0xd6, 0xcc,
+	0xc8, 0x5f, 0x27, 0xef, 0xff, 0x3b, 0x00, 0x92, 0xb5, 0x61, 0x71, 0x35, 0x09, 0x00, 0x00,
 }
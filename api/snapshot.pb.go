@@ -52,6 +52,12 @@ type StoreSnapshot struct {
 	Resources  []*Resource  `protobuf:"bytes,7,rep,name=resources" json:"resources,omitempty"`
 	Extensions []*Extension `protobuf:"bytes,8,rep,name=extensions" json:"extensions,omitempty"`
 	Configs    []*Config    `protobuf:"bytes,9,rep,name=configs" json:"configs,omitempty"`
+	// Checksum is the SHA-256 digest of the rest of a serialized snapshot.
+	// It is written after all the other fields, so that it covers all the
+	// bytes before it. Snapshots written before it was added don't have
+	// one.
+	Checksum     []byte         `protobuf:"bytes,10,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	ApprovedKeys []*ApprovedKey `protobuf:"bytes,11,rep,name=approved_keys,json=approvedKeys" json:"approved_keys,omitempty"`
 }
 
 func (m *StoreSnapshot) Reset()                    { *m = StoreSnapshot{} }
@@ -170,6 +176,18 @@ func (m *StoreSnapshot) CopyFrom(src interface{}) {
 		}
 	}
 
+	if o.Checksum != nil {
+		m.Checksum = make([]byte, len(o.Checksum))
+		copy(m.Checksum, o.Checksum)
+	}
+	if o.ApprovedKeys != nil {
+		m.ApprovedKeys = make([]*ApprovedKey, len(o.ApprovedKeys))
+		for i := range m.ApprovedKeys {
+			m.ApprovedKeys[i] = &ApprovedKey{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.ApprovedKeys[i], o.ApprovedKeys[i])
+		}
+	}
+
 }
 
 func (m *ClusterSnapshot) Copy() *ClusterSnapshot {
@@ -340,6 +358,24 @@ func (m *StoreSnapshot) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Checksum) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Checksum)))
+		i += copy(dAtA[i:], m.Checksum)
+	}
+	if len(m.ApprovedKeys) > 0 {
+		for _, msg := range m.ApprovedKeys {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintSnapshot(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -514,6 +550,16 @@ func (m *StoreSnapshot) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if len(m.ApprovedKeys) > 0 {
+		for _, e := range m.ApprovedKeys {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -576,6 +622,8 @@ func (this *StoreSnapshot) String() string {
 		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "Resource", "Resource", 1) + `,`,
 		`Extensions:` + strings.Replace(fmt.Sprintf("%v", this.Extensions), "Extension", "Extension", 1) + `,`,
 		`Configs:` + strings.Replace(fmt.Sprintf("%v", this.Configs), "Config", "Config", 1) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`ApprovedKeys:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedKeys), "ApprovedKey", "ApprovedKey", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -919,6 +967,68 @@ func (m *StoreSnapshot) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = append(m.Checksum[:0], dAtA[iNdEx:postIndex]...)
+			if m.Checksum == nil {
+				m.Checksum = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovedKeys = append(m.ApprovedKeys, &ApprovedKey{})
+			if err := m.ApprovedKeys[len(m.ApprovedKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -1320,35 +1430,37 @@ var (
 func init() { proto.RegisterFile("snapshot.proto", fileDescriptorSnapshot) }
 
 var fileDescriptorSnapshot = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0x13, 0x4d,
-	0x10, 0x87, 0xbd, 0xfe, 0x77, 0xce, 0x44, 0xc9, 0xfb, 0xb2, 0xa2, 0x58, 0x19, 0x38, 0x8c, 0xa1,
-	0x70, 0x75, 0x80, 0x41, 0x02, 0x21, 0x85, 0x22, 0x11, 0x05, 0x05, 0x29, 0xd6, 0x28, 0xa2, 0x3d,
-	0x9f, 0xc7, 0xce, 0x71, 0xf8, 0xd6, 0xda, 0xd9, 0x38, 0x94, 0xf0, 0xed, 0x5c, 0x52, 0x52, 0x21,
-	0xe2, 0x86, 0xaf, 0x81, 0x76, 0xf7, 0xee, 0xb0, 0xc4, 0x99, 0x6e, 0x6d, 0x3d, 0xcf, 0xcc, 0x6f,
-	0xf7, 0x66, 0xe0, 0x98, 0xf2, 0x78, 0x45, 0x97, 0xca, 0x44, 0x2b, 0xad, 0x8c, 0xe2, 0x7c, 0xa6,
-	0x92, 0x0c, 0x75, 0x44, 0xd7, 0xb1, 0x5e, 0x66, 0xa9, 0x89, 0xd6, 0x4f, 0xfb, 0x47, 0x6a, 0xfa,
-	0x11, 0x13, 0x43, 0x1e, 0xe9, 0x83, 0x8e, 0xe7, 0x05, 0xde, 0xbf, 0xbd, 0x50, 0x0b, 0xe5, 0x8e,
-	0x8f, 0xed, 0xc9, 0xff, 0x3b, 0xfc, 0xda, 0x86, 0xa3, 0x89, 0x51, 0x1a, 0x27, 0x45, 0x71, 0x1e,
-	0x41, 0x27, 0x57, 0x33, 0x24, 0xc1, 0x06, 0xad, 0xd1, 0xe1, 0x58, 0x44, 0x7f, 0xb7, 0x89, 0xce,
-	0xd5, 0x0c, 0xa5, 0xc7, 0xf8, 0x0b, 0xe8, 0x11, 0xea, 0x75, 0x9a, 0x20, 0x89, 0xa6, 0x53, 0xee,
-	0xd4, 0x29, 0x13, 0xcf, 0xc8, 0x0a, 0xb6, 0x62, 0x8e, 0xe6, 0x5a, 0xe9, 0x8c, 0x44, 0x6b, 0xbf,
-	0x78, 0xee, 0x19, 0x59, 0xc1, 0x36, 0xa1, 0x89, 0x29, 0x23, 0xd1, 0xde, 0x9f, 0xf0, 0x7d, 0x4c,
-	0x99, 0xf4, 0x98, 0x6d, 0x94, 0x7c, 0xba, 0x22, 0x83, 0x9a, 0x44, 0x67, 0x7f, 0xa3, 0x33, 0xcf,
-	0xc8, 0x0a, 0xe6, 0xcf, 0x21, 0x20, 0x4c, 0x34, 0x1a, 0x12, 0x5d, 0xe7, 0xf5, 0xeb, 0x6f, 0x66,
-	0x11, 0x59, 0xa2, 0xfc, 0x15, 0x1c, 0x68, 0x24, 0x75, 0xa5, 0xed, 0x8b, 0x04, 0xce, 0xbb, 0x5b,
-	0xe7, 0xc9, 0x02, 0x92, 0x7f, 0x70, 0x7e, 0x02, 0x80, 0x9f, 0x0d, 0xe6, 0x94, 0xaa, 0x9c, 0x44,
-	0xcf, 0xc9, 0xf7, 0xea, 0xe4, 0x37, 0x25, 0x25, 0x77, 0x04, 0x1b, 0x38, 0x51, 0xf9, 0x3c, 0x5d,
-	0x90, 0x38, 0xd8, 0x1f, 0xf8, 0xcc, 0x21, 0xb2, 0x44, 0x87, 0x08, 0xff, 0x15, 0x77, 0xaf, 0x86,
-	0xe0, 0x25, 0x04, 0x4b, 0x5c, 0x4e, 0xed, 0x8b, 0xf9, 0x31, 0x08, 0x6b, 0x6f, 0x10, 0xcf, 0xcd,
-	0x3b, 0x87, 0xc9, 0x12, 0xe7, 0x02, 0x02, 0x8d, 0x4b, 0xb5, 0xc6, 0x99, 0x9b, 0x86, 0xb6, 0x2c,
-	0x7f, 0x0e, 0x7f, 0x31, 0xe8, 0x55, 0x0d, 0x5e, 0x43, 0xb0, 0x46, 0x6d, 0x53, 0x0b, 0x36, 0x60,
-	0xa3, 0xe3, 0xf1, 0xa3, 0xda, 0xa7, 0x2d, 0x27, 0xfe, 0xc2, 0xb3, 0xb2, 0x94, 0xf8, 0x5b, 0x80,
-	0xa2, 0xe3, 0x65, 0xba, 0x12, 0xcd, 0x01, 0x1b, 0x1d, 0x8e, 0x1f, 0xfe, 0xe3, 0xab, 0x96, 0x95,
-	0x4e, 0xdb, 0x9b, 0x1f, 0xf7, 0x1b, 0x72, 0x47, 0xe6, 0x27, 0xd0, 0x21, 0xbb, 0x01, 0xa2, 0xe5,
-	0xaa, 0x3c, 0xa8, 0x0d, 0xb2, 0xbb, 0x22, 0x45, 0x0d, 0x6f, 0x0d, 0x6f, 0x41, 0x50, 0xa4, 0xe3,
-	0x5d, 0x68, 0x5e, 0x3c, 0xf9, 0xbf, 0x71, 0x2a, 0x36, 0x37, 0x61, 0xe3, 0xfb, 0x4d, 0xd8, 0xf8,
-	0xb2, 0x0d, 0xd9, 0x66, 0x1b, 0xb2, 0x6f, 0xdb, 0x90, 0xfd, 0xdc, 0x86, 0xec, 0x43, 0x73, 0xda,
-	0x75, 0x7b, 0xf7, 0xec, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x41, 0x5b, 0x51, 0xce, 0x03,
-	0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3b, 0x6f, 0x13, 0x41,
+	0x10, 0xc7, 0x7d, 0x7e, 0x9d, 0x33, 0x8e, 0x03, 0xac, 0x28, 0x56, 0x06, 0x2e, 0xc6, 0x50, 0xb8,
+	0x3a, 0xc0, 0x20, 0x81, 0x90, 0x82, 0x44, 0x02, 0x05, 0x42, 0xa4, 0x58, 0xa3, 0x88, 0x0e, 0x9d,
+	0xcf, 0xe3, 0x07, 0x87, 0x6f, 0xad, 0x9d, 0xb5, 0x43, 0x3a, 0x3e, 0x9e, 0x4b, 0x4a, 0x2a, 0x44,
+	0x4c, 0xc1, 0xd7, 0x40, 0xbb, 0xf7, 0x88, 0x25, 0xce, 0xe9, 0x76, 0xad, 0xdf, 0xef, 0x3f, 0xe3,
+	0xb9, 0x59, 0x38, 0xa0, 0x38, 0x58, 0xd0, 0x54, 0x6a, 0x7f, 0xa1, 0xa4, 0x96, 0x8c, 0x8d, 0x64,
+	0x18, 0xa1, 0xf2, 0xe9, 0x3c, 0x50, 0xf3, 0x68, 0xa6, 0xfd, 0xd5, 0x93, 0x76, 0x4b, 0x0e, 0xbf,
+	0x60, 0xa8, 0x29, 0x41, 0xda, 0xa0, 0x82, 0x71, 0x8a, 0xb7, 0x6f, 0x4f, 0xe4, 0x44, 0xda, 0xe3,
+	0x23, 0x73, 0x4a, 0x7e, 0xed, 0xfe, 0xa9, 0x42, 0x6b, 0xa0, 0xa5, 0xc2, 0x41, 0x1a, 0xce, 0x7c,
+	0xa8, 0xc5, 0x72, 0x84, 0xc4, 0x9d, 0x4e, 0xa5, 0xd7, 0xec, 0x73, 0xff, 0xff, 0x32, 0xfe, 0xa9,
+	0x1c, 0xa1, 0x48, 0x30, 0xf6, 0x1c, 0x1a, 0x84, 0x6a, 0x35, 0x0b, 0x91, 0x78, 0xd9, 0x2a, 0x77,
+	0x8a, 0x94, 0x41, 0xc2, 0x88, 0x1c, 0x36, 0x62, 0x8c, 0xfa, 0x5c, 0xaa, 0x88, 0x78, 0x65, 0xb7,
+	0x78, 0x9a, 0x30, 0x22, 0x87, 0x4d, 0x87, 0x3a, 0xa0, 0x88, 0x78, 0x75, 0x77, 0x87, 0x1f, 0x03,
+	0x8a, 0x44, 0x82, 0x99, 0x42, 0xe1, 0xd7, 0x25, 0x69, 0x54, 0xc4, 0x6b, 0xbb, 0x0b, 0x9d, 0x24,
+	0x8c, 0xc8, 0x61, 0xf6, 0x0c, 0x5c, 0xc2, 0x50, 0xa1, 0x26, 0x5e, 0xb7, 0x5e, 0xbb, 0xf8, 0x9f,
+	0x19, 0x44, 0x64, 0x28, 0x7b, 0x09, 0x7b, 0x0a, 0x49, 0x2e, 0x95, 0x99, 0x88, 0x6b, 0xbd, 0xbb,
+	0x45, 0x9e, 0x48, 0x21, 0x71, 0x85, 0xb3, 0x23, 0x00, 0xfc, 0xa6, 0x31, 0xa6, 0x99, 0x8c, 0x89,
+	0x37, 0xac, 0x7c, 0xaf, 0x48, 0x7e, 0x9b, 0x51, 0x62, 0x4b, 0x30, 0x0d, 0x87, 0x32, 0x1e, 0xcf,
+	0x26, 0xc4, 0xf7, 0x76, 0x37, 0x7c, 0x62, 0x11, 0x91, 0xa1, 0xac, 0x0d, 0x8d, 0x70, 0x8a, 0x61,
+	0x44, 0xcb, 0x39, 0x87, 0x8e, 0xd3, 0xdb, 0x17, 0xf9, 0x9d, 0xbd, 0x81, 0x56, 0xb0, 0x58, 0x28,
+	0xb9, 0xc2, 0xd1, 0xe7, 0x08, 0x2f, 0x88, 0x37, 0x6d, 0xee, 0x61, 0x51, 0xee, 0xeb, 0x14, 0x7c,
+	0x8f, 0x17, 0x62, 0x3f, 0xb8, 0xba, 0x50, 0x17, 0xe1, 0x46, 0x3a, 0xdd, 0x7c, 0xcd, 0x5e, 0x80,
+	0x3b, 0xc7, 0xf9, 0x10, 0x55, 0xb6, 0x68, 0x5e, 0xe1, 0x8c, 0x82, 0xb1, 0xfe, 0x60, 0x31, 0x91,
+	0xe1, 0x8c, 0x83, 0xab, 0x70, 0x6e, 0xb2, 0xed, 0xbe, 0x55, 0x45, 0x76, 0xed, 0xfe, 0x75, 0xa0,
+	0x91, 0x17, 0x78, 0x05, 0xee, 0x0a, 0x95, 0x99, 0x0b, 0x77, 0x3a, 0x4e, 0xef, 0xa0, 0xff, 0xb0,
+	0xf0, 0xe3, 0xa5, 0xb8, 0x7f, 0x96, 0xb0, 0x22, 0x93, 0xd8, 0x3b, 0x80, 0xb4, 0xe2, 0x74, 0xb6,
+	0xe0, 0xe5, 0x8e, 0xd3, 0x6b, 0xf6, 0x1f, 0x5c, 0xb3, 0x37, 0x59, 0xd2, 0x71, 0x75, 0xfd, 0xeb,
+	0xb0, 0x24, 0xb6, 0x64, 0x76, 0x04, 0x35, 0x32, 0x6f, 0x8c, 0x57, 0x6c, 0xca, 0xfd, 0xc2, 0x46,
+	0xb6, 0x1f, 0x61, 0x9a, 0x91, 0x58, 0xdd, 0x5b, 0xe0, 0xa6, 0xdd, 0xb1, 0x3a, 0x94, 0xcf, 0x1e,
+	0xdf, 0x2c, 0x1d, 0xf3, 0xf5, 0xa5, 0x57, 0xfa, 0x79, 0xe9, 0x95, 0xbe, 0x6f, 0x3c, 0x67, 0xbd,
+	0xf1, 0x9c, 0x1f, 0x1b, 0xcf, 0xf9, 0xbd, 0xf1, 0x9c, 0x4f, 0xe5, 0x61, 0xdd, 0xbe, 0xec, 0xa7,
+	0xff, 0x06, 0x00, 0xc7, 0x40, 0xaf, 0x4e, 0x30, 0x04, 0x00, 0x00,
 }
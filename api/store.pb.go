@@ -74,6 +74,7 @@ type Object struct {
 	//	*Object_Resource
 	//	*Object_Extension
 	//	*Object_Config
+	//	*Object_ApprovedKey
 	Object isObject_Object `protobuf_oneof:"Object"`
 }
 
@@ -114,16 +115,20 @@ type Object_Extension struct {
 type Object_Config struct {
 	Config *Config `protobuf:"bytes,9,opt,name=config,oneof"`
 }
+type Object_ApprovedKey struct {
+	ApprovedKey *ApprovedKey `protobuf:"bytes,10,opt,name=approved_key,json=approvedKey,oneof"`
+}
 
-func (*Object_Node) isObject_Object()      {}
-func (*Object_Service) isObject_Object()   {}
-func (*Object_Network) isObject_Object()   {}
-func (*Object_Task) isObject_Object()      {}
-func (*Object_Cluster) isObject_Object()   {}
-func (*Object_Secret) isObject_Object()    {}
-func (*Object_Resource) isObject_Object()  {}
-func (*Object_Extension) isObject_Object() {}
-func (*Object_Config) isObject_Object()    {}
+func (*Object_Node) isObject_Object()        {}
+func (*Object_Service) isObject_Object()     {}
+func (*Object_Network) isObject_Object()     {}
+func (*Object_Task) isObject_Object()        {}
+func (*Object_Cluster) isObject_Object()     {}
+func (*Object_Secret) isObject_Object()      {}
+func (*Object_Resource) isObject_Object()    {}
+func (*Object_Extension) isObject_Object()   {}
+func (*Object_Config) isObject_Object()      {}
+func (*Object_ApprovedKey) isObject_Object() {}
 
 func (m *Object) GetObject() isObject_Object {
 	if m != nil {
@@ -195,6 +200,13 @@ func (m *Object) GetConfig() *Config {
 	return nil
 }
 
+func (m *Object) GetApprovedKey() *ApprovedKey {
+	if x, ok := m.GetObject().(*Object_ApprovedKey); ok {
+		return x.ApprovedKey
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Object) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Object_OneofMarshaler, _Object_OneofUnmarshaler, _Object_OneofSizer, []interface{}{
@@ -207,6 +219,7 @@ func (*Object) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error,
 		(*Object_Resource)(nil),
 		(*Object_Extension)(nil),
 		(*Object_Config)(nil),
+		(*Object_ApprovedKey)(nil),
 	}
 }
 
@@ -259,6 +272,11 @@ func _Object_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Config); err != nil {
 			return err
 		}
+	case *Object_ApprovedKey:
+		_ = b.EncodeVarint(10<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ApprovedKey); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Object.Object has unexpected type %T", x)
@@ -341,6 +359,14 @@ func _Object_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer)
 		err := b.DecodeMessage(msg)
 		m.Object = &Object_Config{msg}
 		return true, err
+	case 10: // Object.approved_key
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ApprovedKey)
+		err := b.DecodeMessage(msg)
+		m.Object = &Object_ApprovedKey{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -395,6 +421,11 @@ func _Object_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(9<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Object_ApprovedKey:
+		s := proto.Size(x.ApprovedKey)
+		n += proto.SizeVarint(10<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -968,11 +999,13 @@ func (*WatchRequest_WatchEntry) Descriptor() ([]byte, []int) { return fileDescri
 
 // WatchMessage is the type of the stream that's returned to the client by
 // Watch. Note that the first item of this stream will always be a WatchMessage
-// with a nil Object, to signal that the stream has started.
+// with no events, to signal that the stream has started. Its version is the
+// version of the data store the stream starts from.
 type WatchMessage struct {
 	Events []*WatchMessage_Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
 	// Index versions this change to the data store. It can be used to
-	// resume the watch from this point.
+	// resume the watch from this point: a watch started with it as
+	// ResumeFrom returns the changes after it, and none before.
 	Version *Version `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
 }
 
@@ -1098,6 +1131,12 @@ func (m *Object) CopyFrom(src interface{}) {
 			}
 			github_com_docker_swarmkit_api_deepcopy.Copy(v.Config, o.GetConfig())
 			m.Object = &v
+		case *Object_ApprovedKey:
+			v := Object_ApprovedKey{
+				ApprovedKey: &ApprovedKey{},
+			}
+			github_com_docker_swarmkit_api_deepcopy.Copy(v.ApprovedKey, o.GetApprovedKey())
+			m.Object = &v
 		}
 	}
 
@@ -1349,9 +1388,9 @@ const _ = grpc.SupportPackageIsVersion4
 type StoreClient interface {
 	// Watch starts a stream that returns any changes to objects that match
 	// the specified selectors. When the stream begins, it immediately sends
-	// an empty message back to the client. It is important to wait for
-	// this message before taking any actions that depend on an established
-	// stream of changes for consistency.
+	// a message with no events back to the client. It is important to wait
+	// for this message before taking any actions that depend on an
+	// established stream of changes for consistency.
 	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Store_WatchClient, error)
 }
 
@@ -1400,9 +1439,9 @@ func (x *storeWatchClient) Recv() (*WatchMessage, error) {
 type StoreServer interface {
 	// Watch starts a stream that returns any changes to objects that match
 	// the specified selectors. When the stream begins, it immediately sends
-	// an empty message back to the client. It is important to wait for
-	// this message before taking any actions that depend on an established
-	// stream of changes for consistency.
+	// a message with no events back to the client. It is important to wait
+	// for this message before taking any actions that depend on an
+	// established stream of changes for consistency.
 	Watch(*WatchRequest, Store_WatchServer) error
 }
 
@@ -1596,6 +1635,20 @@ func (m *Object_Config) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Object_ApprovedKey) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ApprovedKey != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintStore(dAtA, i, uint64(m.ApprovedKey.Size()))
+		n11, err := m.ApprovedKey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
 func (m *SelectBySlot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1677,11 +1730,11 @@ func (m *SelectBy) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.By != nil {
-		nn11, err := m.By.MarshalTo(dAtA[i:])
+		nn12, err := m.By.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn11
+		i += nn12
 	}
 	return i, nil
 }
@@ -1724,11 +1777,11 @@ func (m *SelectBy_Custom) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintStore(dAtA, i, uint64(m.Custom.Size()))
-		n12, err := m.Custom.MarshalTo(dAtA[i:])
+		n13, err := m.Custom.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	return i, nil
 }
@@ -1738,11 +1791,11 @@ func (m *SelectBy_CustomPrefix) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintStore(dAtA, i, uint64(m.CustomPrefix.Size()))
-		n13, err := m.CustomPrefix.MarshalTo(dAtA[i:])
+		n14, err := m.CustomPrefix.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n14
 	}
 	return i, nil
 }
@@ -1768,11 +1821,11 @@ func (m *SelectBy_Slot) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintStore(dAtA, i, uint64(m.Slot.Size()))
-		n14, err := m.Slot.MarshalTo(dAtA[i:])
+		n15, err := m.Slot.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n15
 	}
 	return i, nil
 }
@@ -1862,11 +1915,11 @@ func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintStore(dAtA, i, uint64(m.ResumeFrom.Size()))
-		n15, err := m.ResumeFrom.MarshalTo(dAtA[i:])
+		n16, err := m.ResumeFrom.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n16
 	}
 	if m.IncludeOldObject {
 		dAtA[i] = 0x18
@@ -1953,11 +2006,11 @@ func (m *WatchMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintStore(dAtA, i, uint64(m.Version.Size()))
-		n16, err := m.Version.MarshalTo(dAtA[i:])
+		n17, err := m.Version.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n17
 	}
 	return i, nil
 }
@@ -1986,21 +2039,21 @@ func (m *WatchMessage_Event) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintStore(dAtA, i, uint64(m.Object.Size()))
-		n17, err := m.Object.MarshalTo(dAtA[i:])
+		n18, err := m.Object.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n18
 	}
 	if m.OldObject != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintStore(dAtA, i, uint64(m.OldObject.Size()))
-		n18, err := m.OldObject.MarshalTo(dAtA[i:])
+		n19, err := m.OldObject.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n19
 	}
 	return i, nil
 }
@@ -2125,7 +2178,7 @@ func (p *raftProxyStoreServer) Watch(r *WatchRequest, stream Store_WatchServer)
 			}
 			streamWrapper := Store_WatchServerWrapper{
 				Store_WatchServer: stream,
-				ctx:               ctx,
+				ctx: ctx,
 			}
 			return p.local.Watch(r, streamWrapper)
 		}
@@ -2246,6 +2299,15 @@ func (m *Object_Config) Size() (n int) {
 	}
 	return n
 }
+func (m *Object_ApprovedKey) Size() (n int) {
+	var l int
+	_ = l
+	if m.ApprovedKey != nil {
+		l = m.ApprovedKey.Size()
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
 func (m *SelectBySlot) Size() (n int) {
 	var l int
 	_ = l
@@ -2585,6 +2647,16 @@ func (this *Object_Config) String() string {
 	}, "")
 	return s
 }
+func (this *Object_ApprovedKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Object_ApprovedKey{`,
+		`ApprovedKey:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedKey), "ApprovedKey", "ApprovedKey", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SelectBySlot) String() string {
 	if this == nil {
 		return "nil"
@@ -3150,6 +3222,38 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 			}
 			m.Object = &Object_Config{v}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApprovedKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Object = &Object_ApprovedKey{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
@@ -4522,78 +4626,79 @@ var (
 func init() { proto.RegisterFile("store.proto", fileDescriptorStore) }
 
 var fileDescriptorStore = []byte{
-	// 1160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x96, 0xbd, 0x73, 0x1b, 0xc5,
-	0x1b, 0xc7, 0x75, 0x8a, 0x7c, 0x92, 0x1e, 0xd9, 0x89, 0x67, 0xed, 0x24, 0xf7, 0xd3, 0x2f, 0xc8,
-	0x42, 0x0c, 0x90, 0x21, 0x41, 0x01, 0x13, 0xc2, 0x00, 0x81, 0x19, 0x4b, 0x16, 0x23, 0x91, 0xf1,
-	0xcb, 0xac, 0xec, 0xa4, 0xd4, 0x5c, 0xee, 0x1e, 0x2b, 0x87, 0xee, 0x6e, 0xc5, 0xde, 0x49, 0x89,
-	0x3b, 0x0a, 0x0a, 0x26, 0x3d, 0x33, 0x34, 0xa9, 0xa0, 0xa6, 0xa1, 0x83, 0x7f, 0x20, 0x43, 0x45,
-	0x09, 0x8d, 0x86, 0xa8, 0xa4, 0xe0, 0x2f, 0xa0, 0x60, 0xf6, 0xe5, 0xfc, 0xa2, 0x9c, 0x1c, 0x52,
-	0x69, 0x77, 0xef, 0xf3, 0x7d, 0xf6, 0xb9, 0xe7, 0xed, 0x04, 0xa5, 0x28, 0x66, 0x1c, 0xeb, 0x43,
-	0xce, 0x62, 0x46, 0x88, 0xcb, 0x9c, 0x01, 0xf2, 0x7a, 0xf4, 0xd0, 0xe6, 0xc1, 0xc0, 0x8b, 0xeb,
-	0xe3, 0x77, 0xcb, 0xa5, 0x68, 0x88, 0x4e, 0xa4, 0x80, 0xf2, 0x12, 0xbb, 0xff, 0x05, 0x3a, 0x71,
-	0xb2, 0x2d, 0xc5, 0x87, 0x43, 0x4c, 0x36, 0xab, 0x7d, 0xd6, 0x67, 0x72, 0x79, 0x43, 0xac, 0xf4,
-	0xe9, 0xca, 0xd0, 0x1f, 0xf5, 0xbd, 0xf0, 0x86, 0xfa, 0x51, 0x87, 0xb5, 0xaf, 0x73, 0x60, 0xee,
-	0x48, 0x4b, 0xa4, 0x0e, 0xb9, 0x90, 0xb9, 0x68, 0x19, 0x55, 0xe3, 0x6a, 0x69, 0xdd, 0xaa, 0x3f,
-	0xef, 0x41, 0x7d, 0x9b, 0xb9, 0xd8, 0xce, 0x50, 0xc9, 0x91, 0x0f, 0x20, 0x1f, 0x21, 0x1f, 0x7b,
-	0x0e, 0x5a, 0x59, 0x29, 0xf9, 0x7f, 0x9a, 0xa4, 0xab, 0x90, 0x76, 0x86, 0x26, 0xb4, 0x10, 0x86,
-	0x18, 0x3f, 0x64, 0x7c, 0x60, 0x9d, 0x9b, 0x2f, 0xdc, 0x56, 0x88, 0x10, 0x6a, 0x5a, 0x78, 0x18,
-	0xdb, 0xd1, 0xc0, 0xca, 0xcd, 0xf7, 0x70, 0xcf, 0x8e, 0x84, 0x44, 0x72, 0xe2, 0x22, 0xc7, 0x1f,
-	0x45, 0x31, 0x72, 0x6b, 0x61, 0xfe, 0x45, 0x4d, 0x85, 0x88, 0x8b, 0x34, 0x4d, 0x6e, 0x82, 0x19,
-	0xa1, 0xc3, 0x31, 0xb6, 0x4c, 0xa9, 0x2b, 0xa7, 0xbf, 0x99, 0x20, 0xda, 0x19, 0xaa, 0x59, 0xf2,
-	0x11, 0x14, 0x38, 0x46, 0x6c, 0xc4, 0x1d, 0xb4, 0xf2, 0x52, 0x77, 0x25, 0x4d, 0x47, 0x35, 0xd3,
-	0xce, 0xd0, 0x23, 0x9e, 0x7c, 0x02, 0x45, 0x7c, 0x14, 0x63, 0x18, 0x79, 0x2c, 0xb4, 0x0a, 0x52,
-	0xfc, 0x4a, 0x9a, 0xb8, 0x95, 0x40, 0xed, 0x0c, 0x3d, 0x56, 0x08, 0x87, 0x1d, 0x16, 0x1e, 0x78,
-	0x7d, 0xab, 0x38, 0xdf, 0xe1, 0xa6, 0x24, 0x84, 0xc3, 0x8a, 0x6d, 0x14, 0x92, 0xdc, 0xd7, 0x76,
-	0x61, 0xb1, 0x8b, 0x3e, 0x3a, 0x71, 0xe3, 0xb0, 0xeb, 0xb3, 0x98, 0x5c, 0x07, 0xd0, 0xd9, 0xea,
-	0x79, 0xae, 0xac, 0x88, 0x62, 0x63, 0x69, 0x3a, 0x59, 0x2b, 0xea, 0x74, 0x76, 0x36, 0x69, 0x51,
-	0x03, 0x1d, 0x97, 0x10, 0xc8, 0x45, 0x3e, 0x8b, 0x65, 0x19, 0xe4, 0xa8, 0x5c, 0xd7, 0x76, 0xe1,
-	0x7c, 0x62, 0xb1, 0x39, 0x8a, 0x62, 0x16, 0x08, 0x6a, 0xe0, 0x85, 0xda, 0x1a, 0x95, 0x6b, 0xb2,
-	0x0a, 0x0b, 0x5e, 0xe8, 0xe2, 0x23, 0x29, 0x2d, 0x52, 0xb5, 0x11, 0xa7, 0x63, 0xdb, 0x1f, 0xa1,
-	0x2c, 0x8f, 0x22, 0x55, 0x9b, 0xda, 0x5f, 0x26, 0x14, 0x12, 0x93, 0xc4, 0x82, 0xec, 0x91, 0x63,
-	0xe6, 0x74, 0xb2, 0x96, 0xed, 0x6c, 0xb6, 0x33, 0x34, 0xeb, 0xb9, 0xe4, 0x1a, 0x14, 0x3d, 0xb7,
-	0x37, 0xe4, 0x78, 0xe0, 0x69, 0xb3, 0x8d, 0xc5, 0xe9, 0x64, 0xad, 0xd0, 0xd9, 0xdc, 0x95, 0x67,
-	0x22, 0xec, 0x9e, 0xab, 0xd6, 0x64, 0x15, 0x72, 0xa1, 0x1d, 0xe8, 0x8b, 0x64, 0x65, 0xdb, 0x01,
-	0x92, 0x57, 0xa1, 0x24, 0x7e, 0x13, 0x23, 0x39, 0xfd, 0x10, 0xc4, 0xa1, 0x16, 0xde, 0x06, 0xd3,
-	0x91, 0xaf, 0xa5, 0x2b, 0xab, 0x96, 0x5e, 0x21, 0x27, 0x03, 0x20, 0x03, 0xaf, 0x42, 0xd1, 0x81,
-	0x25, 0xb5, 0x4a, 0xae, 0x30, 0x5f, 0xc2, 0xc8, 0xa2, 0x92, 0x6a, 0x47, 0xea, 0xa7, 0x32, 0x95,
-	0x4f, 0xc9, 0x94, 0xa8, 0x94, 0xe3, 0x5c, 0xbd, 0x0e, 0x79, 0xd1, 0xbd, 0x02, 0x2e, 0x48, 0x18,
-	0xa6, 0x93, 0x35, 0x53, 0x34, 0xb6, 0x24, 0x4d, 0xf1, 0xb0, 0xe3, 0x92, 0x5b, 0x3a, 0xa5, 0xaa,
-	0x9c, 0xaa, 0x67, 0x39, 0x26, 0x0a, 0x46, 0x84, 0x4e, 0xf0, 0x64, 0x13, 0x96, 0x5c, 0x8c, 0x3c,
-	0x8e, 0x6e, 0x2f, 0x8a, 0xed, 0x18, 0x2d, 0xa8, 0x1a, 0x57, 0xcf, 0xa7, 0xd7, 0xb2, 0xe8, 0xd5,
-	0xae, 0x80, 0xc4, 0x4b, 0x69, 0x95, 0xdc, 0x93, 0x75, 0xc8, 0x71, 0xe6, 0xa3, 0x55, 0x92, 0xe2,
-	0x2b, 0xf3, 0x46, 0x11, 0x65, 0xbe, 0x1c, 0x47, 0x82, 0x25, 0x1d, 0x80, 0x00, 0x83, 0xfb, 0xc8,
-	0xa3, 0x07, 0xde, 0xd0, 0x5a, 0x94, 0xca, 0x37, 0xe7, 0x29, 0xbb, 0x43, 0x74, 0xea, 0x5b, 0x47,
-	0xb8, 0x48, 0xee, 0xb1, 0x98, 0x6c, 0xc1, 0x45, 0x8e, 0x07, 0xc8, 0x31, 0x74, 0xd0, 0xed, 0xe9,
-	0xe9, 0x23, 0x22, 0xb6, 0x24, 0x23, 0x76, 0x79, 0x3a, 0x59, 0x5b, 0xa1, 0x47, 0x80, 0x1e, 0x54,
-	0x32, 0x7c, 0x2b, 0xfc, 0xb9, 0x63, 0x97, 0x7c, 0x0e, 0xab, 0x27, 0xcc, 0xa9, 0x61, 0x21, 0xac,
-	0x9d, 0x97, 0xd6, 0x2e, 0x4d, 0x27, 0x6b, 0xe4, 0xd8, 0x9a, 0x9a, 0x2a, 0xd2, 0x18, 0xe1, 0xb3,
-	0xa7, 0xa2, 0x61, 0x54, 0x13, 0x5d, 0x48, 0x0a, 0x56, 0xb6, 0xd1, 0xe9, 0x1b, 0x54, 0x77, 0x8b,
-	0x1b, 0x96, 0xd3, 0x6e, 0x50, 0x63, 0x60, 0xf6, 0x06, 0x7d, 0xea, 0x36, 0x72, 0x90, 0x6d, 0x1c,
-	0xd6, 0xfe, 0xc8, 0xc2, 0xe2, 0x3d, 0x3b, 0x76, 0x1e, 0x50, 0xfc, 0x72, 0x84, 0x51, 0x4c, 0x5a,
-	0x90, 0xc7, 0x30, 0xe6, 0x1e, 0x46, 0x96, 0x51, 0x3d, 0x77, 0xb5, 0xb4, 0x7e, 0x2d, 0x2d, 0xb6,
-	0x27, 0x25, 0x6a, 0xd3, 0x0a, 0x63, 0x7e, 0x48, 0x13, 0x2d, 0xb9, 0x0d, 0x25, 0x8e, 0xd1, 0x28,
-	0xc0, 0xde, 0x01, 0x67, 0xc1, 0x59, 0x1f, 0x8e, 0xbb, 0xc8, 0xc5, 0x68, 0xa3, 0xa0, 0xf8, 0xcf,
-	0x38, 0x0b, 0xc8, 0x75, 0x20, 0x5e, 0xe8, 0xf8, 0x23, 0x17, 0x7b, 0xcc, 0x77, 0x7b, 0xea, 0x13,
-	0x28, 0x9b, 0xb7, 0x40, 0x97, 0xf5, 0x93, 0x1d, 0xdf, 0x55, 0x43, 0xad, 0xfc, 0xad, 0x01, 0x70,
-	0xec, 0x43, 0xea, 0xfc, 0xf9, 0x18, 0x4c, 0xdb, 0x89, 0xc5, 0xcc, 0xcd, 0xca, 0x82, 0x79, 0x6d,
-	0xee, 0x4b, 0x6d, 0x48, 0xec, 0x8e, 0x17, 0xba, 0x54, 0x4b, 0xc8, 0x2d, 0xc8, 0x1f, 0x78, 0x7e,
-	0x8c, 0x3c, 0xb2, 0xce, 0xc9, 0x90, 0x5c, 0x39, 0xab, 0x4d, 0x68, 0x02, 0xd7, 0x7e, 0x49, 0x62,
-	0xbb, 0x85, 0x51, 0x64, 0xf7, 0x91, 0x7c, 0x0a, 0x26, 0x8e, 0x31, 0x8c, 0x93, 0xd0, 0xbe, 0x31,
-	0xd7, 0x0b, 0xad, 0xa8, 0xb7, 0x04, 0x4e, 0xb5, 0x8a, 0xbc, 0x0f, 0xf9, 0xb1, 0x8a, 0xd6, 0x7f,
-	0x09, 0x68, 0xc2, 0x96, 0x7f, 0x32, 0x60, 0x41, 0x1a, 0x3a, 0x11, 0x06, 0xe3, 0xe5, 0xc3, 0xb0,
-	0x0e, 0xa6, 0x4e, 0x44, 0x76, 0xfe, 0xb7, 0x47, 0xa5, 0x84, 0x6a, 0x92, 0x7c, 0x08, 0x30, 0x93,
-	0xc0, 0xb3, 0x75, 0x45, 0x96, 0x64, 0xf5, 0xad, 0x7f, 0x0c, 0xb8, 0x30, 0xe3, 0x0a, 0xb9, 0x09,
-	0xab, 0xf7, 0x36, 0xf6, 0x9a, 0xed, 0xde, 0x46, 0x73, 0xaf, 0xb3, 0xb3, 0xdd, 0xdb, 0xdf, 0xbe,
-	0xb3, 0xbd, 0x73, 0x6f, 0x7b, 0x39, 0x53, 0x2e, 0x3f, 0x7e, 0x52, 0xbd, 0x34, 0x83, 0xef, 0x87,
-	0x83, 0x90, 0x3d, 0x14, 0x8e, 0xaf, 0x9c, 0x52, 0x35, 0x69, 0x6b, 0x63, 0xaf, 0xb5, 0x6c, 0x94,
-	0xff, 0xf7, 0xf8, 0x49, 0xf5, 0xe2, 0x8c, 0xa8, 0xc9, 0x51, 0x4d, 0xa6, 0xd3, 0x9a, 0xfd, 0xdd,
-	0x4d, 0xa1, 0xc9, 0xa6, 0x6a, 0xf6, 0x87, 0x6e, 0x9a, 0x86, 0xb6, 0xb6, 0x76, 0xee, 0xb6, 0x96,
-	0x73, 0xa9, 0x1a, 0x8a, 0x01, 0x1b, 0x63, 0xf9, 0xf2, 0x37, 0xdf, 0x57, 0x32, 0x3f, 0xff, 0x50,
-	0x99, 0x7d, 0xd5, 0xf5, 0x00, 0x16, 0xba, 0xe2, 0x7f, 0x22, 0x71, 0x61, 0x41, 0x3e, 0x23, 0xd5,
-	0x17, 0x35, 0x62, 0xb9, 0xfa, 0xa2, 0x7a, 0xaa, 0x5d, 0xfc, 0xf5, 0xc7, 0xbf, 0xbf, 0xcb, 0x5e,
-	0x80, 0x25, 0x49, 0xbc, 0x1d, 0xd8, 0xa1, 0xdd, 0x47, 0xfe, 0x8e, 0xd1, 0xb0, 0x9e, 0x3e, 0xab,
-	0x64, 0x7e, 0x7f, 0x56, 0xc9, 0x7c, 0x35, 0xad, 0x18, 0x4f, 0xa7, 0x15, 0xe3, 0xb7, 0x69, 0xc5,
-	0xf8, 0x73, 0x5a, 0x31, 0xee, 0x9b, 0xf2, 0x0f, 0xe4, 0x7b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff,
-	0x9d, 0xb1, 0x59, 0x21, 0xb7, 0x0a, 0x00, 0x00,
+	// 1182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xbf, 0x73, 0x1b, 0x45,
+	0x14, 0xc7, 0x75, 0x8a, 0x7c, 0x92, 0x9e, 0xec, 0xc4, 0xb3, 0x76, 0x92, 0x43, 0x24, 0x92, 0x10,
+	0x03, 0x64, 0x48, 0x50, 0xc0, 0x84, 0x30, 0x40, 0x60, 0xc6, 0x92, 0xc5, 0x48, 0x64, 0xfc, 0x63,
+	0xd6, 0x76, 0x52, 0x6a, 0x2e, 0x77, 0xcf, 0xce, 0x21, 0xdd, 0xad, 0xd8, 0x5b, 0x29, 0x71, 0x47,
+	0xc9, 0xa4, 0x67, 0x86, 0x26, 0x15, 0xd4, 0x34, 0x74, 0xf0, 0x0f, 0x64, 0x52, 0x51, 0x42, 0xe3,
+	0x21, 0x2a, 0x29, 0xf8, 0x0b, 0x28, 0x98, 0xfd, 0x71, 0xb6, 0xa3, 0x9c, 0x1c, 0x52, 0x69, 0x77,
+	0xef, 0xfb, 0x79, 0xef, 0xdd, 0x7b, 0x6f, 0xdf, 0x09, 0x4a, 0xb1, 0x60, 0x1c, 0x1b, 0x43, 0xce,
+	0x04, 0x23, 0xc4, 0x67, 0x5e, 0x1f, 0x79, 0x23, 0x7e, 0xe0, 0xf2, 0xb0, 0x1f, 0x88, 0xc6, 0xf8,
+	0x83, 0x72, 0x29, 0x1e, 0xa2, 0x17, 0x6b, 0x41, 0x79, 0x81, 0xdd, 0xfb, 0x1a, 0x3d, 0x91, 0x6c,
+	0x4b, 0xe2, 0x60, 0x88, 0xc9, 0x66, 0x79, 0x9f, 0xed, 0x33, 0xb5, 0xbc, 0x2e, 0x57, 0xe6, 0x74,
+	0x69, 0x38, 0x18, 0xed, 0x07, 0xd1, 0x75, 0xfd, 0xa3, 0x0f, 0xeb, 0x4f, 0x73, 0x60, 0x6f, 0x2a,
+	0x4b, 0xa4, 0x01, 0xb9, 0x88, 0xf9, 0xe8, 0x58, 0x35, 0xeb, 0x4a, 0x69, 0xc5, 0x69, 0xbc, 0x18,
+	0x41, 0x63, 0x83, 0xf9, 0xd8, 0xc9, 0x50, 0xa5, 0x23, 0x1f, 0x43, 0x3e, 0x46, 0x3e, 0x0e, 0x3c,
+	0x74, 0xb2, 0x0a, 0x79, 0x3d, 0x0d, 0xd9, 0xd6, 0x92, 0x4e, 0x86, 0x26, 0x6a, 0x09, 0x46, 0x28,
+	0x1e, 0x30, 0xde, 0x77, 0xce, 0xcc, 0x06, 0x37, 0xb4, 0x44, 0x82, 0x46, 0x2d, 0x23, 0x14, 0x6e,
+	0xdc, 0x77, 0x72, 0xb3, 0x23, 0xdc, 0x71, 0x63, 0x89, 0x28, 0x9d, 0x74, 0xe4, 0x0d, 0x46, 0xb1,
+	0x40, 0xee, 0xcc, 0xcd, 0x76, 0xd4, 0xd2, 0x12, 0xe9, 0xc8, 0xa8, 0xc9, 0x0d, 0xb0, 0x63, 0xf4,
+	0x38, 0x0a, 0xc7, 0x56, 0x5c, 0x39, 0xfd, 0xcd, 0xa4, 0xa2, 0x93, 0xa1, 0x46, 0x4b, 0x3e, 0x85,
+	0x02, 0xc7, 0x98, 0x8d, 0xb8, 0x87, 0x4e, 0x5e, 0x71, 0x97, 0xd2, 0x38, 0x6a, 0x34, 0x9d, 0x0c,
+	0x3d, 0xd2, 0x93, 0xcf, 0xa1, 0x88, 0x0f, 0x05, 0x46, 0x71, 0xc0, 0x22, 0xa7, 0xa0, 0xe0, 0xcb,
+	0x69, 0x70, 0x3b, 0x11, 0x75, 0x32, 0xf4, 0x98, 0x90, 0x01, 0x7b, 0x2c, 0xda, 0x0b, 0xf6, 0x9d,
+	0xe2, 0xec, 0x80, 0x5b, 0x4a, 0x21, 0x03, 0xd6, 0x5a, 0xb2, 0x06, 0xf3, 0xee, 0x70, 0xc8, 0xd9,
+	0x18, 0xfd, 0x5e, 0x1f, 0x0f, 0x1c, 0x50, 0x6c, 0x35, 0x8d, 0x5d, 0x35, 0xba, 0xdb, 0x78, 0xd0,
+	0xc9, 0xd0, 0x92, 0x7b, 0xbc, 0x6d, 0x16, 0x92, 0x0e, 0xaa, 0x6f, 0xc1, 0xfc, 0x36, 0x0e, 0xd0,
+	0x13, 0xcd, 0x83, 0xed, 0x01, 0x13, 0xe4, 0x1a, 0x80, 0xa9, 0x79, 0x2f, 0xf0, 0x55, 0x5f, 0x15,
+	0x9b, 0x0b, 0x93, 0xc3, 0x6a, 0xd1, 0x34, 0x45, 0x77, 0x8d, 0x16, 0x8d, 0xa0, 0xeb, 0x13, 0x02,
+	0xb9, 0x78, 0xc0, 0x84, 0x6a, 0xa6, 0x1c, 0x55, 0xeb, 0xfa, 0x16, 0x9c, 0x4d, 0x2c, 0xb6, 0x46,
+	0xb1, 0x60, 0xa1, 0x54, 0xf5, 0x83, 0xc8, 0x58, 0xa3, 0x6a, 0x4d, 0x96, 0x61, 0x2e, 0x88, 0x7c,
+	0x7c, 0xa8, 0xd0, 0x22, 0xd5, 0x1b, 0x79, 0x3a, 0x76, 0x07, 0x23, 0x54, 0x4d, 0x56, 0xa4, 0x7a,
+	0x53, 0xff, 0xdb, 0x86, 0x42, 0x62, 0x92, 0x38, 0x90, 0x3d, 0x0a, 0xcc, 0x9e, 0x1c, 0x56, 0xb3,
+	0xdd, 0xb5, 0x4e, 0x86, 0x66, 0x03, 0x9f, 0x5c, 0x85, 0x62, 0xe0, 0xf7, 0x86, 0x1c, 0xf7, 0x02,
+	0x63, 0xb6, 0x39, 0x3f, 0x39, 0xac, 0x16, 0xba, 0x6b, 0x5b, 0xea, 0x4c, 0x16, 0x2f, 0xf0, 0xf5,
+	0x9a, 0x2c, 0x43, 0x2e, 0x72, 0x43, 0xe3, 0x48, 0xdd, 0x0f, 0x37, 0x44, 0xf2, 0x06, 0x94, 0xe4,
+	0x6f, 0x62, 0x24, 0x67, 0x1e, 0x82, 0x3c, 0x34, 0xe0, 0x2d, 0xb0, 0x3d, 0xf5, 0x5a, 0xa6, 0x3f,
+	0xeb, 0xe9, 0x7d, 0x76, 0x32, 0x01, 0xaa, 0x7c, 0x3a, 0x15, 0x5d, 0x58, 0xd0, 0xab, 0xc4, 0x85,
+	0xfd, 0x0a, 0x46, 0xe6, 0x35, 0x6a, 0x02, 0x69, 0x3c, 0x57, 0xa9, 0x7c, 0x4a, 0xa5, 0x64, 0xbf,
+	0x1d, 0xd7, 0xea, 0x2d, 0xc8, 0xcb, 0x19, 0x20, 0xc5, 0x05, 0x25, 0x86, 0xc9, 0x61, 0xd5, 0x96,
+	0xe3, 0x41, 0x29, 0x6d, 0xf9, 0xb0, 0xeb, 0x93, 0x9b, 0xa6, 0xa4, 0xba, 0x29, 0x6b, 0xa7, 0x05,
+	0x26, 0x1b, 0x46, 0xa6, 0x4e, 0xea, 0xc9, 0x1a, 0x2c, 0xf8, 0x18, 0x07, 0x1c, 0xfd, 0x5e, 0x2c,
+	0x5c, 0x81, 0xaa, 0x33, 0xcf, 0xae, 0x5c, 0x9e, 0x75, 0xe3, 0xb7, 0xa5, 0x48, 0xbe, 0x94, 0xa1,
+	0xd4, 0x9e, 0xac, 0x40, 0x8e, 0xb3, 0x01, 0x3a, 0x25, 0x05, 0x5f, 0x9a, 0x35, 0xd0, 0x28, 0x1b,
+	0xa8, 0xa1, 0x26, 0xb5, 0xa4, 0x0b, 0x10, 0x62, 0x78, 0x0f, 0x79, 0x7c, 0x3f, 0x18, 0x3a, 0xf3,
+	0x8a, 0x7c, 0x67, 0x16, 0xb9, 0x3d, 0x44, 0xaf, 0xb1, 0x7e, 0x24, 0x97, 0xc5, 0x3d, 0x86, 0xc9,
+	0x3a, 0x9c, 0xe7, 0xb8, 0x87, 0x1c, 0x23, 0x0f, 0xfd, 0x9e, 0x99, 0x61, 0x32, 0x63, 0x0b, 0x2a,
+	0x63, 0x17, 0x27, 0x87, 0xd5, 0x25, 0x7a, 0x24, 0x30, 0xe3, 0x4e, 0xa5, 0x6f, 0x89, 0xbf, 0x70,
+	0xec, 0x93, 0xaf, 0x60, 0xf9, 0x84, 0x39, 0x3d, 0x72, 0xa4, 0xb5, 0xb3, 0xca, 0xda, 0x85, 0xc9,
+	0x61, 0x95, 0x1c, 0x5b, 0xd3, 0xb3, 0x49, 0x19, 0x23, 0x7c, 0xfa, 0x54, 0x5e, 0x18, 0x7d, 0x89,
+	0xce, 0x25, 0x0d, 0x2b, 0x77, 0x53, 0x1e, 0xf4, 0x8c, 0x90, 0x1e, 0x16, 0xd3, 0x3c, 0xe8, 0x61,
+	0x32, 0xed, 0xc1, 0x9c, 0xfa, 0xcd, 0x1c, 0x64, 0x9b, 0x07, 0xf5, 0x3f, 0xb3, 0x30, 0x7f, 0xd7,
+	0x15, 0xde, 0x7d, 0x8a, 0xdf, 0x8c, 0x30, 0x16, 0xa4, 0x0d, 0x79, 0x8c, 0x04, 0x0f, 0x30, 0x76,
+	0xac, 0xda, 0x99, 0x2b, 0xa5, 0x95, 0xab, 0x69, 0xb9, 0x3d, 0x89, 0xe8, 0x4d, 0x3b, 0x12, 0xfc,
+	0x80, 0x26, 0x2c, 0xb9, 0x05, 0x25, 0x8e, 0xf1, 0x28, 0xc4, 0xde, 0x1e, 0x67, 0xe1, 0x69, 0x9f,
+	0x9f, 0x3b, 0xc8, 0xe5, 0x80, 0xa4, 0xa0, 0xf5, 0x5f, 0x72, 0x16, 0x92, 0x6b, 0x40, 0x82, 0xc8,
+	0x1b, 0x8c, 0x7c, 0xec, 0xb1, 0x81, 0xdf, 0xd3, 0x1f, 0x52, 0x75, 0x79, 0x0b, 0x74, 0xd1, 0x3c,
+	0xd9, 0x1c, 0xf8, 0x7a, 0xa8, 0x95, 0xbf, 0xb7, 0x00, 0x8e, 0x63, 0x48, 0x9d, 0x3f, 0x9f, 0x81,
+	0xed, 0x7a, 0x42, 0x4e, 0xee, 0xac, 0x6a, 0x98, 0x37, 0x67, 0xbe, 0xd4, 0xaa, 0x92, 0xdd, 0x0e,
+	0x22, 0x9f, 0x1a, 0x84, 0xdc, 0x84, 0xfc, 0x5e, 0x30, 0x10, 0xc8, 0x63, 0xe7, 0x8c, 0x4a, 0xc9,
+	0xa5, 0xd3, 0xae, 0x09, 0x4d, 0xc4, 0xf5, 0xdf, 0x92, 0xdc, 0xae, 0x63, 0x1c, 0xbb, 0xfb, 0x48,
+	0xbe, 0x00, 0x1b, 0xc7, 0x18, 0x89, 0x24, 0xb5, 0x6f, 0xcf, 0x8c, 0xc2, 0x10, 0x8d, 0xb6, 0x94,
+	0x53, 0x43, 0x91, 0x8f, 0x20, 0x3f, 0xd6, 0xd9, 0xfa, 0x3f, 0x09, 0x4d, 0xb4, 0xe5, 0x5f, 0x2c,
+	0x98, 0x53, 0x86, 0x4e, 0xa4, 0xc1, 0x7a, 0xf5, 0x34, 0xac, 0x80, 0x6d, 0x0a, 0x91, 0x9d, 0xfd,
+	0x05, 0xd3, 0x25, 0xa1, 0x46, 0x49, 0x3e, 0x01, 0x98, 0x2a, 0xe0, 0xe9, 0x5c, 0x91, 0x25, 0x55,
+	0x7d, 0xf7, 0x5f, 0x0b, 0xce, 0x4d, 0x85, 0x42, 0x6e, 0xc0, 0xf2, 0xdd, 0xd5, 0x9d, 0x56, 0xa7,
+	0xb7, 0xda, 0xda, 0xe9, 0x6e, 0x6e, 0xf4, 0x76, 0x37, 0x6e, 0x6f, 0x6c, 0xde, 0xdd, 0x58, 0xcc,
+	0x94, 0xcb, 0x8f, 0x1e, 0xd7, 0x2e, 0x4c, 0xc9, 0x77, 0xa3, 0x7e, 0xc4, 0x1e, 0xc8, 0xc0, 0x97,
+	0x9e, 0xa3, 0x5a, 0xb4, 0xbd, 0xba, 0xd3, 0x5e, 0xb4, 0xca, 0xaf, 0x3d, 0x7a, 0x5c, 0x3b, 0x3f,
+	0x05, 0xb5, 0x38, 0xea, 0xc9, 0xf4, 0x3c, 0xb3, 0xbb, 0xb5, 0x26, 0x99, 0x6c, 0x2a, 0xb3, 0x3b,
+	0xf4, 0xd3, 0x18, 0xda, 0x5e, 0xdf, 0xbc, 0xd3, 0x5e, 0xcc, 0xa5, 0x32, 0x14, 0x43, 0x36, 0xc6,
+	0xf2, 0xc5, 0xef, 0x7e, 0xac, 0x64, 0x7e, 0xfd, 0xa9, 0x32, 0xfd, 0xaa, 0x2b, 0x21, 0xcc, 0x6d,
+	0x0b, 0xc6, 0x91, 0xf8, 0x30, 0xa7, 0x9e, 0x91, 0xda, 0xcb, 0x2e, 0x62, 0xb9, 0xf6, 0xb2, 0x7e,
+	0xaa, 0x9f, 0x7f, 0xfa, 0xf3, 0x3f, 0x3f, 0x64, 0xcf, 0xc1, 0x82, 0x52, 0xbc, 0x17, 0xba, 0x91,
+	0xbb, 0x8f, 0xfc, 0x7d, 0xab, 0xe9, 0x3c, 0x79, 0x56, 0xc9, 0xfc, 0xf1, 0xac, 0x92, 0xf9, 0x76,
+	0x52, 0xb1, 0x9e, 0x4c, 0x2a, 0xd6, 0xef, 0x93, 0x8a, 0xf5, 0xd7, 0xa4, 0x62, 0xdd, 0xb3, 0xd5,
+	0xdf, 0xd0, 0x0f, 0xff, 0x1b, 0x00, 0x97, 0x2b, 0xea, 0x3b, 0xfd, 0x0a, 0x00, 0x00,
 }
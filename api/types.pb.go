@@ -3,215 +3,228 @@
 // DO NOT EDIT!
 
 /*
-	Package api is a generated protocol buffer package.
-
-	It is generated from these files:
-		types.proto
-		specs.proto
-		objects.proto
-		control.proto
-		dispatcher.proto
-		ca.proto
-		snapshot.proto
-		raft.proto
-		health.proto
-		resource.proto
-		logbroker.proto
-		store.proto
-
-	It has these top-level messages:
-		Version
-		IndexEntry
-		Annotations
-		Resources
-		ResourceRequirements
-		Platform
-		PluginDescription
-		EngineDescription
-		NodeDescription
-		NodeTLSInfo
-		RaftMemberStatus
-		NodeStatus
-		Image
-		Mount
-		RestartPolicy
-		UpdateConfig
-		UpdateStatus
-		ContainerStatus
-		PortStatus
-		TaskStatus
-		NetworkAttachmentConfig
-		IPAMConfig
-		PortConfig
-		Driver
-		IPAMOptions
-		Peer
-		WeightedPeer
-		IssuanceStatus
-		AcceptancePolicy
-		ExternalCA
-		CAConfig
-		OrchestrationConfig
-		TaskDefaults
-		DispatcherConfig
-		RaftConfig
-		EncryptionConfig
-		SpreadOver
-		PlacementPreference
-		Placement
-		JoinTokens
-		RootCA
-		Certificate
-		EncryptionKey
-		ManagerStatus
-		FileTarget
-		SecretReference
-		ConfigReference
-		BlacklistedCertificate
-		HealthConfig
-		MaybeEncryptedRecord
-		RootRotation
-		Privileges
-		NodeSpec
-		ServiceSpec
-		ReplicatedService
-		GlobalService
-		TaskSpec
-		GenericRuntimeSpec
-		NetworkAttachmentSpec
-		ContainerSpec
-		EndpointSpec
-		NetworkSpec
-		ClusterSpec
-		SecretSpec
-		ConfigSpec
-		Meta
-		Node
-		Service
-		Endpoint
-		Task
-		NetworkAttachment
-		Network
-		Cluster
-		Secret
-		Config
-		Resource
-		Extension
-		GetNodeRequest
-		GetNodeResponse
-		ListNodesRequest
-		ListNodesResponse
-		UpdateNodeRequest
-		UpdateNodeResponse
-		RemoveNodeRequest
-		RemoveNodeResponse
-		GetTaskRequest
-		GetTaskResponse
-		RemoveTaskRequest
-		RemoveTaskResponse
-		ListTasksRequest
-		ListTasksResponse
-		CreateServiceRequest
-		CreateServiceResponse
-		GetServiceRequest
-		GetServiceResponse
-		UpdateServiceRequest
-		UpdateServiceResponse
-		RemoveServiceRequest
-		RemoveServiceResponse
-		ListServicesRequest
-		ListServicesResponse
-		CreateNetworkRequest
-		CreateNetworkResponse
-		GetNetworkRequest
-		GetNetworkResponse
-		RemoveNetworkRequest
-		RemoveNetworkResponse
-		ListNetworksRequest
-		ListNetworksResponse
-		GetClusterRequest
-		GetClusterResponse
-		ListClustersRequest
-		ListClustersResponse
-		KeyRotation
-		UpdateClusterRequest
-		UpdateClusterResponse
-		GetSecretRequest
-		GetSecretResponse
-		UpdateSecretRequest
-		UpdateSecretResponse
-		ListSecretsRequest
-		ListSecretsResponse
-		CreateSecretRequest
-		CreateSecretResponse
-		RemoveSecretRequest
-		RemoveSecretResponse
-		GetConfigRequest
-		GetConfigResponse
-		UpdateConfigRequest
-		UpdateConfigResponse
-		ListConfigsRequest
-		ListConfigsResponse
-		CreateConfigRequest
-		CreateConfigResponse
-		RemoveConfigRequest
-		RemoveConfigResponse
-		SessionRequest
-		SessionMessage
-		HeartbeatRequest
-		HeartbeatResponse
-		UpdateTaskStatusRequest
-		UpdateTaskStatusResponse
-		TasksRequest
-		TasksMessage
-		AssignmentsRequest
-		Assignment
-		AssignmentChange
-		AssignmentsMessage
-		NodeCertificateStatusRequest
-		NodeCertificateStatusResponse
-		IssueNodeCertificateRequest
-		IssueNodeCertificateResponse
-		GetRootCACertificateRequest
-		GetRootCACertificateResponse
-		GetUnlockKeyRequest
-		GetUnlockKeyResponse
-		StoreSnapshot
-		ClusterSnapshot
-		Snapshot
-		RaftMember
-		JoinRequest
-		JoinResponse
-		LeaveRequest
-		LeaveResponse
-		ProcessRaftMessageRequest
-		ProcessRaftMessageResponse
-		ResolveAddressRequest
-		ResolveAddressResponse
-		InternalRaftRequest
-		StoreAction
-		HealthCheckRequest
-		HealthCheckResponse
-		AttachNetworkRequest
-		AttachNetworkResponse
-		DetachNetworkRequest
-		DetachNetworkResponse
-		LogSubscriptionOptions
-		LogSelector
-		LogContext
-		LogMessage
-		SubscribeLogsRequest
-		SubscribeLogsMessage
-		ListenSubscriptionsRequest
-		SubscriptionMessage
-		PublishLogsMessage
-		PublishLogsResponse
-		Object
-		SelectBySlot
-		SelectByCustom
-		SelectBy
-		WatchRequest
-		WatchMessage
+Package api is a generated protocol buffer package.
+
+It is generated from these files:
+
+	types.proto
+	specs.proto
+	objects.proto
+	control.proto
+	dispatcher.proto
+	ca.proto
+	snapshot.proto
+	raft.proto
+	health.proto
+	resource.proto
+	logbroker.proto
+	store.proto
+
+It has these top-level messages:
+
+	Version
+	IndexEntry
+	Annotations
+	Resources
+	ResourceRequirements
+	Platform
+	PluginDescription
+	EngineDescription
+	NodeDescription
+	NodeTLSInfo
+	RaftMemberStatus
+	NodeStatus
+	Image
+	Mount
+	RestartPolicy
+	UpdateConfig
+	UpdateStatus
+	ContainerStatus
+	PortStatus
+	TaskStatus
+	NetworkAttachmentConfig
+	IPAMConfig
+	PortConfig
+	Driver
+	IPAMOptions
+	Peer
+	WeightedPeer
+	IssuanceStatus
+	AcceptancePolicy
+	ExternalCA
+	CAConfig
+	OrchestrationConfig
+	TaskDefaults
+	DispatcherConfig
+	RaftConfig
+	EncryptionConfig
+	SpreadOver
+	PlacementPreference
+	Placement
+	JoinTokens
+	RootCA
+	Certificate
+	IssuanceRecord
+	EncryptionKey
+	ManagerStatus
+	FileTarget
+	SecretReference
+	ConfigReference
+	BlacklistedCertificate
+	HealthConfig
+	MaybeEncryptedRecord
+	RootRotation
+	RootRotationCursor
+	Privileges
+	NodeSpec
+	ServiceSpec
+	ReplicatedService
+	GlobalService
+	TaskSpec
+	GenericRuntimeSpec
+	NetworkAttachmentSpec
+	ContainerSpec
+	EndpointSpec
+	NetworkSpec
+	ClusterSpec
+	SecretSpec
+	ConfigSpec
+	Meta
+	Node
+	MembershipTransition
+	Service
+	Endpoint
+	Task
+	NetworkAttachment
+	Network
+	Cluster
+	Secret
+	Config
+	ApprovedKey
+	Resource
+	Extension
+	GetNodeRequest
+	GetNodeResponse
+	ListNodesRequest
+	ListNodesResponse
+	UpdateNodeRequest
+	UpdateNodeResponse
+	RemoveNodeRequest
+	RemoveNodeResponse
+	GetTaskRequest
+	GetTaskResponse
+	RemoveTaskRequest
+	RemoveTaskResponse
+	ListTasksRequest
+	ListTasksResponse
+	CreateServiceRequest
+	CreateServiceResponse
+	GetServiceRequest
+	GetServiceResponse
+	UpdateServiceRequest
+	UpdateServiceResponse
+	RemoveServiceRequest
+	RemoveServiceResponse
+	ListServicesRequest
+	ListServicesResponse
+	CreateNetworkRequest
+	CreateNetworkResponse
+	GetNetworkRequest
+	GetNetworkResponse
+	RemoveNetworkRequest
+	RemoveNetworkResponse
+	ListNetworksRequest
+	ListNetworksResponse
+	GetClusterRequest
+	GetClusterResponse
+	ListClustersRequest
+	ListClustersResponse
+	KeyRotation
+	UpdateClusterRequest
+	UpdateClusterResponse
+	ListJoinTokenInfoRequest
+	JoinTokenInfo
+	ListJoinTokenInfoResponse
+	GetSecretRequest
+	GetSecretResponse
+	UpdateSecretRequest
+	UpdateSecretResponse
+	ListSecretsRequest
+	ListSecretsResponse
+	CreateSecretRequest
+	CreateSecretResponse
+	RemoveSecretRequest
+	RemoveSecretResponse
+	GetConfigRequest
+	GetConfigResponse
+	UpdateConfigRequest
+	UpdateConfigResponse
+	ListConfigsRequest
+	ListConfigsResponse
+	CreateConfigRequest
+	CreateConfigResponse
+	RemoveConfigRequest
+	RemoveConfigResponse
+	SessionRequest
+	SessionMessage
+	HeartbeatRequest
+	HeartbeatResponse
+	UpdateTaskStatusRequest
+	UpdateTaskStatusResponse
+	TasksRequest
+	TasksMessage
+	AssignmentsRequest
+	Assignment
+	AssignmentChange
+	AssignmentsMessage
+	NodeCertificateStatusRequest
+	NodeCertificateStatusResponse
+	IssueNodeCertificateRequest
+	IssueNodeCertificateResponse
+	GetRootCACertificateRequest
+	GetRootCACertificateResponse
+	GetUnlockKeyRequest
+	GetUnlockKeyResponse
+	GetNodeCertificateChainRequest
+	GetNodeCertificateChainResponse
+	GetNodeIssuanceHistoryRequest
+	GetNodeIssuanceHistoryResponse
+	StoreSnapshot
+	ClusterSnapshot
+	Snapshot
+	RaftMember
+	JoinRequest
+	JoinResponse
+	LeaveRequest
+	LeaveResponse
+	ProcessRaftMessageRequest
+	ProcessRaftMessageResponse
+	ResolveAddressRequest
+	ResolveAddressResponse
+	InternalRaftRequest
+	StoreAction
+	HealthCheckRequest
+	HealthCheckResponse
+	AttachNetworkRequest
+	AttachNetworkResponse
+	DetachNetworkRequest
+	DetachNetworkResponse
+	LogSubscriptionOptions
+	LogSelector
+	LogContext
+	LogMessage
+	SubscribeLogsRequest
+	SubscribeLogsMessage
+	ListenSubscriptionsRequest
+	SubscriptionMessage
+	PublishLogsMessage
+	PublishLogsResponse
+	Object
+	SelectBySlot
+	SelectByCustom
+	SelectBy
+	WatchRequest
+	WatchMessage
 */
 package api
 
@@ -678,7 +691,9 @@ var IssuanceStatus_State_value = map[string]int32{
 func (x IssuanceStatus_State) String() string {
 	return proto.EnumName(IssuanceStatus_State_name, int32(x))
 }
-func (IssuanceStatus_State) EnumDescriptor() ([]byte, []int) { return fileDescriptorTypes, []int{27, 0} }
+func (IssuanceStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{27, 0}
+}
 
 type ExternalCA_CAProtocol int32
 
@@ -700,6 +715,35 @@ func (ExternalCA_CAProtocol) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptorTypes, []int{29, 0}
 }
 
+type IssuanceRecord_Reason int32
+
+const (
+	// The node joined the cluster
+	IssuanceReasonJoin IssuanceRecord_Reason = 0
+	// The node renewed its certificate
+	IssuanceReasonRenewal IssuanceRecord_Reason = 1
+	// The node renewed its certificate during a root rotation
+	IssuanceReasonRotation IssuanceRecord_Reason = 2
+)
+
+var IssuanceRecord_Reason_name = map[int32]string{
+	0: "JOIN",
+	1: "RENEWAL",
+	2: "ROTATION",
+}
+var IssuanceRecord_Reason_value = map[string]int32{
+	"JOIN":     0,
+	"RENEWAL":  1,
+	"ROTATION": 2,
+}
+
+func (x IssuanceRecord_Reason) String() string {
+	return proto.EnumName(IssuanceRecord_Reason_name, int32(x))
+}
+func (IssuanceRecord_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorTypes, []int{42, 0}
+}
+
 // Encryption algorithm that can implemented using this key
 type EncryptionKey_Algorithm int32
 
@@ -718,7 +762,7 @@ func (x EncryptionKey_Algorithm) String() string {
 	return proto.EnumName(EncryptionKey_Algorithm_name, int32(x))
 }
 func (EncryptionKey_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{42, 0}
+	return fileDescriptorTypes, []int{43, 0}
 }
 
 type MaybeEncryptedRecord_Algorithm int32
@@ -733,7 +777,7 @@ var MaybeEncryptedRecord_Algorithm_name = map[int32]string{
 	1: "SECRETBOX_SALSA20_POLY1305",
 }
 var MaybeEncryptedRecord_Algorithm_value = map[string]int32{
-	"NONE": 0,
+	"NONE":                       0,
 	"SECRETBOX_SALSA20_POLY1305": 1,
 }
 
@@ -741,7 +785,7 @@ func (x MaybeEncryptedRecord_Algorithm) String() string {
 	return proto.EnumName(MaybeEncryptedRecord_Algorithm_name, int32(x))
 }
 func (MaybeEncryptedRecord_Algorithm) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{49, 0}
+	return fileDescriptorTypes, []int{50, 0}
 }
 
 // Version tracks the last time an object in the store was updated.
@@ -1394,6 +1438,10 @@ type CAConfig struct {
 	// parameters have been in the spec. This will force the manager to generate a new
 	// certificate and key, if none have been provided.
 	ForceRotate uint64 `protobuf:"varint,5,opt,name=force_rotate,json=forceRotate,proto3" json:"force_rotate,omitempty"`
+	// JoinTokenHashAlgorithm is the digest algorithm used for the hash of the
+	// root CA certificate that is embedded in join tokens, either "sha256" or
+	// "sha512". If empty, "sha256" is used.
+	JoinTokenHashAlgorithm string `protobuf:"bytes,6,opt,name=join_token_hash_algorithm,json=joinTokenHashAlgorithm,proto3" json:"join_token_hash_algorithm,omitempty"`
 }
 
 func (m *CAConfig) Reset()                    { *m = CAConfig{} }
@@ -1610,6 +1658,16 @@ type RootCA struct {
 	// LastForcedRotation matches the Cluster Spec's CAConfig's ForceRotation counter.
 	// It indicates when the current CA cert and key were generated (or updated).
 	LastForcedRotation uint64 `protobuf:"varint,6,opt,name=last_forced_rotation,json=lastForcedRotation,proto3" json:"last_forced_rotation,omitempty"`
+	// QueuedRotations contains root rotations to perform, in order, once RootRotation has completed. Each
+	// one's cross-signed CA cert is signed by the root before it in the queue (or by RootRotation's root
+	// for the first one).
+	QueuedRotations []*RootRotation `protobuf:"bytes,7,rep,name=queued_rotations,json=queuedRotations" json:"queued_rotations,omitempty"`
+	// IntermediateCACert, if set, is a CA certificate issued by CACert.  Outside of a root rotation, node
+	// certificates are signed with it and IntermediateCAKey rather than with the root key, so that the
+	// signing key can be rotated without rotating the root.
+	IntermediateCACert []byte `protobuf:"bytes,8,opt,name=intermediate_ca_cert,json=intermediateCaCert,proto3" json:"intermediate_ca_cert,omitempty"`
+	// IntermediateCAKey is the private key for IntermediateCACert.
+	IntermediateCAKey []byte `protobuf:"bytes,9,opt,name=intermediate_ca_key,json=intermediateCaKey,proto3" json:"intermediate_ca_key,omitempty"`
 }
 
 func (m *RootCA) Reset()                    { *m = RootCA{} }
@@ -1623,12 +1681,45 @@ type Certificate struct {
 	Certificate []byte         `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
 	// CN represents the node ID.
 	CN string `protobuf:"bytes,5,opt,name=cn,proto3" json:"cn,omitempty"`
+	// LastIssued is the time at which a certificate was last successfully
+	// issued to the node, whether for a new node or a renewal.
+	LastIssued *google_protobuf.Timestamp `protobuf:"bytes,6,opt,name=last_issued,json=lastIssued" json:"last_issued,omitempty"`
+	// Attestation is the hardware attestation presented with the CSR, if
+	// any.
+	Attestation []byte `protobuf:"bytes,7,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// NotAfter is the expiry time of the issued certificate. It is recorded
+	// so that nodes with certificates about to expire can be found without
+	// parsing every certificate.
+	NotAfter *google_protobuf.Timestamp `protobuf:"bytes,8,opt,name=not_after,json=notAfter" json:"not_after,omitempty"`
+	// Serial is the serial number of the issued certificate, in lowercase
+	// hex. It is recorded so that a certificate seen elsewhere, such as in a
+	// log, can be traced back to its node.
+	Serial string `protobuf:"bytes,9,opt,name=serial,proto3" json:"serial,omitempty"`
+	// History records the most recent certificates issued to the node,
+	// oldest first.
+	History []*IssuanceRecord `protobuf:"bytes,10,rep,name=history" json:"history,omitempty"`
 }
 
 func (m *Certificate) Reset()                    { *m = Certificate{} }
 func (*Certificate) ProtoMessage()               {}
 func (*Certificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{41} }
 
+// IssuanceRecord describes a certificate issued to a node.
+type IssuanceRecord struct {
+	Timestamp *google_protobuf.Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	// Serial is the serial number of the certificate, in lowercase hex.
+	Serial string `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	// Issuer is the subject of the certificate that signed it.
+	Issuer string                `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Reason IssuanceRecord_Reason `protobuf:"varint,4,opt,name=reason,proto3,enum=docker.swarmkit.v1.IssuanceRecord_Reason" json:"reason,omitempty"`
+	// NotAfter is the expiry time of the certificate.
+	NotAfter *google_protobuf.Timestamp `protobuf:"bytes,5,opt,name=not_after,json=notAfter" json:"not_after,omitempty"`
+}
+
+func (m *IssuanceRecord) Reset()                    { *m = IssuanceRecord{} }
+func (*IssuanceRecord) ProtoMessage()               {}
+func (*IssuanceRecord) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
+
 // Symmetric keys to encrypt inter-agent communication.
 type EncryptionKey struct {
 	// Agent subsystem the key is intended for. Example:
@@ -1643,7 +1734,7 @@ type EncryptionKey struct {
 
 func (m *EncryptionKey) Reset()                    { *m = EncryptionKey{} }
 func (*EncryptionKey) ProtoMessage()               {}
-func (*EncryptionKey) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{42} }
+func (*EncryptionKey) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
 
 // ManagerStatus provides informations about the state of a manager in the cluster.
 type ManagerStatus struct {
@@ -1660,7 +1751,7 @@ type ManagerStatus struct {
 
 func (m *ManagerStatus) Reset()                    { *m = ManagerStatus{} }
 func (*ManagerStatus) ProtoMessage()               {}
-func (*ManagerStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{43} }
+func (*ManagerStatus) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
 
 // FileTarget represents a specific target that is backed by a file
 type FileTarget struct {
@@ -1676,7 +1767,7 @@ type FileTarget struct {
 
 func (m *FileTarget) Reset()                    { *m = FileTarget{} }
 func (*FileTarget) ProtoMessage()               {}
-func (*FileTarget) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{44} }
+func (*FileTarget) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
 
 // SecretReference is the linkage between a service and a secret that it uses.
 type SecretReference struct {
@@ -1696,7 +1787,7 @@ type SecretReference struct {
 
 func (m *SecretReference) Reset()                    { *m = SecretReference{} }
 func (*SecretReference) ProtoMessage()               {}
-func (*SecretReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{45} }
+func (*SecretReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
 
 type isSecretReference_Target interface {
 	isSecretReference_Target()
@@ -1796,7 +1887,7 @@ type ConfigReference struct {
 
 func (m *ConfigReference) Reset()                    { *m = ConfigReference{} }
 func (*ConfigReference) ProtoMessage()               {}
-func (*ConfigReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{46} }
+func (*ConfigReference) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
 
 type isConfigReference_Target interface {
 	isConfigReference_Target()
@@ -1890,7 +1981,7 @@ type BlacklistedCertificate struct {
 
 func (m *BlacklistedCertificate) Reset()                    { *m = BlacklistedCertificate{} }
 func (*BlacklistedCertificate) ProtoMessage()               {}
-func (*BlacklistedCertificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{47} }
+func (*BlacklistedCertificate) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
 
 // HealthConfig holds configuration settings for the HEALTHCHECK feature.
 type HealthConfig struct {
@@ -1920,7 +2011,7 @@ type HealthConfig struct {
 
 func (m *HealthConfig) Reset()                    { *m = HealthConfig{} }
 func (*HealthConfig) ProtoMessage()               {}
-func (*HealthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{48} }
+func (*HealthConfig) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
 
 type MaybeEncryptedRecord struct {
 	Algorithm MaybeEncryptedRecord_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=docker.swarmkit.v1.MaybeEncryptedRecord_Algorithm" json:"algorithm,omitempty"`
@@ -1930,7 +2021,7 @@ type MaybeEncryptedRecord struct {
 
 func (m *MaybeEncryptedRecord) Reset()                    { *m = MaybeEncryptedRecord{} }
 func (*MaybeEncryptedRecord) ProtoMessage()               {}
-func (*MaybeEncryptedRecord) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{49} }
+func (*MaybeEncryptedRecord) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
 
 type RootRotation struct {
 	CACert []byte `protobuf:"bytes,1,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
@@ -1941,7 +2032,21 @@ type RootRotation struct {
 
 func (m *RootRotation) Reset()                    { *m = RootRotation{} }
 func (*RootRotation) ProtoMessage()               {}
-func (*RootRotation) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{50} }
+func (*RootRotation) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
+
+// RootRotationCursor is the progress of a root rotation reconciliation loop.
+type RootRotationCursor struct {
+	// RotationDigest is the digest of the certificate of the root being
+	// rotated to.
+	RotationDigest string `protobuf:"bytes,1,opt,name=rotation_digest,json=rotationDigest,proto3" json:"rotation_digest,omitempty"`
+	// LastBatch is when the loop last told a batch of nodes to rotate their
+	// certificates.
+	LastBatch *google_protobuf.Timestamp `protobuf:"bytes,2,opt,name=last_batch,json=lastBatch" json:"last_batch,omitempty"`
+}
+
+func (m *RootRotationCursor) Reset()                    { *m = RootRotationCursor{} }
+func (*RootRotationCursor) ProtoMessage()               {}
+func (*RootRotationCursor) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{52} }
 
 // Privileges specifies security configuration/permissions.
 type Privileges struct {
@@ -1951,7 +2056,7 @@ type Privileges struct {
 
 func (m *Privileges) Reset()                    { *m = Privileges{} }
 func (*Privileges) ProtoMessage()               {}
-func (*Privileges) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{51} }
+func (*Privileges) Descriptor() ([]byte, []int) { return fileDescriptorTypes, []int{53} }
 
 // CredentialSpec for managed service account (Windows only).
 type Privileges_CredentialSpec struct {
@@ -1964,7 +2069,7 @@ type Privileges_CredentialSpec struct {
 func (m *Privileges_CredentialSpec) Reset()      { *m = Privileges_CredentialSpec{} }
 func (*Privileges_CredentialSpec) ProtoMessage() {}
 func (*Privileges_CredentialSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{51, 0}
+	return fileDescriptorTypes, []int{53, 0}
 }
 
 type isPrivileges_CredentialSpec_Source interface {
@@ -2082,7 +2187,7 @@ type Privileges_SELinuxContext struct {
 func (m *Privileges_SELinuxContext) Reset()      { *m = Privileges_SELinuxContext{} }
 func (*Privileges_SELinuxContext) ProtoMessage() {}
 func (*Privileges_SELinuxContext) Descriptor() ([]byte, []int) {
-	return fileDescriptorTypes, []int{51, 1}
+	return fileDescriptorTypes, []int{53, 1}
 }
 
 func init() {
@@ -2133,6 +2238,7 @@ func init() {
 	proto.RegisterType((*JoinTokens)(nil), "docker.swarmkit.v1.JoinTokens")
 	proto.RegisterType((*RootCA)(nil), "docker.swarmkit.v1.RootCA")
 	proto.RegisterType((*Certificate)(nil), "docker.swarmkit.v1.Certificate")
+	proto.RegisterType((*IssuanceRecord)(nil), "docker.swarmkit.v1.IssuanceRecord")
 	proto.RegisterType((*EncryptionKey)(nil), "docker.swarmkit.v1.EncryptionKey")
 	proto.RegisterType((*ManagerStatus)(nil), "docker.swarmkit.v1.ManagerStatus")
 	proto.RegisterType((*FileTarget)(nil), "docker.swarmkit.v1.FileTarget")
@@ -2142,6 +2248,7 @@ func init() {
 	proto.RegisterType((*HealthConfig)(nil), "docker.swarmkit.v1.HealthConfig")
 	proto.RegisterType((*MaybeEncryptedRecord)(nil), "docker.swarmkit.v1.MaybeEncryptedRecord")
 	proto.RegisterType((*RootRotation)(nil), "docker.swarmkit.v1.RootRotation")
+	proto.RegisterType((*RootRotationCursor)(nil), "docker.swarmkit.v1.RootRotationCursor")
 	proto.RegisterType((*Privileges)(nil), "docker.swarmkit.v1.Privileges")
 	proto.RegisterType((*Privileges_CredentialSpec)(nil), "docker.swarmkit.v1.Privileges.CredentialSpec")
 	proto.RegisterType((*Privileges_SELinuxContext)(nil), "docker.swarmkit.v1.Privileges.SELinuxContext")
@@ -2160,6 +2267,7 @@ func init() {
 	proto.RegisterEnum("docker.swarmkit.v1.PortConfig_PublishMode", PortConfig_PublishMode_name, PortConfig_PublishMode_value)
 	proto.RegisterEnum("docker.swarmkit.v1.IssuanceStatus_State", IssuanceStatus_State_name, IssuanceStatus_State_value)
 	proto.RegisterEnum("docker.swarmkit.v1.ExternalCA_CAProtocol", ExternalCA_CAProtocol_name, ExternalCA_CAProtocol_value)
+	proto.RegisterEnum("docker.swarmkit.v1.IssuanceRecord_Reason", IssuanceRecord_Reason_name, IssuanceRecord_Reason_value)
 	proto.RegisterEnum("docker.swarmkit.v1.EncryptionKey_Algorithm", EncryptionKey_Algorithm_name, EncryptionKey_Algorithm_value)
 	proto.RegisterEnum("docker.swarmkit.v1.MaybeEncryptedRecord_Algorithm", MaybeEncryptedRecord_Algorithm_name, MaybeEncryptedRecord_Algorithm_value)
 }
@@ -3119,6 +3227,22 @@ func (m *RootCA) CopyFrom(src interface{}) {
 		m.RootRotation = &RootRotation{}
 		github_com_docker_swarmkit_api_deepcopy.Copy(m.RootRotation, o.RootRotation)
 	}
+	if o.QueuedRotations != nil {
+		m.QueuedRotations = make([]*RootRotation, len(o.QueuedRotations))
+		for i := range m.QueuedRotations {
+			m.QueuedRotations[i] = &RootRotation{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.QueuedRotations[i], o.QueuedRotations[i])
+		}
+	}
+
+	if o.IntermediateCACert != nil {
+		m.IntermediateCACert = make([]byte, len(o.IntermediateCACert))
+		copy(m.IntermediateCACert, o.IntermediateCACert)
+	}
+	if o.IntermediateCAKey != nil {
+		m.IntermediateCAKey = make([]byte, len(o.IntermediateCAKey))
+		copy(m.IntermediateCAKey, o.IntermediateCAKey)
+	}
 }
 
 func (m *Certificate) Copy() *Certificate {
@@ -3143,6 +3267,49 @@ func (m *Certificate) CopyFrom(src interface{}) {
 		m.Certificate = make([]byte, len(o.Certificate))
 		copy(m.Certificate, o.Certificate)
 	}
+	if o.LastIssued != nil {
+		m.LastIssued = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.LastIssued, o.LastIssued)
+	}
+	if o.Attestation != nil {
+		m.Attestation = make([]byte, len(o.Attestation))
+		copy(m.Attestation, o.Attestation)
+	}
+	if o.NotAfter != nil {
+		m.NotAfter = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.NotAfter, o.NotAfter)
+	}
+	if o.History != nil {
+		m.History = make([]*IssuanceRecord, len(o.History))
+		for i := range m.History {
+			m.History[i] = &IssuanceRecord{}
+			github_com_docker_swarmkit_api_deepcopy.Copy(m.History[i], o.History[i])
+		}
+	}
+
+}
+
+func (m *IssuanceRecord) Copy() *IssuanceRecord {
+	if m == nil {
+		return nil
+	}
+	o := &IssuanceRecord{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *IssuanceRecord) CopyFrom(src interface{}) {
+
+	o := src.(*IssuanceRecord)
+	*m = *o
+	if o.Timestamp != nil {
+		m.Timestamp = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.Timestamp, o.Timestamp)
+	}
+	if o.NotAfter != nil {
+		m.NotAfter = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.NotAfter, o.NotAfter)
+	}
 }
 
 func (m *EncryptionKey) Copy() *EncryptionKey {
@@ -3347,6 +3514,25 @@ func (m *RootRotation) CopyFrom(src interface{}) {
 	}
 }
 
+func (m *RootRotationCursor) Copy() *RootRotationCursor {
+	if m == nil {
+		return nil
+	}
+	o := &RootRotationCursor{}
+	o.CopyFrom(m)
+	return o
+}
+
+func (m *RootRotationCursor) CopyFrom(src interface{}) {
+
+	o := src.(*RootRotationCursor)
+	*m = *o
+	if o.LastBatch != nil {
+		m.LastBatch = &google_protobuf.Timestamp{}
+		github_com_docker_swarmkit_api_deepcopy.Copy(m.LastBatch, o.LastBatch)
+	}
+}
+
 func (m *Privileges) Copy() *Privileges {
 	if m == nil {
 		return nil
@@ -4910,6 +5096,12 @@ func (m *CAConfig) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.ForceRotate))
 	}
+	if len(m.JoinTokenHashAlgorithm) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.JoinTokenHashAlgorithm)))
+		i += copy(dAtA[i:], m.JoinTokenHashAlgorithm)
+	}
 	return i, nil
 }
 
@@ -5257,6 +5449,30 @@ func (m *RootCA) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.LastForcedRotation))
 	}
+	if len(m.QueuedRotations) > 0 {
+		for _, msg := range m.QueuedRotations {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.IntermediateCACert) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IntermediateCACert)))
+		i += copy(dAtA[i:], m.IntermediateCACert)
+	}
+	if len(m.IntermediateCAKey) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IntermediateCAKey)))
+		i += copy(dAtA[i:], m.IntermediateCAKey)
+	}
 	return i, nil
 }
 
@@ -5306,6 +5522,105 @@ func (m *Certificate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.CN)))
 		i += copy(dAtA[i:], m.CN)
 	}
+	if m.LastIssued != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastIssued.Size()))
+		n32, err := m.LastIssued.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if len(m.Attestation) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Attestation)))
+		i += copy(dAtA[i:], m.Attestation)
+	}
+	if m.NotAfter != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.NotAfter.Size()))
+		n33, err := m.NotAfter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if len(m.Serial) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Serial)))
+		i += copy(dAtA[i:], m.Serial)
+	}
+	if len(m.History) > 0 {
+		for _, msg := range m.History {
+			dAtA[i] = 0x52
+			i++
+			i = encodeVarintTypes(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *IssuanceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssuanceRecord) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp.Size()))
+		n34, err := m.Timestamp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if len(m.Serial) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Serial)))
+		i += copy(dAtA[i:], m.Serial)
+	}
+	if len(m.Issuer) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Issuer)))
+		i += copy(dAtA[i:], m.Issuer)
+	}
+	if m.Reason != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+	}
+	if m.NotAfter != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.NotAfter.Size()))
+		n35, err := m.NotAfter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
 	return i, nil
 }
 
@@ -5462,11 +5777,11 @@ func (m *SecretReference) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.SecretName)
 	}
 	if m.Target != nil {
-		nn32, err := m.Target.MarshalTo(dAtA[i:])
+		nn36, err := m.Target.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn32
+		i += nn36
 	}
 	return i, nil
 }
@@ -5477,11 +5792,11 @@ func (m *SecretReference_File) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.File.Size()))
-		n33, err := m.File.MarshalTo(dAtA[i:])
+		n37, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n37
 	}
 	return i, nil
 }
@@ -5513,11 +5828,11 @@ func (m *ConfigReference) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.ConfigName)
 	}
 	if m.Target != nil {
-		nn34, err := m.Target.MarshalTo(dAtA[i:])
+		nn38, err := m.Target.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn34
+		i += nn38
 	}
 	return i, nil
 }
@@ -5528,11 +5843,11 @@ func (m *ConfigReference_File) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.File.Size()))
-		n35, err := m.File.MarshalTo(dAtA[i:])
+		n39, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n39
 	}
 	return i, nil
 }
@@ -5555,11 +5870,11 @@ func (m *BlacklistedCertificate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Expiry.Size()))
-		n36, err := m.Expiry.MarshalTo(dAtA[i:])
+		n40, err := m.Expiry.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n40
 	}
 	return i, nil
 }
@@ -5598,21 +5913,21 @@ func (m *HealthConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Interval.Size()))
-		n37, err := m.Interval.MarshalTo(dAtA[i:])
+		n41, err := m.Interval.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n41
 	}
 	if m.Timeout != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Timeout.Size()))
-		n38, err := m.Timeout.MarshalTo(dAtA[i:])
+		n42, err := m.Timeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n42
 	}
 	if m.Retries != 0 {
 		dAtA[i] = 0x20
@@ -5623,11 +5938,11 @@ func (m *HealthConfig) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.StartPeriod.Size()))
-		n39, err := m.StartPeriod.MarshalTo(dAtA[i:])
+		n43, err := m.StartPeriod.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n43
 	}
 	return i, nil
 }
@@ -5703,6 +6018,40 @@ func (m *RootRotation) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RootRotationCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RootRotationCursor) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RotationDigest) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RotationDigest)))
+		i += copy(dAtA[i:], m.RotationDigest)
+	}
+	if m.LastBatch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastBatch.Size()))
+		n44, err := m.LastBatch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	return i, nil
+}
+
 func (m *Privileges) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5722,21 +6071,21 @@ func (m *Privileges) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.CredentialSpec.Size()))
-		n40, err := m.CredentialSpec.MarshalTo(dAtA[i:])
+		n45, err := m.CredentialSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n45
 	}
 	if m.SELinuxContext != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.SELinuxContext.Size()))
-		n41, err := m.SELinuxContext.MarshalTo(dAtA[i:])
+		n46, err := m.SELinuxContext.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n46
 	}
 	return i, nil
 }
@@ -5757,11 +6106,11 @@ func (m *Privileges_CredentialSpec) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Source != nil {
-		nn42, err := m.Source.MarshalTo(dAtA[i:])
+		nn47, err := m.Source.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn42
+		i += nn47
 	}
 	return i, nil
 }
@@ -6516,6 +6865,10 @@ func (m *CAConfig) Size() (n int) {
 	if m.ForceRotate != 0 {
 		n += 1 + sovTypes(uint64(m.ForceRotate))
 	}
+	l = len(m.JoinTokenHashAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -6662,6 +7015,20 @@ func (m *RootCA) Size() (n int) {
 	if m.LastForcedRotation != 0 {
 		n += 1 + sovTypes(uint64(m.LastForcedRotation))
 	}
+	if len(m.QueuedRotations) > 0 {
+		for _, e := range m.QueuedRotations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.IntermediateCACert)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IntermediateCAKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -6685,6 +7052,53 @@ func (m *Certificate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.LastIssued != nil {
+		l = m.LastIssued.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NotAfter != nil {
+		l = m.NotAfter.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Serial)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IssuanceRecord) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Serial)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	if m.NotAfter != nil {
+		l = m.NotAfter.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -6872,6 +7286,20 @@ func (m *RootRotation) Size() (n int) {
 	return n
 }
 
+func (m *RootRotationCursor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RotationDigest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastBatch != nil {
+		l = m.LastBatch.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Privileges) Size() (n int) {
 	var l int
 	_ = l
@@ -7444,6 +7872,7 @@ func (this *CAConfig) String() string {
 		`SigningCACert:` + fmt.Sprintf("%v", this.SigningCACert) + `,`,
 		`SigningCAKey:` + fmt.Sprintf("%v", this.SigningCAKey) + `,`,
 		`ForceRotate:` + fmt.Sprintf("%v", this.ForceRotate) + `,`,
+		`JoinTokenHashAlgorithm:` + fmt.Sprintf("%v", this.JoinTokenHashAlgorithm) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7565,6 +7994,9 @@ func (this *RootCA) String() string {
 		`JoinTokens:` + strings.Replace(strings.Replace(this.JoinTokens.String(), "JoinTokens", "JoinTokens", 1), `&`, ``, 1) + `,`,
 		`RootRotation:` + strings.Replace(fmt.Sprintf("%v", this.RootRotation), "RootRotation", "RootRotation", 1) + `,`,
 		`LastForcedRotation:` + fmt.Sprintf("%v", this.LastForcedRotation) + `,`,
+		`QueuedRotations:` + strings.Replace(fmt.Sprintf("%v", this.QueuedRotations), "RootRotation", "RootRotation", 1) + `,`,
+		`IntermediateCACert:` + fmt.Sprintf("%v", this.IntermediateCACert) + `,`,
+		`IntermediateCAKey:` + fmt.Sprintf("%v", this.IntermediateCAKey) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7579,6 +8011,25 @@ func (this *Certificate) String() string {
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "IssuanceStatus", "IssuanceStatus", 1), `&`, ``, 1) + `,`,
 		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
 		`CN:` + fmt.Sprintf("%v", this.CN) + `,`,
+		`LastIssued:` + strings.Replace(fmt.Sprintf("%v", this.LastIssued), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
+		`Attestation:` + fmt.Sprintf("%v", this.Attestation) + `,`,
+		`NotAfter:` + strings.Replace(fmt.Sprintf("%v", this.NotAfter), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
+		`Serial:` + fmt.Sprintf("%v", this.Serial) + `,`,
+		`History:` + strings.Replace(fmt.Sprintf("%v", this.History), "IssuanceRecord", "IssuanceRecord", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IssuanceRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IssuanceRecord{`,
+		`Timestamp:` + strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
+		`Serial:` + fmt.Sprintf("%v", this.Serial) + `,`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`NotAfter:` + strings.Replace(fmt.Sprintf("%v", this.NotAfter), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7714,6 +8165,17 @@ func (this *RootRotation) String() string {
 	}, "")
 	return s
 }
+func (this *RootRotationCursor) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RootRotationCursor{`,
+		`RotationDigest:` + fmt.Sprintf("%v", this.RotationDigest) + `,`,
+		`LastBatch:` + strings.Replace(fmt.Sprintf("%v", this.LastBatch), "Timestamp", "google_protobuf.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Privileges) String() string {
 	if this == nil {
 		return "nil"
@@ -12964,14 +13426,43 @@ func (m *CAConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthTypes
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTokenHashAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinTokenHashAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -13874,11 +14365,436 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				m.CAKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACert = append(m.CACert[:0], dAtA[iNdEx:postIndex]...)
+			if m.CACert == nil {
+				m.CACert = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACertHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootRotation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RootRotation == nil {
+				m.RootRotation = &RootRotation{}
+			}
+			if err := m.RootRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastForcedRotation", wireType)
+			}
+			m.LastForcedRotation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastForcedRotation |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedRotations = append(m.QueuedRotations, &RootRotation{})
+			if err := m.QueuedRotations[len(m.QueuedRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateCACert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediateCACert = append(m.IntermediateCACert[:0], dAtA[iNdEx:postIndex]...)
+			if m.IntermediateCACert == nil {
+				m.IntermediateCACert = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateCAKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediateCAKey = append(m.IntermediateCAKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.IntermediateCAKey == nil {
+				m.IntermediateCAKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Certificate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Certificate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Certificate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= (NodeRole(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CSR", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CSR = append(m.CSR[:0], dAtA[iNdEx:postIndex]...)
+			if m.CSR == nil {
+				m.CSR = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIssued", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13888,28 +14804,30 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CACert = append(m.CACert[:0], dAtA[iNdEx:postIndex]...)
-			if m.CACert == nil {
-				m.CACert = []byte{}
+			if m.LastIssued == nil {
+				m.LastIssued = &google_protobuf.Timestamp{}
+			}
+			if err := m.LastIssued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACertHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13919,24 +14837,26 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CACertHash = string(dAtA[iNdEx:postIndex])
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13960,15 +14880,18 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.JoinTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NotAfter == nil {
+				m.NotAfter = &google_protobuf.Timestamp{}
+			}
+			if err := m.NotAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootRotation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Serial", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -13978,30 +14901,26 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RootRotation == nil {
-				m.RootRotation = &RootRotation{}
-			}
-			if err := m.RootRotation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Serial = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastForcedRotation", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			m.LastForcedRotation = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14011,11 +14930,23 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastForcedRotation |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &IssuanceRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14037,7 +14968,7 @@ func (m *RootCA) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Certificate) Unmarshal(dAtA []byte) error {
+func (m *IssuanceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14060,17 +14991,17 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Certificate: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssuanceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Certificate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssuanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Role = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14080,16 +15011,30 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= (NodeRole(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &google_protobuf.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CSR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Serial", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14099,28 +15044,26 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CSR = append(m.CSR[:0], dAtA[iNdEx:postIndex]...)
-			if m.CSR == nil {
-				m.CSR = []byte{}
-			}
+			m.Serial = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14130,27 +15073,26 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14160,28 +15102,16 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Reason |= (IssuanceRecord_Reason(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
-			if m.Certificate == nil {
-				m.Certificate = []byte{}
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -14191,20 +15121,24 @@ func (m *Certificate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CN = string(dAtA[iNdEx:postIndex])
+			if m.NotAfter == nil {
+				m.NotAfter = &google_protobuf.Timestamp{}
+			}
+			if err := m.NotAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15502,6 +16436,118 @@ func (m *RootRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RootRotationCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RootRotationCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RootRotationCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotationDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastBatch == nil {
+				m.LastBatch = &google_protobuf.Timestamp{}
+			}
+			if err := m.LastBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Privileges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16020,296 +17066,315 @@ var (
 func init() { proto.RegisterFile("types.proto", fileDescriptorTypes) }
 
 var fileDescriptorTypes = []byte{
-	// 4643 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x5a, 0x4d, 0x6c, 0x23, 0x47,
-	0x76, 0x16, 0x7f, 0x45, 0x3e, 0x52, 0x52, 0x4f, 0x8d, 0x76, 0x56, 0xc3, 0x1d, 0x4b, 0x74, 0xdb,
-	0xb3, 0xf6, 0x7a, 0x1d, 0x7a, 0x7e, 0x76, 0x17, 0x63, 0x3b, 0x6b, 0x9b, 0x7f, 0x1a, 0x71, 0x47,
-	0x22, 0x89, 0x22, 0x35, 0xb3, 0x3e, 0x24, 0x8d, 0x56, 0x77, 0x89, 0x6a, 0xab, 0xd9, 0xc5, 0x74,
-	0x17, 0xa5, 0x61, 0x7e, 0x90, 0x41, 0x0e, 0x49, 0xa0, 0x53, 0x72, 0x0b, 0x10, 0x28, 0x97, 0xe4,
-	0x14, 0xe4, 0x96, 0x43, 0x90, 0x5c, 0xe2, 0x00, 0x39, 0xf8, 0x96, 0x4d, 0x02, 0x04, 0x8b, 0x04,
-	0x50, 0x62, 0x1d, 0x72, 0x0b, 0x92, 0xcb, 0x22, 0x97, 0x04, 0x08, 0xea, 0xa7, 0x9b, 0x4d, 0x0d,
-	0x25, 0x8d, 0xe3, 0xbd, 0x48, 0x5d, 0xef, 0x7d, 0xef, 0xd5, 0xab, 0x57, 0xaf, 0xaa, 0xde, 0xab,
-	0x22, 0x14, 0xd8, 0x64, 0x44, 0x82, 0xca, 0xc8, 0xa7, 0x8c, 0x22, 0x64, 0x53, 0xeb, 0x90, 0xf8,
-	0x95, 0xe0, 0xd8, 0xf4, 0x87, 0x87, 0x0e, 0xab, 0x1c, 0xdd, 0x2f, 0x6d, 0x0c, 0x28, 0x1d, 0xb8,
-	0xe4, 0x3d, 0x81, 0xd8, 0x1b, 0xef, 0xbf, 0xc7, 0x9c, 0x21, 0x09, 0x98, 0x39, 0x1c, 0x49, 0xa1,
-	0xd2, 0xfa, 0x45, 0x80, 0x3d, 0xf6, 0x4d, 0xe6, 0x50, 0x4f, 0xf1, 0x57, 0x07, 0x74, 0x40, 0xc5,
-	0xe7, 0x7b, 0xfc, 0x4b, 0x52, 0xf5, 0x0d, 0x58, 0x7c, 0x4a, 0xfc, 0xc0, 0xa1, 0x1e, 0x5a, 0x85,
-	0x8c, 0xe3, 0xd9, 0xe4, 0xf9, 0x5a, 0xa2, 0x9c, 0x78, 0x3b, 0x8d, 0x65, 0x43, 0xbf, 0x07, 0xd0,
-	0xe2, 0x1f, 0x4d, 0x8f, 0xf9, 0x13, 0xa4, 0x41, 0xea, 0x90, 0x4c, 0x04, 0x22, 0x8f, 0xf9, 0x27,
-	0xa7, 0x1c, 0x99, 0xee, 0x5a, 0x52, 0x52, 0x8e, 0x4c, 0x57, 0xff, 0x32, 0x01, 0x85, 0xaa, 0xe7,
-	0x51, 0x26, 0x7a, 0x0f, 0x10, 0x82, 0xb4, 0x67, 0x0e, 0x89, 0x12, 0x12, 0xdf, 0xa8, 0x0e, 0x59,
-	0xd7, 0xdc, 0x23, 0x6e, 0xb0, 0x96, 0x2c, 0xa7, 0xde, 0x2e, 0x3c, 0xf8, 0x6e, 0xe5, 0xe5, 0x21,
-	0x57, 0x62, 0x4a, 0x2a, 0xdb, 0x02, 0x2d, 0x8c, 0xc0, 0x4a, 0x14, 0x7d, 0x04, 0x8b, 0x8e, 0x67,
-	0x3b, 0x16, 0x09, 0xd6, 0xd2, 0x42, 0xcb, 0xfa, 0x3c, 0x2d, 0x53, 0xeb, 0x6b, 0xe9, 0x2f, 0xce,
-	0x36, 0x16, 0x70, 0x28, 0x54, 0x7a, 0x1f, 0x0a, 0x31, 0xb5, 0x73, 0xc6, 0xb6, 0x0a, 0x99, 0x23,
-	0xd3, 0x1d, 0x13, 0x35, 0x3a, 0xd9, 0xf8, 0x20, 0xf9, 0x28, 0xa1, 0x7f, 0x0a, 0x79, 0x4c, 0x02,
-	0x3a, 0xf6, 0x2d, 0x12, 0xa0, 0xef, 0x40, 0xde, 0x33, 0x3d, 0x6a, 0x58, 0xa3, 0x71, 0x20, 0xc4,
-	0x53, 0xb5, 0xe2, 0xf9, 0xd9, 0x46, 0xae, 0x6d, 0x7a, 0xb4, 0xde, 0xdd, 0x0d, 0x70, 0x8e, 0xb3,
-	0xeb, 0xa3, 0x71, 0x80, 0x5e, 0x87, 0xe2, 0x90, 0x0c, 0xa9, 0x3f, 0x31, 0xf6, 0x26, 0x8c, 0x04,
-	0x42, 0x71, 0x0a, 0x17, 0x24, 0xad, 0xc6, 0x49, 0xfa, 0xef, 0x25, 0x60, 0x35, 0xd4, 0x8d, 0xc9,
-	0xaf, 0x8c, 0x1d, 0x9f, 0x0c, 0x89, 0xc7, 0x02, 0xf4, 0x7d, 0xc8, 0xba, 0xce, 0xd0, 0x61, 0xb2,
-	0x8f, 0xc2, 0x83, 0xd7, 0xe6, 0x8d, 0x36, 0xb2, 0x0a, 0x2b, 0x30, 0xaa, 0x42, 0xd1, 0x27, 0x01,
-	0xf1, 0x8f, 0xa4, 0x27, 0x45, 0x97, 0xd7, 0x0a, 0xcf, 0x88, 0xe8, 0x9b, 0x90, 0xeb, 0xba, 0x26,
-	0xdb, 0xa7, 0xfe, 0x10, 0xe9, 0x50, 0x34, 0x7d, 0xeb, 0xc0, 0x61, 0xc4, 0x62, 0x63, 0x3f, 0x9c,
-	0xd5, 0x19, 0x1a, 0xba, 0x05, 0x49, 0x2a, 0x3b, 0xca, 0xd7, 0xb2, 0xe7, 0x67, 0x1b, 0xc9, 0x4e,
-	0x0f, 0x27, 0x69, 0xa0, 0x7f, 0x08, 0x37, 0xba, 0xee, 0x78, 0xe0, 0x78, 0x0d, 0x12, 0x58, 0xbe,
-	0x33, 0xe2, 0xda, 0x79, 0x78, 0xf0, 0xd8, 0x0f, 0xc3, 0x83, 0x7f, 0x47, 0x21, 0x93, 0x9c, 0x86,
-	0x8c, 0xfe, 0x3b, 0x49, 0xb8, 0xd1, 0xf4, 0x06, 0x8e, 0x47, 0xe2, 0xd2, 0x77, 0x61, 0x99, 0x08,
-	0xa2, 0x71, 0x24, 0xc3, 0x58, 0xe9, 0x59, 0x92, 0xd4, 0x30, 0xb6, 0x5b, 0x17, 0xe2, 0xed, 0xfe,
-	0xbc, 0xe1, 0xbf, 0xa4, 0x7d, 0x6e, 0xd4, 0x35, 0x61, 0x71, 0x24, 0x06, 0x11, 0xac, 0xa5, 0x84,
-	0xae, 0xbb, 0xf3, 0x74, 0xbd, 0x34, 0xce, 0x30, 0xf8, 0x94, 0xec, 0xd7, 0x09, 0xbe, 0x3f, 0x4b,
-	0xc2, 0x4a, 0x9b, 0xda, 0x33, 0x7e, 0x28, 0x41, 0xee, 0x80, 0x06, 0x2c, 0xb6, 0xd0, 0xa2, 0x36,
-	0x7a, 0x04, 0xb9, 0x91, 0x9a, 0x3e, 0x35, 0xfb, 0x77, 0xe6, 0x9b, 0x2c, 0x31, 0x38, 0x42, 0xa3,
-	0x0f, 0x21, 0xef, 0x87, 0x31, 0xb1, 0x96, 0x7a, 0x95, 0xc0, 0x99, 0xe2, 0xd1, 0x0f, 0x21, 0x2b,
-	0x27, 0x61, 0x2d, 0x2d, 0x24, 0xef, 0xbe, 0x92, 0xcf, 0xb1, 0x12, 0x42, 0x8f, 0x21, 0xc7, 0xdc,
-	0xc0, 0x70, 0xbc, 0x7d, 0xba, 0x96, 0x11, 0x0a, 0x36, 0xe6, 0x29, 0xe0, 0x8e, 0xe8, 0x6f, 0xf7,
-	0x5a, 0xde, 0x3e, 0xad, 0x15, 0xce, 0xcf, 0x36, 0x16, 0x55, 0x03, 0x2f, 0x32, 0x37, 0xe0, 0x1f,
-	0xfa, 0xef, 0x27, 0xa0, 0x10, 0x43, 0xa1, 0xd7, 0x00, 0x98, 0x3f, 0x0e, 0x98, 0xe1, 0x53, 0xca,
-	0x84, 0xb3, 0x8a, 0x38, 0x2f, 0x28, 0x98, 0x52, 0x86, 0x2a, 0x70, 0xd3, 0x22, 0x3e, 0x33, 0x9c,
-	0x20, 0x18, 0x13, 0xdf, 0x08, 0xc6, 0x7b, 0x9f, 0x11, 0x8b, 0x09, 0xc7, 0x15, 0xf1, 0x0d, 0xce,
-	0x6a, 0x09, 0x4e, 0x4f, 0x32, 0xd0, 0x43, 0xb8, 0x15, 0xc7, 0x8f, 0xc6, 0x7b, 0xae, 0x63, 0x19,
-	0x7c, 0x32, 0x53, 0x42, 0xe4, 0xe6, 0x54, 0xa4, 0x2b, 0x78, 0x4f, 0xc8, 0x44, 0xff, 0x69, 0x02,
-	0x34, 0x6c, 0xee, 0xb3, 0x1d, 0x32, 0xdc, 0x23, 0x7e, 0x8f, 0x99, 0x6c, 0x1c, 0xa0, 0x5b, 0x90,
-	0x75, 0x89, 0x69, 0x13, 0x5f, 0x18, 0x95, 0xc3, 0xaa, 0x85, 0x76, 0xf9, 0x0a, 0x36, 0xad, 0x03,
-	0x73, 0xcf, 0x71, 0x1d, 0x36, 0x11, 0xa6, 0x2c, 0xcf, 0x0f, 0xe1, 0x8b, 0x3a, 0x2b, 0x38, 0x26,
-	0x88, 0x67, 0xd4, 0xa0, 0x35, 0x58, 0x1c, 0x92, 0x20, 0x30, 0x07, 0x44, 0x58, 0x9a, 0xc7, 0x61,
-	0x53, 0xff, 0x10, 0x8a, 0x71, 0x39, 0x54, 0x80, 0xc5, 0xdd, 0xf6, 0x93, 0x76, 0xe7, 0x59, 0x5b,
-	0x5b, 0x40, 0x2b, 0x50, 0xd8, 0x6d, 0xe3, 0x66, 0xb5, 0xbe, 0x55, 0xad, 0x6d, 0x37, 0xb5, 0x04,
-	0x5a, 0x82, 0xfc, 0xb4, 0x99, 0xd4, 0xff, 0x3c, 0x01, 0xc0, 0xdd, 0xad, 0x06, 0xf5, 0x01, 0x64,
-	0x02, 0x66, 0x32, 0x19, 0x95, 0xcb, 0x0f, 0xde, 0xbc, 0x6c, 0x0e, 0x95, 0xbd, 0xfc, 0x1f, 0xc1,
-	0x52, 0x24, 0x6e, 0x61, 0x72, 0xc6, 0x42, 0xbe, 0x41, 0x98, 0xb6, 0xed, 0x2b, 0xc3, 0xc5, 0xb7,
-	0xfe, 0x21, 0x64, 0x84, 0xf4, 0xac, 0xb9, 0x39, 0x48, 0x37, 0xf8, 0x57, 0x02, 0xe5, 0x21, 0x83,
-	0x9b, 0xd5, 0xc6, 0xa7, 0x5a, 0x12, 0x69, 0x50, 0x6c, 0xb4, 0x7a, 0xf5, 0x4e, 0xbb, 0xdd, 0xac,
-	0xf7, 0x9b, 0x0d, 0x2d, 0xa5, 0xdf, 0x85, 0x4c, 0x6b, 0xc8, 0x35, 0xdf, 0xe1, 0x21, 0xbf, 0x4f,
-	0x7c, 0xe2, 0x59, 0xe1, 0x4a, 0x9a, 0x12, 0xf4, 0x9f, 0xe4, 0x21, 0xb3, 0x43, 0xc7, 0x1e, 0x43,
-	0x0f, 0x62, 0xdb, 0xd6, 0xf2, 0xfc, 0x93, 0x47, 0x00, 0x2b, 0xfd, 0xc9, 0x88, 0xa8, 0x6d, 0xed,
-	0x16, 0x64, 0xe5, 0xe2, 0x50, 0xc3, 0x51, 0x2d, 0x4e, 0x67, 0xa6, 0x3f, 0x20, 0x4c, 0x8d, 0x47,
-	0xb5, 0xd0, 0xdb, 0x90, 0xf3, 0x89, 0x69, 0x53, 0xcf, 0x9d, 0x88, 0x35, 0x94, 0x93, 0xe7, 0x0a,
-	0x26, 0xa6, 0xdd, 0xf1, 0xdc, 0x09, 0x8e, 0xb8, 0x68, 0x0b, 0x8a, 0x7b, 0x8e, 0x67, 0x1b, 0x74,
-	0x24, 0x37, 0xf9, 0xcc, 0xe5, 0x2b, 0x4e, 0x5a, 0x55, 0x73, 0x3c, 0xbb, 0x23, 0xc1, 0xb8, 0xb0,
-	0x37, 0x6d, 0xa0, 0x36, 0x2c, 0x1f, 0x51, 0x77, 0x3c, 0x24, 0x91, 0xae, 0xac, 0xd0, 0xf5, 0xd6,
-	0xe5, 0xba, 0x9e, 0x0a, 0x7c, 0xa8, 0x6d, 0xe9, 0x28, 0xde, 0x44, 0x4f, 0x60, 0x89, 0x0d, 0x47,
-	0xfb, 0x41, 0xa4, 0x6e, 0x51, 0xa8, 0xfb, 0xf6, 0x15, 0x0e, 0xe3, 0xf0, 0x50, 0x5b, 0x91, 0xc5,
-	0x5a, 0xa5, 0xdf, 0x4a, 0x41, 0x21, 0x66, 0x39, 0xea, 0x41, 0x61, 0xe4, 0xd3, 0x91, 0x39, 0x10,
-	0x07, 0x95, 0x9a, 0x8b, 0xfb, 0xaf, 0x34, 0xea, 0x4a, 0x77, 0x2a, 0x88, 0xe3, 0x5a, 0xf4, 0xd3,
-	0x24, 0x14, 0x62, 0x4c, 0xf4, 0x0e, 0xe4, 0x70, 0x17, 0xb7, 0x9e, 0x56, 0xfb, 0x4d, 0x6d, 0xa1,
-	0x74, 0xe7, 0xe4, 0xb4, 0xbc, 0x26, 0xb4, 0xc5, 0x15, 0x74, 0x7d, 0xe7, 0x88, 0x87, 0xde, 0xdb,
-	0xb0, 0x18, 0x42, 0x13, 0xa5, 0x6f, 0x9d, 0x9c, 0x96, 0xbf, 0x79, 0x11, 0x1a, 0x43, 0xe2, 0xde,
-	0x56, 0x15, 0x37, 0x1b, 0x5a, 0x72, 0x3e, 0x12, 0xf7, 0x0e, 0x4c, 0x9f, 0xd8, 0xe8, 0xdb, 0x90,
-	0x55, 0xc0, 0x54, 0xa9, 0x74, 0x72, 0x5a, 0xbe, 0x75, 0x11, 0x38, 0xc5, 0xe1, 0xde, 0x76, 0xf5,
-	0x69, 0x53, 0x4b, 0xcf, 0xc7, 0xe1, 0x9e, 0x6b, 0x1e, 0x11, 0xf4, 0x26, 0x64, 0x24, 0x2c, 0x53,
-	0xba, 0x7d, 0x72, 0x5a, 0xfe, 0xc6, 0x4b, 0xea, 0x38, 0xaa, 0xb4, 0xf6, 0xbb, 0x7f, 0xbc, 0xbe,
-	0xf0, 0x57, 0x7f, 0xb2, 0xae, 0x5d, 0x64, 0x97, 0xfe, 0x27, 0x01, 0x4b, 0x33, 0x53, 0x8e, 0x74,
-	0xc8, 0x7a, 0xd4, 0xa2, 0x23, 0x79, 0x7e, 0xe5, 0x6a, 0x70, 0x7e, 0xb6, 0x91, 0x6d, 0xd3, 0x3a,
-	0x1d, 0x4d, 0xb0, 0xe2, 0xa0, 0x27, 0x17, 0x4e, 0xe0, 0x87, 0xaf, 0x18, 0x4f, 0x73, 0xcf, 0xe0,
-	0x8f, 0x61, 0xc9, 0xf6, 0x9d, 0x23, 0xe2, 0x1b, 0x16, 0xf5, 0xf6, 0x9d, 0x81, 0x3a, 0x9b, 0x4a,
-	0xf3, 0x74, 0x36, 0x04, 0x10, 0x17, 0xa5, 0x40, 0x5d, 0xe0, 0xbf, 0xc6, 0xe9, 0x5b, 0x7a, 0x0a,
-	0xc5, 0x78, 0x84, 0xf2, 0xe3, 0x24, 0x70, 0x7e, 0x95, 0xa8, 0x84, 0x4e, 0xa4, 0x7f, 0x38, 0xcf,
-	0x29, 0x22, 0x9d, 0x43, 0x6f, 0x41, 0x7a, 0x48, 0x6d, 0xa9, 0x67, 0xa9, 0x76, 0x93, 0x27, 0x01,
-	0xff, 0x7c, 0xb6, 0x51, 0xa0, 0x41, 0x65, 0xd3, 0x71, 0xc9, 0x0e, 0xb5, 0x09, 0x16, 0x00, 0xfd,
-	0x08, 0xd2, 0x7c, 0xab, 0x40, 0xdf, 0x82, 0x74, 0xad, 0xd5, 0x6e, 0x68, 0x0b, 0xa5, 0x1b, 0x27,
-	0xa7, 0xe5, 0x25, 0xe1, 0x12, 0xce, 0xe0, 0xb1, 0x8b, 0x36, 0x20, 0xfb, 0xb4, 0xb3, 0xbd, 0xbb,
-	0xc3, 0xc3, 0xeb, 0xe6, 0xc9, 0x69, 0x79, 0x25, 0x62, 0x4b, 0xa7, 0xa1, 0xd7, 0x20, 0xd3, 0xdf,
-	0xe9, 0x6e, 0xf6, 0xb4, 0x64, 0x09, 0x9d, 0x9c, 0x96, 0x97, 0x23, 0xbe, 0xb0, 0xb9, 0x74, 0x43,
-	0xcd, 0x6a, 0x3e, 0xa2, 0xeb, 0x3f, 0x4b, 0xc2, 0x12, 0xe6, 0x95, 0x84, 0xcf, 0xba, 0xd4, 0x75,
-	0xac, 0x09, 0xea, 0x42, 0xde, 0xa2, 0x9e, 0xed, 0xc4, 0xd6, 0xd4, 0x83, 0x4b, 0x4e, 0xfd, 0xa9,
-	0x54, 0xd8, 0xaa, 0x87, 0x92, 0x78, 0xaa, 0x04, 0xbd, 0x07, 0x19, 0x9b, 0xb8, 0xe6, 0x44, 0xa5,
-	0x1f, 0xb7, 0x2b, 0xb2, 0x56, 0xa9, 0x84, 0xb5, 0x4a, 0xa5, 0xa1, 0x6a, 0x15, 0x2c, 0x71, 0x22,
-	0x4f, 0x36, 0x9f, 0x1b, 0x26, 0x63, 0x64, 0x38, 0x62, 0x32, 0xf7, 0x48, 0xe3, 0xc2, 0xd0, 0x7c,
-	0x5e, 0x55, 0x24, 0x74, 0x1f, 0xb2, 0xc7, 0x8e, 0x67, 0xd3, 0x63, 0x95, 0x5e, 0x5c, 0xa1, 0x54,
-	0x01, 0xf5, 0x13, 0x7e, 0xea, 0x5e, 0x30, 0x93, 0xfb, 0xbb, 0xdd, 0x69, 0x37, 0x43, 0x7f, 0x2b,
-	0x7e, 0xc7, 0x6b, 0x53, 0x8f, 0xaf, 0x15, 0xe8, 0xb4, 0x8d, 0xcd, 0x6a, 0x6b, 0x7b, 0x17, 0x73,
-	0x9f, 0xaf, 0x9e, 0x9c, 0x96, 0xb5, 0x08, 0xb2, 0x69, 0x3a, 0x2e, 0xcf, 0x77, 0x6f, 0x43, 0xaa,
-	0xda, 0xfe, 0x54, 0x4b, 0x96, 0xb4, 0x93, 0xd3, 0x72, 0x31, 0x62, 0x57, 0xbd, 0xc9, 0x74, 0x19,
-	0x5d, 0xec, 0x57, 0xff, 0xbb, 0x14, 0x14, 0x77, 0x47, 0xb6, 0xc9, 0x88, 0x8c, 0x49, 0x54, 0x86,
-	0xc2, 0xc8, 0xf4, 0x4d, 0xd7, 0x25, 0xae, 0x13, 0x0c, 0x55, 0x15, 0x16, 0x27, 0xa1, 0xf7, 0x5f,
-	0xd5, 0x8d, 0xb5, 0x1c, 0x8f, 0xb3, 0x3f, 0xf8, 0xd7, 0x8d, 0x44, 0xe8, 0xd0, 0x5d, 0x58, 0xde,
-	0x97, 0xd6, 0x1a, 0xa6, 0x25, 0x26, 0x36, 0x25, 0x26, 0xb6, 0x32, 0x6f, 0x62, 0xe3, 0x66, 0x55,
-	0xd4, 0x20, 0xab, 0x42, 0x0a, 0x2f, 0xed, 0xc7, 0x9b, 0xe8, 0x21, 0x2c, 0x0e, 0xa9, 0xe7, 0x30,
-	0xea, 0x5f, 0x3f, 0x0b, 0x21, 0x12, 0xbd, 0x03, 0x37, 0xf8, 0xe4, 0x86, 0xf6, 0x08, 0xb6, 0x38,
-	0xb1, 0x92, 0x78, 0x65, 0x68, 0x3e, 0x57, 0x1d, 0x62, 0x4e, 0x46, 0x35, 0xc8, 0x50, 0x9f, 0xa7,
-	0x44, 0x59, 0x61, 0xee, 0xbb, 0xd7, 0x9a, 0x2b, 0x1b, 0x1d, 0x2e, 0x83, 0xa5, 0xa8, 0xfe, 0x03,
-	0x58, 0x9a, 0x19, 0x04, 0xcf, 0x04, 0xba, 0xd5, 0xdd, 0x5e, 0x53, 0x5b, 0x40, 0x45, 0xc8, 0xd5,
-	0x3b, 0xed, 0x7e, 0xab, 0xbd, 0xcb, 0x53, 0x99, 0x22, 0xe4, 0x70, 0x67, 0x7b, 0xbb, 0x56, 0xad,
-	0x3f, 0xd1, 0x92, 0x7a, 0x05, 0x0a, 0x31, 0x6d, 0x68, 0x19, 0xa0, 0xd7, 0xef, 0x74, 0x8d, 0xcd,
-	0x16, 0xee, 0xf5, 0x65, 0x22, 0xd4, 0xeb, 0x57, 0x71, 0x5f, 0x11, 0x12, 0xfa, 0x7f, 0x26, 0xc3,
-	0x19, 0x55, 0xb9, 0x4f, 0x6d, 0x36, 0xf7, 0xb9, 0xc2, 0x78, 0x95, 0xfd, 0x4c, 0x1b, 0x51, 0x0e,
-	0xf4, 0x3e, 0x80, 0x08, 0x1c, 0x62, 0x1b, 0x26, 0x53, 0x13, 0x5f, 0x7a, 0xc9, 0xc9, 0xfd, 0xf0,
-	0x32, 0x00, 0xe7, 0x15, 0xba, 0xca, 0xd0, 0x0f, 0xa1, 0x68, 0xd1, 0xe1, 0xc8, 0x25, 0x4a, 0x38,
-	0x75, 0xad, 0x70, 0x21, 0xc2, 0x57, 0x59, 0x3c, 0xfb, 0x4a, 0xcf, 0xe6, 0x87, 0xbf, 0x9d, 0x08,
-	0x3d, 0x33, 0x27, 0xe1, 0x2a, 0x42, 0x6e, 0xb7, 0xdb, 0xa8, 0xf6, 0x5b, 0xed, 0xc7, 0x5a, 0x02,
-	0x01, 0x64, 0x85, 0xab, 0x1b, 0x5a, 0x92, 0x27, 0x8a, 0xf5, 0xce, 0x4e, 0x77, 0xbb, 0x29, 0x52,
-	0x2e, 0xb4, 0x0a, 0x5a, 0xe8, 0x6c, 0x43, 0x38, 0xb2, 0xd9, 0xd0, 0xd2, 0xe8, 0x26, 0xac, 0x44,
-	0x54, 0x25, 0x99, 0x41, 0xb7, 0x00, 0x45, 0xc4, 0xa9, 0x8a, 0xac, 0xfe, 0x1b, 0xb0, 0x52, 0xa7,
-	0x1e, 0x33, 0x1d, 0x2f, 0x4a, 0xa2, 0x1f, 0xf0, 0x41, 0x2b, 0x92, 0xe1, 0xd8, 0x72, 0x4f, 0xaf,
-	0xad, 0x9c, 0x9f, 0x6d, 0x14, 0x22, 0x68, 0xab, 0xc1, 0x47, 0x1a, 0x36, 0x6c, 0xbe, 0x7e, 0x47,
-	0x8e, 0x2d, 0x9c, 0x9b, 0xa9, 0x2d, 0x9e, 0x9f, 0x6d, 0xa4, 0xba, 0xad, 0x06, 0xe6, 0x34, 0xf4,
-	0x2d, 0xc8, 0x93, 0xe7, 0x0e, 0x33, 0x2c, 0xbe, 0x87, 0x73, 0x07, 0x66, 0x70, 0x8e, 0x13, 0xea,
-	0x7c, 0xcb, 0xae, 0x01, 0x74, 0xa9, 0xcf, 0x54, 0xcf, 0xdf, 0x83, 0xcc, 0x88, 0xfa, 0xa2, 0x3c,
-	0xbf, 0xf4, 0x32, 0x82, 0xc3, 0x65, 0xa0, 0x62, 0x09, 0xd6, 0xff, 0x3a, 0x09, 0xd0, 0x37, 0x83,
-	0x43, 0xa5, 0xe4, 0x11, 0xe4, 0xa3, 0x8b, 0x1d, 0x55, 0xe7, 0x5f, 0x39, 0xdb, 0x11, 0x18, 0x3d,
-	0x0c, 0x83, 0x4d, 0x96, 0x07, 0x73, 0xeb, 0xb4, 0xb0, 0xa3, 0x79, 0x19, 0xf6, 0x6c, 0x0d, 0xc0,
-	0x8f, 0x44, 0xe2, 0xfb, 0x6a, 0xe6, 0xf9, 0x27, 0xaa, 0x8b, 0x63, 0x41, 0x3a, 0x4d, 0x25, 0x98,
-	0x6f, 0xcc, 0xeb, 0xe4, 0xc2, 0x8c, 0x6c, 0x2d, 0xe0, 0xa9, 0x1c, 0xfa, 0x18, 0x0a, 0x7c, 0xdc,
-	0x46, 0x20, 0x78, 0x2a, 0xb7, 0xbc, 0xd4, 0x55, 0x52, 0x03, 0x86, 0x51, 0xf4, 0x5d, 0xd3, 0x60,
-	0xd9, 0x1f, 0x7b, 0x7c, 0xd8, 0x4a, 0x87, 0xee, 0xc0, 0x37, 0xdb, 0x84, 0x1d, 0x53, 0xff, 0xb0,
-	0xca, 0x98, 0x69, 0x1d, 0x0c, 0x89, 0xa7, 0x7c, 0x1c, 0x4b, 0xac, 0x13, 0x33, 0x89, 0xf5, 0x1a,
-	0x2c, 0x9a, 0xae, 0x63, 0x06, 0x44, 0x66, 0x23, 0x79, 0x1c, 0x36, 0x79, 0xfa, 0xcf, 0x8b, 0x09,
-	0x12, 0x04, 0x44, 0xd6, 0xf7, 0x79, 0x3c, 0x25, 0xe8, 0xff, 0x98, 0x04, 0x68, 0x75, 0xab, 0x3b,
-	0x4a, 0x7d, 0x03, 0xb2, 0xfb, 0xe6, 0xd0, 0x71, 0x27, 0x57, 0x2d, 0xf0, 0x29, 0xbe, 0x52, 0x95,
-	0x8a, 0x36, 0x85, 0x0c, 0x56, 0xb2, 0xa2, 0x2a, 0x18, 0xef, 0x79, 0x84, 0x45, 0x55, 0x81, 0x68,
-	0xf1, 0x14, 0xc4, 0x37, 0xbd, 0x68, 0x66, 0x64, 0x83, 0x9b, 0x3e, 0x30, 0x19, 0x39, 0x36, 0x27,
-	0xe1, 0xaa, 0x54, 0x4d, 0xb4, 0xc5, 0xab, 0x85, 0x80, 0xf8, 0x47, 0xc4, 0x5e, 0xcb, 0x88, 0x10,
-	0xbc, 0xce, 0x1e, 0xac, 0xe0, 0x32, 0xb9, 0x8a, 0xa4, 0x4b, 0x1f, 0x8a, 0x8c, 0x60, 0xca, 0xfa,
-	0x4a, 0xb7, 0x13, 0xf7, 0x60, 0x69, 0x66, 0x9c, 0x2f, 0x95, 0x63, 0xad, 0xee, 0xd3, 0xef, 0x69,
-	0x69, 0xf5, 0xf5, 0x03, 0x2d, 0xab, 0xff, 0x69, 0x4a, 0xae, 0x23, 0xe5, 0xd5, 0xf9, 0xf7, 0x85,
-	0x39, 0x11, 0xfd, 0x16, 0x75, 0x55, 0x7c, 0xbf, 0x75, 0xf5, 0xf2, 0xe2, 0xe9, 0xbd, 0x80, 0xe3,
-	0x48, 0x10, 0x6d, 0x40, 0x41, 0xce, 0xbf, 0xc1, 0xe3, 0x49, 0xb8, 0x75, 0x09, 0x83, 0x24, 0x71,
-	0x49, 0x74, 0x17, 0x96, 0x45, 0xf9, 0x1e, 0x1c, 0x10, 0x5b, 0x62, 0xd2, 0x02, 0xb3, 0x14, 0x51,
-	0x05, 0x6c, 0x07, 0x8a, 0x8a, 0x60, 0x88, 0xd4, 0x2e, 0x23, 0x0c, 0x7a, 0xe7, 0x3a, 0x83, 0xa4,
-	0x88, 0xc8, 0xf8, 0x0a, 0xa3, 0x69, 0x43, 0x6f, 0x40, 0x2e, 0x34, 0x16, 0xad, 0x41, 0xaa, 0x5f,
-	0xef, 0x6a, 0x0b, 0xa5, 0x95, 0x93, 0xd3, 0x72, 0x21, 0x24, 0xf7, 0xeb, 0x5d, 0xce, 0xd9, 0x6d,
-	0x74, 0xb5, 0xc4, 0x2c, 0x67, 0xb7, 0xd1, 0x2d, 0xa5, 0x79, 0x8a, 0xa1, 0xef, 0x43, 0x21, 0xd6,
-	0x03, 0x7a, 0x03, 0x16, 0x5b, 0xed, 0xc7, 0xb8, 0xd9, 0xeb, 0x69, 0x0b, 0xa5, 0x5b, 0x27, 0xa7,
-	0x65, 0x14, 0xe3, 0xb6, 0xbc, 0x01, 0x9f, 0x1f, 0xf4, 0x1a, 0xa4, 0xb7, 0x3a, 0xfc, 0xe8, 0x92,
-	0xb9, 0x64, 0x0c, 0xb1, 0x45, 0x03, 0x56, 0xba, 0xa9, 0x72, 0x97, 0xb8, 0x62, 0xfd, 0x0f, 0x13,
-	0x90, 0x95, 0x29, 0xf5, 0xdc, 0x89, 0xaa, 0xc2, 0x62, 0x58, 0xe8, 0xc9, 0x3c, 0xff, 0xad, 0xcb,
-	0x73, 0xf2, 0x8a, 0x4a, 0xa1, 0x65, 0xf8, 0x85, 0x72, 0xa5, 0x0f, 0xa0, 0x18, 0x67, 0x7c, 0xa5,
-	0xe0, 0xfb, 0x35, 0x28, 0xf0, 0xf8, 0x0e, 0x73, 0xf3, 0x07, 0x90, 0x95, 0x69, 0x7f, 0xb4, 0x95,
-	0x5e, 0x5e, 0x20, 0x28, 0x24, 0x7a, 0x04, 0x8b, 0xb2, 0xa8, 0x08, 0xef, 0xf7, 0xd6, 0xaf, 0x5e,
-	0x45, 0x38, 0x84, 0xeb, 0x1f, 0x43, 0xba, 0x4b, 0x88, 0xcf, 0x7d, 0xef, 0x51, 0x9b, 0x4c, 0x4f,
-	0x1f, 0x55, 0x0f, 0xd9, 0xa4, 0xd5, 0xe0, 0xf5, 0x90, 0x4d, 0x5a, 0x76, 0x74, 0x83, 0x91, 0x8c,
-	0xdd, 0x60, 0xf4, 0xa1, 0xf8, 0x8c, 0x38, 0x83, 0x03, 0x46, 0x6c, 0xa1, 0xe8, 0x5d, 0x48, 0x8f,
-	0x48, 0x64, 0xfc, 0xda, 0xdc, 0x00, 0x23, 0xc4, 0xc7, 0x02, 0xc5, 0xf7, 0x91, 0x63, 0x21, 0xad,
-	0x6e, 0x95, 0x55, 0x4b, 0xff, 0x87, 0x24, 0x2c, 0xb7, 0x82, 0x60, 0x6c, 0x7a, 0x56, 0x98, 0x98,
-	0x7c, 0x34, 0x9b, 0x98, 0xbc, 0x3d, 0x77, 0x84, 0x33, 0x22, 0xb3, 0x17, 0x33, 0xea, 0x70, 0x48,
-	0x46, 0x87, 0x83, 0xfe, 0x1f, 0x89, 0xf0, 0xf6, 0xe5, 0x6e, 0x6c, 0xb9, 0x97, 0xd6, 0x4e, 0x4e,
-	0xcb, 0xab, 0x71, 0x4d, 0x64, 0xd7, 0x3b, 0xf4, 0xe8, 0xb1, 0x87, 0x5e, 0x87, 0x0c, 0x6e, 0xb6,
-	0x9b, 0xcf, 0xb4, 0x84, 0x0c, 0xcf, 0x19, 0x10, 0x26, 0x1e, 0x39, 0xe6, 0x9a, 0xba, 0xcd, 0x76,
-	0x83, 0x27, 0x12, 0xc9, 0x39, 0x9a, 0xba, 0xc4, 0xb3, 0x1d, 0x6f, 0x80, 0xde, 0x80, 0x6c, 0xab,
-	0xd7, 0xdb, 0x15, 0xf5, 0xf1, 0x37, 0x4f, 0x4e, 0xcb, 0x37, 0x67, 0x50, 0xe2, 0xe6, 0xcd, 0xe6,
-	0x20, 0x9e, 0xc5, 0xf3, 0x14, 0x63, 0x0e, 0x88, 0xa7, 0x87, 0x12, 0x84, 0x3b, 0x7d, 0x5e, 0xbc,
-	0x67, 0xe6, 0x80, 0x30, 0xe5, 0x7f, 0xd5, 0x72, 0xfb, 0x97, 0x24, 0x68, 0x55, 0xcb, 0x22, 0x23,
-	0xc6, 0xf9, 0xaa, 0x70, 0xea, 0x43, 0x6e, 0xc4, 0xbf, 0x1c, 0x12, 0x26, 0x01, 0x8f, 0xe6, 0xbe,
-	0x6b, 0x5c, 0x90, 0xab, 0x60, 0xea, 0x92, 0xaa, 0x3d, 0x74, 0x82, 0xc0, 0xa1, 0x9e, 0xa4, 0xe1,
-	0x48, 0x53, 0xe9, 0xbf, 0x12, 0x70, 0x73, 0x0e, 0x02, 0xdd, 0x83, 0xb4, 0x4f, 0xdd, 0x70, 0x0e,
-	0xef, 0x5c, 0x76, 0xb1, 0xc6, 0x45, 0xb1, 0x40, 0xa2, 0x75, 0x00, 0x73, 0xcc, 0xa8, 0x29, 0xfa,
-	0x17, 0xb3, 0x97, 0xc3, 0x31, 0x0a, 0x7a, 0x06, 0xd9, 0x80, 0x58, 0x3e, 0x09, 0x53, 0xc5, 0x8f,
-	0xff, 0xbf, 0xd6, 0x57, 0x7a, 0x42, 0x0d, 0x56, 0xea, 0x4a, 0x15, 0xc8, 0x4a, 0x0a, 0x0f, 0x7b,
-	0xdb, 0x64, 0xa6, 0xba, 0x76, 0x15, 0xdf, 0x3c, 0x9a, 0x4c, 0x77, 0x10, 0x46, 0x93, 0xe9, 0x0e,
-	0xf4, 0xbf, 0x4d, 0x02, 0x34, 0x9f, 0x33, 0xe2, 0x7b, 0xa6, 0x5b, 0xaf, 0xa2, 0x66, 0x6c, 0xf7,
-	0x97, 0xa3, 0xfd, 0xce, 0xdc, 0xbb, 0xe4, 0x48, 0xa2, 0x52, 0xaf, 0xce, 0xd9, 0xff, 0x6f, 0x43,
-	0x6a, 0xec, 0xab, 0xa7, 0x2a, 0x99, 0xe6, 0xed, 0xe2, 0x6d, 0xcc, 0x69, 0xa8, 0x39, 0xdd, 0xb6,
-	0x52, 0x97, 0x3f, 0x48, 0xc5, 0x3a, 0x98, 0xbb, 0x75, 0xf1, 0x95, 0x6f, 0x99, 0x86, 0x45, 0xd4,
-	0xc9, 0x51, 0x94, 0x2b, 0xbf, 0x5e, 0xad, 0x13, 0x9f, 0xe1, 0xac, 0x65, 0xf2, 0xff, 0x5f, 0x6b,
-	0x7f, 0x7b, 0x17, 0x60, 0x3a, 0x34, 0xb4, 0x0e, 0x99, 0xfa, 0x66, 0xaf, 0xb7, 0xad, 0x2d, 0xc8,
-	0x0d, 0x7c, 0xca, 0x12, 0x64, 0xfd, 0x2f, 0x93, 0x90, 0xab, 0x57, 0xd5, 0xb1, 0x5a, 0x07, 0x4d,
-	0xec, 0x4a, 0xe2, 0xb2, 0x9a, 0x3c, 0x1f, 0x39, 0xfe, 0x44, 0x6d, 0x2c, 0x57, 0xd4, 0x6c, 0xcb,
-	0x5c, 0x84, 0x5b, 0xdd, 0x14, 0x02, 0x08, 0x43, 0x91, 0x28, 0x27, 0x18, 0x96, 0x19, 0xee, 0xf1,
-	0xeb, 0x57, 0x3b, 0x4b, 0x66, 0xdf, 0xd3, 0x76, 0x80, 0x0b, 0xa1, 0x92, 0xba, 0x19, 0xa0, 0xf7,
-	0x61, 0x25, 0x70, 0x06, 0x9e, 0xe3, 0x0d, 0x8c, 0xd0, 0x79, 0xe2, 0xe6, 0xbc, 0x76, 0xe3, 0xfc,
-	0x6c, 0x63, 0xa9, 0x27, 0x59, 0xca, 0x87, 0x4b, 0x0a, 0x59, 0x17, 0xae, 0x44, 0x3f, 0x80, 0xe5,
-	0x98, 0x28, 0xf7, 0xa2, 0x74, 0xbb, 0x76, 0x7e, 0xb6, 0x51, 0x8c, 0x24, 0x9f, 0x90, 0x09, 0x2e,
-	0x46, 0x82, 0x4f, 0x88, 0xb8, 0x5e, 0xd8, 0xa7, 0xbe, 0x45, 0x0c, 0x5f, 0xac, 0x69, 0x71, 0x82,
-	0xa7, 0x71, 0x41, 0xd0, 0xe4, 0x32, 0xd7, 0x9f, 0xc2, 0xcd, 0x8e, 0x6f, 0x1d, 0x90, 0x80, 0x49,
-	0x57, 0x28, 0x2f, 0x7e, 0x0c, 0x77, 0x98, 0x19, 0x1c, 0x1a, 0x07, 0x4e, 0xc0, 0xa8, 0x3f, 0x31,
-	0x7c, 0xc2, 0x88, 0xc7, 0xf9, 0x86, 0x78, 0x6e, 0x53, 0xf7, 0x3f, 0xb7, 0x39, 0x66, 0x4b, 0x42,
-	0x70, 0x88, 0xd8, 0xe6, 0x00, 0xbd, 0x05, 0x45, 0x9e, 0x85, 0x37, 0xc8, 0xbe, 0x39, 0x76, 0x19,
-	0x1f, 0x3d, 0xb8, 0x74, 0x60, 0xbc, 0xf2, 0x31, 0x95, 0x77, 0xe9, 0x40, 0x7e, 0xea, 0x3f, 0x06,
-	0xad, 0xe1, 0x04, 0x23, 0x93, 0x59, 0x07, 0xe1, 0xc5, 0x16, 0x6a, 0x80, 0x76, 0x40, 0x4c, 0x9f,
-	0xed, 0x11, 0x93, 0x19, 0x23, 0xe2, 0x3b, 0xd4, 0xbe, 0x7e, 0x96, 0x57, 0x22, 0x91, 0xae, 0x90,
-	0xd0, 0xff, 0x3b, 0x01, 0x80, 0xcd, 0xfd, 0x30, 0x23, 0xfb, 0x2e, 0xdc, 0x08, 0x3c, 0x73, 0x14,
-	0x1c, 0x50, 0x66, 0x38, 0x1e, 0x23, 0xfe, 0x91, 0xe9, 0xaa, 0xfb, 0x09, 0x2d, 0x64, 0xb4, 0x14,
-	0x1d, 0xbd, 0x0b, 0xe8, 0x90, 0x90, 0x91, 0x41, 0x5d, 0xdb, 0x08, 0x99, 0xf2, 0x31, 0x30, 0x8d,
-	0x35, 0xce, 0xe9, 0xb8, 0x76, 0x2f, 0xa4, 0xa3, 0x1a, 0xac, 0xf3, 0xe1, 0x13, 0x8f, 0xf9, 0x0e,
-	0x09, 0x8c, 0x7d, 0xea, 0x1b, 0x81, 0x4b, 0x8f, 0x8d, 0x7d, 0xea, 0xba, 0xf4, 0x98, 0xf8, 0xe1,
-	0xd5, 0x4f, 0xc9, 0xa5, 0x83, 0xa6, 0x04, 0x6d, 0x52, 0xbf, 0xe7, 0xd2, 0xe3, 0xcd, 0x10, 0xc1,
-	0xd3, 0xb6, 0xe9, 0x98, 0x99, 0x63, 0x1d, 0x86, 0x69, 0x5b, 0x44, 0xed, 0x3b, 0xd6, 0x21, 0x7a,
-	0x03, 0x96, 0x88, 0x4b, 0xc4, 0x0d, 0x80, 0x44, 0x65, 0x04, 0xaa, 0x18, 0x12, 0x39, 0x48, 0xff,
-	0x04, 0xb4, 0xa6, 0x67, 0xf9, 0x93, 0x51, 0x6c, 0xce, 0xdf, 0x05, 0xc4, 0x37, 0x49, 0xc3, 0xa5,
-	0xd6, 0xa1, 0x31, 0x34, 0x3d, 0x73, 0xc0, 0xed, 0x92, 0x6f, 0x34, 0x1a, 0xe7, 0x6c, 0x53, 0xeb,
-	0x70, 0x47, 0xd1, 0xf5, 0xf7, 0x01, 0x7a, 0x23, 0x9f, 0x98, 0x76, 0x87, 0x67, 0x13, 0xdc, 0x75,
-	0xa2, 0x65, 0xd8, 0xea, 0x8d, 0x8b, 0xfa, 0x6a, 0xa9, 0x6b, 0x92, 0xd1, 0x88, 0xe8, 0xfa, 0x2f,
-	0xc1, 0xcd, 0xae, 0x6b, 0x5a, 0xe2, 0xbd, 0xb7, 0x1b, 0x3d, 0x3a, 0xa0, 0x47, 0x90, 0x95, 0x50,
-	0x35, 0x93, 0x73, 0x97, 0xdb, 0xb4, 0xcf, 0xad, 0x05, 0xac, 0xf0, 0xb5, 0x22, 0xc0, 0x54, 0x8f,
-	0xfe, 0x1c, 0xf2, 0x91, 0x7a, 0x54, 0x06, 0x5e, 0x02, 0xf3, 0xe8, 0x76, 0x3c, 0x55, 0xb3, 0xe6,
-	0x71, 0x9c, 0x84, 0x5a, 0x50, 0x18, 0x45, 0xc2, 0x57, 0xa6, 0x73, 0x73, 0x8c, 0xc6, 0x71, 0x59,
-	0xfd, 0x23, 0x80, 0x1f, 0x51, 0xc7, 0xeb, 0xd3, 0x43, 0xe2, 0x89, 0x77, 0x2e, 0x5e, 0xad, 0x91,
-	0xd0, 0x11, 0xaa, 0x25, 0x8a, 0x51, 0xe9, 0xc5, 0xe8, 0xb9, 0x47, 0x36, 0xf5, 0xbf, 0x49, 0x42,
-	0x16, 0x53, 0xca, 0xea, 0x55, 0x54, 0x86, 0xac, 0x5a, 0xea, 0xe2, 0x08, 0xa9, 0xe5, 0xcf, 0xcf,
-	0x36, 0x32, 0x72, 0x8d, 0x67, 0x2c, 0xb1, 0xb8, 0x63, 0x9b, 0x70, 0xf2, 0xb2, 0x4d, 0x18, 0xdd,
-	0x83, 0xa2, 0x02, 0x19, 0x07, 0x66, 0x70, 0x20, 0x6b, 0xac, 0xda, 0xf2, 0xf9, 0xd9, 0x06, 0x48,
-	0xe4, 0x96, 0x19, 0x1c, 0x60, 0x90, 0x68, 0xfe, 0x8d, 0x9a, 0x50, 0xf8, 0x8c, 0x3a, 0x9e, 0xc1,
-	0xc4, 0x20, 0xd4, 0x75, 0xd7, 0xdc, 0xa9, 0x98, 0x0e, 0x55, 0x3d, 0xfa, 0xc2, 0x67, 0xd3, 0xc1,
-	0x37, 0x61, 0xc9, 0xa7, 0x94, 0xc9, 0x9d, 0xc7, 0xa1, 0x9e, 0xaa, 0xa4, 0xcb, 0x73, 0x2f, 0x58,
-	0x29, 0x65, 0x58, 0xe1, 0x70, 0xd1, 0x8f, 0xb5, 0xd0, 0x3d, 0x58, 0x75, 0xcd, 0x80, 0x19, 0x62,
-	0xcb, 0xb2, 0xa7, 0xda, 0xb2, 0x62, 0xb5, 0x20, 0xce, 0xdb, 0x14, 0xac, 0x50, 0x42, 0xff, 0xa7,
-	0x04, 0x14, 0xf8, 0x60, 0x9c, 0x7d, 0xc7, 0xe2, 0x79, 0xda, 0x57, 0x4f, 0x1f, 0x6e, 0x43, 0xca,
-	0x0a, 0x7c, 0xe5, 0x54, 0x71, 0x7e, 0xd6, 0x7b, 0x18, 0x73, 0x1a, 0xfa, 0x04, 0xb2, 0xaa, 0xa2,
-	0x97, 0x99, 0x83, 0x7e, 0x7d, 0x46, 0xa9, 0x7c, 0xa3, 0xe4, 0x44, 0x3c, 0x4e, 0xad, 0x93, 0xfb,
-	0x38, 0x8e, 0x93, 0xd0, 0x2d, 0x48, 0x5a, 0xd2, 0x5d, 0xea, 0x57, 0x05, 0xf5, 0x36, 0x4e, 0x5a,
-	0x9e, 0xfe, 0xf7, 0x09, 0x58, 0x9a, 0xae, 0x59, 0x1e, 0x01, 0x77, 0x20, 0x1f, 0x8c, 0xf7, 0x82,
-	0x49, 0xc0, 0xc8, 0x30, 0x7c, 0xc3, 0x8b, 0x08, 0xa8, 0x05, 0x79, 0xd3, 0x1d, 0x50, 0xdf, 0x61,
-	0x07, 0x43, 0x55, 0x4c, 0xce, 0x3f, 0xed, 0xe3, 0x3a, 0x2b, 0xd5, 0x50, 0x04, 0x4f, 0xa5, 0xc3,
-	0xa3, 0x5b, 0x3e, 0xf4, 0x8a, 0xa3, 0xfb, 0x75, 0x28, 0xba, 0xe6, 0x50, 0x5c, 0x71, 0x30, 0x67,
-	0x28, 0xc7, 0x91, 0xc6, 0x05, 0x45, 0xeb, 0x3b, 0x43, 0xa2, 0xeb, 0x90, 0x8f, 0x94, 0xa1, 0x15,
-	0x28, 0x54, 0x9b, 0x3d, 0xe3, 0xfe, 0x83, 0x47, 0xc6, 0xe3, 0xfa, 0x8e, 0xb6, 0xa0, 0xd2, 0xcb,
-	0xbf, 0x48, 0xc0, 0x92, 0xda, 0x51, 0x54, 0xca, 0xfe, 0x06, 0x2c, 0xfa, 0xe6, 0x3e, 0x0b, 0x8b,
-	0x8a, 0xb4, 0x8c, 0x6a, 0xbe, 0x49, 0xf3, 0xa2, 0x82, 0xb3, 0xe6, 0x17, 0x15, 0xb1, 0x57, 0xe5,
-	0xd4, 0x95, 0xaf, 0xca, 0xe9, 0x9f, 0xcb, 0xab, 0xb2, 0xfe, 0x9b, 0x00, 0x9b, 0x8e, 0x4b, 0xfa,
-	0xf2, 0xa2, 0x65, 0x5e, 0x89, 0xc8, 0xd3, 0x30, 0x75, 0xdb, 0x16, 0xa6, 0x61, 0xad, 0x06, 0xe6,
-	0x34, 0xce, 0x1a, 0x38, 0xb6, 0x5a, 0x8c, 0x82, 0xf5, 0x98, 0xb3, 0x06, 0x8e, 0x1d, 0xbd, 0xa3,
-	0xa4, 0xaf, 0x7b, 0x47, 0x39, 0x4d, 0xc0, 0x8a, 0x4a, 0x3f, 0xa3, 0x1d, 0xf4, 0x3b, 0x90, 0x97,
-	0x99, 0xe8, 0xb4, 0x26, 0x13, 0x2f, 0xa9, 0x12, 0xd7, 0x6a, 0xe0, 0x9c, 0x64, 0xb7, 0x6c, 0xb4,
-	0x01, 0x05, 0x05, 0x8d, 0xfd, 0x02, 0x05, 0x24, 0xa9, 0xcd, 0xcd, 0xff, 0x1e, 0xa4, 0xf7, 0x1d,
-	0x97, 0xa8, 0x40, 0x9f, 0xbb, 0x01, 0x4c, 0x1d, 0xb0, 0xb5, 0x80, 0x05, 0xba, 0x96, 0x0b, 0x6f,
-	0xa2, 0x84, 0x7d, 0xaa, 0x72, 0x8c, 0xdb, 0x27, 0x8b, 0xc8, 0x0b, 0xf6, 0x49, 0x1c, 0xb7, 0x4f,
-	0xb2, 0xa5, 0x7d, 0x0a, 0x1a, 0xb7, 0x4f, 0x92, 0x7e, 0x2e, 0xf6, 0x6d, 0xc3, 0xad, 0x9a, 0x6b,
-	0x5a, 0x87, 0xae, 0x13, 0x30, 0x62, 0xc7, 0x77, 0x8c, 0x07, 0x90, 0x9d, 0xc9, 0x1b, 0xaf, 0xba,
-	0x98, 0x54, 0x48, 0xfd, 0xdf, 0x13, 0x50, 0xdc, 0x22, 0xa6, 0xcb, 0x0e, 0xa6, 0xb7, 0x3b, 0x8c,
-	0x04, 0x4c, 0x1d, 0x38, 0xe2, 0x1b, 0x7d, 0x1f, 0x72, 0x51, 0x5a, 0x71, 0xed, 0x0b, 0x51, 0x04,
-	0x45, 0x0f, 0x61, 0x91, 0xaf, 0x31, 0x3a, 0x0e, 0xeb, 0x95, 0xab, 0x1e, 0x1f, 0x14, 0x92, 0x1f,
-	0x32, 0x3e, 0x11, 0x79, 0x84, 0x08, 0xa5, 0x0c, 0x0e, 0x9b, 0xe8, 0x17, 0xa1, 0x28, 0xee, 0xce,
-	0xc3, 0xb4, 0x29, 0x73, 0x9d, 0xce, 0x82, 0x7c, 0xfe, 0x92, 0x29, 0xd3, 0xff, 0x26, 0x60, 0x75,
-	0xc7, 0x9c, 0xec, 0x11, 0xb5, 0x6d, 0x10, 0x1b, 0x13, 0x8b, 0xfa, 0x36, 0xea, 0xc6, 0xb7, 0x9b,
-	0x2b, 0x5e, 0xd3, 0xe6, 0x09, 0xcf, 0xdf, 0x75, 0xc2, 0x1a, 0x2a, 0x19, 0xab, 0xa1, 0x56, 0x21,
-	0xe3, 0x51, 0xcf, 0x22, 0x6a, 0x2f, 0x92, 0x0d, 0xdd, 0x89, 0x6f, 0x35, 0xa5, 0xe8, 0xa1, 0x4b,
-	0x3c, 0x53, 0xb5, 0x29, 0x8b, 0x7a, 0x43, 0x9f, 0x40, 0xa9, 0xd7, 0xac, 0xe3, 0x66, 0xbf, 0xd6,
-	0xf9, 0xb1, 0xd1, 0xab, 0x6e, 0xf7, 0xaa, 0x0f, 0xee, 0x19, 0xdd, 0xce, 0xf6, 0xa7, 0xf7, 0x1f,
-	0xde, 0xfb, 0xbe, 0x96, 0x28, 0x95, 0x4f, 0x4e, 0xcb, 0x77, 0xda, 0xd5, 0xfa, 0xb6, 0x5c, 0x31,
-	0x7b, 0xf4, 0x79, 0xcf, 0x74, 0x03, 0xf3, 0xc1, 0xbd, 0x2e, 0x75, 0x27, 0x1c, 0xc3, 0xc3, 0xba,
-	0x18, 0x3f, 0xaf, 0xe2, 0xc7, 0x70, 0xe2, 0xd2, 0x63, 0x78, 0x7a, 0x9a, 0x27, 0x2f, 0x39, 0xcd,
-	0x37, 0x61, 0xd5, 0xf2, 0x69, 0x10, 0x18, 0x3c, 0x81, 0x27, 0xf6, 0x85, 0x12, 0xe1, 0x1b, 0xe7,
-	0x67, 0x1b, 0x37, 0xea, 0x9c, 0xdf, 0x13, 0x6c, 0xa5, 0xfe, 0x86, 0x15, 0x23, 0x89, 0x9e, 0xf4,
-	0x3f, 0x4a, 0xf1, 0x5c, 0xc8, 0x39, 0x72, 0x5c, 0x32, 0x20, 0x01, 0x7a, 0x0a, 0x2b, 0x96, 0x4f,
-	0x6c, 0x9e, 0x99, 0x9b, 0xae, 0x11, 0x8c, 0x88, 0xa5, 0x82, 0xfa, 0x17, 0xe6, 0x26, 0x38, 0x91,
-	0x60, 0xa5, 0x1e, 0x49, 0xf5, 0x46, 0xc4, 0xc2, 0xcb, 0xd6, 0x4c, 0x1b, 0x7d, 0x06, 0x2b, 0x01,
-	0x71, 0x1d, 0x6f, 0xfc, 0xdc, 0xb0, 0xa8, 0xc7, 0xc8, 0xf3, 0xf0, 0xcd, 0xe6, 0x3a, 0xbd, 0xbd,
-	0xe6, 0x36, 0x97, 0xaa, 0x4b, 0xa1, 0x1a, 0x3a, 0x3f, 0xdb, 0x58, 0x9e, 0xa5, 0xe1, 0x65, 0xa5,
-	0x59, 0xb5, 0x4b, 0x6d, 0x58, 0x9e, 0xb5, 0x06, 0xad, 0xaa, 0xb5, 0x2f, 0xb6, 0x90, 0x70, 0x6d,
-	0xa3, 0x3b, 0x90, 0xf3, 0xc9, 0xc0, 0x09, 0x98, 0x2f, 0xdd, 0xcc, 0x39, 0x11, 0x85, 0xaf, 0x7c,
-	0xf9, 0x33, 0x94, 0xd2, 0xaf, 0xc3, 0x85, 0x1e, 0xf9, 0x62, 0xb1, 0x9d, 0xc0, 0xdc, 0x53, 0x2a,
-	0x73, 0x38, 0x6c, 0xf2, 0x18, 0x1c, 0x07, 0x51, 0xa2, 0x26, 0xbe, 0x39, 0x4d, 0x64, 0x14, 0xea,
-	0x47, 0x39, 0x22, 0x67, 0x08, 0x7f, 0xdd, 0x97, 0x8e, 0xfd, 0xba, 0x6f, 0x15, 0x32, 0x2e, 0x39,
-	0x22, 0xae, 0x3c, 0xcb, 0xb1, 0x6c, 0xbc, 0xf3, 0xb3, 0x14, 0xe4, 0xa3, 0xf7, 0x09, 0x7e, 0x12,
-	0xb4, 0x9b, 0xcf, 0xc2, 0x58, 0x8d, 0xe8, 0x6d, 0x72, 0x8c, 0x5e, 0x9f, 0x5e, 0x0b, 0x7d, 0x22,
-	0x1f, 0x64, 0x23, 0x76, 0x78, 0x25, 0xf4, 0x26, 0xe4, 0xaa, 0xbd, 0x5e, 0xeb, 0x71, 0xbb, 0xd9,
-	0xd0, 0x3e, 0x4f, 0x94, 0xbe, 0x71, 0x72, 0x5a, 0xbe, 0x11, 0x81, 0xaa, 0x81, 0x0c, 0x25, 0x81,
-	0xaa, 0xd7, 0x9b, 0xdd, 0x7e, 0xb3, 0xa1, 0xbd, 0x48, 0x5e, 0x44, 0x89, 0x6b, 0x0e, 0xf1, 0xb3,
-	0x8a, 0x7c, 0x17, 0x37, 0xbb, 0x55, 0xcc, 0x3b, 0xfc, 0x3c, 0x29, 0x6f, 0xab, 0xa6, 0x3d, 0xfa,
-	0x64, 0x64, 0xfa, 0xbc, 0xcf, 0xf5, 0xf0, 0xe7, 0x45, 0x2f, 0x52, 0xf2, 0xe9, 0x7d, 0xfa, 0xd8,
-	0x42, 0x4c, 0x7b, 0xc2, 0x7b, 0x13, 0xaf, 0x5c, 0x42, 0x4d, 0xea, 0x42, 0x6f, 0x3d, 0xbe, 0x93,
-	0x70, 0x2d, 0x3a, 0x2c, 0xe2, 0xdd, 0x76, 0x9b, 0x83, 0x5e, 0xa4, 0x2f, 0x8c, 0x0e, 0x8f, 0x3d,
-	0x5e, 0xc2, 0xa2, 0xbb, 0x90, 0x0b, 0x1f, 0xc1, 0xb4, 0xcf, 0xd3, 0x17, 0x0c, 0xaa, 0x87, 0x2f,
-	0x78, 0xa2, 0xc3, 0xad, 0xdd, 0xbe, 0xf8, 0xf5, 0xd3, 0x8b, 0xcc, 0xc5, 0x0e, 0x0f, 0xc6, 0xcc,
-	0xa6, 0xc7, 0x1e, 0x5f, 0x81, 0xea, 0x62, 0xec, 0xf3, 0x8c, 0xbc, 0x45, 0x88, 0x30, 0xea, 0x56,
-	0xec, 0x4d, 0xc8, 0xe1, 0xe6, 0x8f, 0xe4, 0x0f, 0xa5, 0x5e, 0x64, 0x2f, 0xe8, 0xc1, 0xe4, 0x33,
-	0x62, 0xa9, 0xde, 0x3a, 0xb8, 0xbb, 0x55, 0x15, 0x2e, 0xbf, 0x88, 0xea, 0xf8, 0xa3, 0x03, 0xd3,
-	0x23, 0xf6, 0xf4, 0xf7, 0x07, 0x11, 0xeb, 0x9d, 0x5f, 0x86, 0x5c, 0x98, 0x67, 0xa2, 0x75, 0xc8,
-	0x3e, 0xeb, 0xe0, 0x27, 0x4d, 0xac, 0x2d, 0x48, 0x1f, 0x86, 0x9c, 0x67, 0xb2, 0x42, 0x28, 0xc3,
-	0xe2, 0x4e, 0xb5, 0x5d, 0x7d, 0xdc, 0xc4, 0xe1, 0x9d, 0x75, 0x08, 0x50, 0xc9, 0x52, 0x49, 0x53,
-	0x1d, 0x44, 0x3a, 0x6b, 0x6b, 0x5f, 0x7c, 0xb9, 0xbe, 0xf0, 0xd3, 0x2f, 0xd7, 0x17, 0x5e, 0x9c,
-	0xaf, 0x27, 0xbe, 0x38, 0x5f, 0x4f, 0xfc, 0xe4, 0x7c, 0x3d, 0xf1, 0x6f, 0xe7, 0xeb, 0x89, 0xbd,
-	0xac, 0xd8, 0xd2, 0x1f, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xf3, 0xe4, 0x8f, 0x7c,
-	0x2d, 0x00, 0x00,
+	// 4958 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7a, 0x4d, 0x6c, 0x23, 0xc9,
+	0x75, 0xbf, 0xf8, 0x29, 0xf2, 0x91, 0x92, 0x5a, 0x35, 0xf2, 0x98, 0x43, 0xcf, 0x4a, 0x74, 0xef,
+	0xae, 0x77, 0xbd, 0xde, 0x3f, 0x77, 0x76, 0xc6, 0xf6, 0x7f, 0xc6, 0x63, 0x7b, 0x97, 0x5f, 0x1a,
+	0xd1, 0x23, 0x91, 0x44, 0x91, 0x9a, 0xf1, 0x1e, 0x92, 0x46, 0xab, 0xbb, 0x44, 0xf5, 0xaa, 0xd9,
+	0x4d, 0x77, 0x37, 0xf5, 0x91, 0x0f, 0x64, 0xe0, 0x43, 0x12, 0xe8, 0x94, 0xdc, 0x02, 0x04, 0x4a,
+	0x0e, 0xc9, 0x29, 0xc8, 0x2d, 0x87, 0x00, 0xb9, 0x64, 0x0f, 0x3e, 0xf8, 0x16, 0x27, 0xb9, 0x18,
+	0x09, 0xa0, 0xc4, 0x3a, 0xe4, 0x16, 0x24, 0x87, 0x18, 0x39, 0x24, 0x01, 0x82, 0x57, 0x55, 0xdd,
+	0x6c, 0x6a, 0xa8, 0xd1, 0x38, 0xeb, 0x8b, 0xc4, 0x7a, 0xef, 0xf7, 0x5e, 0x55, 0xbd, 0xaa, 0x7a,
+	0xf5, 0xde, 0xab, 0x86, 0x42, 0x70, 0x3a, 0x66, 0x7e, 0x75, 0xec, 0xb9, 0x81, 0x4b, 0x88, 0xe9,
+	0x1a, 0x87, 0xcc, 0xab, 0xfa, 0xc7, 0xba, 0x37, 0x3a, 0xb4, 0x82, 0xea, 0xd1, 0x87, 0xe5, 0x8d,
+	0xa1, 0xeb, 0x0e, 0x6d, 0xf6, 0x01, 0x47, 0xec, 0x4d, 0xf6, 0x3f, 0x08, 0xac, 0x11, 0xf3, 0x03,
+	0x7d, 0x34, 0x16, 0x42, 0xe5, 0xf5, 0xab, 0x00, 0x73, 0xe2, 0xe9, 0x81, 0xe5, 0x3a, 0x92, 0xbf,
+	0x36, 0x74, 0x87, 0x2e, 0xff, 0xf9, 0x01, 0xfe, 0x12, 0x54, 0x75, 0x03, 0x16, 0x9f, 0x31, 0xcf,
+	0xb7, 0x5c, 0x87, 0xac, 0x41, 0xc6, 0x72, 0x4c, 0x76, 0x52, 0x4a, 0x54, 0x12, 0xef, 0xa6, 0xa9,
+	0x68, 0xa8, 0xf7, 0x00, 0xda, 0xf8, 0xa3, 0xe5, 0x04, 0xde, 0x29, 0x51, 0x20, 0x75, 0xc8, 0x4e,
+	0x39, 0x22, 0x4f, 0xf1, 0x27, 0x52, 0x8e, 0x74, 0xbb, 0x94, 0x14, 0x94, 0x23, 0xdd, 0x56, 0x7f,
+	0x96, 0x80, 0x42, 0xcd, 0x71, 0xdc, 0x80, 0xf7, 0xee, 0x13, 0x02, 0x69, 0x47, 0x1f, 0x31, 0x29,
+	0xc4, 0x7f, 0x93, 0x06, 0x64, 0x6d, 0x7d, 0x8f, 0xd9, 0x7e, 0x29, 0x59, 0x49, 0xbd, 0x5b, 0xb8,
+	0xff, 0xb5, 0xea, 0xcb, 0x53, 0xae, 0xc6, 0x94, 0x54, 0xb7, 0x39, 0x9a, 0x0f, 0x82, 0x4a, 0x51,
+	0xf2, 0x5d, 0x58, 0xb4, 0x1c, 0xd3, 0x32, 0x98, 0x5f, 0x4a, 0x73, 0x2d, 0xeb, 0xf3, 0xb4, 0x4c,
+	0x47, 0x5f, 0x4f, 0xff, 0xf8, 0x62, 0x63, 0x81, 0x86, 0x42, 0xe5, 0x47, 0x50, 0x88, 0xa9, 0x9d,
+	0x33, 0xb7, 0x35, 0xc8, 0x1c, 0xe9, 0xf6, 0x84, 0xc9, 0xd9, 0x89, 0xc6, 0xb7, 0x92, 0x0f, 0x13,
+	0xea, 0x27, 0x90, 0xa7, 0xcc, 0x77, 0x27, 0x9e, 0xc1, 0x7c, 0xf2, 0x55, 0xc8, 0x3b, 0xba, 0xe3,
+	0x6a, 0xc6, 0x78, 0xe2, 0x73, 0xf1, 0x54, 0xbd, 0x78, 0x79, 0xb1, 0x91, 0xeb, 0xe8, 0x8e, 0xdb,
+	0xe8, 0xed, 0xfa, 0x34, 0x87, 0xec, 0xc6, 0x78, 0xe2, 0x93, 0x2f, 0x43, 0x71, 0xc4, 0x46, 0xae,
+	0x77, 0xaa, 0xed, 0x9d, 0x06, 0xcc, 0xe7, 0x8a, 0x53, 0xb4, 0x20, 0x68, 0x75, 0x24, 0xa9, 0xbf,
+	0x97, 0x80, 0xb5, 0x50, 0x37, 0x65, 0x3f, 0x98, 0x58, 0x1e, 0x1b, 0x31, 0x27, 0xf0, 0xc9, 0x37,
+	0x20, 0x6b, 0x5b, 0x23, 0x2b, 0x10, 0x7d, 0x14, 0xee, 0xbf, 0x31, 0x6f, 0xb6, 0xd1, 0xa8, 0xa8,
+	0x04, 0x93, 0x1a, 0x14, 0x3d, 0xe6, 0x33, 0xef, 0x48, 0x58, 0xb2, 0x94, 0x7c, 0x1d, 0xe1, 0x19,
+	0x11, 0x75, 0x13, 0x72, 0x3d, 0x5b, 0x0f, 0xf6, 0x5d, 0x6f, 0x44, 0x54, 0x28, 0xea, 0x9e, 0x71,
+	0x60, 0x05, 0xcc, 0x08, 0x26, 0x5e, 0xb8, 0xaa, 0x33, 0x34, 0x72, 0x1b, 0x92, 0xae, 0xe8, 0x28,
+	0x5f, 0xcf, 0x5e, 0x5e, 0x6c, 0x24, 0xbb, 0x7d, 0x9a, 0x74, 0x7d, 0xf5, 0x31, 0xac, 0xf6, 0xec,
+	0xc9, 0xd0, 0x72, 0x9a, 0xcc, 0x37, 0x3c, 0x6b, 0x8c, 0xda, 0x71, 0x7b, 0xe0, 0xde, 0x0f, 0xb7,
+	0x07, 0xfe, 0x8e, 0xb6, 0x4c, 0x72, 0xba, 0x65, 0xd4, 0xdf, 0x49, 0xc2, 0x6a, 0xcb, 0x19, 0x5a,
+	0x0e, 0x8b, 0x4b, 0xbf, 0x0d, 0xcb, 0x8c, 0x13, 0xb5, 0x23, 0xb1, 0x8d, 0xa5, 0x9e, 0x25, 0x41,
+	0x0d, 0xf7, 0x76, 0xfb, 0xca, 0x7e, 0xfb, 0x70, 0xde, 0xf4, 0x5f, 0xd2, 0x3e, 0x77, 0xd7, 0xb5,
+	0x60, 0x71, 0xcc, 0x27, 0xe1, 0x97, 0x52, 0x5c, 0xd7, 0xdb, 0xf3, 0x74, 0xbd, 0x34, 0xcf, 0x70,
+	0xf3, 0x49, 0xd9, 0xcf, 0xb3, 0xf9, 0xfe, 0x3c, 0x09, 0x2b, 0x1d, 0xd7, 0x9c, 0xb1, 0x43, 0x19,
+	0x72, 0x07, 0xae, 0x1f, 0xc4, 0x0e, 0x5a, 0xd4, 0x26, 0x0f, 0x21, 0x37, 0x96, 0xcb, 0x27, 0x57,
+	0xff, 0xee, 0xfc, 0x21, 0x0b, 0x0c, 0x8d, 0xd0, 0xe4, 0x31, 0xe4, 0xbd, 0x70, 0x4f, 0x94, 0x52,
+	0xaf, 0xb3, 0x71, 0xa6, 0x78, 0xf2, 0x1d, 0xc8, 0x8a, 0x45, 0x28, 0xa5, 0x2b, 0x89, 0xeb, 0xec,
+	0xf4, 0x92, 0xcd, 0xa9, 0x14, 0x22, 0x4f, 0x20, 0x17, 0xd8, 0xbe, 0x66, 0x39, 0xfb, 0x6e, 0x29,
+	0xc3, 0x15, 0x6c, 0xcc, 0x53, 0x80, 0x86, 0x18, 0x6c, 0xf7, 0xdb, 0xce, 0xbe, 0x5b, 0x2f, 0x5c,
+	0x5e, 0x6c, 0x2c, 0xca, 0x06, 0x5d, 0x0c, 0x6c, 0x1f, 0x7f, 0xa8, 0xbf, 0x9f, 0x80, 0x42, 0x0c,
+	0x45, 0xde, 0x00, 0x08, 0xbc, 0x89, 0x1f, 0x68, 0x9e, 0xeb, 0x06, 0xdc, 0x58, 0x45, 0x9a, 0xe7,
+	0x14, 0xea, 0xba, 0x01, 0xa9, 0xc2, 0x2d, 0x83, 0x79, 0x81, 0x66, 0xf9, 0xfe, 0x84, 0x79, 0x9a,
+	0x3f, 0xd9, 0xfb, 0x94, 0x19, 0x01, 0x37, 0x5c, 0x91, 0xae, 0x22, 0xab, 0xcd, 0x39, 0x7d, 0xc1,
+	0x20, 0x0f, 0xe0, 0x76, 0x1c, 0x3f, 0x9e, 0xec, 0xd9, 0x96, 0xa1, 0xe1, 0x62, 0xa6, 0xb8, 0xc8,
+	0xad, 0xa9, 0x48, 0x8f, 0xf3, 0x9e, 0xb2, 0x53, 0xf5, 0xa7, 0x09, 0x50, 0xa8, 0xbe, 0x1f, 0xec,
+	0xb0, 0xd1, 0x1e, 0xf3, 0xfa, 0x81, 0x1e, 0x4c, 0x7c, 0x72, 0x1b, 0xb2, 0x36, 0xd3, 0x4d, 0xe6,
+	0xf1, 0x41, 0xe5, 0xa8, 0x6c, 0x91, 0x5d, 0x3c, 0xc1, 0xba, 0x71, 0xa0, 0xef, 0x59, 0xb6, 0x15,
+	0x9c, 0xf2, 0xa1, 0x2c, 0xcf, 0xdf, 0xc2, 0x57, 0x75, 0x56, 0x69, 0x4c, 0x90, 0xce, 0xa8, 0x21,
+	0x25, 0x58, 0x1c, 0x31, 0xdf, 0xd7, 0x87, 0x8c, 0x8f, 0x34, 0x4f, 0xc3, 0xa6, 0xfa, 0x18, 0x8a,
+	0x71, 0x39, 0x52, 0x80, 0xc5, 0xdd, 0xce, 0xd3, 0x4e, 0xf7, 0x79, 0x47, 0x59, 0x20, 0x2b, 0x50,
+	0xd8, 0xed, 0xd0, 0x56, 0xad, 0xb1, 0x55, 0xab, 0x6f, 0xb7, 0x94, 0x04, 0x59, 0x82, 0xfc, 0xb4,
+	0x99, 0x54, 0xff, 0x22, 0x01, 0x80, 0xe6, 0x96, 0x93, 0xfa, 0x16, 0x64, 0xfc, 0x40, 0x0f, 0xc4,
+	0xae, 0x5c, 0xbe, 0xff, 0xd6, 0x75, 0x6b, 0x28, 0xc7, 0x8b, 0xff, 0x18, 0x15, 0x22, 0xf1, 0x11,
+	0x26, 0x67, 0x46, 0x88, 0x0e, 0x42, 0x37, 0x4d, 0x4f, 0x0e, 0x9c, 0xff, 0x56, 0x1f, 0x43, 0x86,
+	0x4b, 0xcf, 0x0e, 0x37, 0x07, 0xe9, 0x26, 0xfe, 0x4a, 0x90, 0x3c, 0x64, 0x68, 0xab, 0xd6, 0xfc,
+	0x44, 0x49, 0x12, 0x05, 0x8a, 0xcd, 0x76, 0xbf, 0xd1, 0xed, 0x74, 0x5a, 0x8d, 0x41, 0xab, 0xa9,
+	0xa4, 0xd4, 0xb7, 0x21, 0xd3, 0x1e, 0xa1, 0xe6, 0xbb, 0xb8, 0xe5, 0xf7, 0x99, 0xc7, 0x1c, 0x23,
+	0x3c, 0x49, 0x53, 0x82, 0xfa, 0x93, 0x3c, 0x64, 0x76, 0xdc, 0x89, 0x13, 0x90, 0xfb, 0x31, 0xb7,
+	0xb5, 0x3c, 0xff, 0xe6, 0xe1, 0xc0, 0xea, 0xe0, 0x74, 0xcc, 0xa4, 0x5b, 0xbb, 0x0d, 0x59, 0x71,
+	0x38, 0xe4, 0x74, 0x64, 0x0b, 0xe9, 0x81, 0xee, 0x0d, 0x59, 0x20, 0xe7, 0x23, 0x5b, 0xe4, 0x5d,
+	0xc8, 0x79, 0x4c, 0x37, 0x5d, 0xc7, 0x3e, 0xe5, 0x67, 0x28, 0x27, 0xee, 0x15, 0xca, 0x74, 0xb3,
+	0xeb, 0xd8, 0xa7, 0x34, 0xe2, 0x92, 0x2d, 0x28, 0xee, 0x59, 0x8e, 0xa9, 0xb9, 0x63, 0xe1, 0xe4,
+	0x33, 0xd7, 0x9f, 0x38, 0x31, 0xaa, 0xba, 0xe5, 0x98, 0x5d, 0x01, 0xa6, 0x85, 0xbd, 0x69, 0x83,
+	0x74, 0x60, 0xf9, 0xc8, 0xb5, 0x27, 0x23, 0x16, 0xe9, 0xca, 0x72, 0x5d, 0xef, 0x5c, 0xaf, 0xeb,
+	0x19, 0xc7, 0x87, 0xda, 0x96, 0x8e, 0xe2, 0x4d, 0xf2, 0x14, 0x96, 0x82, 0xd1, 0x78, 0xdf, 0x8f,
+	0xd4, 0x2d, 0x72, 0x75, 0x5f, 0x79, 0x85, 0xc1, 0x10, 0x1e, 0x6a, 0x2b, 0x06, 0xb1, 0x56, 0xf9,
+	0x87, 0x29, 0x28, 0xc4, 0x46, 0x4e, 0xfa, 0x50, 0x18, 0x7b, 0xee, 0x58, 0x1f, 0xf2, 0x8b, 0xaa,
+	0x94, 0xb8, 0xfe, 0x60, 0xbc, 0x34, 0xeb, 0x6a, 0x6f, 0x2a, 0x48, 0xe3, 0x5a, 0xd4, 0xf3, 0x24,
+	0x14, 0x62, 0x4c, 0xf2, 0x1e, 0xe4, 0x68, 0x8f, 0xb6, 0x9f, 0xd5, 0x06, 0x2d, 0x65, 0xa1, 0x7c,
+	0xf7, 0xec, 0xbc, 0x52, 0xe2, 0xda, 0xe2, 0x0a, 0x7a, 0x9e, 0x75, 0x84, 0x5b, 0xef, 0x5d, 0x58,
+	0x0c, 0xa1, 0x89, 0xf2, 0x97, 0xce, 0xce, 0x2b, 0x5f, 0xbc, 0x0a, 0x8d, 0x21, 0x69, 0x7f, 0xab,
+	0x46, 0x5b, 0x4d, 0x25, 0x39, 0x1f, 0x49, 0xfb, 0x07, 0xba, 0xc7, 0x4c, 0xf2, 0x15, 0xc8, 0x4a,
+	0x60, 0xaa, 0x5c, 0x3e, 0x3b, 0xaf, 0xdc, 0xbe, 0x0a, 0x9c, 0xe2, 0x68, 0x7f, 0xbb, 0xf6, 0xac,
+	0xa5, 0xa4, 0xe7, 0xe3, 0x68, 0xdf, 0xd6, 0x8f, 0x18, 0x79, 0x0b, 0x32, 0x02, 0x96, 0x29, 0xdf,
+	0x39, 0x3b, 0xaf, 0x7c, 0xe1, 0x25, 0x75, 0x88, 0x2a, 0x97, 0x7e, 0xf7, 0x4f, 0xd6, 0x17, 0xfe,
+	0xea, 0x4f, 0xd7, 0x95, 0xab, 0xec, 0xf2, 0x7f, 0x27, 0x60, 0x69, 0x66, 0xc9, 0x89, 0x0a, 0x59,
+	0xc7, 0x35, 0xdc, 0xb1, 0xb8, 0xbf, 0x72, 0x75, 0xb8, 0xbc, 0xd8, 0xc8, 0x76, 0xdc, 0x86, 0x3b,
+	0x3e, 0xa5, 0x92, 0x43, 0x9e, 0x5e, 0xb9, 0x81, 0x1f, 0xbc, 0xe6, 0x7e, 0x9a, 0x7b, 0x07, 0x7f,
+	0x04, 0x4b, 0xa6, 0x67, 0x1d, 0x31, 0x4f, 0x33, 0x5c, 0x67, 0xdf, 0x1a, 0xca, 0xbb, 0xa9, 0x3c,
+	0x4f, 0x67, 0x93, 0x03, 0x69, 0x51, 0x08, 0x34, 0x38, 0xfe, 0x73, 0xdc, 0xbe, 0xe5, 0x67, 0x50,
+	0x8c, 0xef, 0x50, 0xbc, 0x4e, 0x7c, 0xeb, 0xd7, 0x98, 0x0c, 0xe8, 0x78, 0xf8, 0x47, 0xf3, 0x48,
+	0xe1, 0xe1, 0x1c, 0x79, 0x07, 0xd2, 0x23, 0xd7, 0x14, 0x7a, 0x96, 0xea, 0xb7, 0x30, 0x08, 0xf8,
+	0x87, 0x8b, 0x8d, 0x82, 0xeb, 0x57, 0x37, 0x2d, 0x9b, 0xed, 0xb8, 0x26, 0xa3, 0x1c, 0xa0, 0x1e,
+	0x41, 0x1a, 0x5d, 0x05, 0xf9, 0x12, 0xa4, 0xeb, 0xed, 0x4e, 0x53, 0x59, 0x28, 0xaf, 0x9e, 0x9d,
+	0x57, 0x96, 0xb8, 0x49, 0x90, 0x81, 0x7b, 0x97, 0x6c, 0x40, 0xf6, 0x59, 0x77, 0x7b, 0x77, 0x07,
+	0xb7, 0xd7, 0xad, 0xb3, 0xf3, 0xca, 0x4a, 0xc4, 0x16, 0x46, 0x23, 0x6f, 0x40, 0x66, 0xb0, 0xd3,
+	0xdb, 0xec, 0x2b, 0xc9, 0x32, 0x39, 0x3b, 0xaf, 0x2c, 0x47, 0x7c, 0x3e, 0xe6, 0xf2, 0xaa, 0x5c,
+	0xd5, 0x7c, 0x44, 0x57, 0x7f, 0x9e, 0x84, 0x25, 0x8a, 0x99, 0x84, 0x17, 0xf4, 0x5c, 0xdb, 0x32,
+	0x4e, 0x49, 0x0f, 0xf2, 0x86, 0xeb, 0x98, 0x56, 0xec, 0x4c, 0xdd, 0xbf, 0xe6, 0xd6, 0x9f, 0x4a,
+	0x85, 0xad, 0x46, 0x28, 0x49, 0xa7, 0x4a, 0xc8, 0x07, 0x90, 0x31, 0x99, 0xad, 0x9f, 0xca, 0xf0,
+	0xe3, 0x4e, 0x55, 0xe4, 0x2a, 0xd5, 0x30, 0x57, 0xa9, 0x36, 0x65, 0xae, 0x42, 0x05, 0x8e, 0xc7,
+	0xc9, 0xfa, 0x89, 0xa6, 0x07, 0x01, 0x1b, 0x8d, 0x03, 0x11, 0x7b, 0xa4, 0x69, 0x61, 0xa4, 0x9f,
+	0xd4, 0x24, 0x89, 0x7c, 0x08, 0xd9, 0x63, 0xcb, 0x31, 0xdd, 0xe3, 0x52, 0xfa, 0x26, 0xa5, 0x12,
+	0xa8, 0x9e, 0xe1, 0xad, 0x7b, 0x65, 0x98, 0x68, 0xef, 0x4e, 0xb7, 0xd3, 0x0a, 0xed, 0x2d, 0xf9,
+	0x5d, 0xa7, 0xe3, 0x3a, 0x78, 0x56, 0xa0, 0xdb, 0xd1, 0x36, 0x6b, 0xed, 0xed, 0x5d, 0x8a, 0x36,
+	0x5f, 0x3b, 0x3b, 0xaf, 0x28, 0x11, 0x64, 0x53, 0xb7, 0x6c, 0x8c, 0x77, 0xef, 0x40, 0xaa, 0xd6,
+	0xf9, 0x44, 0x49, 0x96, 0x95, 0xb3, 0xf3, 0x4a, 0x31, 0x62, 0xd7, 0x9c, 0xd3, 0xe9, 0x31, 0xba,
+	0xda, 0xaf, 0xfa, 0x37, 0x29, 0x28, 0xee, 0x8e, 0x4d, 0x3d, 0x60, 0x62, 0x4f, 0x92, 0x0a, 0x14,
+	0xc6, 0xba, 0xa7, 0xdb, 0x36, 0xb3, 0x2d, 0x7f, 0x24, 0xb3, 0xb0, 0x38, 0x89, 0x3c, 0x7a, 0x5d,
+	0x33, 0xd6, 0x73, 0xb8, 0xcf, 0xfe, 0xe0, 0x9f, 0x36, 0x12, 0xa1, 0x41, 0x77, 0x61, 0x79, 0x5f,
+	0x8c, 0x56, 0xd3, 0x0d, 0xbe, 0xb0, 0x29, 0xbe, 0xb0, 0xd5, 0x79, 0x0b, 0x1b, 0x1f, 0x56, 0x55,
+	0x4e, 0xb2, 0xc6, 0xa5, 0xe8, 0xd2, 0x7e, 0xbc, 0x49, 0x1e, 0xc0, 0xe2, 0xc8, 0x75, 0xac, 0xc0,
+	0xf5, 0x6e, 0x5e, 0x85, 0x10, 0x49, 0xde, 0x83, 0x55, 0x5c, 0xdc, 0x70, 0x3c, 0x9c, 0xcd, 0x6f,
+	0xac, 0x24, 0x5d, 0x19, 0xe9, 0x27, 0xb2, 0x43, 0x8a, 0x64, 0x52, 0x87, 0x8c, 0xeb, 0x61, 0x48,
+	0x94, 0xe5, 0xc3, 0x7d, 0xff, 0xc6, 0xe1, 0x8a, 0x46, 0x17, 0x65, 0xa8, 0x10, 0x55, 0xbf, 0x09,
+	0x4b, 0x33, 0x93, 0xc0, 0x48, 0xa0, 0x57, 0xdb, 0xed, 0xb7, 0x94, 0x05, 0x52, 0x84, 0x5c, 0xa3,
+	0xdb, 0x19, 0xb4, 0x3b, 0xbb, 0x18, 0xca, 0x14, 0x21, 0x47, 0xbb, 0xdb, 0xdb, 0xf5, 0x5a, 0xe3,
+	0xa9, 0x92, 0x54, 0xab, 0x50, 0x88, 0x69, 0x23, 0xcb, 0x00, 0xfd, 0x41, 0xb7, 0xa7, 0x6d, 0xb6,
+	0x69, 0x7f, 0x20, 0x02, 0xa1, 0xfe, 0xa0, 0x46, 0x07, 0x92, 0x90, 0x50, 0xff, 0x2d, 0x19, 0xae,
+	0xa8, 0x8c, 0x7d, 0xea, 0xb3, 0xb1, 0xcf, 0x2b, 0x06, 0x2f, 0x04, 0x62, 0x8d, 0x28, 0x06, 0x7a,
+	0x04, 0xc0, 0x37, 0x0e, 0x33, 0x35, 0x3d, 0x90, 0x0b, 0x5f, 0x7e, 0xc9, 0xc8, 0x83, 0xb0, 0x18,
+	0x40, 0xf3, 0x12, 0x5d, 0x0b, 0xc8, 0x77, 0xa0, 0x68, 0xb8, 0xa3, 0xb1, 0xcd, 0xa4, 0x70, 0xea,
+	0x46, 0xe1, 0x42, 0x84, 0xaf, 0x05, 0xf1, 0xe8, 0x2b, 0x3d, 0x1b, 0x1f, 0xfe, 0x76, 0x02, 0x0a,
+	0xb1, 0xa1, 0xce, 0x06, 0x5c, 0x45, 0xc8, 0xed, 0xf6, 0x9a, 0xb5, 0x41, 0xbb, 0xf3, 0x44, 0x49,
+	0x10, 0x80, 0x2c, 0x37, 0x75, 0x53, 0x49, 0x62, 0xa0, 0xd8, 0xe8, 0xee, 0xf4, 0xb6, 0x5b, 0x3c,
+	0xe4, 0x22, 0x6b, 0xa0, 0x84, 0xc6, 0xd6, 0xb8, 0x21, 0x5b, 0x4d, 0x25, 0x4d, 0x6e, 0xc1, 0x4a,
+	0x44, 0x95, 0x92, 0x19, 0x72, 0x1b, 0x48, 0x44, 0x9c, 0xaa, 0xc8, 0xaa, 0xbf, 0x09, 0x2b, 0x0d,
+	0xd7, 0x09, 0x74, 0xcb, 0x89, 0x82, 0xe8, 0xfb, 0x38, 0x69, 0x49, 0xd2, 0x2c, 0x53, 0xf8, 0xf4,
+	0xfa, 0xca, 0xe5, 0xc5, 0x46, 0x21, 0x82, 0xb6, 0x9b, 0x38, 0xd3, 0xb0, 0x61, 0xe2, 0xf9, 0x1d,
+	0x5b, 0x26, 0x37, 0x6e, 0xa6, 0xbe, 0x78, 0x79, 0xb1, 0x91, 0xea, 0xb5, 0x9b, 0x14, 0x69, 0xe4,
+	0x4b, 0x90, 0x67, 0x27, 0x56, 0xa0, 0x19, 0xe8, 0xc3, 0xd1, 0x80, 0x19, 0x9a, 0x43, 0x42, 0x03,
+	0x5d, 0x76, 0x1d, 0xa0, 0xe7, 0x7a, 0x81, 0xec, 0xf9, 0xeb, 0x90, 0x19, 0xbb, 0x1e, 0x4f, 0xcf,
+	0xaf, 0x2d, 0x46, 0x20, 0x5c, 0x6c, 0x54, 0x2a, 0xc0, 0xea, 0x5f, 0x27, 0x01, 0x06, 0xba, 0x7f,
+	0x28, 0x95, 0x3c, 0x84, 0x7c, 0x54, 0xd8, 0x29, 0x25, 0x6e, 0x5c, 0xb0, 0x29, 0x98, 0x3c, 0x08,
+	0x37, 0x9b, 0x48, 0x0f, 0xe6, 0xe6, 0x69, 0x61, 0x47, 0xf3, 0x22, 0xec, 0xd9, 0x1c, 0x00, 0xaf,
+	0x44, 0xe6, 0x79, 0x72, 0xe5, 0xf1, 0x27, 0x69, 0x40, 0x3e, 0x32, 0x9a, 0x0c, 0x30, 0xdf, 0x9c,
+	0xd7, 0xc9, 0x95, 0x15, 0xd9, 0x5a, 0xa0, 0x53, 0x39, 0xf2, 0x11, 0x14, 0x70, 0xde, 0x9a, 0xcf,
+	0x79, 0x32, 0xb6, 0xbc, 0xd6, 0x54, 0x42, 0x03, 0x85, 0x71, 0xf4, 0xbb, 0xae, 0xc0, 0xb2, 0x37,
+	0x71, 0x70, 0xda, 0x52, 0x87, 0x6a, 0xc1, 0x17, 0x3b, 0x2c, 0x38, 0x76, 0xbd, 0xc3, 0x5a, 0x10,
+	0xe8, 0xc6, 0x01, 0x56, 0x4b, 0xa4, 0x4b, 0x9d, 0x06, 0xd6, 0x89, 0x99, 0xc0, 0xba, 0x04, 0x8b,
+	0xba, 0x6d, 0xe9, 0x3e, 0x13, 0xd1, 0x48, 0x9e, 0x86, 0x4d, 0x0c, 0xff, 0x31, 0x99, 0x60, 0xbe,
+	0xcf, 0x44, 0x7e, 0x9f, 0xa7, 0x53, 0x82, 0xfa, 0xf7, 0x49, 0x80, 0x76, 0xaf, 0xb6, 0x23, 0xd5,
+	0x37, 0x21, 0xbb, 0xaf, 0x8f, 0x2c, 0xfb, 0xf4, 0x55, 0x07, 0x7c, 0x8a, 0xaf, 0xd6, 0x84, 0xa2,
+	0x4d, 0x2e, 0x43, 0xa5, 0x2c, 0xcf, 0x0a, 0x26, 0x7b, 0x0e, 0x0b, 0xa2, 0xac, 0x80, 0xb7, 0x30,
+	0x04, 0xf1, 0x74, 0x27, 0x5a, 0x19, 0xd1, 0xc0, 0xa1, 0x0f, 0xf5, 0x80, 0x1d, 0xeb, 0xa7, 0xe1,
+	0xa9, 0x94, 0x4d, 0xb2, 0x05, 0x39, 0x51, 0xb5, 0x61, 0x66, 0x29, 0xc3, 0xb7, 0xe0, 0x4d, 0xe3,
+	0xa1, 0x12, 0x2e, 0x82, 0xab, 0x48, 0xba, 0xfc, 0x98, 0x47, 0x04, 0x53, 0xd6, 0x2f, 0x54, 0x9d,
+	0xb8, 0x07, 0x4b, 0x33, 0xf3, 0x7c, 0x29, 0x1d, 0x6b, 0xf7, 0x9e, 0x7d, 0x5d, 0x49, 0xcb, 0x5f,
+	0xdf, 0x54, 0xb2, 0xea, 0x9f, 0xa5, 0xc4, 0x39, 0x92, 0x56, 0x9d, 0x5f, 0x2f, 0xcc, 0xf1, 0xdd,
+	0x6f, 0xb8, 0xb6, 0xdc, 0xdf, 0xef, 0xbc, 0xfa, 0x78, 0x55, 0x7b, 0x12, 0x4e, 0x23, 0x41, 0xb2,
+	0x01, 0x05, 0xb1, 0xfe, 0x1a, 0xee, 0x27, 0x6e, 0xd6, 0x25, 0x0a, 0x82, 0x84, 0x92, 0x58, 0x4c,
+	0xe2, 0xe9, 0xbb, 0x7f, 0xc0, 0x4c, 0x81, 0x49, 0x73, 0xcc, 0x52, 0x44, 0xe5, 0xb0, 0x1d, 0x28,
+	0x4a, 0x82, 0xc6, 0x43, 0xbb, 0x0c, 0x1f, 0xd0, 0x7b, 0x37, 0x0d, 0x48, 0x88, 0xf0, 0x88, 0xaf,
+	0x30, 0x9e, 0x36, 0xd4, 0x26, 0xe4, 0xc2, 0xc1, 0x92, 0x12, 0xa4, 0x06, 0x8d, 0x9e, 0xb2, 0x50,
+	0x5e, 0x39, 0x3b, 0xaf, 0x14, 0x42, 0xf2, 0xa0, 0xd1, 0x43, 0xce, 0x6e, 0xb3, 0xa7, 0x24, 0x66,
+	0x39, 0xbb, 0xcd, 0x5e, 0x39, 0x8d, 0x21, 0x86, 0xba, 0x0f, 0x85, 0x58, 0x0f, 0xe4, 0x4d, 0x58,
+	0x6c, 0x77, 0x9e, 0xd0, 0x56, 0xbf, 0xaf, 0x2c, 0x94, 0x6f, 0x9f, 0x9d, 0x57, 0x48, 0x8c, 0xdb,
+	0x76, 0x86, 0xb8, 0x3e, 0xe4, 0x0d, 0x48, 0x6f, 0x75, 0xfb, 0x83, 0x30, 0x96, 0x8c, 0x21, 0xb6,
+	0x5c, 0x3f, 0x28, 0xdf, 0x92, 0xb1, 0x4b, 0x5c, 0xb1, 0xfa, 0x87, 0x09, 0xc8, 0x8a, 0x90, 0x7a,
+	0xee, 0x42, 0xd5, 0x60, 0x31, 0x4c, 0xf4, 0x44, 0x9c, 0xff, 0xce, 0xf5, 0x31, 0x79, 0x55, 0x86,
+	0xd0, 0x62, 0xfb, 0x85, 0x72, 0xe5, 0x6f, 0x41, 0x31, 0xce, 0xf8, 0x85, 0x36, 0xdf, 0xaf, 0x43,
+	0x01, 0xf7, 0xb7, 0x94, 0x27, 0xf7, 0x21, 0x2b, 0xc2, 0xfe, 0xc8, 0x95, 0x5e, 0x9f, 0x20, 0x48,
+	0x24, 0x79, 0x08, 0x8b, 0x22, 0xa9, 0x08, 0xeb, 0x7b, 0xeb, 0xaf, 0x3e, 0x45, 0x34, 0x84, 0xab,
+	0x1f, 0x41, 0xba, 0xc7, 0x98, 0x87, 0xb6, 0x77, 0x5c, 0x93, 0x4d, 0x6f, 0x1f, 0x99, 0x0f, 0x99,
+	0xac, 0xdd, 0xc4, 0x7c, 0xc8, 0x64, 0x6d, 0x33, 0xaa, 0x60, 0x24, 0x63, 0x15, 0x8c, 0x01, 0x14,
+	0x9f, 0x33, 0x6b, 0x78, 0x10, 0x30, 0x93, 0x2b, 0x7a, 0x1f, 0xd2, 0x63, 0x16, 0x0d, 0xbe, 0x34,
+	0x77, 0x83, 0x31, 0xe6, 0x51, 0x8e, 0x42, 0x3f, 0x72, 0xcc, 0xa5, 0x65, 0x55, 0x59, 0xb6, 0xd4,
+	0xbf, 0x4b, 0xc2, 0x32, 0xd6, 0x9f, 0x74, 0xc7, 0x08, 0x03, 0x93, 0xef, 0xce, 0x06, 0x26, 0xef,
+	0xce, 0x9d, 0xe1, 0x8c, 0xc8, 0x6c, 0x61, 0x46, 0x5e, 0x0e, 0xc9, 0xe8, 0x72, 0x50, 0xff, 0x35,
+	0x11, 0x56, 0x5f, 0xde, 0x8e, 0x1d, 0xf7, 0x72, 0xe9, 0xec, 0xbc, 0xb2, 0x16, 0xd7, 0xc4, 0x76,
+	0x9d, 0x43, 0xc7, 0x3d, 0x76, 0xc8, 0x97, 0xb1, 0x1a, 0xd3, 0x69, 0x3d, 0x57, 0x12, 0x62, 0x7b,
+	0xce, 0x80, 0x28, 0x73, 0xd8, 0x31, 0x6a, 0xea, 0xb5, 0x3a, 0x4d, 0x0c, 0x24, 0x92, 0x73, 0x34,
+	0xf5, 0x98, 0x63, 0x5a, 0xce, 0x90, 0xbc, 0x09, 0xd9, 0x76, 0xbf, 0xbf, 0xcb, 0xf3, 0xe3, 0x2f,
+	0x9e, 0x9d, 0x57, 0x6e, 0xcd, 0xa0, 0xb0, 0xc1, 0x4c, 0x04, 0x61, 0x14, 0x8f, 0x21, 0xc6, 0x1c,
+	0x10, 0x86, 0x87, 0x02, 0x44, 0xbb, 0x03, 0x4c, 0xde, 0x33, 0x73, 0x40, 0xd4, 0xc5, 0xbf, 0xf2,
+	0xb8, 0xfd, 0x63, 0x12, 0x94, 0x9a, 0x61, 0xb0, 0x71, 0x80, 0x7c, 0x99, 0x38, 0x0d, 0x20, 0x37,
+	0xc6, 0x5f, 0x16, 0x0b, 0x83, 0x80, 0x87, 0x73, 0xdf, 0x35, 0xae, 0xc8, 0x55, 0xa9, 0x6b, 0xb3,
+	0x9a, 0x39, 0xb2, 0x7c, 0xac, 0x55, 0x0b, 0x1a, 0x8d, 0x34, 0x95, 0xff, 0x3d, 0x01, 0xb7, 0xe6,
+	0x20, 0xc8, 0x3d, 0x48, 0x7b, 0xae, 0x1d, 0xae, 0xe1, 0xdd, 0xeb, 0x0a, 0x6b, 0x28, 0x4a, 0x39,
+	0x92, 0xac, 0x03, 0xe8, 0x93, 0xc0, 0xd5, 0x79, 0xff, 0x7c, 0xf5, 0x72, 0x34, 0x46, 0x21, 0xcf,
+	0x21, 0xeb, 0x33, 0xc3, 0x63, 0x61, 0xa8, 0xf8, 0xd1, 0xff, 0x75, 0xf4, 0xd5, 0x3e, 0x57, 0x43,
+	0xa5, 0xba, 0x72, 0x15, 0xb2, 0x82, 0x82, 0xdb, 0xde, 0xd4, 0x03, 0x5d, 0x96, 0x5d, 0xf9, 0x6f,
+	0xdc, 0x4d, 0xba, 0x3d, 0x0c, 0x77, 0x93, 0x6e, 0x0f, 0xd5, 0x1f, 0x25, 0x01, 0x5a, 0x27, 0x01,
+	0xf3, 0x1c, 0xdd, 0x6e, 0xd4, 0x48, 0x2b, 0xe6, 0xfd, 0xc5, 0x6c, 0xbf, 0x3a, 0xb7, 0x96, 0x1c,
+	0x49, 0x54, 0x1b, 0xb5, 0x39, 0xfe, 0xff, 0x0e, 0xa4, 0x26, 0x9e, 0x7c, 0xaa, 0x12, 0x61, 0xde,
+	0x2e, 0xdd, 0xa6, 0x48, 0xc3, 0xa2, 0x7e, 0xe8, 0xb6, 0x52, 0xd7, 0x3f, 0x48, 0xc5, 0x3a, 0x98,
+	0xeb, 0xba, 0xf0, 0xe4, 0x1b, 0xba, 0x66, 0x30, 0x79, 0x73, 0x14, 0xc5, 0xc9, 0x6f, 0xd4, 0x1a,
+	0xcc, 0x0b, 0x68, 0xd6, 0xd0, 0xf1, 0xff, 0xe7, 0xf2, 0x6f, 0xef, 0x03, 0x4c, 0xa7, 0x46, 0xd6,
+	0x21, 0xd3, 0xd8, 0xec, 0xf7, 0xb7, 0x95, 0x05, 0xe1, 0xc0, 0xa7, 0x2c, 0x4e, 0x56, 0xff, 0x23,
+	0x09, 0xb9, 0x46, 0x4d, 0x5e, 0xab, 0x0d, 0x50, 0xb8, 0x57, 0xe2, 0xc5, 0x6a, 0x76, 0x32, 0xb6,
+	0xbc, 0xd3, 0x52, 0xe2, 0xa6, 0x9c, 0x6d, 0x19, 0x45, 0x70, 0xd4, 0x2d, 0x2e, 0x40, 0x28, 0x14,
+	0x99, 0x34, 0x82, 0x66, 0xe8, 0xa1, 0x8f, 0x5f, 0x7f, 0xb5, 0xb1, 0x44, 0xf4, 0x3d, 0x6d, 0xfb,
+	0xb4, 0x10, 0x2a, 0x69, 0xe8, 0x3e, 0x79, 0x04, 0x2b, 0xbe, 0x35, 0x74, 0x2c, 0x67, 0xa8, 0x85,
+	0xc6, 0xe3, 0x95, 0xf3, 0xfa, 0xea, 0xe5, 0xc5, 0xc6, 0x52, 0x5f, 0xb0, 0xa4, 0x0d, 0x97, 0x24,
+	0xb2, 0xc1, 0x4d, 0x49, 0xbe, 0x09, 0xcb, 0x31, 0x51, 0xb4, 0xa2, 0x30, 0xbb, 0x72, 0x79, 0xb1,
+	0x51, 0x8c, 0x24, 0x9f, 0xb2, 0x53, 0x5a, 0x8c, 0x04, 0x9f, 0x32, 0x5e, 0x5e, 0xd8, 0x77, 0x3d,
+	0x83, 0x69, 0x1e, 0x3f, 0xd3, 0xfc, 0x06, 0x4f, 0xd3, 0x02, 0xa7, 0x89, 0x63, 0x4e, 0x1e, 0xc1,
+	0x9d, 0x4f, 0x5d, 0xcb, 0xd1, 0x02, 0xf7, 0x90, 0x39, 0xda, 0x81, 0xee, 0x1f, 0x68, 0xba, 0x3d,
+	0x74, 0x3d, 0x2b, 0x38, 0x18, 0xf1, 0xb0, 0x35, 0x4f, 0x6f, 0x23, 0x60, 0x80, 0xfc, 0x2d, 0xdd,
+	0x3f, 0xa8, 0x85, 0x5c, 0xf5, 0x19, 0xdc, 0xea, 0x7a, 0xc6, 0x01, 0xf3, 0x03, 0x61, 0x45, 0xb9,
+	0x00, 0x1f, 0xc1, 0xdd, 0x40, 0xf7, 0x0f, 0xb5, 0x03, 0xcb, 0x0f, 0xf0, 0x05, 0xd0, 0x63, 0x01,
+	0x73, 0x90, 0xaf, 0xf1, 0x97, 0x3a, 0x59, 0x3a, 0xba, 0x83, 0x98, 0x2d, 0x01, 0xa1, 0x21, 0x62,
+	0x1b, 0x01, 0x6a, 0x1b, 0x8a, 0x18, 0xc0, 0x37, 0xd9, 0xbe, 0x3e, 0xb1, 0x03, 0x34, 0x1c, 0xd8,
+	0xee, 0x50, 0x7b, 0xed, 0x1b, 0x2e, 0x6f, 0xbb, 0x43, 0xf1, 0x53, 0xfd, 0x3e, 0x28, 0x4d, 0xcb,
+	0x1f, 0xeb, 0x81, 0x71, 0x10, 0xd6, 0xc4, 0x48, 0x13, 0x94, 0x03, 0xa6, 0x7b, 0xc1, 0x1e, 0xd3,
+	0x03, 0x6d, 0xcc, 0x3c, 0xcb, 0x35, 0x6f, 0xde, 0x20, 0x2b, 0x91, 0x48, 0x8f, 0x4b, 0xa8, 0xff,
+	0x99, 0x00, 0xc0, 0x57, 0x08, 0xa9, 0xf4, 0x6b, 0xb0, 0xea, 0x3b, 0xfa, 0xd8, 0x3f, 0x70, 0x03,
+	0xcd, 0x72, 0x02, 0x7c, 0x53, 0xb4, 0x65, 0x69, 0x43, 0x09, 0x19, 0x6d, 0x49, 0x27, 0xef, 0x03,
+	0x39, 0x64, 0x6c, 0xac, 0xb9, 0xb6, 0xa9, 0x85, 0x4c, 0xf1, 0x8e, 0x98, 0xa6, 0x0a, 0x72, 0xba,
+	0xb6, 0xd9, 0x0f, 0xe9, 0xa4, 0x0e, 0xeb, 0x38, 0x7d, 0xe6, 0x04, 0x9e, 0xc5, 0x7c, 0x6d, 0xdf,
+	0xf5, 0x34, 0xdf, 0x76, 0x8f, 0xb5, 0x7d, 0xd7, 0xb6, 0xdd, 0x63, 0xe6, 0x85, 0x55, 0xa3, 0xb2,
+	0xed, 0x0e, 0x5b, 0x02, 0xb4, 0xe9, 0x7a, 0x7d, 0xdb, 0x3d, 0xde, 0x0c, 0x11, 0x18, 0xf1, 0x4d,
+	0xe7, 0x1c, 0x58, 0xc6, 0x61, 0x18, 0xf1, 0x45, 0xd4, 0x81, 0x65, 0x1c, 0x92, 0x37, 0x61, 0x89,
+	0xd9, 0x8c, 0x17, 0x0f, 0x04, 0x2a, 0xc3, 0x51, 0xc5, 0x90, 0x88, 0x20, 0xf5, 0x63, 0x50, 0x5a,
+	0x8e, 0xe1, 0x9d, 0x8e, 0x63, 0x6b, 0xfe, 0x3e, 0x10, 0xf4, 0xaf, 0x9a, 0xed, 0x1a, 0x87, 0xda,
+	0x48, 0x77, 0xf4, 0x21, 0x8e, 0x4b, 0x3c, 0xef, 0x28, 0xc8, 0xd9, 0x76, 0x8d, 0xc3, 0x1d, 0x49,
+	0x57, 0x1f, 0x01, 0xf4, 0xc7, 0x58, 0xd3, 0xef, 0x62, 0x20, 0x82, 0xa6, 0xe3, 0x2d, 0xcd, 0x94,
+	0xcf, 0x63, 0xae, 0x27, 0xbd, 0x84, 0x22, 0x18, 0xcd, 0x88, 0xae, 0xfe, 0x0a, 0xdc, 0xea, 0xd9,
+	0xba, 0xc1, 0x9f, 0x8a, 0x7b, 0xd1, 0x7b, 0x05, 0x79, 0x08, 0x59, 0x01, 0x95, 0x2b, 0x39, 0xf7,
+	0xa4, 0x4e, 0xfb, 0xdc, 0x5a, 0xa0, 0x12, 0x5f, 0x2f, 0x02, 0x4c, 0xf5, 0xa8, 0x27, 0x90, 0x8f,
+	0xd4, 0x63, 0xa1, 0xca, 0x70, 0x1d, 0xdc, 0xdd, 0x96, 0x23, 0xd3, 0xdd, 0x3c, 0x8d, 0x93, 0x48,
+	0x1b, 0xeb, 0xf2, 0xa1, 0xf0, 0x2b, 0x23, 0xc1, 0x39, 0x83, 0xa6, 0x71, 0x59, 0xf5, 0xbb, 0x00,
+	0xdf, 0x0b, 0x8f, 0x19, 0x7f, 0x22, 0xc3, 0x44, 0x8f, 0x85, 0x86, 0x90, 0x2d, 0x9e, 0xc7, 0x0a,
+	0x2b, 0x46, 0x2f, 0x45, 0xa2, 0xa9, 0xfe, 0x71, 0x1a, 0xb2, 0xd4, 0x75, 0x83, 0x46, 0x8d, 0x54,
+	0x20, 0x2b, 0xbd, 0x04, 0xbf, 0x7d, 0xea, 0xf9, 0xcb, 0x8b, 0x8d, 0x8c, 0x70, 0x0f, 0x19, 0x83,
+	0xfb, 0x85, 0x98, 0xff, 0x4e, 0x5e, 0xe7, 0xbf, 0xc9, 0x3d, 0x28, 0x4a, 0x10, 0x77, 0x0b, 0x22,
+	0x3d, 0xab, 0x2f, 0x5f, 0x5e, 0x6c, 0x80, 0x40, 0xa2, 0x37, 0xa0, 0x60, 0xe8, 0xe1, 0x6f, 0xd2,
+	0x82, 0xc2, 0xd4, 0x97, 0xf8, 0xa5, 0xf4, 0xf5, 0x4b, 0x31, 0x9d, 0xaa, 0x7c, 0x2f, 0x86, 0x4f,
+	0xa7, 0x93, 0x6f, 0xc1, 0x92, 0xe7, 0xba, 0x81, 0x70, 0x5a, 0x58, 0xc2, 0x13, 0x49, 0x78, 0x65,
+	0x9e, 0x22, 0x9c, 0x32, 0x95, 0x38, 0x5a, 0xf4, 0x62, 0x2d, 0x72, 0x0f, 0xd6, 0x6c, 0xdd, 0x0f,
+	0x34, 0xee, 0xed, 0xcc, 0xa9, 0xb6, 0x2c, 0x3f, 0x2d, 0x04, 0x79, 0x9b, 0x9c, 0x15, 0x49, 0x3c,
+	0x05, 0xe5, 0x07, 0x13, 0x36, 0x89, 0x81, 0xf1, 0x19, 0x27, 0xf5, 0x5a, 0x7d, 0xaf, 0x08, 0xc9,
+	0xb0, 0xed, 0x93, 0x2d, 0x58, 0xe3, 0x8e, 0x60, 0xc4, 0x4c, 0x4b, 0x0f, 0x58, 0xe4, 0xf3, 0x73,
+	0xdc, 0xe0, 0xb7, 0x2f, 0x2f, 0x36, 0x48, 0x3b, 0xc6, 0x97, 0xc6, 0x27, 0x71, 0x19, 0xe9, 0xfd,
+	0x5b, 0x70, 0xeb, 0xaa, 0x26, 0x5c, 0xdc, 0x3c, 0x57, 0xf4, 0x85, 0xcb, 0x8b, 0x8d, 0xd5, 0x59,
+	0x45, 0xb8, 0xd0, 0xab, 0xb3, 0x7a, 0xf0, 0x2d, 0xf6, 0x47, 0x29, 0x28, 0xa0, 0x3e, 0x6b, 0xdf,
+	0x32, 0xd0, 0xf3, 0xff, 0xe2, 0x71, 0xd5, 0x1d, 0x48, 0x19, 0xbe, 0x27, 0xb7, 0x0c, 0x0f, 0x2c,
+	0x1a, 0x7d, 0x4a, 0x91, 0x46, 0x3e, 0x86, 0xac, 0x2c, 0x75, 0x88, 0x90, 0x4a, 0xbd, 0x39, 0xd4,
+	0x96, 0x2b, 0x2f, 0xe5, 0xf8, 0x69, 0x9b, 0x8e, 0x4e, 0x5c, 0x70, 0x34, 0x4e, 0xc2, 0xcf, 0x2d,
+	0x0c, 0xb1, 0x19, 0xe4, 0xe7, 0x16, 0x8d, 0x0e, 0x4d, 0x1a, 0x0e, 0x79, 0x0c, 0x05, 0xbe, 0xd0,
+	0xfc, 0x65, 0xda, 0x2c, 0x65, 0x6f, 0xac, 0x26, 0x01, 0xc2, 0x65, 0xc0, 0x5c, 0x81, 0x82, 0x1e,
+	0x04, 0xc8, 0xe0, 0x9b, 0x63, 0x51, 0x74, 0x1b, 0x23, 0x91, 0xff, 0x0f, 0x79, 0xc7, 0x0d, 0x34,
+	0x7d, 0x3f, 0x60, 0x5e, 0x29, 0x77, 0xa3, 0xf2, 0x9c, 0xe3, 0x06, 0x35, 0xc4, 0xf2, 0x82, 0x07,
+	0xf3, 0x2c, 0xdd, 0x2e, 0xe5, 0x65, 0xc1, 0x83, 0xb7, 0xc8, 0xb7, 0x61, 0x51, 0xde, 0x8d, 0x25,
+	0xa8, 0xa4, 0x6e, 0x32, 0x16, 0x65, 0x86, 0xeb, 0x99, 0x34, 0x14, 0x51, 0xff, 0x2b, 0x96, 0xe6,
+	0x08, 0xde, 0xe7, 0x28, 0xa6, 0x4d, 0x87, 0x98, 0x9c, 0x19, 0xe2, 0x6d, 0xc8, 0x72, 0x6b, 0x86,
+	0x2f, 0xcf, 0xb2, 0x45, 0x6a, 0x90, 0xf5, 0x98, 0xee, 0xbb, 0x4e, 0x29, 0x7d, 0x7d, 0x7c, 0x3a,
+	0x3b, 0x3a, 0x7c, 0x9a, 0xf7, 0xf1, 0x71, 0x42, 0x08, 0xce, 0x9a, 0x33, 0xf3, 0xfa, 0xe6, 0x54,
+	0x7f, 0x98, 0x80, 0xac, 0xd0, 0x45, 0x2a, 0x90, 0xfe, 0x5e, 0xb7, 0xdd, 0x09, 0x53, 0xfe, 0x69,
+	0x87, 0xc8, 0x45, 0xdf, 0x42, 0xbe, 0x02, 0x8b, 0x3c, 0xed, 0xaa, 0x6d, 0x2b, 0x09, 0xf1, 0xfc,
+	0x37, 0x0b, 0xe2, 0x99, 0x97, 0x6e, 0xe3, 0xd3, 0x33, 0x4f, 0x85, 0xda, 0xdd, 0x8e, 0x92, 0x14,
+	0xcf, 0x89, 0x57, 0x80, 0xf2, 0x40, 0xcb, 0x7c, 0xe8, 0x6f, 0x13, 0xb0, 0x34, 0xbd, 0xfd, 0xd0,
+	0x97, 0xde, 0x85, 0xbc, 0x3f, 0xd9, 0xf3, 0x4f, 0xfd, 0x80, 0x8d, 0xc2, 0x87, 0xf4, 0x88, 0x40,
+	0xda, 0x90, 0x9f, 0x86, 0x53, 0xa2, 0xa2, 0x33, 0x3f, 0xe4, 0x8e, 0xeb, 0xac, 0x46, 0x31, 0x16,
+	0x9d, 0x4a, 0x87, 0xf1, 0xb3, 0xf8, 0xda, 0x22, 0x75, 0x28, 0xc2, 0x3b, 0x5b, 0x1f, 0xf1, 0x3a,
+	0x23, 0x2e, 0x29, 0x5f, 0x93, 0x34, 0x2d, 0x48, 0x1a, 0xda, 0x50, 0x55, 0x21, 0x1f, 0x29, 0xc3,
+	0x4a, 0x7e, 0xad, 0xd5, 0xd7, 0x3e, 0xbc, 0xff, 0x50, 0x7b, 0xd2, 0xd8, 0x51, 0x16, 0xe4, 0x9c,
+	0xfe, 0x32, 0x01, 0x4b, 0xf2, 0x6e, 0x96, 0x79, 0xf3, 0x9b, 0xb0, 0xe8, 0xe9, 0xfb, 0x41, 0x98,
+	0xd9, 0xa7, 0xc5, 0xfd, 0x80, 0xe1, 0x0e, 0x66, 0xf6, 0xc8, 0x9a, 0x9f, 0xd9, 0xc7, 0x3e, 0xed,
+	0x48, 0xbd, 0xf2, 0xd3, 0x8e, 0xf4, 0x2f, 0xe5, 0xd3, 0x0e, 0xf5, 0xb7, 0x00, 0xf0, 0x75, 0x71,
+	0x20, 0xaa, 0x9d, 0xf3, 0xea, 0x34, 0x98, 0x0b, 0x59, 0xe6, 0x4c, 0x2e, 0x84, 0x25, 0xef, 0x89,
+	0xc5, 0xab, 0xe1, 0x43, 0xcb, 0x2c, 0xa5, 0xa6, 0xac, 0x27, 0xc8, 0x1a, 0x5a, 0x66, 0xf4, 0x98,
+	0x99, 0xbe, 0xe9, 0x31, 0xf3, 0x3c, 0x01, 0x2b, 0x32, 0x07, 0x8c, 0x62, 0x91, 0xaf, 0x42, 0x5e,
+	0xa4, 0x83, 0xd3, 0xc2, 0x08, 0xff, 0x9c, 0x41, 0xe0, 0xda, 0x4d, 0x9a, 0x13, 0xec, 0x36, 0x3e,
+	0x73, 0x16, 0x24, 0x34, 0xf6, 0x19, 0x18, 0x08, 0x52, 0x07, 0x87, 0xff, 0x75, 0x48, 0xef, 0x5b,
+	0x36, 0x2b, 0xa5, 0xae, 0xbf, 0x4a, 0xa7, 0x06, 0xd8, 0x5a, 0xa0, 0x1c, 0x5d, 0xcf, 0x85, 0xe5,
+	0x60, 0x3e, 0x3e, 0x59, 0xbe, 0x89, 0x8f, 0x4f, 0x54, 0x72, 0xae, 0x8c, 0x4f, 0xe0, 0x70, 0x7c,
+	0x82, 0x2d, 0xc6, 0x27, 0xa1, 0xf1, 0xf1, 0x09, 0xd2, 0x2f, 0x65, 0x7c, 0xdb, 0x70, 0xbb, 0x6e,
+	0xeb, 0xc6, 0xa1, 0x6d, 0xf9, 0x01, 0x33, 0xe3, 0xb7, 0xd3, 0x7d, 0xc8, 0xce, 0x24, 0x6f, 0xaf,
+	0xf2, 0x11, 0x12, 0xa9, 0xfe, 0x4b, 0x02, 0x8a, 0x5b, 0x4c, 0xb7, 0x83, 0x83, 0x69, 0x89, 0x15,
+	0xfd, 0xb8, 0x0c, 0xdd, 0xf8, 0x6f, 0xf2, 0x0d, 0xc8, 0x45, 0x01, 0xfa, 0x8d, 0xcf, 0xb4, 0x11,
+	0x14, 0x5f, 0x00, 0xf1, 0x8c, 0xb9, 0x93, 0xb0, 0x68, 0xf0, 0xaa, 0x17, 0x40, 0x89, 0xc4, 0x70,
+	0xcd, 0x63, 0x3c, 0x22, 0xe7, 0x5b, 0x29, 0x43, 0xc3, 0x26, 0xf9, 0x36, 0x14, 0xf9, 0x03, 0x56,
+	0x98, 0x80, 0x64, 0x6e, 0xd2, 0x59, 0xe0, 0x70, 0x99, 0x7c, 0xfc, 0x4f, 0x02, 0xd6, 0x76, 0xf4,
+	0xd3, 0x3d, 0x26, 0xdd, 0x06, 0x33, 0xe5, 0x4d, 0xd0, 0x8b, 0xbb, 0x9b, 0x57, 0x3c, 0x69, 0xcf,
+	0x13, 0x9e, 0xef, 0x75, 0xc2, 0x42, 0x46, 0x32, 0x56, 0xc8, 0x58, 0x83, 0x8c, 0xe3, 0xe2, 0x77,
+	0x43, 0xc2, 0x17, 0x89, 0x86, 0x6a, 0xc5, 0x5d, 0x4d, 0x39, 0x7a, 0x6d, 0xe6, 0x6f, 0xc5, 0x1d,
+	0x37, 0x88, 0x7a, 0x23, 0x1f, 0x43, 0xb9, 0xdf, 0x6a, 0xd0, 0xd6, 0xa0, 0xde, 0xfd, 0xbe, 0xd6,
+	0xaf, 0x6d, 0xf7, 0x6b, 0xf7, 0xef, 0x69, 0xbd, 0xee, 0xf6, 0x27, 0x1f, 0x3e, 0xb8, 0xf7, 0x0d,
+	0x25, 0x51, 0xae, 0x9c, 0x9d, 0x57, 0xee, 0x76, 0x6a, 0x8d, 0x6d, 0x71, 0x62, 0xf6, 0xdc, 0x93,
+	0xbe, 0x6e, 0xfb, 0xfa, 0xfd, 0x7b, 0x3d, 0xd7, 0x3e, 0x45, 0x0c, 0x6e, 0xeb, 0x62, 0x3c, 0xfa,
+	0x8a, 0x07, 0xb4, 0x89, 0x6b, 0x03, 0xda, 0x69, 0x5c, 0x9c, 0xbc, 0x26, 0x2e, 0xde, 0x84, 0x35,
+	0xc3, 0x73, 0x7d, 0x5f, 0xc3, 0x2c, 0x9a, 0x99, 0x57, 0xf2, 0x74, 0x1e, 0x6a, 0x35, 0x90, 0xdf,
+	0xe7, 0x6c, 0xa9, 0x7e, 0xd5, 0x88, 0x91, 0x78, 0x4f, 0xea, 0x09, 0x90, 0xf8, 0xf0, 0x1a, 0x13,
+	0xcf, 0x77, 0x3d, 0xf2, 0x0e, 0xac, 0x84, 0x71, 0xa5, 0x66, 0x5a, 0x43, 0xb1, 0x31, 0xf1, 0x44,
+	0x2d, 0x87, 0xe4, 0x26, 0xa7, 0xf2, 0x84, 0x17, 0x03, 0x9a, 0x3d, 0xcc, 0x5b, 0x5f, 0xe7, 0x2d,
+	0x14, 0xd1, 0x75, 0x04, 0xab, 0x7f, 0x84, 0x6f, 0x0c, 0x9e, 0x75, 0x64, 0xd9, 0x6c, 0xc8, 0x7c,
+	0xf2, 0x0c, 0x56, 0x0c, 0x8f, 0x99, 0x98, 0x5d, 0xeb, 0xb6, 0xe6, 0x8f, 0x99, 0x21, 0x8f, 0xd3,
+	0xff, 0x9b, 0x9b, 0xa4, 0x44, 0x82, 0xd5, 0x46, 0x24, 0xd5, 0x1f, 0x33, 0x83, 0x2e, 0x1b, 0x33,
+	0x6d, 0xf2, 0x29, 0xac, 0xf8, 0xcc, 0xb6, 0x9c, 0xc9, 0x09, 0x7e, 0x99, 0x12, 0xb0, 0x93, 0xf0,
+	0xc9, 0xf6, 0x26, 0xbd, 0xfd, 0xd6, 0x36, 0x4a, 0x35, 0x84, 0x50, 0x9d, 0x5c, 0x5e, 0x6c, 0x2c,
+	0xcf, 0xd2, 0xe8, 0xb2, 0xd4, 0x2c, 0xdb, 0xe5, 0x0e, 0x2c, 0xcf, 0x8e, 0x86, 0xac, 0x49, 0xaf,
+	0xc3, 0xad, 0x17, 0x7a, 0x15, 0x72, 0x17, 0xdf, 0x85, 0x86, 0x96, 0x1f, 0x78, 0x62, 0x81, 0x91,
+	0x13, 0x51, 0xd0, 0xe7, 0x88, 0xaf, 0xd0, 0xca, 0xbf, 0x01, 0x57, 0x7a, 0xc4, 0x63, 0x6a, 0x5a,
+	0xbe, 0xbe, 0x27, 0x55, 0xe6, 0x68, 0xd8, 0xc4, 0xdd, 0x3f, 0xf1, 0xa3, 0x64, 0x8b, 0xff, 0x46,
+	0x1a, 0x8f, 0x9b, 0xe5, 0x37, 0x79, 0xf8, 0x3b, 0xfa, 0xb8, 0x37, 0x1d, 0xfb, 0xb8, 0x77, 0x0d,
+	0x32, 0x36, 0x3b, 0x62, 0xb6, 0x88, 0x58, 0xa9, 0x68, 0xbc, 0xf7, 0xf3, 0x14, 0xe4, 0xa3, 0xe7,
+	0x49, 0xbc, 0x83, 0xb0, 0x36, 0x2c, 0x4f, 0x49, 0x44, 0xef, 0xb0, 0x63, 0xf2, 0xe5, 0x69, 0x55,
+	0xf8, 0x63, 0xf1, 0x3d, 0x46, 0xc4, 0x0e, 0x2b, 0xc2, 0x6f, 0x41, 0xae, 0xd6, 0xef, 0xb7, 0x9f,
+	0x74, 0x5a, 0x4d, 0xe5, 0xb3, 0x44, 0xf9, 0x0b, 0x67, 0xe7, 0x95, 0xd5, 0x08, 0x54, 0xf3, 0xc5,
+	0x26, 0xe6, 0xa8, 0x46, 0xa3, 0xd5, 0xc3, 0xa7, 0xe4, 0x17, 0xc9, 0xab, 0x28, 0x5e, 0xe5, 0xe4,
+	0x5f, 0x55, 0xe5, 0x7b, 0xb4, 0xd5, 0xab, 0x51, 0xec, 0xf0, 0xb3, 0xa4, 0x08, 0xac, 0xa6, 0x3d,
+	0x7a, 0x6c, 0xac, 0x7b, 0xd8, 0xe7, 0x7a, 0xf8, 0x75, 0xe1, 0x8b, 0x94, 0xf8, 0xf2, 0x26, 0xc2,
+	0xe0, 0xe7, 0x7a, 0xa7, 0xd8, 0x1b, 0x7f, 0xe4, 0xe6, 0x6a, 0x52, 0x57, 0x7a, 0xeb, 0xa3, 0x0f,
+	0x43, 0x2d, 0x2a, 0x2c, 0xd2, 0xdd, 0x4e, 0x07, 0x41, 0x2f, 0xd2, 0x57, 0x66, 0x47, 0x27, 0x0e,
+	0x56, 0xb0, 0xc8, 0xdb, 0x90, 0x0b, 0xdf, 0xc0, 0x95, 0xcf, 0xd2, 0x57, 0x06, 0xd4, 0x08, 0x1f,
+	0xf0, 0x79, 0x87, 0x5b, 0xbb, 0x03, 0xfe, 0xf1, 0xe3, 0x8b, 0xcc, 0xd5, 0x0e, 0x0f, 0x26, 0x81,
+	0x89, 0x65, 0xf8, 0x4a, 0x54, 0x17, 0xff, 0x2c, 0x23, 0x8a, 0x88, 0x11, 0x46, 0x16, 0xc5, 0xdf,
+	0x82, 0x1c, 0x6d, 0x7d, 0x4f, 0x7c, 0x27, 0xf9, 0x22, 0x7b, 0x45, 0x0f, 0x65, 0xf8, 0x0d, 0xac,
+	0x40, 0x75, 0x69, 0x6f, 0xab, 0xc6, 0x4d, 0x7e, 0x15, 0xd5, 0xf5, 0xc6, 0x07, 0xba, 0xc3, 0xcc,
+	0xe9, 0xe7, 0x47, 0x11, 0xeb, 0xbd, 0x5f, 0x85, 0x5c, 0x98, 0x4d, 0x91, 0x75, 0xc8, 0x3e, 0xef,
+	0xd2, 0xa7, 0x2d, 0xaa, 0x2c, 0x08, 0x1b, 0x86, 0x9c, 0xe7, 0x22, 0xcb, 0xaf, 0xc0, 0xe2, 0x4e,
+	0xad, 0x53, 0x7b, 0xd2, 0xa2, 0xe1, 0x93, 0x55, 0x08, 0x90, 0x61, 0x5a, 0x59, 0x91, 0x1d, 0x44,
+	0x3a, 0xeb, 0xa5, 0x1f, 0xff, 0x6c, 0x7d, 0xe1, 0xa7, 0x3f, 0x5b, 0x5f, 0x78, 0x71, 0xb9, 0x9e,
+	0xf8, 0xf1, 0xe5, 0x7a, 0xe2, 0x27, 0x97, 0xeb, 0x89, 0x7f, 0xbe, 0x5c, 0x4f, 0xec, 0x65, 0xb9,
+	0xc7, 0x78, 0xf0, 0xbf, 0x03, 0x00, 0xaf, 0x4a, 0xeb, 0x50, 0x7b, 0x31, 0x00, 0x00,
 }
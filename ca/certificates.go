@@ -10,14 +10,19 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/asn1"
+	"encoding/hex"
 	"encoding/pem"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
 
+	cfconfig "github.com/cloudflare/cfssl/config"
 	cfcsr "github.com/cloudflare/cfssl/csr"
 	"github.com/cloudflare/cfssl/helpers"
 	"github.com/cloudflare/cfssl/initca"
@@ -90,6 +95,22 @@ func (r recoverableErr) Error() string {
 // certificate file does not exist.
 var ErrNoLocalRootCA = errors.New("local root CA certificate does not exist")
 
+const (
+	// MinSerialBitLength is the smallest number of random bits accepted for
+	// issued certificate serial numbers. Fewer bits make collisions between
+	// serials issued by the same CA likely.
+	MinSerialBitLength = 64
+	// MaxSerialBitLength is the largest number of random bits accepted for
+	// issued certificate serial numbers. RFC 5280 limits serial numbers to
+	// 20 octets, and they must be positive, so the top bit is always clear.
+	MaxSerialBitLength = 20*8 - 1
+
+	// DefaultClockSkewTolerance is how far the NotBefore time of issued
+	// certificates is backdated, to allow for nodes whose clocks are behind
+	// the CA's.
+	DefaultClockSkewTolerance = 5 * time.Minute
+)
+
 // ErrNoValidSigner is an error type used to indicate that our RootCA doesn't have the ability to
 // sign certificates.
 var ErrNoValidSigner = recoverableErr{err: errors.New("no valid signer found")}
@@ -124,6 +145,10 @@ type LocalSigner struct {
 	// just cached parsed values for validation, etc.
 	parsedCert   *x509.Certificate
 	cryptoSigner crypto.Signer
+
+	// certExpiry is the expiration the signer was created with, before
+	// SigningPolicy replaced one shorter than MinNodeCertExpiration.
+	certExpiry time.Duration
 }
 
 // RootCA is the representation of everything we need to sign certificates and/or to verify certificates
@@ -186,6 +211,116 @@ type RootCA struct {
 
 	// This signer will be nil if the node doesn't have the appropriate key material
 	signer *LocalSigner
+
+	// serialBits is the number of random bits in the serial numbers of
+	// issued certificates. Zero means the signer's default is used.
+	serialBits int
+
+	// clockSkew is how far the NotBefore time of issued certificates is
+	// backdated. Zero means DefaultClockSkewTolerance is used.
+	clockSkew time.Duration
+
+	// uriSAN, if set, is added as a URI subject alternative name to issued
+	// certificates.
+	uriSAN string
+
+	// dnsSANs are added as DNS subject alternative names to issued
+	// certificates.
+	dnsSANs []string
+
+	// ipSANs are added as IP address subject alternative names to issued
+	// certificates.
+	ipSANs []net.IP
+
+	// extensions are added to issued certificates, in addition to the ones
+	// the signer adds itself.
+	extensions []cfsigner.Extension
+
+	// ocspURL, if set, is added to issued certificates as the OCSP responder
+	// of their Authority Information Access extension.
+	ocspURL string
+
+	// extraOU, if set, is added to issued certificates as a second
+	// organizational unit, after the one holding the node's role.
+	extraOU string
+
+	// expiryMargin is how long before the signing certificate, or any of the
+	// intermediates, expires issued certificates must expire.
+	expiryMargin time.Duration
+
+	// expiryClamped, if set, is called with the NotAfter time of an issued
+	// certificate whose validity was cut short so that it doesn't outlive
+	// the certificates it chains through.
+	expiryClamped func(notAfter time.Time)
+
+	// minExpiry, if set, is the shortest expiration certificates are issued
+	// with. A signer configured with a shorter one has it raised, see
+	// signingExpiry.
+	minExpiry time.Duration
+
+	// expiryRaised, if set, is called with the expiration a certificate was
+	// issued with when it was raised to minExpiry.
+	expiryRaised func(expiry time.Duration)
+
+	// rand is the source of randomness for serial numbers, cross-signing and
+	// join token secrets. nil means crypto/rand.
+	rand io.Reader
+}
+
+// SetRandSource makes this root CA read the randomness for the serial numbers
+// of the certificates it signs, for cross-signing other CA certificates, and
+// for the secrets of the join tokens generated for it, from r instead of
+// crypto/rand. It does not cover private keys, which are generated by cfssl
+// (see GenerateNewCSR and CreateRootCA) with crypto/rand, nor the signatures
+// of node certificates, which cfssl also makes with crypto/rand.
+func (rca *RootCA) SetRandSource(r io.Reader) {
+	rca.rand = r
+}
+
+// RandSource returns the source of randomness of this root CA, which is
+// crypto/rand unless SetRandSource was called.
+func (rca *RootCA) RandSource() io.Reader {
+	if rca.rand == nil {
+		return cryptorand.Reader
+	}
+	return rca.rand
+}
+
+// SetSerialBitLength changes the number of random bits used for the serial
+// numbers of certificates signed by this root CA. bits must be between
+// MinSerialBitLength and MaxSerialBitLength.
+func (rca *RootCA) SetSerialBitLength(bits int) error {
+	if err := validateSerialBitLength(bits); err != nil {
+		return err
+	}
+	rca.serialBits = bits
+	return nil
+}
+
+// SetClockSkewTolerance changes how far the NotBefore time of certificates
+// signed by this root CA is backdated. The backdating is capped at half of the
+// certificate's validity period, so that issued certificates stay valid for
+// at least half of it.
+func (rca *RootCA) SetClockSkewTolerance(skew time.Duration) error {
+	if err := validateClockSkewTolerance(skew); err != nil {
+		return err
+	}
+	rca.clockSkew = skew
+	return nil
+}
+
+func validateClockSkewTolerance(skew time.Duration) error {
+	if skew <= 0 {
+		return errors.Errorf("clock skew tolerance must be positive, got %v", skew)
+	}
+	return nil
+}
+
+func validateSerialBitLength(bits int) error {
+	if bits < MinSerialBitLength || bits > MaxSerialBitLength {
+		return errors.Errorf("serial number length must be between %d and %d bits, got %d", MinSerialBitLength, MaxSerialBitLength, bits)
+	}
+	return nil
 }
 
 // Signer is an accessor for the local signer that returns an error if this root cannot sign.
@@ -370,14 +505,190 @@ func (rca *RootCA) ParseValidateAndSignCSR(csrBytes []byte, cn, ou, org string)
 	if err != nil {
 		return nil, err
 	}
-	cert, err := signer.Sign(signRequest)
+	if rca.extraOU != "" {
+		signRequest.Subject.Names = append(signRequest.Subject.Names, cfcsr.Name{OU: rca.extraOU})
+	}
+	signRequest.Hosts = append(signRequest.Hosts, rca.dnsSANs...)
+	for _, ip := range rca.ipSANs {
+		signRequest.Hosts = append(signRequest.Hosts, ip.String())
+	}
+	if rca.uriSAN != "" {
+		ext, err := subjectAltNameExtension(signRequest.Hosts, rca.uriSAN)
+		if err != nil {
+			return nil, err
+		}
+		signRequest.Extensions = append(signRequest.Extensions, ext)
+	}
+	signRequest.Extensions = append(signRequest.Extensions, rca.extensions...)
+	// An issued certificate is no use once the certificates it chains through
+	// expire, so its validity is cut short if it would outlive them.
+	expiry, raised := signingExpiry(signer, rca.minExpiry)
+	expiryChanged := expiry != signer.Policy().Default.Expiry
+	notAfter := rca.signerNotAfter(signer).Add(-rca.expiryMargin)
+	clamped := time.Now().Add(expiry).After(notAfter)
+	if clamped && !notAfter.After(time.Now()) {
+		return nil, errors.Errorf("the signing certificate expires too soon to sign a certificate, at %s", notAfter.Add(rca.expiryMargin))
+	}
+	var cfSigner cfsigner.Signer = signer
+	// The signer generates serial numbers with crypto/rand, so they are
+	// generated here if they must come from another source.
+	serialBits := rca.serialBits
+	if serialBits == 0 && rca.rand != nil {
+		serialBits = MaxSerialBitLength
+	}
+	if serialBits != 0 || rca.clockSkew != 0 || rca.uriSAN != "" || len(rca.extensions) != 0 || rca.ocspURL != "" || expiryChanged || clamped {
+		cfSigner, err = signer.withProfile(func(profile *cfconfig.SigningProfile) {
+			if expiryChanged {
+				profile.Expiry = expiry
+			}
+			if clamped {
+				profile.NotAfter = notAfter
+			}
+			if rca.ocspURL != "" {
+				profile.OCSP = rca.ocspURL
+			}
+			if serialBits != 0 {
+				profile.ClientProvidesSerialNumbers = true
+			}
+			if rca.uriSAN != "" || len(rca.extensions) != 0 {
+				whitelist := make(map[string]bool)
+				for oid, allowed := range profile.ExtensionWhitelist {
+					whitelist[oid] = allowed
+				}
+				if rca.uriSAN != "" {
+					whitelist[oidSubjectAltName.String()] = true
+				}
+				for _, ext := range rca.extensions {
+					whitelist[asn1.ObjectIdentifier(ext.ID).String()] = true
+				}
+				profile.ExtensionWhitelist = whitelist
+			}
+			if rca.clockSkew != 0 {
+				profile.Backdate = rca.clockSkew
+				if profile.Backdate > profile.Expiry/2 {
+					profile.Backdate = profile.Expiry / 2
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if serialBits != 0 {
+		if signRequest.Serial, err = randomSerial(rca.RandSource(), serialBits); err != nil {
+			return nil, errors.Wrap(err, "failed to generate serial number")
+		}
+	}
+	cert, err := cfSigner.Sign(signRequest)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to sign node certificate")
 	}
+	if raised && !clamped && rca.expiryRaised != nil {
+		rca.expiryRaised(expiry - CertBackdate)
+	}
+	if clamped && rca.expiryClamped != nil {
+		rca.expiryClamped(notAfter)
+	}
 
 	return append(cert, rca.Intermediates...), nil
 }
 
+// signerNotAfter returns when the first of the signing certificate and the
+// intermediates issued certificates chain through expires.
+func (rca *RootCA) signerNotAfter(signer *LocalSigner) time.Time {
+	notAfter := signer.parsedCert.NotAfter
+	intermediates, err := helpers.ParseCertificatesPEM(rca.Intermediates)
+	if err != nil {
+		// NewRootCA has already validated the intermediates
+		return notAfter
+	}
+	for _, intermediate := range intermediates {
+		if intermediate.NotAfter.Before(notAfter) {
+			notAfter = intermediate.NotAfter
+		}
+	}
+	return notAfter
+}
+
+// withProfile returns a signer with the same key and certificate as this one,
+// and a copy of its signing profile modified by update.
+func (ls *LocalSigner) withProfile(update func(*cfconfig.SigningProfile)) (cfsigner.Signer, error) {
+	policy := *ls.Policy()
+	profile := *policy.Default
+	update(&profile)
+	policy.Default = &profile
+	return local.NewSigner(ls.cryptoSigner, ls.parsedCert, cfsigner.DefaultSigAlgo(ls.cryptoSigner), &policy)
+}
+
+var oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+// subjectAltNameExtension returns a subject alternative name extension with the given DNS names and URI. The
+// signer only knows how to add DNS names, IP addresses and email addresses to a certificate, so a certificate
+// with a URI SAN needs the whole extension to be provided.
+func subjectAltNameExtension(hosts []string, uri string) (cfsigner.Extension, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil || !parsed.IsAbs() {
+		return cfsigner.Extension{}, errors.Errorf("invalid URI subject alternative name %q", uri)
+	}
+
+	// GeneralName tags, from RFC 5280 section 4.2.1.6
+	const (
+		tagDNSName   = 2
+		tagURI       = 6
+		tagIPAddress = 7
+	)
+	var names []asn1.RawValue
+	for _, name := range hosts {
+		// hosts that parse as IP addresses are IP SANs, as the signer
+		// would make them
+		if ip := net.ParseIP(name); ip != nil {
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			names = append(names, asn1.RawValue{Tag: tagIPAddress, Class: asn1.ClassContextSpecific, Bytes: ip})
+			continue
+		}
+		names = append(names, asn1.RawValue{Tag: tagDNSName, Class: asn1.ClassContextSpecific, Bytes: []byte(name)})
+	}
+	names = append(names, asn1.RawValue{Tag: tagURI, Class: asn1.ClassContextSpecific, Bytes: []byte(parsed.String())})
+	value, err := asn1.Marshal(names)
+	if err != nil {
+		return cfsigner.Extension{}, errors.Wrap(err, "failed to marshal subject alternative names")
+	}
+	return cfsigner.Extension{
+		ID:    cfconfig.OID(oidSubjectAltName),
+		Value: hex.EncodeToString(value),
+	}, nil
+}
+
+// utf8StringExtension returns a non-critical extension with the given OID
+// whose value is value, encoded as an ASN.1 UTF8String.
+func utf8StringExtension(oid asn1.ObjectIdentifier, value string) (cfsigner.Extension, error) {
+	encoded, err := asn1.MarshalWithParams(value, "utf8")
+	if err != nil {
+		return cfsigner.Extension{}, errors.Wrapf(err, "failed to marshal extension %s", oid)
+	}
+	return cfsigner.Extension{
+		ID:    cfconfig.OID(oid),
+		Value: hex.EncodeToString(encoded),
+	}, nil
+}
+
+// randomSerial returns a random positive serial number of at most bits bits,
+// read from rand.
+func randomSerial(rand io.Reader, bits int) (*big.Int, error) {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	for {
+		serial, err := cryptorand.Int(rand, limit)
+		if err != nil {
+			return nil, err
+		}
+		if serial.Sign() > 0 {
+			return serial, nil
+		}
+	}
+}
+
 // CrossSignCACertificate takes a CA root certificate and generates an intermediate CA from it signed with the current root signer
 func (rca *RootCA) CrossSignCACertificate(otherCAPEM []byte) ([]byte, error) {
 	signer, err := rca.Signer()
@@ -395,7 +706,7 @@ func (rca *RootCA) CrossSignCACertificate(otherCAPEM []byte) ([]byte, error) {
 		return nil, errors.New("certificate not a CA")
 	}
 
-	derBytes, err := x509.CreateCertificate(cryptorand.Reader, newCert, signer.parsedCert, newCert.PublicKey, signer.cryptoSigner)
+	derBytes, err := x509.CreateCertificate(rca.RandSource(), newCert, signer.parsedCert, newCert.PublicKey, signer.cryptoSigner)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not cross-sign new CA certificate using old CA material")
 	}
@@ -415,6 +726,25 @@ func validateSignatureAlgorithm(cert *x509.Certificate) error {
 	}
 }
 
+// signingExpiry returns the expiration, including the backdate, that signer
+// issues certificates with given the minimum expiration minExpiry, and whether
+// it was raised to the minimum. Once a minimum is set, it takes the place of
+// MinNodeCertExpiration: a shorter configured expiration is raised to the
+// minimum rather than replaced by DefaultNodeCertExpiration.
+func signingExpiry(signer *LocalSigner, minExpiry time.Duration) (time.Duration, bool) {
+	expiry := signer.Policy().Default.Expiry
+	if minExpiry == 0 {
+		return expiry, false
+	}
+	if signer.certExpiry > 0 && signer.certExpiry < MinNodeCertExpiration {
+		expiry = signer.certExpiry + CertBackdate
+	}
+	if expiry < minExpiry+CertBackdate {
+		return minExpiry + CertBackdate, true
+	}
+	return expiry, false
+}
+
 // NewRootCA creates a new RootCA object from unparsed PEM cert bundle and key byte
 // slices. key may be nil, and in this case NewRootCA will return a RootCA
 // without a signer.
@@ -483,6 +813,71 @@ func NewRootCA(rootCertBytes, signCertBytes, signKeyBytes []byte, certExpiry tim
 	return RootCA{signer: localSigner, Intermediates: intermediates, Digest: digest, Certs: rootCertBytes, Pool: pool}, nil
 }
 
+// VerifiedCertificate is the result of a successful RootCA.VerifyCertificate.
+type VerifiedCertificate struct {
+	// Leaf is the verified certificate.
+	Leaf *x509.Certificate
+	// Chains are the chains from Leaf to one of the root certificates.
+	Chains [][]*x509.Certificate
+
+	// NodeID, Role and Organization are the identity the certificate
+	// grants, taken from its CN, OU and O.
+	NodeID       string
+	Role         string
+	Organization string
+}
+
+// VerifyCertificate checks that the first certificate in certPEM chains up to
+// one of the root certificates. The other certificates in certPEM, and the
+// root CA's intermediates, may be used to build the chain, so during a root
+// rotation, certificates issued by both the old and the new root verify.
+func (rca *RootCA) VerifyCertificate(certPEM []byte) (*VerifiedCertificate, error) {
+	certs, err := helpers.ParseCertificatesPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate to verify")
+	}
+
+	intermediatePool := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediatePool.AddCert(cert)
+	}
+	if len(rca.Intermediates) > 0 {
+		intermediates, err := helpers.ParseCertificatesPEM(rca.Intermediates)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid intermediate chain")
+		}
+		for _, cert := range intermediates {
+			intermediatePool.AddCert(cert)
+		}
+	}
+
+	leaf := certs[0]
+	chains, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         rca.Pool,
+		Intermediates: intermediatePool,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "certificate does not chain to the root CA")
+	}
+
+	verified := &VerifiedCertificate{
+		Leaf:   leaf,
+		Chains: chains,
+		NodeID: leaf.Subject.CommonName,
+	}
+	if len(leaf.Subject.OrganizationalUnit) > 0 {
+		verified.Role = roleFromOUs(leaf.Subject.OrganizationalUnit)
+	}
+	if len(leaf.Subject.Organization) > 0 {
+		verified.Organization = leaf.Subject.Organization[0]
+	}
+	return verified, nil
+}
+
 // ValidateCertChain checks checks that the certificates provided chain up to the root pool provided.  In addition
 // it also enforces that every cert in the bundle certificates form a chain, each one certifying the one above,
 // as per RFC5246 section 7.4.2, and that every certificate (whether or not it is necessary to form a chain to the root
@@ -651,7 +1046,7 @@ func newLocalSigner(keyBytes, certBytes []byte, certExpiry time.Duration, rootPo
 		}
 	}
 
-	return &LocalSigner{Cert: certBytes, Key: keyBytes, Signer: signer, parsedCert: parsedCerts[0], cryptoSigner: priv}, nil
+	return &LocalSigner{Cert: certBytes, Key: keyBytes, Signer: signer, parsedCert: parsedCerts[0], cryptoSigner: priv, certExpiry: certExpiry}, nil
 }
 
 func ensureCertKeyMatch(cert *x509.Certificate, key crypto.PublicKey) error {
@@ -10,6 +10,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
+	mathrand "math/rand"
 	"net"
 	"os"
 	"sync"
@@ -224,6 +225,97 @@ func TestParseValidateAndSignCSR(t *testing.T) {
 	assert.Len(t, checkLeafCert(t, signedCert, "rootCN", "CN", "OU", "ORG"), 1)
 }
 
+func TestParseValidateAndSignCSRSerialBitLength(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	require.Error(t, rootCA.SetSerialBitLength(0))
+	require.Error(t, rootCA.SetSerialBitLength(ca.MinSerialBitLength-1))
+	require.Error(t, rootCA.SetSerialBitLength(ca.MaxSerialBitLength+1))
+	require.NoError(t, rootCA.SetSerialBitLength(ca.MinSerialBitLength))
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	seen := make(map[string]struct{})
+	for i := 0; i < 10; i++ {
+		signedCert, err := rootCA.ParseValidateAndSignCSR(csr, "CN", "OU", "ORG")
+		require.NoError(t, err)
+
+		leaves := checkLeafCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
+		require.Len(t, leaves, 1)
+		serial := leaves[0].SerialNumber
+		require.Equal(t, 1, serial.Sign())
+		require.True(t, serial.BitLen() <= ca.MinSerialBitLength)
+		seen[serial.String()] = struct{}{}
+	}
+	require.Len(t, seen, 10)
+}
+
+func TestRootCARandSource(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+	require.Equal(t, cryptorand.Reader, rootCA.RandSource())
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// the same seed gives the same serial numbers and join tokens
+	serialsAndTokens := func(seed int64) ([]string, []string) {
+		rootCA.SetRandSource(mathrand.New(mathrand.NewSource(seed)))
+		var serials, tokens []string
+		for i := 0; i < 3; i++ {
+			signedCert, err := rootCA.ParseValidateAndSignCSR(csr, "CN", "OU", "ORG")
+			require.NoError(t, err)
+			leaves := checkLeafCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
+			require.Len(t, leaves, 1)
+			require.Equal(t, 1, leaves[0].SerialNumber.Sign())
+			serials = append(serials, leaves[0].SerialNumber.String())
+			tokens = append(tokens, ca.GenerateJoinToken(&rootCA))
+		}
+		return serials, tokens
+	}
+	serials, tokens := serialsAndTokens(1)
+	sameSerials, sameTokens := serialsAndTokens(1)
+	require.Equal(t, serials, sameSerials)
+	require.Equal(t, tokens, sameTokens)
+	otherSerials, otherTokens := serialsAndTokens(2)
+	require.NotEqual(t, serials, otherSerials)
+	require.NotEqual(t, tokens, otherTokens)
+}
+
+func TestParseValidateAndSignCSRClockSkewTolerance(t *testing.T) {
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	require.Error(t, rootCA.SetClockSkewTolerance(0))
+	require.Error(t, rootCA.SetClockSkewTolerance(-time.Minute))
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// the signer rounds the issuance time to the minute before backdating
+	skew := time.Hour
+	require.NoError(t, rootCA.SetClockSkewTolerance(skew))
+	issued := time.Now()
+	signedCert, err := rootCA.ParseValidateAndSignCSR(csr, "CN", "OU", "ORG")
+	require.NoError(t, err)
+	leaves := checkLeafCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
+	require.Len(t, leaves, 1)
+	backdate := issued.Sub(leaves[0].NotBefore)
+	require.True(t, backdate > skew-time.Minute && backdate < skew+time.Minute, "backdated by %v", backdate)
+
+	// backdating is capped at half of the validity period
+	require.NoError(t, rootCA.SetClockSkewTolerance(10*ca.DefaultNodeCertExpiration))
+	issued = time.Now()
+	signedCert, err = rootCA.ParseValidateAndSignCSR(csr, "CN", "OU", "ORG")
+	require.NoError(t, err)
+	leaves = checkLeafCert(t, signedCert, "rootCN", "CN", "OU", "ORG")
+	require.Len(t, leaves, 1)
+	remaining := leaves[0].NotAfter.Sub(issued)
+	require.True(t, remaining > ca.DefaultNodeCertExpiration/2-time.Minute, "valid for %v after issuance", remaining)
+}
+
 func TestParseValidateAndSignMaliciousCSR(t *testing.T) {
 	rootCA, err := ca.CreateRootCA("rootCN")
 	assert.NoError(t, err)
@@ -1550,6 +1642,59 @@ func TestRootCACrossSignCACertificate(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRootCAVerifyCertificate(t *testing.T) {
+	t.Parallel()
+
+	cert1, key1, err := cautils.CreateRootCertAndKey("rootCN")
+	require.NoError(t, err)
+	oldRootCA, err := ca.NewRootCA(cert1, cert1, key1, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+
+	cert2, key2, err := cautils.CreateRootCertAndKey("rootCN2")
+	require.NoError(t, err)
+	intermediate, err := oldRootCA.CrossSignCACertificate(cert2)
+	require.NoError(t, err)
+
+	// during a rotation, the old root is trusted, and certificates are signed by the new root
+	rotatingRootCA, err := ca.NewRootCA(cert1, intermediate, key2, ca.DefaultNodeCertExpiration, intermediate)
+	require.NoError(t, err)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	oldCert, err := oldRootCA.ParseValidateAndSignCSR(csr, "oldnode", ca.WorkerRole, "org")
+	require.NoError(t, err)
+	newCert, err := rotatingRootCA.ParseValidateAndSignCSR(csr, "newnode", ca.ManagerRole, "org")
+	require.NoError(t, err)
+	newLeaf, _ := pem.Decode(newCert)
+
+	for _, testcase := range []struct {
+		certPEM []byte
+		nodeID  string
+		role    string
+	}{
+		{certPEM: oldCert, nodeID: "oldnode", role: ca.WorkerRole},
+		{certPEM: newCert, nodeID: "newnode", role: ca.ManagerRole},
+		// the root CA's intermediates are used even if they aren't in the bundle
+		{certPEM: pem.EncodeToMemory(newLeaf), nodeID: "newnode", role: ca.ManagerRole},
+	} {
+		verified, err := rotatingRootCA.VerifyCertificate(testcase.certPEM)
+		require.NoError(t, err)
+		require.Equal(t, testcase.nodeID, verified.NodeID)
+		require.Equal(t, testcase.role, verified.Role)
+		require.Equal(t, "org", verified.Organization)
+		require.NotEmpty(t, verified.Chains)
+		chain := verified.Chains[0]
+		require.Equal(t, verified.Leaf, chain[0])
+		require.Equal(t, "rootCN", chain[len(chain)-1].Subject.CommonName)
+	}
+
+	// without the cross-signed intermediate, a certificate from the new root does not chain to the old one
+	_, err = oldRootCA.VerifyCertificate(pem.EncodeToMemory(newLeaf))
+	require.Error(t, err)
+	_, err = oldRootCA.VerifyCertificate([]byte("garbage"))
+	require.Error(t, err)
+}
+
 func concat(byteSlices ...[]byte) []byte {
 	var results []byte
 	for _, slice := range byteSlices {
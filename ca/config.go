@@ -1,10 +1,12 @@
 package ca
 
 import (
-	cryptorand "crypto/rand"
+	_ "crypto/sha512" // for digest.SHA512
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"io"
 	"math/big"
 	"math/rand"
 	"path/filepath"
@@ -36,6 +38,9 @@ const (
 
 	// DefaultRootCN represents the root CN that we should create roots CAs with by default
 	DefaultRootCN = "swarm-ca"
+	// DefaultIntermediateCN represents the CN that intermediate signing CAs are created with by default, which
+	// differs from the root's so that the two can be told apart
+	DefaultIntermediateCN = DefaultRootCN + "-intermediate"
 	// ManagerRole represents the Manager node type, and is used for authorization to endpoints
 	ManagerRole = "swarm-manager"
 	// WorkerRole represents the Worker node type, and is used for authorization to endpoints
@@ -49,8 +54,17 @@ const (
 	maxGeneratedSecretLength = 25
 	// ceil(log(2^256-1, 36))
 	base36DigestLen = 50
+	// ceil(log(2^512-1, 36))
+	base36SHA512DigestLen = 100
 )
 
+// joinTokenDigestLens is the length of the CA hash in a join token, for each
+// digest algorithm a join token can use.
+var joinTokenDigestLens = map[digest.Algorithm]int{
+	digest.SHA256: base36DigestLen,
+	digest.SHA512: base36SHA512DigestLen,
+}
+
 // RenewTLSExponentialBackoff sets the exponential backoff when trying to renew TLS certificates that have expired
 var RenewTLSExponentialBackoff = events.ExponentialBackoffConfig{
 	Base:   time.Second * 5,
@@ -176,6 +190,102 @@ func (s *SecurityConfig) IssuerInfo() *IssuerInfo {
 	return s.issuerInfo
 }
 
+// ServerTLSConfig returns a TLS config for a server presenting this node's
+// certificate, along with the intermediates needed to chain it to the root,
+// and verifying client certificates against the trusted roots. During a root
+// rotation, clients with certificates from either the old or the new root are
+// trusted. The certificate and roots are looked up on every handshake, so the
+// config keeps up with certificate renewals and root CA updates.
+func (s *SecurityConfig) ServerTLSConfig() *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := s.tlsMaterial()
+			return NewServerTLSConfig([]tls.Certificate{cert}, pool)
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// ClientTLSConfig returns a TLS config for a client presenting this node's
+// certificate, along with its intermediates, and verifying that the server
+// has a certificate for serverName from one of the trusted roots. During a
+// root rotation, servers with certificates from either the old or the new root
+// are trusted. Like ServerTLSConfig, the certificate and roots are looked up
+// on every handshake.
+func (s *SecurityConfig) ClientTLSConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := s.tlsMaterial()
+			return &cert, nil
+		},
+		// The root pool can't be changed once the config is in use, so the
+		// server's certificate is verified against the current one below
+		// instead.
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			_, pool := s.tlsMaterial()
+			return verifyPeerCertificate(rawCerts, pool, serverName)
+		},
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// tlsMaterial returns this node's certificate, with the root CA's
+// intermediates appended if it doesn't already include them, and the pool of
+// trusted roots. During a root rotation, the root CA only holds the old root,
+// but the external CA pool holds both the old and the new one.
+func (s *SecurityConfig) tlsMaterial() (tls.Certificate, *x509.CertPool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cert := *s.certificate
+	if len(cert.Certificate) == 1 && len(s.rootCA.Intermediates) > 0 {
+		chain := append([][]byte{}, cert.Certificate...)
+		rest := s.rootCA.Intermediates
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			chain = append(chain, block.Bytes)
+		}
+		cert.Certificate = chain
+	}
+	pool := s.externalCAClientRootPool
+	if pool == nil {
+		pool = s.rootCA.Pool
+	}
+	return cert, pool
+}
+
+// verifyPeerCertificate verifies the chain presented by a peer against the
+// roots in pool, checking that the leaf is valid for serverName.
+func verifyPeerCertificate(rawCerts [][]byte, pool *x509.CertPool, serverName string) error {
+	if len(rawCerts) == 0 {
+		return errors.New("no certificates presented")
+	}
+	var certs []*x509.Certificate
+	for _, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse certificate presented by peer")
+		}
+		certs = append(certs, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		DNSName:       serverName,
+		Roots:         pool,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
 // This function expects something else to have taken out a lock on the SecurityConfig.
 func (s *SecurityConfig) updateTLSCredentials(certificate *tls.Certificate, issuerInfo *IssuerInfo) error {
 	certs := []tls.Certificate{*certificate}
@@ -262,30 +372,88 @@ func NewConfigPaths(baseCertDir string) *SecurityConfigPaths {
 	}
 }
 
-// GenerateJoinToken creates a new join token.
+// GenerateJoinToken creates a new join token, with a SHA-256 hash of the root
+// CA certificate.
 func GenerateJoinToken(rootCA *RootCA) string {
+	token, err := GenerateJoinTokenWithAlgorithm(rootCA, digest.SHA256)
+	if err != nil {
+		panic(err)
+	}
+	return token
+}
+
+// GenerateJoinTokenWithAlgorithm creates a new join token, with a hash of the
+// root CA certificate computed with the given algorithm, which must be
+// digest.SHA256 or digest.SHA512. The length of the hash tells nodes joining
+// with the token which algorithm to verify the root CA certificate with.
+func GenerateJoinTokenWithAlgorithm(rootCA *RootCA, algorithm digest.Algorithm) (string, error) {
+	digestLen, ok := joinTokenDigestLens[algorithm]
+	if !ok {
+		return "", errors.Errorf("unsupported join token hash algorithm %q", algorithm)
+	}
+	caDigest := rootCA.Digest
+	if caDigest.Algorithm() != algorithm {
+		caDigest = algorithm.FromBytes(rootCA.Certs)
+	}
+
 	var secretBytes [generatedSecretEntropyBytes]byte
 
-	if _, err := cryptorand.Read(secretBytes[:]); err != nil {
+	if _, err := io.ReadFull(rootCA.RandSource(), secretBytes[:]); err != nil {
 		panic(fmt.Errorf("failed to read random bytes: %v", err))
 	}
 
 	var nn, digest big.Int
 	nn.SetBytes(secretBytes[:])
-	digest.SetString(rootCA.Digest.Hex(), 16)
-	return fmt.Sprintf("SWMTKN-1-%0[1]*s-%0[3]*s", base36DigestLen, digest.Text(joinTokenBase), maxGeneratedSecretLength, nn.Text(joinTokenBase))
+	digest.SetString(caDigest.Hex(), 16)
+	return fmt.Sprintf("SWMTKN-1-%0[1]*s-%0[3]*s", digestLen, digest.Text(joinTokenBase), maxGeneratedSecretLength, nn.Text(joinTokenBase)), nil
+}
+
+// JoinTokenHashAlgorithm returns the digest algorithm named by a CA config's
+// JoinTokenHashAlgorithm, which defaults to SHA-256 if empty.
+func JoinTokenHashAlgorithm(name string) (digest.Algorithm, error) {
+	if name == "" {
+		return digest.SHA256, nil
+	}
+	algorithm := digest.Algorithm(name)
+	if _, ok := joinTokenDigestLens[algorithm]; !ok {
+		return "", errors.Errorf("unsupported join token hash algorithm %q", name)
+	}
+	return algorithm, nil
+}
+
+// JoinTokenFingerprint returns the digest of the root CA certificate embedded
+// in a join token, and a fingerprint of the token's secret. The fingerprint
+// identifies the token, but the secret can't be recovered from it.
+func JoinTokenFingerprint(token string) (caHash digest.Digest, fingerprint digest.Digest, err error) {
+	caHash, err = getCAHashFromToken(token)
+	if err != nil {
+		return "", "", err
+	}
+	secret := token[strings.LastIndex(token, "-")+1:]
+	return caHash, digest.FromString(secret), nil
 }
 
 func getCAHashFromToken(token string) (digest.Digest, error) {
 	split := strings.Split(token, "-")
-	if len(split) != 4 || split[0] != "SWMTKN" || split[1] != "1" || len(split[2]) != base36DigestLen || len(split[3]) != maxGeneratedSecretLength {
+	if len(split) != 4 || split[0] != "SWMTKN" || split[1] != "1" || len(split[3]) != maxGeneratedSecretLength {
+		return "", errors.New("invalid join token")
+	}
+
+	var algorithm digest.Algorithm
+	for a, digestLen := range joinTokenDigestLens {
+		if len(split[2]) == digestLen {
+			algorithm = a
+		}
+	}
+	if algorithm == "" {
 		return "", errors.New("invalid join token")
 	}
 
 	var digestInt big.Int
 	digestInt.SetString(split[2], joinTokenBase)
 
-	return digest.Parse(fmt.Sprintf("sha256:%0[1]*s", 64, digestInt.Text(16)))
+	hexLen := algorithm.Size() * 2
+	return digest.Parse(fmt.Sprintf("%s:%0[2]*s", algorithm, hexLen, digestInt.Text(16)))
 }
 
 // DownloadRootCA tries to retrieve a remote root CA and matches the digest against the provided token.
@@ -22,6 +22,7 @@ import (
 	"github.com/docker/swarmkit/ca/testutils"
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/docker/swarmkit/watch"
+	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -55,6 +56,37 @@ func TestDownloadRootCASuccess(t *testing.T) {
 	require.Equal(t, tc.RootCA.Certs, rootCA.Certs)
 }
 
+func TestDownloadRootCASHA512Token(t *testing.T) {
+	tc := testutils.NewTestCA(t)
+	defer tc.Stop()
+
+	_, err := ca.GenerateJoinTokenWithAlgorithm(&tc.RootCA, digest.SHA384)
+	require.Error(t, err)
+
+	token, err := ca.GenerateJoinTokenWithAlgorithm(&tc.RootCA, digest.SHA512)
+	require.NoError(t, err)
+	require.Len(t, strings.Split(token, "-")[2], 100)
+
+	// Remove the CA cert
+	os.RemoveAll(tc.Paths.RootCA.Cert)
+
+	rootCA, err := ca.DownloadRootCA(tc.Context, tc.Paths.RootCA, token, tc.ConnBroker)
+	require.NoError(t, err)
+	require.Equal(t, tc.RootCA.Certs, rootCA.Certs)
+
+	// a SHA-512 token for another root doesn't match
+	otherRootCA, err := ca.CreateRootCA("other")
+	require.NoError(t, err)
+	otherToken, err := ca.GenerateJoinTokenWithAlgorithm(&otherRootCA, digest.SHA512)
+	require.NoError(t, err)
+
+	os.RemoveAll(tc.Paths.RootCA.Cert)
+
+	_, err = ca.DownloadRootCA(tc.Context, tc.Paths.RootCA, otherToken, tc.ConnBroker)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "remote CA does not match fingerprint.")
+}
+
 func TestDownloadRootCAWrongCAHash(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -398,6 +430,101 @@ func TestSecurityConfigUpdateRootCA(t *testing.T) {
 	}
 }
 
+// tlsHandshake completes a TLS handshake between a client and a server using the given configs.
+func tlsHandshake(clientConfig, serverConfig *tls.Config) error {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		server := tls.Server(conn, serverConfig)
+		err = server.Handshake()
+		if err == nil && len(server.ConnectionState().PeerCertificates) == 0 {
+			err = errors.New("client did not present a certificate")
+		}
+		serverErr <- err
+	}()
+
+	conn, err := tls.Dial("tcp", l.Addr().String(), clientConfig)
+	if err == nil {
+		conn.Close()
+	}
+	if serverErr := <-serverErr; serverErr != nil {
+		return serverErr
+	}
+	return err
+}
+
+func TestSecurityConfigTLSConfigsDuringRotation(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "test-security-config-tls-configs")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	oldCert, oldKey, err := testutils.CreateRootCertAndKey("old-root")
+	require.NoError(t, err)
+	oldRootCA, err := ca.NewRootCA(oldCert, oldCert, oldKey, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	newCert, newKey, err := testutils.CreateRootCertAndKey("new-root")
+	require.NoError(t, err)
+	newRootCA, err := ca.NewRootCA(newCert, newCert, newKey, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+
+	// the server has a certificate from the old root
+	serverSecConfig, err := oldRootCA.CreateSecurityConfig(context.Background(),
+		ca.NewKeyReadWriter(ca.NewConfigPaths(tempdir+"/server").Node, nil, nil), ca.CertificateRequestConfig{})
+	require.NoError(t, err)
+
+	// the client has already got a certificate from the new root, but still trusts the old root only
+	clientSecConfig, err := newRootCA.CreateSecurityConfig(context.Background(),
+		ca.NewKeyReadWriter(ca.NewConfigPaths(tempdir+"/client").Node, nil, nil), ca.CertificateRequestConfig{})
+	require.NoError(t, err)
+	oldRootOnly, err := ca.NewRootCA(oldCert, nil, nil, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	require.NoError(t, clientSecConfig.UpdateRootCA(&oldRootOnly, oldRootOnly.Pool))
+
+	serverConfig := serverSecConfig.ServerTLSConfig()
+	clientConfig := clientSecConfig.ClientTLSConfig(ca.ManagerRole)
+
+	// before the rotation starts, the server doesn't trust the client's certificate
+	require.Error(t, tlsHandshake(clientConfig, serverConfig))
+
+	// during the rotation, the server signs with the cross-signed new root, and trusts both roots
+	crossSigned, err := oldRootCA.CrossSignCACertificate(newCert)
+	require.NoError(t, err)
+	rotatingRootCA, err := ca.NewRootCA(oldCert, crossSigned, newKey, ca.DefaultNodeCertExpiration, crossSigned)
+	require.NoError(t, err)
+	bothRoots := x509.NewCertPool()
+	bothRoots.AppendCertsFromPEM(oldCert)
+	bothRoots.AppendCertsFromPEM(newCert)
+	require.NoError(t, serverSecConfig.UpdateRootCA(&rotatingRootCA, bothRoots))
+
+	// the configs built before the update pick it up
+	require.NoError(t, tlsHandshake(clientConfig, serverConfig))
+
+	// once the server has a certificate from the new root, it presents the cross-signed root along with it,
+	// so the client, which only trusts the old root, can still verify it
+	krw := ca.NewKeyReadWriter(ca.NewConfigPaths(tempdir+"/server-renewed").Node, nil, nil)
+	renewed, issuerInfo, err := rotatingRootCA.IssueAndSaveNewCertificates(krw, "server", ca.ManagerRole, "org")
+	require.NoError(t, err)
+	renewed.Certificate = renewed.Certificate[:1]
+	renewedSecConfig, err := ca.NewSecurityConfig(&rotatingRootCA, krw, renewed, issuerInfo)
+	require.NoError(t, err)
+	require.NoError(t, renewedSecConfig.UpdateRootCA(&rotatingRootCA, bothRoots))
+	require.NoError(t, tlsHandshake(clientConfig, renewedSecConfig.ServerTLSConfig()))
+
+	// the client checks the server name
+	require.Error(t, tlsHandshake(clientSecConfig.ClientTLSConfig("some-other-name"), serverConfig))
+}
+
 func TestSecurityConfigSetWatch(t *testing.T) {
 	tc := testutils.NewTestCA(t)
 	defer tc.Stop()
@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"sync"
@@ -27,15 +28,103 @@ import (
 // configured with no URLs to which it can proxy certificate signing requests.
 var ErrNoExternalCAURLs = errors.New("no external CA URLs")
 
+// SignRequestEncoder produces the body, and its content type, of the HTTP
+// request sent to an external CA for a signing request. Servers that are
+// compatible with the CFSSL API but expect a different request envelope can
+// be used by providing an encoder for it.
+type SignRequestEncoder func(req signer.SignRequest) (body []byte, contentType string, err error)
+
+// EncodeCFSSLSignRequest encodes a signing request as the JSON expected by the
+// CFSSL sign API. It is the default SignRequestEncoder.
+func EncodeCFSSLSignRequest(req signer.SignRequest) ([]byte, string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "unable to JSON-encode CFSSL signing request")
+	}
+	return body, "application/json", nil
+}
+
 // ExternalCA is able to make certificate signing requests to one of a list
 // remote CFSSL API endpoints.
 type ExternalCA struct {
 	ExternalRequestTimeout time.Duration
 
-	mu     sync.Mutex
-	rootCA *RootCA
-	urls   []string
-	client *http.Client
+	mu      sync.Mutex
+	rootCA  *RootCA
+	urls    []string
+	client  *http.Client
+	encoder SignRequestEncoder
+
+	// health records the outcome of the last request to each URL
+	health map[string]ExternalCAHealth
+
+	// inFlight, if set, holds a token for every signing request in flight,
+	// limiting how many there can be at once
+	inFlight chan struct{}
+
+	// adaptive, if set, replaces ExternalRequestTimeout as the timeout of
+	// each signing request
+	adaptive *adaptiveTimeout
+}
+
+// adaptiveTimeout is a request timeout that grows while the external CA
+// servers are slow to respond, and shrinks back once they respond quickly.
+type adaptiveTimeout struct {
+	mu       sync.Mutex
+	min, max time.Duration
+	current  time.Duration
+}
+
+func (a *adaptiveTimeout) get() time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.current
+}
+
+// observe adjusts the timeout after a request that succeeded in latency. A
+// request that took at least half the timeout doubles it, so that the next one
+// isn't cut off if the server gets slower still, and one that took less than a
+// quarter of it shrinks it by a quarter.
+func (a *adaptiveTimeout) observe(latency time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	switch {
+	case latency >= a.current/2:
+		a.grow()
+	case latency < a.current/4:
+		a.current -= a.current / 4
+		if a.current < a.min {
+			a.current = a.min
+		}
+	}
+}
+
+// timedOut doubles the timeout after a request that was cut off by it, so that
+// a server that has become slower than the timeout can still be reached.
+func (a *adaptiveTimeout) timedOut() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.grow()
+}
+
+// grow doubles the timeout, up to the maximum. a.mu must be held.
+func (a *adaptiveTimeout) grow() {
+	a.current *= 2
+	if a.current > a.max {
+		a.current = a.max
+	}
+}
+
+// ExternalCAHealth is the outcome of the last request made to an external CA
+// server.
+type ExternalCAHealth struct {
+	URL string
+	// Checked is when the server was last contacted. It is zero if the
+	// server hasn't been contacted yet.
+	Checked time.Time
+	// Err is the error the last request failed with, if it did.
+	Err string
 }
 
 // NewExternalCA creates a new ExternalCA which uses the given tlsConfig to
@@ -50,6 +139,8 @@ func NewExternalCA(rootCA *RootCA, tlsConfig *tls.Config, urls ...string) *Exter
 				TLSClientConfig: tlsConfig,
 			},
 		},
+		encoder: EncodeCFSSLSignRequest,
+		health:  make(map[string]ExternalCAHealth),
 	}
 }
 
@@ -58,11 +149,19 @@ func (eca *ExternalCA) Copy() *ExternalCA {
 	eca.mu.Lock()
 	defer eca.mu.Unlock()
 
+	health := make(map[string]ExternalCAHealth, len(eca.health))
+	for url, h := range eca.health {
+		health[url] = h
+	}
 	return &ExternalCA{
 		ExternalRequestTimeout: eca.ExternalRequestTimeout,
 		rootCA:                 eca.rootCA,
 		urls:                   eca.urls,
 		client:                 eca.client,
+		encoder:                eca.encoder,
+		health:                 health,
+		inFlight:               eca.inFlight,
+		adaptive:               eca.adaptive,
 	}
 }
 
@@ -88,6 +187,105 @@ func (eca *ExternalCA) UpdateURLs(urls ...string) {
 	eca.urls = urls
 }
 
+// UpdateRequestEncoder changes how signing requests are encoded for the
+// external CA servers. Passing nil restores EncodeCFSSLSignRequest.
+func (eca *ExternalCA) UpdateRequestEncoder(encoder SignRequestEncoder) {
+	eca.mu.Lock()
+	defer eca.mu.Unlock()
+
+	if encoder == nil {
+		encoder = EncodeCFSSLSignRequest
+	}
+	eca.encoder = encoder
+}
+
+// UpdateConcurrencyLimit limits the number of signing requests in flight to
+// the external CA servers to limit, so that a mass certificate rotation
+// doesn't overwhelm them. Further requests wait for one in flight to finish.
+// The limit applies to each request as a whole, whichever of the servers it
+// ends up being sent to. Copies made after this is called share the limit.
+// A limit of 0 removes it.
+func (eca *ExternalCA) UpdateConcurrencyLimit(limit int) {
+	eca.mu.Lock()
+	defer eca.mu.Unlock()
+
+	if limit <= 0 {
+		eca.inFlight = nil
+		return
+	}
+	eca.inFlight = make(chan struct{}, limit)
+}
+
+// UpdateAdaptiveTimeout makes the timeout of each signing request adapt to
+// how quickly the external CA servers respond, between min and max: it grows
+// after slow responses and requests that time out, and shrinks after fast
+// ones, starting from ExternalRequestTimeout. Requests that fail otherwise
+// don't change it. Copies made after this is called share the timeout. A max
+// of 0 goes back to using ExternalRequestTimeout for every request.
+func (eca *ExternalCA) UpdateAdaptiveTimeout(min, max time.Duration) {
+	eca.mu.Lock()
+	defer eca.mu.Unlock()
+
+	if max <= 0 {
+		eca.adaptive = nil
+		return
+	}
+	if min > max {
+		min = max
+	}
+	current := eca.ExternalRequestTimeout
+	if current < min {
+		current = min
+	} else if current > max {
+		current = max
+	}
+	eca.adaptive = &adaptiveTimeout{min: min, max: max, current: current}
+}
+
+// RequestTimeout returns the timeout the next signing request will be made
+// with.
+func (eca *ExternalCA) RequestTimeout() time.Duration {
+	eca.mu.Lock()
+	adaptive := eca.adaptive
+	eca.mu.Unlock()
+
+	if adaptive == nil {
+		return eca.ExternalRequestTimeout
+	}
+	return adaptive.get()
+}
+
+// Health returns the outcome of the last request made to each of the
+// configured external CA servers, in the order they are tried.
+func (eca *ExternalCA) Health() []ExternalCAHealth {
+	eca.mu.Lock()
+	defer eca.mu.Unlock()
+
+	health := make([]ExternalCAHealth, 0, len(eca.urls))
+	for _, url := range eca.urls {
+		h, ok := eca.health[url]
+		if !ok {
+			h = ExternalCAHealth{URL: url}
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+func (eca *ExternalCA) recordHealth(url string, err error) {
+	h := ExternalCAHealth{URL: url, Checked: time.Now()}
+	if err != nil {
+		h.Err = err.Error()
+	}
+
+	eca.mu.Lock()
+	defer eca.mu.Unlock()
+	if eca.health == nil {
+		eca.health = make(map[string]ExternalCAHealth)
+	}
+	eca.health[url] = h
+}
+
 // Sign signs a new certificate by proxying the given certificate signing
 // request to an external CFSSL API server.
 func (eca *ExternalCA) Sign(ctx context.Context, req signer.SignRequest) (cert []byte, err error) {
@@ -96,32 +294,98 @@ func (eca *ExternalCA) Sign(ctx context.Context, req signer.SignRequest) (cert [
 	eca.mu.Lock()
 	urls := eca.urls
 	client := eca.client
+	encoder := eca.encoder
+	inFlight := eca.inFlight
+	adaptive := eca.adaptive
 	eca.mu.Unlock()
 
 	if len(urls) == 0 {
 		return nil, ErrNoExternalCAURLs
 	}
 
-	csrJSON, err := json.Marshal(req)
+	if inFlight != nil {
+		select {
+		case inFlight <- struct{}{}:
+			defer func() { <-inFlight }()
+		case <-ctx.Done():
+			return nil, recoverableErr{err: errors.Wrap(ctx.Err(), "timed out waiting to send certificate signing request")}
+		}
+	}
+
+	body, contentType, err := encoder(req)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to JSON-encode CFSSL signing request")
+		return nil, err
 	}
 
 	// Try each configured proxy URL. Return after the first success. If
 	// all fail then the last error will be returned.
 	for _, url := range urls {
-		requestCtx, cancel := context.WithTimeout(ctx, eca.ExternalRequestTimeout)
-		cert, err = makeExternalSignRequest(requestCtx, client, url, csrJSON)
+		timeout := eca.ExternalRequestTimeout
+		if adaptive != nil {
+			timeout = adaptive.get()
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		cert, err = makeExternalSignRequest(requestCtx, client, url, body, contentType)
+		// only the request's own deadline counts, not the caller giving up
+		timedOut := requestCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil
 		cancel()
+		eca.recordHealth(url, err)
 		if err == nil {
+			if adaptive != nil {
+				adaptive.observe(time.Since(start))
+			}
 			return append(cert, eca.rootCA.Intermediates...), err
 		}
+		if adaptive != nil && timedOut {
+			adaptive.timedOut()
+		}
 		logrus.Debugf("unable to proxy certificate signing request to %s: %s", url, err)
 	}
 
 	return nil, err
 }
 
+// Prime opens connections to the external CFSSL API servers, so that the first
+// signing request doesn't have to wait for them to be established. It returns
+// ErrNoExternalCAURLs if no servers are configured, and an error if none of
+// them can be reached.
+func (eca *ExternalCA) Prime(ctx context.Context) error {
+	eca.mu.Lock()
+	urls := eca.urls
+	client := eca.client
+	eca.mu.Unlock()
+
+	if len(urls) == 0 {
+		return ErrNoExternalCAURLs
+	}
+
+	var (
+		reached bool
+		err     error
+	)
+	for _, url := range urls {
+		requestCtx, cancel := context.WithTimeout(ctx, eca.ExternalRequestTimeout)
+		var resp *http.Response
+		resp, err = ctxhttp.Head(requestCtx, client, url)
+		cancel()
+		eca.recordHealth(url, err)
+		if err != nil {
+			logrus.Debugf("unable to connect to external CA %s: %s", url, err)
+			continue
+		}
+		// The response status doesn't matter, only that the connection is
+		// now open and can be reused.
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		reached = true
+	}
+	if !reached {
+		return errors.Wrap(err, "unable to connect to any external CA")
+	}
+	return nil
+}
+
 // CrossSignRootCA takes a RootCA object, generates a CA CSR, sends a signing request with the CA CSR to the external
 // CFSSL API server in order to obtain a cross-signed root
 func (eca *ExternalCA) CrossSignRootCA(ctx context.Context, rca RootCA) ([]byte, error) {
@@ -172,8 +436,8 @@ func (eca *ExternalCA) CrossSignRootCA(ctx context.Context, rca RootCA) ([]byte,
 	return eca.Sign(ctx, req)
 }
 
-func makeExternalSignRequest(ctx context.Context, client *http.Client, url string, csrJSON []byte) (cert []byte, err error) {
-	resp, err := ctxhttp.Post(ctx, client, url, "application/json", bytes.NewReader(csrJSON))
+func makeExternalSignRequest(ctx context.Context, client *http.Client, url string, reqBody []byte, contentType string) (cert []byte, err error) {
+	resp, err := ctxhttp.Post(ctx, client, url, contentType, bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, recoverableErr{err: errors.Wrap(err, "unable to perform certificate signing request")}
 	}
@@ -3,12 +3,16 @@ package ca_test
 import (
 	"context"
 	"crypto/x509"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	cfapi "github.com/cloudflare/cfssl/api"
 	"github.com/cloudflare/cfssl/helpers"
+	"github.com/cloudflare/cfssl/signer"
 	"github.com/docker/swarmkit/ca"
 	"github.com/docker/swarmkit/ca/testutils"
 	"github.com/stretchr/testify/require"
@@ -127,6 +131,263 @@ func TestExternalCASignRequestTimesOut(t *testing.T) {
 	}
 }
 
+func TestExternalCAPrime(t *testing.T) {
+	t.Parallel()
+
+	if testutils.External {
+		return // this does not require the external CA in any way
+	}
+
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	require.Equal(t, ca.ErrNoExternalCAURLs, ca.NewExternalCA(&rootCA, nil).Prime(context.Background()))
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer server.Close()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	externalCA := ca.NewExternalCA(&rootCA, nil, unreachable.URL)
+	require.Error(t, externalCA.Prime(context.Background()))
+
+	// priming succeeds as long as one of the servers can be reached, whatever its response
+	externalCA.UpdateURLs(unreachable.URL, server.URL)
+	require.NoError(t, externalCA.Prime(context.Background()))
+	require.NoError(t, externalCA.Prime(context.Background()))
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// the outcome of priming is recorded for each server
+	health := externalCA.Health()
+	require.Len(t, health, 2)
+	require.Equal(t, unreachable.URL, health[0].URL)
+	require.NotEmpty(t, health[0].Err)
+	require.Equal(t, server.URL, health[1].URL)
+	require.Empty(t, health[1].Err)
+	require.False(t, health[1].Checked.IsZero())
+}
+
+func TestExternalCARequestEncoder(t *testing.T) {
+	t.Parallel()
+
+	if testutils.External {
+		return // this does not require the external CA in any way
+	}
+
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	// this server expects the signing request to be wrapped in an envelope
+	type envelope struct {
+		Version     int                `json:"version"`
+		SignRequest signer.SignRequest `json:"sign_request"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req envelope
+		if r.Header.Get("Content-Type") != "application/vnd.envelope+json" ||
+			json.NewDecoder(r.Body).Decode(&req) != nil || req.Version != 2 || req.SignRequest.Subject == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		names := req.SignRequest.Subject.Names
+		cert, err := rootCA.ParseValidateAndSignCSR([]byte(req.SignRequest.Request), req.SignRequest.Subject.CN, names[0].OU, names[0].O)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(cfapi.NewSuccessResponse(map[string]string{"certificate": string(cert)}))
+	}))
+	defer server.Close()
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	signReq := ca.PrepareCSR(csr, "cn", ca.WorkerRole, "org")
+
+	// the default CFSSL encoding is rejected
+	externalCA := ca.NewExternalCA(&rootCA, nil, server.URL)
+	_, err = externalCA.Sign(context.Background(), signReq)
+	require.Error(t, err)
+
+	externalCA.UpdateRequestEncoder(func(req signer.SignRequest) ([]byte, string, error) {
+		body, err := json.Marshal(envelope{Version: 2, SignRequest: req})
+		return body, "application/vnd.envelope+json", err
+	})
+	cert, err := externalCA.Sign(context.Background(), signReq)
+	require.NoError(t, err)
+	verified, err := rootCA.VerifyCertificate(cert)
+	require.NoError(t, err)
+	require.Equal(t, "cn", verified.NodeID)
+
+	// copies keep the encoder
+	cert, err = externalCA.Copy().Sign(context.Background(), signReq)
+	require.NoError(t, err)
+	require.NotEmpty(t, cert)
+}
+
+func TestExternalCAConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+
+	if testutils.External {
+		return // this does not require the external CA in any way
+	}
+
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	const limit = 3
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+
+		var req signer.SignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		names := req.Subject.Names
+		cert, err := rootCA.ParseValidateAndSignCSR([]byte(req.Request), req.Subject.CN, names[0].OU, names[0].O)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(cfapi.NewSuccessResponse(map[string]string{"certificate": string(cert)}))
+	}))
+	defer server.Close()
+
+	externalCA := ca.NewExternalCA(&rootCA, nil, server.URL)
+	externalCA.UpdateConcurrencyLimit(limit)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	signReq := ca.PrepareCSR(csr, "cn", ca.WorkerRole, "org")
+
+	// requests from copies count against the same limit
+	copied := externalCA.Copy()
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		eca := externalCA
+		if i%2 == 1 {
+			eca = copied
+		}
+		go func() {
+			_, err := eca.Sign(context.Background(), signReq)
+			errs <- err
+		}()
+	}
+	for i := 0; i < 20; i++ {
+		require.NoError(t, <-errs)
+	}
+	require.True(t, atomic.LoadInt32(&maxInFlight) <= limit)
+
+	// a request waiting for a slot gives up when its context is done
+	externalCA.UpdateConcurrencyLimit(1)
+	slow := make(chan error, 1)
+	go func() {
+		_, err := externalCA.Sign(context.Background(), signReq)
+		slow <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = externalCA.Sign(ctx, signReq)
+	require.Error(t, err)
+	require.NoError(t, <-slow)
+}
+
+func TestExternalCAAdaptiveTimeout(t *testing.T) {
+	t.Parallel()
+
+	if testutils.External {
+		return // this does not require the external CA in any way
+	}
+
+	rootCA, err := ca.CreateRootCA("rootCN")
+	require.NoError(t, err)
+
+	var latency int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Duration(atomic.LoadInt64(&latency)))
+
+		var req signer.SignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		names := req.Subject.Names
+		cert, err := rootCA.ParseValidateAndSignCSR([]byte(req.Request), req.Subject.CN, names[0].OU, names[0].O)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(cfapi.NewSuccessResponse(map[string]string{"certificate": string(cert)}))
+	}))
+	defer server.Close()
+
+	externalCA := ca.NewExternalCA(&rootCA, nil, server.URL)
+	externalCA.ExternalRequestTimeout = 200 * time.Millisecond
+	externalCA.UpdateAdaptiveTimeout(100*time.Millisecond, 800*time.Millisecond)
+	require.Equal(t, 200*time.Millisecond, externalCA.RequestTimeout())
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	signReq := ca.PrepareCSR(csr, "cn", ca.WorkerRole, "org")
+	sign := func(delay time.Duration) {
+		atomic.StoreInt64(&latency, int64(delay))
+		_, err := externalCA.Sign(context.Background(), signReq)
+		require.NoError(t, err)
+	}
+
+	// slow responses raise the timeout, so that a response slower than the
+	// initial timeout still succeeds, up to the maximum
+	sign(150 * time.Millisecond)
+	require.Equal(t, 400*time.Millisecond, externalCA.RequestTimeout())
+	sign(300 * time.Millisecond)
+	require.Equal(t, 800*time.Millisecond, externalCA.RequestTimeout())
+	sign(500 * time.Millisecond)
+	require.Equal(t, 800*time.Millisecond, externalCA.RequestTimeout())
+
+	// fast responses lower it again, down to the minimum
+	sign(0)
+	require.Equal(t, 600*time.Millisecond, externalCA.RequestTimeout())
+	for i := 0; i < 10; i++ {
+		sign(0)
+	}
+	require.Equal(t, 100*time.Millisecond, externalCA.RequestTimeout())
+
+	// requests cut off by the timeout raise it too, up to the maximum
+	timeOut := func(delay time.Duration) {
+		atomic.StoreInt64(&latency, int64(delay))
+		_, err := externalCA.Sign(context.Background(), signReq)
+		require.Error(t, err)
+	}
+	timeOut(150 * time.Millisecond)
+	require.Equal(t, 200*time.Millisecond, externalCA.RequestTimeout())
+	timeOut(time.Second)
+	require.Equal(t, 400*time.Millisecond, externalCA.RequestTimeout())
+	timeOut(time.Second)
+	require.Equal(t, 800*time.Millisecond, externalCA.RequestTimeout())
+	timeOut(time.Second)
+	require.Equal(t, 800*time.Millisecond, externalCA.RequestTimeout())
+
+	// copies share the timeout, and disabling it restores the fixed one
+	require.Equal(t, 800*time.Millisecond, externalCA.Copy().RequestTimeout())
+	externalCA.UpdateAdaptiveTimeout(0, 0)
+	require.Equal(t, 200*time.Millisecond, externalCA.RequestTimeout())
+}
+
 func TestExternalCACopy(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,95 @@
+package ca
+
+import (
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+)
+
+// inventoryDropped counts the issued certificates that were not exported to
+// an inventory sink because its buffer was full.
+var inventoryDropped = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "swarm_ca_inventory_dropped_total",
+	Help: "Number of issued certificates not exported to the inventory sink because its buffer was full.",
+})
+
+func init() {
+	prometheus.MustRegister(inventoryDropped)
+}
+
+// IssuedCertificate is the metadata of a certificate issued or renewed by the
+// CA server, as exported to an InventorySink.
+type IssuedCertificate struct {
+	NodeID string
+	Role   api.NodeRole
+	// Serial is the certificate's serial number, in hex.
+	Serial   string
+	Subject  string
+	NotAfter time.Time
+}
+
+// InventorySink receives the metadata of the certificates the CA server
+// issues, for example to keep an external certificate inventory up to date.
+type InventorySink interface {
+	// Export is called with the certificates issued since the previous
+	// call. Calls are never concurrent.
+	Export(ctx context.Context, certs []IssuedCertificate) error
+}
+
+// inventoryExporter buffers the certificates issued by the CA server and
+// hands them to the sink in batches, from a goroutine of its own, so that a
+// slow sink never holds up issuance.
+type inventoryExporter struct {
+	sink      InventorySink
+	batchSize int
+	queue     chan IssuedCertificate
+}
+
+func newInventoryExporter(sink InventorySink, bufferSize, batchSize int) *inventoryExporter {
+	return &inventoryExporter{
+		sink:      sink,
+		batchSize: batchSize,
+		queue:     make(chan IssuedCertificate, bufferSize),
+	}
+}
+
+// add queues cert to be exported. If the buffer is full, cert is dropped and
+// counted instead.
+func (e *inventoryExporter) add(cert IssuedCertificate) {
+	select {
+	case e.queue <- cert:
+	default:
+		inventoryDropped.Inc()
+	}
+}
+
+// run exports the queued certificates until ctx is cancelled. Each batch holds
+// whatever was queued while the previous one was being exported, up to the
+// batch size.
+func (e *inventoryExporter) run(ctx context.Context) {
+	for {
+		var batch []IssuedCertificate
+		select {
+		case cert := <-e.queue:
+			batch = append(batch, cert)
+		case <-ctx.Done():
+			return
+		}
+	fill:
+		for len(batch) < e.batchSize {
+			select {
+			case cert := <-e.queue:
+				batch = append(batch, cert)
+			default:
+				break fill
+			}
+		}
+
+		if err := e.sink.Export(ctx, batch); err != nil {
+			log.G(ctx).WithError(err).Errorf("failed to export %d issued certificates to the inventory", len(batch))
+		}
+	}
+}
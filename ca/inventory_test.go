@@ -0,0 +1,157 @@
+package ca_test
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cfssl/helpers"
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/ca"
+	cautils "github.com/docker/swarmkit/ca/testutils"
+	"github.com/docker/swarmkit/testutils"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+)
+
+type testInventorySink struct {
+	mu      sync.Mutex
+	certs   []ca.IssuedCertificate
+	batches int
+	// release, if set, blocks each export until it is closed
+	release chan struct{}
+}
+
+func (s *testInventorySink) Export(ctx context.Context, certs []ca.IssuedCertificate) error {
+	if s.release != nil {
+		select {
+		case <-s.release:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.certs = append(s.certs, certs...)
+	s.batches++
+	return nil
+}
+
+func (s *testInventorySink) exported() []ca.IssuedCertificate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ca.IssuedCertificate(nil), s.certs...)
+}
+
+// issueWorkerCertificate issues a worker certificate, and returns the node ID
+// and the certificate.
+func issueWorkerCertificate(t *testing.T, tc *cautils.TestCA) (string, []byte) {
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	require.NoError(t, err)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	return issueResponse.NodeID, statusResponse.Certificate.Certificate
+}
+
+// scrapeInventoryDropped returns the value of the dropped certificates
+// counter, as exported by the default prometheus registry.
+func scrapeInventoryDropped(t *testing.T) float64 {
+	rec := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	require.NoError(t, err)
+	prometheus.UninstrumentedHandler().ServeHTTP(rec, req)
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "swarm_ca_inventory_dropped_total" {
+			value, err := strconv.ParseFloat(fields[1], 64)
+			require.NoError(t, err)
+			return value
+		}
+	}
+	t.Fatal("swarm_ca_inventory_dropped_total is not registered")
+	return 0
+}
+
+func TestInventorySink(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	sink := &testInventorySink{}
+	droppedBefore := scrapeInventoryDropped(t)
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetInventorySink(sink, 0, 10))
+	require.NoError(t, tc.CAServer.SetInventorySink(sink, 100, 10))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	issued := make(map[string][]byte)
+	for i := 0; i < 5; i++ {
+		nodeID, cert := issueWorkerCertificate(t, tc)
+		issued[nodeID] = cert
+	}
+
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		if n := len(sink.exported()); n != len(issued) {
+			return errors.Errorf("%d certificates exported, expected %d", n, len(issued))
+		}
+		return nil
+	}, 5*time.Second))
+
+	for _, exported := range sink.exported() {
+		cert, ok := issued[exported.NodeID]
+		require.True(t, ok, "unexpected node %s", exported.NodeID)
+		parsed, err := helpers.ParseCertificatesPEM(cert)
+		require.NoError(t, err)
+		assert.Equal(t, api.NodeRoleWorker, exported.Role)
+		assert.Equal(t, parsed[0].SerialNumber.Text(16), exported.Serial)
+		assert.Equal(t, parsed[0].Subject.String(), exported.Subject)
+		assert.Contains(t, exported.Subject, exported.NodeID)
+		assert.True(t, parsed[0].NotAfter.Equal(exported.NotAfter))
+	}
+
+	assert.Equal(t, droppedBefore, scrapeInventoryDropped(t))
+}
+
+func TestInventorySinkSlow(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	sink := &testInventorySink{release: make(chan struct{})}
+	droppedBefore := scrapeInventoryDropped(t)
+	tc.CAServer.Stop()
+	require.NoError(t, tc.CAServer.SetInventorySink(sink, 1, 10))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	// issuance isn't held up by the sink, which is blocked: the certificates
+	// that are being exported or buffered are kept, and the rest are dropped
+	for i := 0; i < 4; i++ {
+		issueWorkerCertificate(t, tc)
+	}
+	dropped := scrapeInventoryDropped(t) - droppedBefore
+	assert.True(t, dropped >= 1)
+
+	close(sink.release)
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		if n := len(sink.exported()); n+int(dropped) != 4 {
+			return errors.Errorf("%d certificates exported", n)
+		}
+		return nil
+	}, 5*time.Second))
+}
@@ -0,0 +1,42 @@
+package ca
+
+import (
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuanceQueueReservations(t *testing.T) {
+	q := newIssuanceQueue(2, 1, 1)
+	renewing := func(id string) *api.Node {
+		return &api.Node{
+			ID:          id,
+			Certificate: api.Certificate{Status: api.IssuanceStatus{State: api.IssuanceStateRenew}},
+		}
+	}
+
+	assert.True(t, q.reserve(laneRenewal, "a"))
+	assert.True(t, q.reserve(laneRenewal, "b"))
+	assert.False(t, q.reserve(laneRenewal, "c"))
+
+	// pushing a node nobody reserved a place for, or pushing a queued
+	// node again, doesn't give back the places held for other nodes
+	q.push(renewing("a"))
+	q.push(renewing("a"))
+	q.push(renewing("unreserved"))
+	assert.False(t, q.reserve(laneRenewal, "c"))
+
+	// the node's own place is given back once it is queued
+	q.push(renewing("b"))
+	assert.Equal(t, "a", q.pop().ID)
+	assert.False(t, q.reserve(laneRenewal, "c"))
+	assert.Equal(t, "unreserved", q.pop().ID)
+	assert.True(t, q.reserve(laneRenewal, "c"))
+
+	// removing a node drops its reservation
+	q.remove("c")
+	assert.True(t, q.reserve(laneRenewal, "d"))
+	q.unreserve(laneRenewal, "d")
+	assert.Equal(t, 0, q.numReserved[laneRenewal])
+}
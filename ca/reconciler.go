@@ -3,7 +3,10 @@ package ca
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"io"
 	"reflect"
 	"sync"
 	"time"
@@ -13,6 +16,9 @@ import (
 	"github.com/docker/swarmkit/api/equality"
 	"github.com/docker/swarmkit/log"
 	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/docker/swarmkit/watch"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 )
 
@@ -26,6 +32,37 @@ func hasIssuer(n *api.Node, info *IssuerInfo) bool {
 	return bytes.Equal(info.Subject, n.Description.TLSInfo.CertIssuerSubject) && bytes.Equal(info.PublicKey, n.Description.TLSInfo.CertIssuerPublicKey)
 }
 
+// reportedIssuerMatchesCert checks that the issuer a node reports in its TLS info is the issuer of the certificate
+// the CA recorded for it: the subject must be the certificate's issuer, and the public key must verify the
+// certificate's signature.  If the CA has no certificate recorded for the node, there is nothing to check against.
+func reportedIssuerMatchesCert(n *api.Node) bool {
+	if n.Description == nil || n.Description.TLSInfo == nil || len(n.Certificate.Certificate) == 0 {
+		return true
+	}
+	issued, err := helpers.ParseCertificatePEM(firstPEM(n.Certificate.Certificate))
+	if err != nil {
+		return false
+	}
+	if !bytes.Equal(issued.RawIssuer, n.Description.TLSInfo.CertIssuerSubject) {
+		return false
+	}
+	issuerKey, err := x509.ParsePKIXPublicKey(n.Description.TLSInfo.CertIssuerPublicKey)
+	if err != nil {
+		return false
+	}
+	issuer := &x509.Certificate{PublicKey: issuerKey}
+	return issuer.CheckSignature(issued.SignatureAlgorithm, issued.RawTBSCertificate, issued.Signature) == nil
+}
+
+// firstPEM returns the first PEM block in a bundle of certificates.
+func firstPEM(certs []byte) []byte {
+	block, _ := pem.Decode(certs)
+	if block == nil {
+		return certs
+	}
+	return pem.EncodeToMemory(block)
+}
+
 var errRootRotationChanged = errors.New("target root rotation has changed")
 
 // rootRotationReconciler keeps track of all the nodes in the store so that we can determine which ones need reconciliation when nodes are updated
@@ -42,10 +79,203 @@ type rootRotationReconciler struct {
 	currentIssuer    IssuerInfo
 	unconvergedNodes map[string]*api.Node
 
+	// events receives a TLSInfoMismatch for every node whose reported TLS info doesn't match its certificate,
+	// and a RootRotationCompleted whenever a root rotation is completed.  mismatchFlagged records the nodes a
+	// TLSInfoMismatch has been published for during the current root rotation, so that each is flagged only once.
+	events          *watch.Queue
+	mismatchFlagged map[string]struct{}
+
+	// rotationCompleted, if set, is called whenever a root rotation is completed
+	rotationCompleted func(RootRotationCompleted)
+
+	// nilTLSInfoGracePeriod is how long a node that reports no TLS info is left alone before it is told to
+	// rotate, in case it has just booted and will report its TLS info shortly.  nilTLSInfoSince records when
+	// each unconverged node was first seen without TLS info.
+	nilTLSInfoGracePeriod time.Duration
+	nilTLSInfoSince       map[string]time.Time
+
+	// expiredIntermediatePolicy controls whether nodes whose certificates chain through an expired intermediate
+	// are told to rotate ahead of the other nodes.  intermediateNotAfter records, for each unconverged node whose
+	// certificate chains through intermediates, when the first of them expires, and expiredFlagged records the
+	// nodes an ExpiredIntermediate has been published for during the current root rotation.
+	expiredIntermediatePolicy ExpiredIntermediatePolicy
+	intermediateNotAfter      map[string]time.Time
+	expiredFlagged            map[string]struct{}
+
+	// debugEvents, if set, makes each pass of the reconciliation loop publish a ReconciliationDecision to events
+	// for every unconverged node it considers
+	debugEvents bool
+
+	// rand, if set, is the source of randomness for the join tokens generated when a root rotation completes
+	rand io.Reader
+
+	// startAfter is when the server's startup delay ends.  Loops don't make any changes before then.
+	startAfter time.Time
+
+	// cursor is the progress of the current loop, if it has told any nodes to rotate.  handedOff is set once
+	// reconciliation has been handed off to another CA server, after which no loop is started.
+	cursor    *api.RootRotationCursor
+	handedOff bool
+
 	wg     sync.WaitGroup
 	cancel func()
 }
 
+// TLSInfoMismatch is published by the CA server when a node reports TLS info whose issuer doesn't match the
+// certificate the CA issued it.  Such a node is not considered to have converged on a new root.
+type TLSInfoMismatch struct {
+	NodeID string
+	// Reported is the issuer the node claims its certificate was issued by.
+	Reported IssuerInfo
+}
+
+// RootRotationCompleted is published by the CA server when it completes a root rotation, replacing the cluster's
+// root CA certificate with the one the cluster was rotating to.
+type RootRotationCompleted struct {
+	// OldRoot is the digest of the root CA certificate that was replaced.
+	OldRoot digest.Digest
+	// NewRoot is the digest of the root CA certificate that replaced it.
+	NewRoot digest.Digest
+}
+
+// ExpiredIntermediate is published by the CA server when the root rotation reconciliation loop finds a node whose
+// certificate chains through an intermediate, such as a root cross-signed by the old root, that has expired, so
+// that the node's certificate can no longer be validated against the old root.  It is published once for each such
+// node during a root rotation.
+type ExpiredIntermediate struct {
+	NodeID string
+	// NotAfter is when the intermediate expired.
+	NotAfter time.Time
+}
+
+// ReconciliationDecision is published by the CA server, if reconciliation debug events are enabled, for every
+// unconverged node the root rotation reconciliation loop considers on a pass, with what it decided to do with the
+// node and why.
+type ReconciliationDecision struct {
+	NodeID   string
+	OldState api.IssuanceStatus_State
+	// NewState is the same as OldState if the node was left alone.
+	NewState api.IssuanceStatus_State
+	Reason   string
+}
+
+// The reasons given in ReconciliationDecision events.
+const (
+	reasonNilTLSInfoGracePeriod = "node has not reported its TLS info, and is within the grace period"
+	reasonAlreadyRotating       = "node is already getting a new certificate"
+	reasonNilTLSInfo            = "node has not reported its TLS info"
+	reasonWrongIssuer           = "node's certificate is not from the target root"
+	reasonExpiredIntermediate   = "node's certificate chains through an expired intermediate"
+)
+
+// converged returns whether a node has a certificate from the given issuer, as reported in its TLS info and
+// confirmed against the certificate the CA recorded for it.  Nodes whose TLS info contradicts their recorded
+// certificate are flagged with a TLSInfoMismatch event, the first time it is found during the current root
+// rotation.  r.mu must be held.
+func (r *rootRotationReconciler) converged(n *api.Node, info *IssuerInfo) bool {
+	if !hasIssuer(n, info) {
+		return false
+	}
+	if !reportedIssuerMatchesCert(n) {
+		if _, flagged := r.mismatchFlagged[n.ID]; flagged {
+			return false
+		}
+		log.G(r.ctx).WithField("node.id", n.ID).Warn("node reported TLS info that does not match its issued certificate")
+		if r.mismatchFlagged == nil {
+			r.mismatchFlagged = make(map[string]struct{})
+		}
+		r.mismatchFlagged[n.ID] = struct{}{}
+		if r.events != nil {
+			r.events.Publish(TLSInfoMismatch{
+				NodeID: n.ID,
+				Reported: IssuerInfo{
+					Subject:   n.Description.TLSInfo.CertIssuerSubject,
+					PublicKey: n.Description.TLSInfo.CertIssuerPublicKey,
+				},
+			})
+		}
+		return false
+	}
+	return true
+}
+
+// trackUnconverged records n as not having converged on the current issuer, noting when the first intermediate
+// its certificate chains through expires, and when it was first seen without TLS info if there is a grace period
+// for such nodes.  r.mu must be held.
+func (r *rootRotationReconciler) trackUnconverged(n *api.Node, now time.Time) {
+	r.unconvergedNodes[n.ID] = n
+	if notAfter, ok := intermediateNotAfter(n); ok {
+		if r.intermediateNotAfter == nil {
+			r.intermediateNotAfter = make(map[string]time.Time)
+		}
+		r.intermediateNotAfter[n.ID] = notAfter
+	} else {
+		delete(r.intermediateNotAfter, n.ID)
+	}
+	if r.nilTLSInfoGracePeriod == 0 {
+		return
+	}
+	if n.Description != nil && n.Description.TLSInfo != nil {
+		delete(r.nilTLSInfoSince, n.ID)
+		return
+	}
+	if r.nilTLSInfoSince == nil {
+		r.nilTLSInfoSince = make(map[string]time.Time)
+	}
+	if _, ok := r.nilTLSInfoSince[n.ID]; !ok {
+		r.nilTLSInfoSince[n.ID] = now
+	}
+}
+
+// inNilTLSInfoGracePeriod returns whether n has reported no TLS info for less than the grace period, so it
+// shouldn't be told to rotate yet.  r.mu must be held.
+func (r *rootRotationReconciler) inNilTLSInfoGracePeriod(n *api.Node, now time.Time) bool {
+	since, ok := r.nilTLSInfoSince[n.ID]
+	return ok && now.Sub(since) < r.nilTLSInfoGracePeriod
+}
+
+// intermediateNotAfter returns when the first of the intermediates that follow the leaf in the certificate the CA
+// recorded for n expires, if there are any.
+func intermediateNotAfter(n *api.Node) (time.Time, bool) {
+	certs, err := helpers.ParseCertificatesPEM(n.Certificate.Certificate)
+	if err != nil || len(certs) < 2 {
+		return time.Time{}, false
+	}
+	notAfter := certs[1].NotAfter
+	for _, intermediate := range certs[2:] {
+		if intermediate.NotAfter.Before(notAfter) {
+			notAfter = intermediate.NotAfter
+		}
+	}
+	return notAfter, true
+}
+
+// expiredIntermediate returns whether n's certificate chains through an intermediate that has expired, publishing
+// an ExpiredIntermediate the first time it is found during the current root rotation.  r.mu must be held.
+func (r *rootRotationReconciler) expiredIntermediate(n *api.Node, now time.Time) bool {
+	notAfter, ok := r.intermediateNotAfter[n.ID]
+	if !ok || now.Before(notAfter) {
+		return false
+	}
+	if _, flagged := r.expiredFlagged[n.ID]; !flagged {
+		log.G(r.ctx).WithField("node.id", n.ID).Warnf("node's certificate chains through an intermediate that expired at %s", notAfter)
+		if r.expiredFlagged == nil {
+			r.expiredFlagged = make(map[string]struct{})
+		}
+		r.expiredFlagged[n.ID] = struct{}{}
+		if r.events != nil {
+			r.events.Publish(ExpiredIntermediate{NodeID: n.ID, NotAfter: notAfter})
+		}
+	}
+	return true
+}
+
+// isNoopRootRotation returns whether the root CA has a root rotation whose target is the root CA's current
+// certificate.  Such a rotation requires no node to get a new certificate.
+func isNoopRootRotation(rootCA *api.RootCA) bool {
+	return rootCA.RootRotation != nil && bytes.Equal(rootCA.RootRotation.CACert, rootCA.CACert)
+}
+
 // IssuerFromAPIRootCA returns the desired issuer given an API root CA object
 func IssuerFromAPIRootCA(rootCA *api.RootCA) (*IssuerInfo, error) {
 	wantedIssuer := rootCA.CACert
@@ -65,6 +295,39 @@ func IssuerFromAPIRootCA(rootCA *api.RootCA) (*IssuerInfo, error) {
 	}, nil
 }
 
+// NodeIssuerSummary counts the nodes of a cluster by the issuer of their certificates, as reported in their TLS
+// info, for example to follow the progress of a root rotation.
+type NodeIssuerSummary struct {
+	// CurrentRoot is the number of nodes with a certificate from the current root.
+	CurrentRoot int
+	// RotationRoot is the number of nodes with a certificate from the root being rotated to.
+	RotationRoot int
+	// Unknown is the number of nodes that have not reported their TLS info, or whose certificate is from
+	// another issuer.
+	Unknown int
+}
+
+// SummarizeNodeIssuers counts the nodes that are members of the cluster by the issuer they report for their
+// certificates: currentRoot, rotationRoot, or neither.  rotationRoot is nil if there is no root rotation in progress.
+func SummarizeNodeIssuers(tx store.ReadTx, currentRoot, rotationRoot *IssuerInfo) (NodeIssuerSummary, error) {
+	var summary NodeIssuerSummary
+	nodes, err := store.FindNodes(tx, store.ByMembership(api.NodeMembershipAccepted))
+	if err != nil {
+		return summary, err
+	}
+	for _, n := range nodes {
+		switch {
+		case rotationRoot != nil && hasIssuer(n, rotationRoot):
+			summary.RotationRoot++
+		case hasIssuer(n, currentRoot):
+			summary.CurrentRoot++
+		default:
+			summary.Unknown++
+		}
+	}
+	return summary, nil
+}
+
 // assumption:  UpdateRootCA will never be called with a `nil` root CA because the caller will be acting in response to
 // a store update event
 func (r *rootRotationReconciler) UpdateRootCA(newRootCA *api.RootCA) {
@@ -90,6 +353,30 @@ func (r *rootRotationReconciler) UpdateRootCA(newRootCA *api.RootCA) {
 		}
 	}()
 
+	// once reconciliation has been handed off, another CA server's loop converges the nodes
+	if r.handedOff {
+		return
+	}
+
+	// a rotation to the current root doesn't require any node to rotate, so start a loop that will clear it
+	// straight away
+	if isNoopRootRotation(newRootCA) {
+		r.unconvergedNodes = make(map[string]*api.Node)
+		r.intermediateNotAfter = nil
+		r.expiredFlagged = nil
+		r.mismatchFlagged = nil
+		shouldStartNewLoop = true
+		if r.cancel != nil {
+			r.cancel()
+			waitForPrevLoop = true
+		}
+		loopCtx, r.cancel = context.WithCancel(r.ctx)
+		r.cursor = nil
+		r.currentRootCA = newRootCA
+		r.currentIssuer = *issuerInfo
+		return
+	}
+
 	// check if the issuer has changed, first
 	if reflect.DeepEqual(&r.currentIssuer, issuerInfo) {
 		r.currentRootCA = newRootCA
@@ -109,9 +396,14 @@ func (r *rootRotationReconciler) UpdateRootCA(newRootCA *api.RootCA) {
 		// from here on out, there will be no more errors that cause us to have to abandon updating the Root CA,
 		// so we can start making changes to r's fields
 		r.unconvergedNodes = make(map[string]*api.Node)
+		r.nilTLSInfoSince = nil
+		r.intermediateNotAfter = nil
+		r.expiredFlagged = nil
+		r.mismatchFlagged = nil
+		now := time.Now()
 		for _, n := range nodes {
-			if !hasIssuer(n, issuerInfo) {
-				r.unconvergedNodes[n.ID] = n
+			if !r.converged(n, issuerInfo) {
+				r.trackUnconverged(n, now)
 			}
 		}
 		shouldStartNewLoop = true
@@ -120,6 +412,7 @@ func (r *rootRotationReconciler) UpdateRootCA(newRootCA *api.RootCA) {
 			waitForPrevLoop = true
 		}
 		loopCtx, r.cancel = context.WithCancel(r.ctx)
+		r.cursor = nil
 	} else {
 		r.unconvergedNodes = nil
 	}
@@ -136,10 +429,16 @@ func (r *rootRotationReconciler) UpdateNode(node *api.Node) {
 	if r.currentRootCA == nil || r.currentRootCA.RootRotation == nil || node.Spec.Membership != api.NodeMembershipAccepted {
 		return
 	}
-	if hasIssuer(node, &r.currentIssuer) {
+	// no node needs to rotate if the rotation's target is the current root
+	if isNoopRootRotation(r.currentRootCA) {
+		return
+	}
+	if r.converged(node, &r.currentIssuer) {
 		delete(r.unconvergedNodes, node.ID)
+		delete(r.nilTLSInfoSince, node.ID)
+		delete(r.intermediateNotAfter, node.ID)
 	} else {
-		r.unconvergedNodes[node.ID] = node
+		r.trackUnconverged(node, time.Now())
 	}
 }
 
@@ -148,11 +447,41 @@ func (r *rootRotationReconciler) UpdateNode(node *api.Node) {
 func (r *rootRotationReconciler) DeleteNode(node *api.Node) {
 	r.mu.Lock()
 	delete(r.unconvergedNodes, node.ID)
+	delete(r.nilTLSInfoSince, node.ID)
+	delete(r.intermediateNotAfter, node.ID)
 	r.mu.Unlock()
 }
 
 func (r *rootRotationReconciler) runReconcilerLoop(ctx context.Context, loopRootCA *api.RootCA) {
 	defer r.wg.Done()
+	// wait out the startup delay, in case this manager has only briefly been elected leader
+	if wait := r.startAfter.Sub(time.Now()); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+	if isNoopRootRotation(loopRootCA) {
+		err := r.store.Update(func(tx store.Tx) error {
+			return r.clearNoopRootRotation(tx, loopRootCA)
+		})
+		if err != nil {
+			log.G(r.ctx).WithError(err).Error("could not clear root rotation to the current root")
+			return
+		}
+		log.G(r.ctx).Info("cleared root rotation to the current root")
+		return
+	}
+	// if another CA server's loop handed off to this one, keep to its interval rather than telling another batch
+	// of nodes to rotate straight away
+	if wait := r.resumeDelay(loopRootCA); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
 	for {
 		r.mu.Lock()
 		if len(r.unconvergedNodes) == 0 {
@@ -163,6 +492,10 @@ func (r *rootRotationReconciler) runReconcilerLoop(ctx context.Context, loopRoot
 			})
 			if err == nil {
 				log.G(r.ctx).Info("completed root rotation")
+				r.notifyRotationCompleted(RootRotationCompleted{
+					OldRoot: digest.FromBytes(loopRootCA.CACert),
+					NewRoot: digest.FromBytes(loopRootCA.RootRotation.CACert),
+				})
 				return
 			}
 			log.G(r.ctx).WithError(err).Error("could not complete root rotation")
@@ -171,22 +504,76 @@ func (r *rootRotationReconciler) runReconcilerLoop(ctx context.Context, loopRoot
 				return
 			}
 		} else {
-			var toUpdate []*api.Node
+			var (
+				toUpdate  []*api.Node
+				decisions []ReconciliationDecision
+			)
+			decide := func(n *api.Node, newState api.IssuanceStatus_State, reason string) {
+				if r.debugEvents && r.events != nil {
+					decisions = append(decisions, ReconciliationDecision{
+						NodeID:   n.ID,
+						OldState: n.Certificate.Status.State,
+						NewState: newState,
+						Reason:   reason,
+					})
+				}
+			}
+			rotate := func(n *api.Node, reason string) {
+				decide(n, api.IssuanceStateRotate, reason)
+				n = n.Copy()
+				n.Certificate.Status.State = api.IssuanceStateRotate
+				toUpdate = append(toUpdate, n)
+			}
+			isRotating := func(n *api.Node) bool {
+				iState := n.Certificate.Status.State
+				return iState == api.IssuanceStateRenew || iState == api.IssuanceStatePending || iState == api.IssuanceStateRotate
+			}
+			now := time.Now()
+			// nodes whose certificates chain through an expired intermediate have a broken chain, so they go
+			// first, ahead of the rest of the batch
+			prioritized := make(map[string]struct{})
+			for id := range r.intermediateNotAfter {
+				n, ok := r.unconvergedNodes[id]
+				if !ok || !r.expiredIntermediate(n, now) || r.expiredIntermediatePolicy != ExpiredIntermediatePrioritize {
+					continue
+				}
+				if len(toUpdate) >= IssuanceStateRotateMaxBatchSize || isRotating(n) || r.inNilTLSInfoGracePeriod(n, now) {
+					continue
+				}
+				rotate(n, reasonExpiredIntermediate)
+				prioritized[id] = struct{}{}
+			}
 			for _, n := range r.unconvergedNodes {
+				if len(toUpdate) >= IssuanceStateRotateMaxBatchSize {
+					break
+				}
+				if _, ok := prioritized[n.ID]; ok {
+					continue
+				}
 				iState := n.Certificate.Status.State
-				if iState != api.IssuanceStateRenew && iState != api.IssuanceStatePending && iState != api.IssuanceStateRotate {
-					n = n.Copy()
-					n.Certificate.Status.State = api.IssuanceStateRotate
-					toUpdate = append(toUpdate, n)
-					if len(toUpdate) >= IssuanceStateRotateMaxBatchSize {
-						break
+				if r.inNilTLSInfoGracePeriod(n, now) {
+					decide(n, iState, reasonNilTLSInfoGracePeriod)
+					continue
+				}
+				if !isRotating(n) {
+					if n.Description == nil || n.Description.TLSInfo == nil {
+						rotate(n, reasonNilTLSInfo)
+					} else {
+						rotate(n, reasonWrongIssuer)
 					}
+				} else {
+					decide(n, iState, reasonAlreadyRotating)
 				}
 			}
 			r.mu.Unlock()
 
 			if err := r.batchUpdateNodes(toUpdate); err != nil {
 				log.G(r.ctx).WithError(err).Errorf("store error when trying to batch update %d nodes to request certificate rotation", len(toUpdate))
+			} else if len(toUpdate) > 0 {
+				r.recordBatch(loopRootCA)
+			}
+			for _, decision := range decisions {
+				r.events.Publish(decision)
 			}
 		}
 
@@ -198,6 +585,60 @@ func (r *rootRotationReconciler) runReconcilerLoop(ctx context.Context, loopRoot
 	}
 }
 
+// recordBatch updates the loop's progress after it has told a batch of nodes to rotate.
+func (r *rootRotationReconciler) recordBatch(loopRootCA *api.RootCA) {
+	lastBatch, err := gogotypes.TimestampProto(time.Now())
+	if err != nil {
+		return
+	}
+	r.mu.Lock()
+	r.cursor = &api.RootRotationCursor{
+		RotationDigest: digest.FromBytes(loopRootCA.RootRotation.CACert).String(),
+		LastBatch:      lastBatch,
+	}
+	r.mu.Unlock()
+}
+
+// resumeDelay returns how long a loop for the given root rotation should wait before its first pass, given the
+// cursor left in the cluster object by the loop of the CA server that handed off to this one, if any.
+func (r *rootRotationReconciler) resumeDelay(loopRootCA *api.RootCA) time.Duration {
+	var cursor *api.RootRotationCursor
+	r.store.View(func(tx store.ReadTx) {
+		if cluster := store.GetCluster(tx, r.clusterID); cluster != nil {
+			cursor = cluster.RootRotationCursor
+		}
+	})
+	if cursor == nil || cursor.RotationDigest != digest.FromBytes(loopRootCA.RootRotation.CACert).String() {
+		return 0
+	}
+	lastBatch, err := gogotypes.TimestampFromProto(cursor.LastBatch)
+	if err != nil {
+		return 0
+	}
+	wait := lastBatch.Add(r.batchUpdateInterval).Sub(time.Now())
+	if wait > r.batchUpdateInterval {
+		// the clocks of the two managers disagree
+		wait = r.batchUpdateInterval
+	}
+	return wait
+}
+
+// handoff stops the reconciliation loop, waiting for it to finish writing any batch of updates, and keeps another
+// from being started.  It returns the loop's progress, or nil if it hadn't told any nodes to rotate.
+func (r *rootRotationReconciler) handoff() *api.RootRotationCursor {
+	r.mu.Lock()
+	r.handedOff = true
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.mu.Unlock()
+	r.wg.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cursor
+}
+
 // This function assumes that the expected root CA has root rotation.  This is intended to be used by
 // `reconcileNodeRootsAndCerts`, which uses the root CA from the `lastSeenClusterRootCA`, and checks
 // that it has a root rotation before calling this function.
@@ -208,8 +649,12 @@ func (r *rootRotationReconciler) finishRootRotation(tx store.Tx, expectedRootCA
 	}
 
 	// If the RootCA object has changed (because another root rotation was started or because some other node
-	// had finished the root rotation), we cannot finish the root rotation that we were working on.
-	if !equality.RootCAEqualStable(expectedRootCA, &cluster.RootCA) {
+	// had finished the root rotation), we cannot finish the root rotation that we were working on.  Rotations
+	// queued behind this one don't affect it.
+	queued := cluster.RootCA.QueuedRotations
+	expected, current := *expectedRootCA, cluster.RootCA
+	expected.QueuedRotations, current.QueuedRotations = nil, nil
+	if !equality.RootCAEqualStable(&expected, &current) {
 		return errRootRotationChanged
 	}
 
@@ -224,17 +669,74 @@ func (r *rootRotationReconciler) finishRootRotation(tx store.Tx, expectedRootCA
 	if err != nil {
 		return errors.Wrap(err, "invalid cluster root rotation object")
 	}
+	updatedRootCA.rand = r.rand
+	tokenAlgorithm, err := JoinTokenHashAlgorithm(cluster.Spec.CAConfig.JoinTokenHashAlgorithm)
+	if err != nil {
+		log.G(r.ctx).WithError(err).Warn("using the default join token hash algorithm")
+		tokenAlgorithm = digest.SHA256
+	}
+	workerToken, err := GenerateJoinTokenWithAlgorithm(&updatedRootCA, tokenAlgorithm)
+	if err != nil {
+		return err
+	}
+	managerToken, err := GenerateJoinTokenWithAlgorithm(&updatedRootCA, tokenAlgorithm)
+	if err != nil {
+		return err
+	}
 	cluster.RootCA = api.RootCA{
 		CACert:     cluster.RootCA.RootRotation.CACert,
 		CAKey:      cluster.RootCA.RootRotation.CAKey,
 		CACertHash: updatedRootCA.Digest.String(),
 		JoinTokens: api.JoinTokens{
-			Worker:  GenerateJoinToken(&updatedRootCA),
-			Manager: GenerateJoinToken(&updatedRootCA),
+			Worker:  workerToken,
+			Manager: managerToken,
 		},
 		LastForcedRotation: cluster.RootCA.LastForcedRotation,
 	}
-	return store.UpdateCluster(tx, cluster)
+	// start the next queued root rotation, if there is one
+	if len(queued) > 0 {
+		cluster.RootCA.RootRotation = queued[0]
+		if len(queued) > 1 {
+			cluster.RootCA.QueuedRotations = queued[1:]
+		}
+	}
+	return store.UpdateClusterRootCA(tx, r.clusterID, &cluster.RootCA)
+}
+
+// clearNoopRootRotation removes a root rotation whose target is the cluster's current root, starting the next
+// queued root rotation if there is one.  The cluster's root CA is otherwise left alone, so a signing key that
+// the rotation object may have omitted is not lost.
+func (r *rootRotationReconciler) clearNoopRootRotation(tx store.Tx, expectedRootCA *api.RootCA) error {
+	cluster := store.GetCluster(tx, r.clusterID)
+	if cluster == nil {
+		return fmt.Errorf("unable to get cluster %s", r.clusterID)
+	}
+
+	queued := cluster.RootCA.QueuedRotations
+	expected, current := *expectedRootCA, cluster.RootCA
+	expected.QueuedRotations, current.QueuedRotations = nil, nil
+	if !equality.RootCAEqualStable(&expected, &current) {
+		return errRootRotationChanged
+	}
+
+	cluster.RootCA.RootRotation = nil
+	cluster.RootCA.QueuedRotations = nil
+	if len(queued) > 0 {
+		cluster.RootCA.RootRotation = queued[0]
+		if len(queued) > 1 {
+			cluster.RootCA.QueuedRotations = queued[1:]
+		}
+	}
+	return store.UpdateClusterRootCA(tx, r.clusterID, &cluster.RootCA)
+}
+
+func (r *rootRotationReconciler) notifyRotationCompleted(completed RootRotationCompleted) {
+	if r.events != nil {
+		r.events.Publish(completed)
+	}
+	if r.rotationCompleted != nil {
+		r.rotationCompleted(completed)
+	}
 }
 
 func (r *rootRotationReconciler) batchUpdateNodes(toUpdate []*api.Node) error {
@@ -2,18 +2,38 @@ package ca
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
 	"crypto/subtle"
 	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/Sirupsen/logrus"
+	cfcsr "github.com/cloudflare/cfssl/csr"
+	"github.com/cloudflare/cfssl/helpers"
+	cfsigner "github.com/cloudflare/cfssl/signer"
+	"github.com/docker/go-events"
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/api/equality"
 	"github.com/docker/swarmkit/identity"
 	"github.com/docker/swarmkit/log"
 	"github.com/docker/swarmkit/manager/state/store"
+	"github.com/docker/swarmkit/protobuf/ptypes"
+	"github.com/docker/swarmkit/watch"
 	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
@@ -23,8 +43,251 @@ import (
 const (
 	defaultReconciliationRetryInterval = 10 * time.Second
 	defaultRootReconciliationInterval  = 3 * time.Second
+
+	// defaultMaxCSRSize is far larger than any CSR a node generates, but
+	// bounds how much we'll accept before trying to parse it.
+	defaultMaxCSRSize = 64 << 10
+
+	// defaultRenewalFraction is the fraction of a certificate's validity
+	// period after which nodes are told to renew it.
+	defaultRenewalFraction = 0.5
+
+	// signerUnavailable prefixes the error recorded on a node whose
+	// certificate could not be issued in fail-fast mode because no signer
+	// was available.
+	signerUnavailable = "signer unavailable"
+
+	// policyRejected prefixes the error recorded on a node whose
+	// certificate was not issued because its attestation was rejected, its
+	// key didn't meet the key strength policy, or it failed a rule of the
+	// issuance policy.
+	policyRejected = "policy rejected"
+)
+
+// MaxIssuanceHistory is the number of issued certificates recorded in each
+// node's issuance history. Older records are dropped.
+const MaxIssuanceHistory = 10
+
+// AttestationVerifier checks the hardware attestation a node presented with
+// its CSR, before the CA signs it.
+type AttestationVerifier interface {
+	// VerifyAttestation returns an error if attestation does not prove
+	// that the key in csr belongs to the node's hardware. attestation is
+	// empty if the node didn't present one.
+	VerifyAttestation(ctx context.Context, nodeID string, csr, attestation []byte) error
+}
+
+// RenewalKeyPolicy controls whether a node may present a CSR for a new key when
+// it renews its certificate.
+type RenewalKeyPolicy int
+
+const (
+	// RenewalKeyAllowChange accepts a renewal CSR for any key. This is the
+	// default.
+	RenewalKeyAllowChange RenewalKeyPolicy = iota
+	// RenewalKeyPinned rejects a renewal CSR whose public key differs from
+	// the key of the node's current certificate, so that a stolen
+	// certificate can't be used to obtain one for an attacker's key.
+	RenewalKeyPinned
+)
+
+// ExpiredIntermediatePolicy controls how the root rotation reconciliation loop
+// handles nodes whose certificates chain through an intermediate, such as a
+// root cross-signed by the old root, that has expired. Such a node's chain is
+// broken for any node that still trusts only the old root.
+type ExpiredIntermediatePolicy int
+
+const (
+	// ExpiredIntermediatePrioritize tells such nodes to rotate ahead of the
+	// other nodes, in the loop's next batch. This is the default.
+	ExpiredIntermediatePrioritize ExpiredIntermediatePolicy = iota
+	// ExpiredIntermediateFlag leaves such nodes in the normal batch order,
+	// and only flags them.
+	ExpiredIntermediateFlag
+)
+
+// JoinAuthorization controls how the CA server authorizes new nodes to join
+// the cluster.
+type JoinAuthorization int
+
+const (
+	// JoinWithToken admits nodes that present a valid join token. This is
+	// the default.
+	JoinWithToken JoinAuthorization = iota
+	// JoinWithTokenOrApprovedKey also admits nodes without a valid join
+	// token whose CSR is for an approved key.
+	JoinWithTokenOrApprovedKey
+	// JoinWithApprovedKey admits only nodes whose CSR is for an approved
+	// key. Join tokens are not accepted.
+	JoinWithApprovedKey
+)
+
+// PublicKeyFingerprint returns the fingerprint of a public key, which is the
+// ID of its api.ApprovedKey: the hex-encoded SHA-256 digest of its
+// DER-encoded SubjectPublicKeyInfo.
+func PublicKeyFingerprint(pub crypto.PublicKey) (string, error) {
+	spki, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+	return spkiFingerprint(spki), nil
+}
+
+func spkiFingerprint(spki []byte) string {
+	return digest.FromBytes(spki).Hex()
+}
+
+// UnmatchedExternalCAPolicy controls how the CA server signs certificates when
+// the cluster has external CAs, but none of them signs with the current signing
+// certificate, for example during a rotation to a new root whose external CA
+// hasn't been added yet.
+type UnmatchedExternalCAPolicy int
+
+const (
+	// UnmatchedExternalCALocal signs with the local signer, if the server
+	// has the key of the current signing certificate, and otherwise leaves
+	// the node pending and retries, like any other unavailable signer. This
+	// is the default.
+	UnmatchedExternalCALocal UnmatchedExternalCAPolicy = iota
+	// UnmatchedExternalCAFail fails the issuance straight away.
+	UnmatchedExternalCAFail
+	// UnmatchedExternalCARetry never signs locally: the node is left
+	// pending, and signing is retried until a matching external CA is
+	// configured.
+	UnmatchedExternalCARetry
+)
+
+// RootPersistencePolicy sets what UpdateRootCA does when it can't save a new
+// cluster root CA to disk.
+type RootPersistencePolicy int
+
+const (
+	// RootPersistenceRequired leaves the server's root CA unchanged, and
+	// UpdateRootCA returns an error, so the update is retried the next time
+	// the cluster changes. This is the default.
+	RootPersistenceRequired RootPersistencePolicy = iota
+	// RootPersistenceBestEffort updates the server's root CA in memory
+	// anyway, logs an error and publishes a RootCANotPersisted event. This
+	// is meant for ephemeral test clusters, whose state doesn't need to
+	// survive a restart.
+	RootPersistenceBestEffort
 )
 
+// errNoMatchingExternalCA is the error recorded when the cluster's external CAs
+// don't sign with the current signing certificate, and the unmatched external
+// CA policy doesn't allow signing locally.
+var errNoMatchingExternalCA = errors.New("no external CA matches the current signing certificate")
+
+// KeyStrengthPolicy sets the minimum strength of the keys the CA server will
+// sign certificates for. The zero value accepts any key.
+type KeyStrengthPolicy struct {
+	// MinRSABits is the smallest RSA modulus accepted, in bits. Zero
+	// accepts RSA keys of any size.
+	MinRSABits int
+	// Curves lists the names of the elliptic curves accepted for ECDSA
+	// keys, such as "P-256". If it is empty, any curve is accepted.
+	Curves []string
+}
+
+// check returns an error if the key in csr doesn't meet the policy.
+func (p KeyStrengthPolicy) check(csr *x509.CertificateRequest) error {
+	switch pub := csr.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if bits := pub.N.BitLen(); bits < p.MinRSABits {
+			return errors.Errorf("RSA key size %d is below the minimum of %d bits", bits, p.MinRSABits)
+		}
+	case *ecdsa.PublicKey:
+		if len(p.Curves) == 0 {
+			return nil
+		}
+		name := pub.Curve.Params().Name
+		for _, curve := range p.Curves {
+			if curve == name {
+				return nil
+			}
+		}
+		return errors.Errorf("elliptic curve %s is not one of the accepted curves %v", name, p.Curves)
+	}
+	return nil
+}
+
+// RenewalStatePolicy lists the node availabilities and states in which the CA
+// server refuses to renew a node's certificate, for example so that nodes
+// being decommissioned don't get new credentials. The zero value refuses no
+// renewal.
+type RenewalStatePolicy struct {
+	// Availabilities lists the refused availabilities, such as
+	// api.NodeAvailabilityDrain.
+	Availabilities []api.NodeSpec_Availability
+	// States lists the refused states, such as api.NodeStatus_DOWN.
+	States []api.NodeStatus_State
+}
+
+// check returns an error if the policy refuses to renew node's certificate.
+func (p RenewalStatePolicy) check(node *api.Node) error {
+	for _, availability := range p.Availabilities {
+		if node.Spec.Availability == availability {
+			return errors.Errorf("node availability is %s", strings.ToLower(availability.String()))
+		}
+	}
+	for _, state := range p.States {
+		if node.Status.State == state {
+			return errors.Errorf("node state is %s", strings.ToLower(state.String()))
+		}
+	}
+	return nil
+}
+
+// IssuanceRule is a check a certificate request must pass before the CA server
+// signs it.
+type IssuanceRule struct {
+	// Name identifies the rule in the error recorded on the nodes it
+	// rejects.
+	Name string
+	// Check returns the reason the certificate requested by node with csr
+	// must not be issued, or nil to let the request through.
+	Check func(node *api.Node, csr *x509.CertificateRequest) error
+}
+
+// KeyStrengthRule returns an IssuanceRule that rejects CSRs whose keys don't
+// meet policy.
+func KeyStrengthRule(policy KeyStrengthPolicy) IssuanceRule {
+	return IssuanceRule{
+		Name: "key strength",
+		Check: func(node *api.Node, csr *x509.CertificateRequest) error {
+			return policy.check(csr)
+		},
+	}
+}
+
+// IssuancePolicy bundles the rules certificate requests are checked against,
+// such as key strength, SAN limits or CN formats. The rules are evaluated in
+// the order they were registered, and the first one to reject a request stops
+// it. The zero value has no rules, and lets every request through.
+type IssuancePolicy struct {
+	rules []IssuanceRule
+}
+
+// NewIssuancePolicy returns a policy made of the given rules.
+func NewIssuancePolicy(rules ...IssuanceRule) IssuancePolicy {
+	return IssuancePolicy{rules: append([]IssuanceRule(nil), rules...)}
+}
+
+// Register adds rule to the end of the policy.
+func (p *IssuancePolicy) Register(rule IssuanceRule) {
+	p.rules = append(p.rules, rule)
+}
+
+// check returns the error of the first rule that rejects the request.
+func (p IssuancePolicy) check(node *api.Node, csr *x509.CertificateRequest) error {
+	for _, rule := range p.rules {
+		if err := rule.Check(node, csr); err != nil {
+			return errors.Wrap(err, rule.Name)
+		}
+	}
+	return nil
+}
+
 // APISecurityConfigUpdater knows how to update a SecurityConfig from an api.Cluster object
 type APISecurityConfigUpdater interface {
 	UpdateRootCA(ctx context.Context, cluster *api.Cluster) error
@@ -45,29 +308,92 @@ type Server struct {
 	securityConfig              *SecurityConfig
 	joinTokens                  *api.JoinTokens
 	reconciliationRetryInterval time.Duration
+	maxCSRSize                  int
+	failFastIssuance            bool
+	externalCALocalFallback     bool
+	serialBits                  int
+	clockSkew                   time.Duration
+	rotationCompleted           func(RootRotationCompleted)
+	renewalFraction             float64
+	stuckRotationTimeout        time.Duration
+	nilTLSInfoGracePeriod       time.Duration
+	signerExpiryMargin          time.Duration
+	minCertExpiry               time.Duration
+	reconciliationDebugEvents   bool
+	checkDuplicateHostnames     bool
+	uriSANTemplate              string
+	trustDomain                 string
+	ocspResponderURL            string
+	metadataExtensionOID        asn1.ObjectIdentifier
+	metadataExtensionTemplate   string
+	addressSANRanges            []*net.IPNet
+	clusterDomain               string
+	roleOUFormat                string
+	renewalKeyPolicy            RenewalKeyPolicy
+	joinAuthorization           JoinAuthorization
+	expiredIntermediatePolicy   ExpiredIntermediatePolicy
+	unmatchedExternalCAPolicy   UnmatchedExternalCAPolicy
+	rootPersistencePolicy       RootPersistencePolicy
+	attestationVerifier         AttestationVerifier
+	keyStrengthPolicy           KeyStrengthPolicy
+	issuancePolicy              IssuancePolicy
+	renewalStatePolicy          RenewalStatePolicy
+	requiredNodeLabels          map[string]string
+	rand                        io.Reader
+	failureAlert                *issuanceFailureAlert
+	issuanceQueue               *issuanceQueue
+	inventory                   *inventoryExporter
 
 	// pending is a map of nodes with pending certificates issuance or
-	// renewal. They are indexed by node ID.
-	pending map[string]*api.Node
+	// renewal. They are indexed by node ID. It is guarded by pendingMu,
+	// because certificates are signed outside the Run loop when there is an
+	// issuance queue.
+	pending   map[string]*api.Node
+	pendingMu sync.Mutex
+
+	// waiting is the set of nodes whose certificates are left pending
+	// until they meet a policy, such as the required node labels, rather
+	// than waiting for a signer. It is guarded by pendingMu.
+	waiting map[string]struct{}
+
+	// rotating tracks the nodes whose certificates are in the rotate state,
+	// when a stuck rotation timeout is set. They are indexed by node ID.
+	rotating map[string]*rotatingNode
+
+	// hostnames tracks the hostnames of the accepted nodes, when duplicate
+	// hostname checks are enabled.
+	hostnames *hostnameTracker
 
 	// started is a channel which gets closed once the server is running
 	// and able to service RPCs.
 	started chan struct{}
 
 	// these are cached values to ensure we only update the security config when
-	// the cluster root CA and external CAs have changed - the cluster object
-	// can change for other reasons, and it would not be necessary to update
-	// the security config as a result
-	lastSeenClusterRootCA *api.RootCA
-	lastSeenExternalCAs   []*api.ExternalCA
+	// the cluster root CA, node certificate expiry and external CAs have
+	// changed - the cluster object can change for other reasons, and it would
+	// not be necessary to update the security config as a result
+	lastSeenClusterRootCA  *api.RootCA
+	lastSeenNodeCertExpiry *gogotypes.Duration
+	lastSeenExternalCAs    []*api.ExternalCA
 	secConfigMu           sync.Mutex
 
+	// rootNotPersisted is whether the current root CA couldn't be saved to
+	// disk, with RootPersistenceBestEffort. It is guarded by secConfigMu.
+	rootNotPersisted bool
+
 	// before we update the security config with the new root CA, we need to be able to save the root certs
 	rootPaths CertPaths
 
 	// lets us monitor and finish root rotations
 	rootReconciler                  *rootRotationReconciler
 	rootReconciliationRetryInterval time.Duration
+	rootReconciliationStartupDelay  time.Duration
+
+	// events publishes notable occurrences, such as a TLSInfoMismatch.
+	// watchesDone is closed when the server stops, to end the watches
+	// opened until then.
+	events      *watch.Queue
+	watchesDone chan struct{}
 }
 
 // DefaultCAConfig returns the default CA Config, with a default expiration.
@@ -83,10 +409,15 @@ func NewServer(store *store.MemoryStore, securityConfig *SecurityConfig, rootCAP
 		store:                           store,
 		securityConfig:                  securityConfig,
 		pending:                         make(map[string]*api.Node),
+		waiting:                         make(map[string]struct{}),
 		started:                         make(chan struct{}),
 		reconciliationRetryInterval:     defaultReconciliationRetryInterval,
 		rootReconciliationRetryInterval: defaultRootReconciliationInterval,
 		rootPaths:                       rootCAPaths,
+		maxCSRSize:                      defaultMaxCSRSize,
+		renewalFraction:                 defaultRenewalFraction,
+		events:                          watch.NewQueue(),
+		watchesDone:                     make(chan struct{}),
 	}
 }
 
@@ -102,184 +433,877 @@ func (s *Server) SetRootReconciliationInterval(interval time.Duration) {
 	s.rootReconciliationRetryInterval = interval
 }
 
-// GetUnlockKey is responsible for returning the current unlock key used for encrypting TLS private keys and
-// other at rest data.  Access to this RPC call should only be allowed via mutual TLS from managers.
-func (s *Server) GetUnlockKey(ctx context.Context, request *api.GetUnlockKeyRequest) (*api.GetUnlockKeyResponse, error) {
-	// This directly queries the store, rather than storing the unlock key and version on
-	// the `Server` object and updating it `updateCluster` is called, because we need this
-	// API to return the latest version of the key.  Otherwise, there might be a slight delay
-	// between when the cluster gets updated, and when this function returns the latest key.
-	// This delay is currently unacceptable because this RPC call is the only way, after a
-	// cluster update, to get the actual value of the unlock key, and we don't want to return
-	// a cached value.
-	resp := api.GetUnlockKeyResponse{}
-	s.store.View(func(tx store.ReadTx) {
-		cluster := store.GetCluster(tx, s.securityConfig.ClientTLSCreds.Organization())
-		resp.Version = cluster.Meta.Version
-		if cluster.Spec.EncryptionConfig.AutoLockManagers {
-			for _, encryptionKey := range cluster.UnlockKeys {
-				if encryptionKey.Subsystem == ManagerRole {
-					resp.UnlockKey = encryptionKey.Key
-					return
-				}
-			}
-		}
-	})
+// SetRootReconciliationStartupDelay makes root rotation reconciliation wait
+// for delay after Run is called before it tells any node to rotate, or
+// completes a root rotation, so that a manager that is only briefly elected
+// leader doesn't start rotations it won't see through. Ready isn't delayed,
+// and certificates are still issued in the meantime. Zero, the default, means
+// no delay. This function must be called before Run.
+func (s *Server) SetRootReconciliationStartupDelay(delay time.Duration) {
+	s.rootReconciliationStartupDelay = delay
+}
 
-	return &resp, nil
+// SetStuckRotationTimeout sets how long a node's certificate may stay in the
+// rotate state before the CA intervenes. Such a node is reset to the issued
+// state if its TLS info shows it already has a certificate from the current
+// issuer, and otherwise a NodeStuckInRotation event is published to Watch for
+// an operator to look into. A zero timeout, the default, leaves nodes in the
+// rotate state alone. This function must be called before Run.
+func (s *Server) SetStuckRotationTimeout(timeout time.Duration) {
+	s.stuckRotationTimeout = timeout
 }
 
-// NodeCertificateStatus returns the current issuance status of an issuance request identified by the nodeID
-func (s *Server) NodeCertificateStatus(ctx context.Context, request *api.NodeCertificateStatusRequest) (*api.NodeCertificateStatusResponse, error) {
-	if request.NodeID == "" {
-		return nil, grpc.Errorf(codes.InvalidArgument, codes.InvalidArgument.String())
+// SetNilTLSInfoGracePeriod sets how long, during a root rotation, a node that
+// reports no TLS info is left alone before it is told to rotate its
+// certificate. A node that has just booted may not have reported its TLS info
+// yet, and may turn out to have a certificate from the new root already. A
+// zero grace period, the default, tells such nodes to rotate straight away.
+// This function must be called before Run.
+func (s *Server) SetNilTLSInfoGracePeriod(period time.Duration) {
+	s.nilTLSInfoGracePeriod = period
+}
+
+// SetSignerExpiryMargin changes how long before the CA's signing certificate,
+// or a cross-signed intermediate it chains through, expires the certificates
+// it issues must expire. An issued certificate that would expire later has
+// its validity cut short, and a CertificateExpiryClamped event is published
+// to Watch. The default is no margin, so issued certificates expire no later
+// than the signing certificate. This only applies to certificates signed by
+// the local root CA, since external CAs choose the validity of the
+// certificates they sign. This function must be called before Run.
+func (s *Server) SetSignerExpiryMargin(margin time.Duration) error {
+	if margin < 0 {
+		return errors.Errorf("signer expiry margin must not be negative, got %s", margin)
 	}
+	s.signerExpiryMargin = margin
+	return nil
+}
 
-	serverCtx, err := s.isRunningLocked()
-	if err != nil {
-		return nil, err
+// SetMinCertExpiry sets the shortest expiration the CA issues certificates with,
+// so that a cluster accidentally configured with a very short expiration
+// doesn't have its nodes renewing their certificates constantly. Certificates
+// are issued with the minimum instead of a shorter configured expiration, and a
+// warning is logged. The signing certificate's expiry still takes precedence,
+// as with SetSignerExpiryMargin. This only applies to certificates signed by
+// the local root CA. A minimum takes the place of MinNodeCertExpiration: a
+// cluster expiration shorter than MinNodeCertExpiration is raised to the
+// minimum, rather than replaced by DefaultNodeCertExpiration. Zero, the
+// default, sets no minimum. This function must be called before Run.
+func (s *Server) SetMinCertExpiry(expiry time.Duration) error {
+	if expiry < 0 {
+		return errors.Errorf("minimum certificate expiry must not be negative, got %s", expiry)
 	}
+	s.minCertExpiry = expiry
+	return nil
+}
 
-	var node *api.Node
+// SetReconciliationDebugEvents enables or disables publishing a
+// ReconciliationDecision to Watch for every unconverged node the root rotation
+// reconciliation loop considers on each pass, to help debug rotations that do
+// not complete. It is disabled by default. This function must be called before
+// Run.
+func (s *Server) SetReconciliationDebugEvents(enabled bool) {
+	s.reconciliationDebugEvents = enabled
+}
 
-	event := api.EventUpdateNode{
-		Node:   &api.Node{ID: request.NodeID},
-		Checks: []api.NodeCheckFunc{api.NodeCheckID},
-	}
+// SetDuplicateHostnameCheck enables or disables flagging accepted nodes that
+// report the same hostname. When enabled, a DuplicateHostname event is
+// published to Watch, and a warning logged, whenever the set of accepted nodes
+// sharing a hostname changes. Certificates are still issued to these nodes. It
+// is disabled by default. This function must be called before Run.
+func (s *Server) SetDuplicateHostnameCheck(enabled bool) {
+	s.checkDuplicateHostnames = enabled
+}
 
-	// Retrieve the current value of the certificate with this token, and create a watcher
-	updates, cancel, err := store.ViewAndWatch(
-		s.store,
-		func(tx store.ReadTx) error {
-			node = store.GetNode(tx, request.NodeID)
-			return nil
-		},
-		event,
-	)
-	if err != nil {
-		return nil, err
+// SetURISANTemplate makes the local root CA add a URI subject alternative name,
+// such as a SPIFFE ID, to the certificates it signs. The URI is the template with
+// "{trust_domain}" replaced by trustDomain, and "{id}" and "{role}" replaced by
+// the node's ID and role, for example "spiffe://{trust_domain}/{role}/{id}".
+// It returns an error if the template does not expand to an absolute URI.
+// This function must be called before Run.
+func (s *Server) SetURISANTemplate(template, trustDomain string) error {
+	uri := expandURISANTemplate(template, trustDomain, "node-id", api.NodeRoleWorker)
+	if parsed, err := url.Parse(uri); err != nil || !parsed.IsAbs() {
+		return errors.Errorf("URI SAN template %q does not expand to an absolute URI", template)
 	}
-	defer cancel()
+	s.uriSANTemplate = template
+	s.trustDomain = trustDomain
+	return nil
+}
 
-	// This node ID doesn't exist
-	if node == nil {
-		return nil, grpc.Errorf(codes.NotFound, codes.NotFound.String())
+// SetOCSPResponderURL makes the local root CA add an Authority Information
+// Access extension to the certificates it signs, pointing clients at the OCSP
+// responder at responderURL. It returns an error if responderURL is not an
+// absolute HTTP or HTTPS URL. This function must be called before Run.
+func (s *Server) SetOCSPResponderURL(responderURL string) error {
+	parsed, err := url.Parse(responderURL)
+	if err != nil || !parsed.IsAbs() || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return errors.Errorf("OCSP responder URL %q is not an absolute HTTP or HTTPS URL", responderURL)
 	}
+	s.ocspResponderURL = responderURL
+	return nil
+}
 
-	log.G(ctx).WithFields(logrus.Fields{
-		"node.id": node.ID,
-		"status":  node.Certificate.Status,
-		"method":  "NodeCertificateStatus",
-	})
+// SetNodeMetadataExtension makes the local root CA add a non-critical
+// extension with the given OID, in dotted notation, to the certificates it
+// signs, so that services can authorize nodes from their certificate alone.
+// Its value is the template, encoded as an ASN.1 UTF8String, with "{id}",
+// "{role}" and "{org}" replaced by the node's ID and role, and the
+// organization (cluster ID), for example "{role}:{org}". Renewed certificates
+// carry the same value, as long as the node's role hasn't changed. It returns
+// an error if the OID doesn't parse, or is one of the standard certificate
+// extensions, which the signer manages. An empty OID, the default, adds no
+// extension. This function must be called before Run.
+func (s *Server) SetNodeMetadataExtension(oid, template string) error {
+	if oid == "" {
+		s.metadataExtensionOID = nil
+		s.metadataExtensionTemplate = ""
+		return nil
+	}
+	parsed, err := parseOID(oid)
+	if err != nil {
+		return err
+	}
+	if len(parsed) >= 3 && parsed[0] == 2 && parsed[1] == 5 && parsed[2] == 29 {
+		return errors.Errorf("OID %s is a standard certificate extension", oid)
+	}
+	if !utf8.ValidString(template) {
+		return errors.New("node metadata extension template is not valid UTF-8")
+	}
+	s.metadataExtensionOID = parsed
+	s.metadataExtensionTemplate = template
+	return nil
+}
 
-	// If this certificate has a final state, return it immediately (both pending and renew are transition states)
-	if isFinalState(node.Certificate.Status) {
-		return &api.NodeCertificateStatusResponse{
-			Status:      &node.Certificate.Status,
-			Certificate: &node.Certificate,
-		}, nil
+// parseOID parses an object identifier in dotted notation, such as
+// "1.3.6.1.4.1.99999.1".
+func parseOID(oid string) (asn1.ObjectIdentifier, error) {
+	parts := strings.Split(oid, ".")
+	if len(parts) < 2 {
+		return nil, errors.Errorf("invalid OID %q", oid)
+	}
+	parsed := make(asn1.ObjectIdentifier, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, errors.Errorf("invalid OID %q", oid)
+		}
+		parsed[i] = n
+	}
+	// the first two arcs are encoded together, which limits their values
+	if parsed[0] > 2 || (parsed[0] < 2 && parsed[1] >= 40) {
+		return nil, errors.Errorf("invalid OID %q", oid)
 	}
+	return parsed, nil
+}
 
-	log.G(ctx).WithFields(logrus.Fields{
-		"node.id": node.ID,
-		"status":  node.Certificate.Status,
-		"method":  "NodeCertificateStatus",
-	}).Debugf("started watching for certificate updates")
+// SetNodeAddressSANs makes the local root CA add the IP addresses of each node
+// that fall within one of allowedRanges, in CIDR notation, to the certificates
+// it signs as IP subject alternative names, so that TLS connections to the node
+// by IP address can be verified. The addresses are the one the node connects
+// to the dispatcher from and, for managers, the one they advertise to the
+// other managers, so a node's first certificate, issued before it connects,
+// has none. An empty list, the default, adds no addresses. It returns an error
+// if a range doesn't parse. This function must be called before Run.
+func (s *Server) SetNodeAddressSANs(allowedRanges []string) error {
+	var parsed []*net.IPNet
+	for _, r := range allowedRanges {
+		_, ipNet, err := net.ParseCIDR(r)
+		if err != nil {
+			return errors.Wrapf(err, "invalid address range %q", r)
+		}
+		parsed = append(parsed, ipNet)
+	}
+	s.addressSANRanges = parsed
+	return nil
+}
 
-	// Certificate is Pending or in an Unknown state, let's wait for changes.
-	for {
-		select {
-		case event := <-updates:
-			switch v := event.(type) {
-			case api.EventUpdateNode:
-				// We got an update on the certificate record. If the status is a final state,
-				// return the certificate.
-				if isFinalState(v.Node.Certificate.Status) {
-					cert := v.Node.Certificate.Copy()
-					return &api.NodeCertificateStatusResponse{
-						Status:      &cert.Status,
-						Certificate: cert,
-					}, nil
-				}
+// SetClusterDomain makes the local root CA add "{hostname}.{domain}", with the
+// hostname each node reports, to the certificates it signs as a DNS subject
+// alternative name, so that a node can be verified by the name service
+// discovery resolves it by. The hostname is lowercased, and must be a legal DNS
+// label, or no name is added. A node's first certificate, issued before it
+// reports its hostname, has no name, but its renewals each get the name again.
+// An empty domain, the default, adds no name. It returns an error if domain
+// isn't a legal DNS name. This function must be called before Run.
+func (s *Server) SetClusterDomain(domain string) error {
+	if domain != "" {
+		// the name must have room for at least a one letter hostname
+		if len(domain) > 253-2 {
+			return errors.Errorf("cluster domain %q is too long", domain)
+		}
+		for _, label := range strings.Split(domain, ".") {
+			if !isDNSLabel(label) {
+				return errors.Errorf("cluster domain %q is not a legal DNS name", domain)
 			}
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-serverCtx.Done():
-			return nil, s.ctx.Err()
 		}
 	}
+	s.clusterDomain = strings.ToLower(domain)
+	return nil
 }
 
-// IssueNodeCertificate is responsible for gatekeeping both certificate requests from new nodes in the swarm,
-// and authorizing certificate renewals.
-// If a node presented a valid certificate, the corresponding certificate is set in a RENEW state.
-// If a node failed to present a valid certificate, we check for a valid join token and set the
-// role accordingly. A new random node ID is generated, and the corresponding node entry is created.
-// IssueNodeCertificate is the only place where new node entries to raft should be created.
-func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNodeCertificateRequest) (*api.IssueNodeCertificateResponse, error) {
-	// First, let's see if the remote node is presenting a non-empty CSR
-	if len(request.CSR) == 0 {
-		return nil, grpc.Errorf(codes.InvalidArgument, codes.InvalidArgument.String())
+// isDNSLabel returns whether label is a legal DNS label: one to 63 letters,
+// digits and hyphens, neither starting nor ending with a hyphen.
+func isDNSLabel(label string) bool {
+	if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+		return false
 	}
-
-	if _, err := s.isRunningLocked(); err != nil {
-		return nil, err
+	for _, c := range label {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
 	}
+	return true
+}
 
-	var (
-		blacklistedCerts map[string]*api.BlacklistedCertificate
-		clusters         []*api.Cluster
-		err              error
-	)
-
-	s.store.View(func(readTx store.ReadTx) {
-		clusters, err = store.FindClusters(readTx, store.ByName("default"))
-
-	})
-
-	// Not having a cluster object yet means we can't check
-	// the blacklist.
-	if err == nil && len(clusters) == 1 {
-		blacklistedCerts = clusters[0].BlacklistedCertificates
+// nodeDNSSANs returns the DNS name of node in the cluster domain, if a cluster
+// domain is set and node has reported a hostname that is a legal DNS label.
+func (s *Server) nodeDNSSANs(ctx context.Context, node *api.Node) []string {
+	if s.clusterDomain == "" || node.Description == nil || node.Description.Hostname == "" {
+		return nil
 	}
-
-	// Renewing the cert with a local (unix socket) is always valid.
-	localNodeInfo := ctx.Value(LocalRequestKey)
-	if localNodeInfo != nil {
-		nodeInfo, ok := localNodeInfo.(RemoteNodeInfo)
-		if ok && nodeInfo.NodeID != "" {
-			return s.issueRenewCertificate(ctx, nodeInfo.NodeID, request.CSR)
-		}
+	hostname := strings.ToLower(node.Description.Hostname)
+	if !isDNSLabel(hostname) || len(hostname)+1+len(s.clusterDomain) > 253 {
+		log.G(ctx).WithFields(logrus.Fields{
+			"node.id":  node.ID,
+			"hostname": node.Description.Hostname,
+			"method":   "(*Server).signNodeCert",
+		}).Warn("node hostname is not a legal DNS label, so its certificate has no name in the cluster domain")
+		return nil
 	}
+	return []string{hostname + "." + s.clusterDomain}
+}
 
-	// If the remote node is a worker (either forwarded by a manager, or calling directly),
-	// issue a renew worker certificate entry with the correct ID
-	nodeID, err := AuthorizeForwardedRoleAndOrg(ctx, []string{WorkerRole}, []string{ManagerRole}, s.securityConfig.ClientTLSCreds.Organization(), blacklistedCerts)
-	if err == nil {
-		return s.issueRenewCertificate(ctx, nodeID, request.CSR)
+// nodeAddressSANs returns the known IP addresses of node that are allowed as
+// subject alternative names.
+func (s *Server) nodeAddressSANs(node *api.Node) []net.IP {
+	if len(s.addressSANRanges) == 0 {
+		return nil
+	}
+	addrs := []string{node.Status.Addr}
+	if node.ManagerStatus != nil {
+		if host, _, err := net.SplitHostPort(node.ManagerStatus.Addr); err == nil {
+			addrs = append(addrs, host)
+		}
 	}
 
-	// If the remote node is a manager (either forwarded by another manager, or calling directly),
-	// issue a renew certificate entry with the correct ID
-	nodeID, err = AuthorizeForwardedRoleAndOrg(ctx, []string{ManagerRole}, []string{ManagerRole}, s.securityConfig.ClientTLSCreds.Organization(), blacklistedCerts)
-	if err == nil {
-		return s.issueRenewCertificate(ctx, nodeID, request.CSR)
+	var ips []net.IP
+next:
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr)
+		if ip == nil || ip.IsUnspecified() {
+			continue
+		}
+		for _, seen := range ips {
+			if seen.Equal(ip) {
+				continue next
+			}
+		}
+		for _, allowed := range s.addressSANRanges {
+			if allowed.Contains(ip) {
+				ips = append(ips, ip)
+				break
+			}
+		}
 	}
+	return ips
+}
 
-	// The remote node didn't successfully present a valid MTLS certificate, let's issue a
-	// certificate with a new random ID
-	role := api.NodeRole(-1)
+func expandURISANTemplate(template, trustDomain, nodeID string, role api.NodeRole) string {
+	return strings.NewReplacer(
+		"{trust_domain}", trustDomain,
+		"{id}", nodeID,
+		"{role}", strings.ToLower(role.String()),
+	).Replace(template)
+}
 
-	s.mu.Lock()
-	if subtle.ConstantTimeCompare([]byte(s.joinTokens.Manager), []byte(request.Token)) == 1 {
-		role = api.NodeRoleManager
-	} else if subtle.ConstantTimeCompare([]byte(s.joinTokens.Worker), []byte(request.Token)) == 1 {
-		role = api.NodeRoleWorker
+// SetRoleOUFormat makes the CA server add a second organizational unit to the
+// certificates it signs, after the one that holds the node's role, for
+// authorizers outside the swarm that parse the OU. The OU is the format with
+// "{role}" replaced by the node's role, for example "acme-{role}" gives
+// "acme-manager" and "acme-worker". The role OU is kept, since it is what the
+// swarm itself authorizes on, so renewals with these certificates are still
+// recognized as renewals. If the format expands to the role OU, as
+// "swarm-{role}" does, it is only added once. It returns an error if the
+// format does not contain "{role}". This function must be called before Run.
+func (s *Server) SetRoleOUFormat(format string) error {
+	if !strings.Contains(format, "{role}") {
+		return errors.Errorf("OU format %q does not contain {role}", format)
 	}
-	s.mu.Unlock()
+	s.roleOUFormat = format
+	return nil
+}
 
-	if role < 0 {
-		return nil, grpc.Errorf(codes.InvalidArgument, "A valid join token is necessary to join this cluster")
+// roleOU returns the OU to add to a certificate for a node of the given role,
+// whose role OU is ou, or an empty string if there is none.
+func (s *Server) roleOU(role api.NodeRole, ou string) string {
+	if s.roleOUFormat == "" {
+		return ""
+	}
+	extra := strings.Replace(s.roleOUFormat, "{role}", strings.ToLower(role.String()), -1)
+	if extra == ou {
+		return ""
+	}
+	return extra
+}
+
+// SetRenewalKeyPolicy changes whether nodes may change their key when they
+// renew their certificates. This function must be called before Run.
+func (s *Server) SetRenewalKeyPolicy(policy RenewalKeyPolicy) {
+	s.renewalKeyPolicy = policy
+}
+
+// SetExpiredIntermediatePolicy changes how the root rotation reconciliation
+// loop handles nodes whose recorded certificates chain through an expired
+// intermediate. Whatever the policy, a warning is logged and an
+// ExpiredIntermediate event is published to Watch for each such node. This
+// function must be called before Run.
+func (s *Server) SetExpiredIntermediatePolicy(policy ExpiredIntermediatePolicy) {
+	s.expiredIntermediatePolicy = policy
+}
+
+// SetJoinAuthorization changes how new nodes are authorized to join the
+// cluster. With JoinWithTokenOrApprovedKey or JoinWithApprovedKey, a node
+// whose CSR is for a key approved with store.CreateApprovedKey joins with the
+// approval's role, and the approval is deleted as the node is created, so that
+// each approval admits a single node. This function must be called before Run.
+func (s *Server) SetJoinAuthorization(authorization JoinAuthorization) {
+	s.joinAuthorization = authorization
+}
+
+// SetUnmatchedExternalCAPolicy changes how certificates are signed when the
+// cluster has external CAs, but none of them matches the current signing
+// certificate. With UnmatchedExternalCARetry, SetFailFastIssuance still makes
+// the issuance fail rather than wait. This function must be called before Run.
+func (s *Server) SetUnmatchedExternalCAPolicy(policy UnmatchedExternalCAPolicy) {
+	s.unmatchedExternalCAPolicy = policy
+}
+
+// SetRootPersistencePolicy changes what UpdateRootCA does when it can't save a
+// new cluster root CA to disk. This function must be called before Run.
+func (s *Server) SetRootPersistencePolicy(policy RootPersistencePolicy) {
+	s.rootPersistencePolicy = policy
+}
+
+// SetAttestationVerifier makes the server check the attestation presented with
+// each CSR before signing it. If the verifier rejects it, the node's
+// certificate moves to the failed state. With no verifier, the default, any
+// attestation is accepted. This function must be called before Run.
+func (s *Server) SetAttestationVerifier(verifier AttestationVerifier) {
+	s.attestationVerifier = verifier
+}
+
+// SetKeyStrengthPolicy makes the server refuse to sign CSRs whose keys don't
+// meet policy. The certificates of nodes presenting such CSRs move to the
+// failed state. The policy is checked as a KeyStrengthRule ahead of the rules
+// of the issuance policy. By default any key is accepted. This function must
+// be called before Run.
+func (s *Server) SetKeyStrengthPolicy(policy KeyStrengthPolicy) {
+	s.keyStrengthPolicy = policy
+}
+
+// SetIssuancePolicy makes the server check every certificate request against
+// the rules of policy before signing it. The certificates of nodes whose
+// requests are rejected move to the failed state, with the reason given by the
+// rule. By default every request is let through. This function must be called
+// before Run.
+func (s *Server) SetIssuancePolicy(policy IssuancePolicy) {
+	s.issuancePolicy = NewIssuancePolicy(policy.rules...)
+}
+
+// issuanceRules returns the rules certificate requests are checked against:
+// the key strength policy, then the rules of the issuance policy.
+func (s *Server) issuanceRules() []IssuanceRule {
+	return append([]IssuanceRule{KeyStrengthRule(s.keyStrengthPolicy)}, s.issuancePolicy.rules...)
+}
+
+// SetRenewalStatePolicy makes the server refuse to renew the certificates of
+// nodes whose availability or state is listed in policy, with
+// codes.FailedPrecondition. Nodes joining the cluster are not affected. By
+// default every node may renew its certificate. This function must be called
+// before Run.
+func (s *Server) SetRenewalStatePolicy(policy RenewalStatePolicy) {
+	s.renewalStatePolicy = policy
+}
+
+// SetRequiredNodeLabels makes the server refuse to issue certificates to
+// nodes whose spec lacks any of labels, for example to only let nodes approved
+// by an admission controller join or renew. Renewals are refused with
+// codes.FailedPrecondition. The certificates of other nodes, such as nodes
+// joining the cluster, which have no labels yet, are left waiting until the
+// nodes are labelled, and a CertificateIssuanceWaiting is published. A label with an empty value only needs to be present.
+// By default no label is required.
+// This function must be called before Run.
+func (s *Server) SetRequiredNodeLabels(labels map[string]string) {
+	s.requiredNodeLabels = make(map[string]string, len(labels))
+	for key, value := range labels {
+		s.requiredNodeLabels[key] = value
+	}
+}
+
+// checkRequiredNodeLabels returns an error if node lacks one of the required
+// labels.
+func (s *Server) checkRequiredNodeLabels(node *api.Node) error {
+	// sorted, so the same missing label is reported each time
+	keys := make([]string, 0, len(s.requiredNodeLabels))
+	for key := range s.requiredNodeLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, ok := node.Spec.Annotations.Labels[key]
+		if !ok {
+			return errors.Errorf("node lacks the required label %s", key)
+		}
+		if want := s.requiredNodeLabels[key]; want != "" && value != want {
+			return errors.Errorf("node label %s is %q, not %q", key, value, want)
+		}
+	}
+	return nil
+}
+
+// SetRandSource makes the server read the randomness for the serial numbers
+// of the certificates it signs, for cross-signing new roots, and for the join
+// tokens generated when a root rotation completes, from r instead of
+// crypto/rand. See (*RootCA).SetRandSource. Join tokens rotated through the
+// control API take their randomness from the control API server, see
+// (*controlapi.Server).SetRandSource. This function must be called before Run.
+func (s *Server) SetRandSource(r io.Reader) {
+	s.rand = r
+}
+
+// SetMaxCSRSize changes the maximum size, in bytes, of a CSR accepted by
+// IssueNodeCertificate. Larger CSRs are rejected before being parsed. It
+// returns an error unless size is positive. This function must be called
+// before Run.
+func (s *Server) SetMaxCSRSize(size int) error {
+	if size <= 0 {
+		return errors.Errorf("maximum CSR size must be positive, got %d", size)
+	}
+	s.maxCSRSize = size
+	return nil
+}
+
+// SetSerialBitLength changes the number of random bits used for the serial
+// numbers of certificates signed by the local root CA. It returns an error if
+// bits is outside MinSerialBitLength and MaxSerialBitLength. This function
+// must be called before Run.
+func (s *Server) SetSerialBitLength(bits int) error {
+	if err := validateSerialBitLength(bits); err != nil {
+		return err
+	}
+	s.serialBits = bits
+	return nil
+}
+
+// SetClockSkewTolerance changes how far the NotBefore time of certificates
+// signed by the local root CA is backdated, to allow for nodes whose clocks
+// are behind. It returns an error if skew is not positive. This function must
+// be called before Run.
+func (s *Server) SetClockSkewTolerance(skew time.Duration) error {
+	if err := validateClockSkewTolerance(skew); err != nil {
+		return err
+	}
+	s.clockSkew = skew
+	return nil
+}
+
+// SetRootRotationCompletedCallback registers a function to be called whenever
+// this server completes a root rotation. The same information is published to
+// Watch as a RootRotationCompleted event. This function must be called before
+// Run.
+func (s *Server) SetRootRotationCompletedCallback(cb func(RootRotationCompleted)) {
+	s.rotationCompleted = cb
+}
+
+// SetIssuanceFailureAlert makes the server call alert when more than threshold
+// attempts to sign a certificate fail within the sliding window. alert is
+// called once when the threshold is crossed, and not again until the number of
+// failures in the window has dropped back to the threshold. This function must
+// be called before Run.
+func (s *Server) SetIssuanceFailureAlert(threshold int, window time.Duration, alert func(IssuanceFailureSpike)) {
+	s.failureAlert = &issuanceFailureAlert{
+		threshold: threshold,
+		window:    window,
+		alert:     alert,
+	}
+}
+
+// SetIssuanceQueue makes the server queue certificates waiting to be signed in
+// two lanes, one for new nodes and one for renewals, each holding at most
+// capacity nodes. The lanes are served in turn, renewalWeight renewals for
+// every newWeight new certificates, so that a burst of joining nodes can't
+// hold up renewals, or the other way around. Requests that would add to a full
+// lane are rejected with codes.ResourceExhausted. It returns an error unless
+// all the arguments are positive. This function must be called before Run.
+func (s *Server) SetIssuanceQueue(capacity, renewalWeight, newWeight int) error {
+	if capacity <= 0 || renewalWeight <= 0 || newWeight <= 0 {
+		return errors.Errorf("issuance queue capacity and weights must be positive, got capacity %d, weights %d and %d", capacity, renewalWeight, newWeight)
+	}
+	s.issuanceQueue = newIssuanceQueue(capacity, renewalWeight, newWeight)
+	return nil
+}
+
+// SetInventorySink makes the server export the metadata of every certificate
+// it issues or renews to sink. Certificates are buffered, up to bufferSize of
+// them, and exported in batches of at most batchSize, so that a slow sink
+// doesn't hold up issuance. Certificates issued while the buffer is full are
+// dropped, and counted by the swarm_ca_inventory_dropped_total metric. It
+// returns an error unless bufferSize and batchSize are positive. This function
+// must be called before Run.
+func (s *Server) SetInventorySink(sink InventorySink, bufferSize, batchSize int) error {
+	if bufferSize <= 0 || batchSize <= 0 {
+		return errors.Errorf("inventory buffer and batch sizes must be positive, got %d and %d", bufferSize, batchSize)
+	}
+	s.inventory = newInventoryExporter(sink, bufferSize, batchSize)
+	return nil
+}
+
+// SetRenewalFraction changes the fraction of an issued certificate's validity
+// period after which NodeCertificateStatus tells the node to renew it. It
+// returns an error unless fraction is greater than 0 and at most 1. This
+// function must be called before Run.
+func (s *Server) SetRenewalFraction(fraction float64) error {
+	if fraction <= 0 || fraction > 1 {
+		return errors.Errorf("renewal fraction must be greater than 0 and at most 1, got %v", fraction)
+	}
+	s.renewalFraction = fraction
+	return nil
+}
+
+// SetExternalCALocalFallback makes the CA server renew certificates with the
+// local signer while the cluster's external CAs are unavailable, if it has the
+// key of the current signing certificate, rather than leaving the nodes
+// pending until an external CA is back. Each fallback is logged, and an
+// ExternalCAFallback event is published to Watch. Certificates for nodes
+// joining the cluster are still only signed by the external CAs. It is
+// disabled by default. This function must be called before Run.
+func (s *Server) SetExternalCALocalFallback(enabled bool) {
+	s.externalCALocalFallback = enabled
+}
+
+// SetFailFastIssuance changes how issuance behaves when no signer is
+// available, for example because the external CA is unreachable. By default
+// the node is left pending and signing is retried. In fail-fast mode the
+// issuance fails instead: IssueNodeCertificate waits for the certificate to
+// be signed, and returns codes.Unavailable if it couldn't be, as does
+// NodeCertificateStatus, so the caller can retry. It doesn't wait for a
+// certificate left pending until the node meets a policy, such as the required
+// node labels. This function must be called before Run.
+func (s *Server) SetFailFastIssuance(failFast bool) {
+	s.failFastIssuance = failFast
+}
+
+// Watch returns a channel of events published by the CA server, such as
+// TLSInfoMismatch, RootRotationCompleted, NodeStuckInRotation,
+// ExpiredIntermediate or, if enabled,
+// ReconciliationDecision, DuplicateHostname and ExternalCAFallback,
+// CertificateExpiryClamped and RootCANotPersisted, and a function to cancel the
+// watch. The channel is closed when the watch is cancelled or the server is
+// stopped.
+func (s *Server) Watch() (eventq chan events.Event, cancel func()) {
+	s.mu.Lock()
+	serverStopped := s.watchesDone
+	s.mu.Unlock()
+
+	watch, cancelWatch := s.events.Watch()
+	ch := make(chan events.Event)
+	done := make(chan struct{})
+	go func() {
+		defer close(ch)
+		defer cancelWatch()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-serverStopped:
+				return
+			case e := <-watch:
+				select {
+				case ch <- e:
+				case <-done:
+					return
+				case <-serverStopped:
+					return
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			close(done)
+		})
+	}
+}
+
+// GetUnlockKey is responsible for returning the current unlock key used for encrypting TLS private keys and
+// other at rest data.  Access to this RPC call should only be allowed via mutual TLS from managers.
+func (s *Server) GetUnlockKey(ctx context.Context, request *api.GetUnlockKeyRequest) (*api.GetUnlockKeyResponse, error) {
+	// This directly queries the store, rather than storing the unlock key and version on
+	// the `Server` object and updating it `updateCluster` is called, because we need this
+	// API to return the latest version of the key.  Otherwise, there might be a slight delay
+	// between when the cluster gets updated, and when this function returns the latest key.
+	// This delay is currently unacceptable because this RPC call is the only way, after a
+	// cluster update, to get the actual value of the unlock key, and we don't want to return
+	// a cached value.
+	resp := api.GetUnlockKeyResponse{}
+	s.store.View(func(tx store.ReadTx) {
+		cluster := store.GetCluster(tx, s.securityConfig.ClientTLSCreds.Organization())
+		resp.Version = cluster.Meta.Version
+		if cluster.Spec.EncryptionConfig.AutoLockManagers {
+			for _, encryptionKey := range cluster.UnlockKeys {
+				if encryptionKey.Subsystem == ManagerRole {
+					resp.UnlockKey = encryptionKey.Key
+					return
+				}
+			}
+		}
+	})
+
+	return &resp, nil
+}
+
+// GetNodeCertificateChain returns the certificate chain the node presents: the leaf certificate recorded for it, and
+// the intermediates issued along with it, so that the chain can be verified independently against the cluster's root
+// CA. Access to this RPC call should only be allowed via mutual TLS from managers.
+func (s *Server) GetNodeCertificateChain(ctx context.Context, request *api.GetNodeCertificateChainRequest) (*api.GetNodeCertificateChainResponse, error) {
+	if request.NodeID == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, codes.InvalidArgument.String())
+	}
+
+	var node *api.Node
+	s.store.View(func(tx store.ReadTx) {
+		node = store.GetNode(tx, request.NodeID)
+	})
+	if node == nil {
+		return nil, grpc.Errorf(codes.NotFound, "node %s not found", request.NodeID)
+	}
+	if len(node.Certificate.Certificate) == 0 {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "node %s has not been issued a certificate yet", request.NodeID)
+	}
+
+	certs, err := helpers.ParseCertificatesPEM(node.Certificate.Certificate)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "could not parse the certificate of node %s: %v", request.NodeID, err)
+	}
+	resp := &api.GetNodeCertificateChainResponse{
+		Certificate: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw}),
+	}
+	for _, intermediate := range certs[1:] {
+		resp.Intermediates = append(resp.Intermediates, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: intermediate.Raw})...)
+	}
+	return resp, nil
+}
+
+// GetNodeIssuanceHistory returns the records of the most recent certificates issued to a node, oldest first. Access
+// to this RPC call should only be allowed via mutual TLS from managers.
+func (s *Server) GetNodeIssuanceHistory(ctx context.Context, request *api.GetNodeIssuanceHistoryRequest) (*api.GetNodeIssuanceHistoryResponse, error) {
+	if request.NodeID == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, codes.InvalidArgument.String())
+	}
+
+	var node *api.Node
+	s.store.View(func(tx store.ReadTx) {
+		node = store.GetNode(tx, request.NodeID)
+	})
+	if node == nil {
+		return nil, grpc.Errorf(codes.NotFound, "node %s not found", request.NodeID)
+	}
+	return &api.GetNodeIssuanceHistoryResponse{History: node.Certificate.History}, nil
+}
+
+// NodeCertificateStatus returns the current issuance status of an issuance request identified by the nodeID
+func (s *Server) NodeCertificateStatus(ctx context.Context, request *api.NodeCertificateStatusRequest) (*api.NodeCertificateStatusResponse, error) {
+	if request.NodeID == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, codes.InvalidArgument.String())
+	}
+
+	serverCtx, err := s.isRunningLocked()
+	if err != nil {
+		return nil, err
+	}
+
+	var node *api.Node
+
+	event := api.EventUpdateNode{
+		Node:   &api.Node{ID: request.NodeID},
+		Checks: []api.NodeCheckFunc{api.NodeCheckID},
+	}
+
+	// Retrieve the current value of the certificate with this token, and create a watcher
+	updates, cancel, err := store.ViewAndWatch(
+		s.store,
+		func(tx store.ReadTx) error {
+			node = store.GetNode(tx, request.NodeID)
+			return nil
+		},
+		event,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	// This node ID doesn't exist
+	if node == nil {
+		return nil, grpc.Errorf(codes.NotFound, codes.NotFound.String())
+	}
+
+	log.G(ctx).WithFields(logrus.Fields{
+		"node.id": node.ID,
+		"status":  node.Certificate.Status,
+		"method":  "NodeCertificateStatus",
+	})
+
+	// If this certificate has a final state, return it immediately (both pending and renew are transition states)
+	if isFinalState(node.Certificate.Status) {
+		return s.nodeCertificateStatusResponse(&node.Certificate)
+	}
+
+	log.G(ctx).WithFields(logrus.Fields{
+		"node.id": node.ID,
+		"status":  node.Certificate.Status,
+		"method":  "NodeCertificateStatus",
+	}).Debugf("started watching for certificate updates")
+
+	// Certificate is Pending or in an Unknown state, let's wait for changes.
+	for {
+		select {
+		case event := <-updates:
+			switch v := event.(type) {
+			case api.EventUpdateNode:
+				// We got an update on the certificate record. If the status is a final state,
+				// return the certificate.
+				if isFinalState(v.Node.Certificate.Status) {
+					return s.nodeCertificateStatusResponse(v.Node.Certificate.Copy())
+				}
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-serverCtx.Done():
+			return nil, s.ctx.Err()
+		}
+	}
+}
+
+// nodeCertificateStatusResponse returns the response to NodeCertificateStatus
+// for a certificate in a final state. Issuance that failed because no signer
+// was available is reported as codes.Unavailable. Issued certificates come with
+// a hint of when to renew them.
+func (s *Server) nodeCertificateStatusResponse(cert *api.Certificate) (*api.NodeCertificateStatusResponse, error) {
+	if cert.Status.State == api.IssuanceStateFailed && strings.HasPrefix(cert.Status.Err, signerUnavailable) {
+		return nil, grpc.Errorf(codes.Unavailable, "%s", cert.Status.Err)
+	}
+	resp := &api.NodeCertificateStatusResponse{
+		Status:      &cert.Status,
+		Certificate: cert,
+	}
+	if cert.Status.State == api.IssuanceStateIssued {
+		if parsed, err := helpers.ParseCertificatesPEM(cert.Certificate); err == nil && len(parsed) > 0 {
+			validity := parsed[0].NotAfter.Sub(parsed[0].NotBefore)
+			renewAfter := parsed[0].NotBefore.Add(time.Duration(float64(validity) * s.renewalFraction))
+			resp.RenewAfter = ptypes.MustTimestampProto(renewAfter)
+		}
+	}
+	return resp, nil
+}
+
+// IssueNodeCertificate is responsible for gatekeeping both certificate requests from new nodes in the swarm,
+// and authorizing certificate renewals.
+// If a node presented a valid certificate, the corresponding certificate is set in a RENEW state.
+// If a node failed to present a valid certificate, we check for a valid join token and set the
+// role accordingly. A new random node ID is generated, and the corresponding node entry is created.
+// IssueNodeCertificate is the only place where new node entries to raft should be created.
+func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNodeCertificateRequest) (*api.IssueNodeCertificateResponse, error) {
+	// First, let's see if the remote node is presenting a non-empty CSR
+	if len(request.CSR) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, codes.InvalidArgument.String())
+	}
+	if len(request.CSR) > s.maxCSRSize {
+		return nil, grpc.Errorf(codes.InvalidArgument, "CSR of %d bytes exceeds the maximum size of %d bytes", len(request.CSR), s.maxCSRSize)
+	}
+
+	if _, err := s.isRunningLocked(); err != nil {
+		return nil, err
+	}
+
+	var (
+		blacklistedCerts map[string]*api.BlacklistedCertificate
+		clusters         []*api.Cluster
+		err              error
+	)
+
+	s.store.View(func(readTx store.ReadTx) {
+		clusters, err = store.FindClusters(readTx, store.ByName("default"))
+
+	})
+
+	// Not having a cluster object yet means we can't check
+	// the blacklist.
+	if err == nil && len(clusters) == 1 {
+		blacklistedCerts = clusters[0].BlacklistedCertificates
+	}
+
+	// Renewing the cert with a local (unix socket) is always valid.
+	localNodeInfo := ctx.Value(LocalRequestKey)
+	if localNodeInfo != nil {
+		nodeInfo, ok := localNodeInfo.(RemoteNodeInfo)
+		if ok && nodeInfo.NodeID != "" {
+			return s.issueRenewCertificate(ctx, nodeInfo.NodeID, request.CSR, request.Attestation)
+		}
+	}
+
+	// If the remote node is a worker (either forwarded by a manager, or calling directly),
+	// issue a renew worker certificate entry with the correct ID
+	nodeID, err := AuthorizeForwardedRoleAndOrg(ctx, []string{WorkerRole}, []string{ManagerRole}, s.securityConfig.ClientTLSCreds.Organization(), blacklistedCerts)
+	if err == nil {
+		return s.issueRenewCertificate(ctx, nodeID, request.CSR, request.Attestation)
+	}
+
+	// If the remote node is a manager (either forwarded by another manager, or calling directly),
+	// issue a renew certificate entry with the correct ID
+	nodeID, err = AuthorizeForwardedRoleAndOrg(ctx, []string{ManagerRole}, []string{ManagerRole}, s.securityConfig.ClientTLSCreds.Organization(), blacklistedCerts)
+	if err == nil {
+		return s.issueRenewCertificate(ctx, nodeID, request.CSR, request.Attestation)
+	}
+
+	// The remote node didn't successfully present a valid MTLS certificate, let's issue a
+	// certificate with a new random ID
+	role := api.NodeRole(-1)
+
+	if s.joinAuthorization != JoinWithApprovedKey {
+		s.mu.Lock()
+		if subtle.ConstantTimeCompare([]byte(s.joinTokens.Manager), []byte(request.Token)) == 1 {
+			role = api.NodeRoleManager
+		} else if subtle.ConstantTimeCompare([]byte(s.joinTokens.Worker), []byte(request.Token)) == 1 {
+			role = api.NodeRoleWorker
+		}
+		s.mu.Unlock()
+	}
+
+	// approvedKey is the fingerprint of the approved key the node joins
+	// with, if it didn't present a valid join token
+	var approvedKey string
+	if role < 0 && s.joinAuthorization != JoinWithToken {
+		if csr, err := helpers.ParseCSRPEM(request.CSR); err == nil {
+			fingerprint := spkiFingerprint(csr.RawSubjectPublicKeyInfo)
+			s.store.View(func(tx store.ReadTx) {
+				if approved := store.GetApprovedKey(tx, fingerprint); approved != nil {
+					approvedKey = fingerprint
+					role = approved.Role
+				}
+			})
+		}
+	}
+
+	if role < 0 {
+		if s.joinAuthorization == JoinWithApprovedKey {
+			return nil, grpc.Errorf(codes.PermissionDenied, "the CSR's public key is not approved to join this cluster")
+		}
+		return nil, grpc.Errorf(codes.InvalidArgument, "A valid join token is necessary to join this cluster")
 	}
 
 	// Max number of collisions of ID or CN to tolerate before giving up
@@ -288,6 +1312,12 @@ func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNod
 	for i := 0; ; i++ {
 		nodeID = identity.NewID()
 
+		if s.issuanceQueue != nil {
+			if !s.issuanceQueue.reserve(laneNew, nodeID) {
+				return nil, grpc.Errorf(codes.ResourceExhausted, "too many certificates waiting to be issued, try again later")
+			}
+		}
+
 		// Create a new node
 		err := s.store.Update(func(tx store.Tx) error {
 			node := &api.Node{
@@ -300,14 +1330,29 @@ func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNod
 					Status: api.IssuanceStatus{
 						State: api.IssuanceStatePending,
 					},
+					Attestation: request.Attestation,
 				},
 				Spec: api.NodeSpec{
 					DesiredRole:  role,
-					Membership:   api.NodeMembershipAccepted,
+					Membership:   api.NodeMembershipPending,
 					Availability: request.Availability,
 				},
 			}
+			// A valid join token or approved key accepts the node into
+			// the cluster
+			if err := store.TransitionNodeMembership(node, api.NodeMembershipAccepted); err != nil {
+				return err
+			}
 
+			if approvedKey != "" {
+				// The approval is consumed by the node it admits
+				if err := store.DeleteApprovedKey(tx, approvedKey); err != nil {
+					if err == store.ErrNotExist {
+						return grpc.Errorf(codes.PermissionDenied, "the CSR's public key is not approved to join this cluster")
+					}
+					return err
+				}
+			}
 			return store.CreateNode(tx, node)
 		})
 		if err == nil {
@@ -318,10 +1363,10 @@ func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNod
 			}).Debugf("new certificate entry added")
 			break
 		}
-		if err != store.ErrExist {
-			return nil, err
+		if s.issuanceQueue != nil {
+			s.issuanceQueue.unreserve(laneNew, nodeID)
 		}
-		if i == maxRetries {
+		if err != store.ErrExist || i == maxRetries {
 			return nil, err
 		}
 		log.G(ctx).WithFields(logrus.Fields{
@@ -331,71 +1376,443 @@ func (s *Server) IssueNodeCertificate(ctx context.Context, request *api.IssueNod
 		}).Errorf("randomly generated node ID collided with an existing one - retrying")
 	}
 
-	return &api.IssueNodeCertificateResponse{
-		NodeID:         nodeID,
-		NodeMembership: api.NodeMembershipAccepted,
-	}, nil
+	if err := s.failFast(ctx, nodeID); err != nil {
+		return nil, err
+	}
+	return &api.IssueNodeCertificateResponse{
+		NodeID:         nodeID,
+		NodeMembership: api.NodeMembershipAccepted,
+	}, nil
+}
+
+// issueRenewCertificate receives a nodeID and a CSR and modifies the node's certificate entry with the new CSR
+// and changes the state to RENEW, so it can be picked up and signed by the signing reconciliation loop
+func (s *Server) issueRenewCertificate(ctx context.Context, nodeID string, csr, attestation []byte) (*api.IssueNodeCertificateResponse, error) {
+	if s.issuanceQueue != nil {
+		if !s.issuanceQueue.reserve(laneRenewal, nodeID) {
+			return nil, grpc.Errorf(codes.ResourceExhausted, "too many certificates waiting to be renewed, try again later")
+		}
+	}
+
+	var (
+		cert api.Certificate
+		node *api.Node
+	)
+	err := s.store.Update(func(tx store.Tx) error {
+		// Attempt to retrieve the node with nodeID
+		node = store.GetNode(tx, nodeID)
+		if node == nil {
+			log.G(ctx).WithFields(logrus.Fields{
+				"node.id": nodeID,
+				"method":  "issueRenewCertificate",
+			}).Warnf("node does not exist")
+			// If this node doesn't exist, we shouldn't be renewing a certificate for it
+			return grpc.Errorf(codes.NotFound, "node %s not found when attempting to renew certificate", nodeID)
+		}
+
+		if err := s.renewalStatePolicy.check(node); err != nil {
+			log.G(ctx).WithFields(logrus.Fields{
+				"node.id": nodeID,
+				"method":  "issueRenewCertificate",
+			}).WithError(err).Warnf("refused renewal")
+			return grpc.Errorf(codes.FailedPrecondition, "renewal for node %s refused: %v", nodeID, err)
+		}
+
+		if err := s.checkRequiredNodeLabels(node); err != nil {
+			log.G(ctx).WithFields(logrus.Fields{
+				"node.id": nodeID,
+				"method":  "issueRenewCertificate",
+			}).WithError(err).Warnf("refused renewal")
+			return grpc.Errorf(codes.FailedPrecondition, "renewal for node %s refused: %v", nodeID, err)
+		}
+
+		if s.renewalKeyPolicy == RenewalKeyPinned {
+			if err := checkRenewalKey(node.Certificate, csr); err != nil {
+				log.G(ctx).WithFields(logrus.Fields{
+					"node.id": nodeID,
+					"method":  "issueRenewCertificate",
+				}).WithError(err).Warnf("rejected renewal with a different key")
+				return grpc.Errorf(codes.PermissionDenied, "renewal for node %s rejected: %v", nodeID, err)
+			}
+		}
+
+		// Create a new Certificate entry for this node with the new CSR and a RENEW state.
+		// LastIssued, NotAfter and Serial are carried over so they keep describing the
+		// previous certificate, which the node still uses, until the renewal is signed.
+		cert = api.Certificate{
+			CSR:  csr,
+			CN:   node.ID,
+			Role: node.Role,
+			Status: api.IssuanceStatus{
+				State: api.IssuanceStateRenew,
+			},
+			LastIssued:  node.Certificate.LastIssued,
+			NotAfter:    node.Certificate.NotAfter,
+			Serial:      node.Certificate.Serial,
+			History:     node.Certificate.History,
+			Attestation: attestation,
+		}
+
+		node.Certificate = cert
+		return store.UpdateNode(tx, node)
+	})
+	if err != nil {
+		if s.issuanceQueue != nil {
+			s.issuanceQueue.unreserve(laneRenewal, nodeID)
+		}
+		return nil, err
+	}
+
+	log.G(ctx).WithFields(logrus.Fields{
+		"cert.cn":   cert.CN,
+		"cert.role": cert.Role,
+		"method":    "issueRenewCertificate",
+	}).Debugf("node certificate updated")
+
+	if err := s.failFast(ctx, nodeID); err != nil {
+		return nil, err
+	}
+	return &api.IssueNodeCertificateResponse{
+		NodeID:         nodeID,
+		NodeMembership: node.Spec.Membership,
+	}, nil
+}
+
+// failFast waits, in fail-fast mode, for the certificate of the node with the
+// given ID to be signed or to fail, and returns a codes.Unavailable error if it
+// failed because no signer was available, so that IssueNodeCertificate fails
+// straight away. It stops waiting, and returns nil, if the certificate is left
+// pending until the node meets a policy, since that can take any time. In the
+// default mode, it returns nil without waiting.
+func (s *Server) failFast(ctx context.Context, nodeID string) error {
+	if !s.failFastIssuance {
+		return nil
+	}
+
+	// watch before checking, so that the node can't start waiting unseen
+	watch, cancelWatch := s.events.Watch()
+	defer cancelWatch()
+	if s.isWaiting(nodeID) {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	statusErr := make(chan error, 1)
+	go func() {
+		_, err := s.NodeCertificateStatus(ctx, &api.NodeCertificateStatusRequest{NodeID: nodeID})
+		statusErr <- err
+	}()
+
+	for {
+		select {
+		case err := <-statusErr:
+			if grpc.Code(err) == codes.Unavailable {
+				return err
+			}
+			return nil
+		case event := <-watch:
+			if waiting, ok := event.(CertificateIssuanceWaiting); ok && waiting.NodeID == nodeID {
+				return nil
+			}
+		}
+	}
+}
+
+// isWaiting returns whether the certificate of the node with the given ID is
+// left pending until the node meets a policy.
+func (s *Server) isWaiting(nodeID string) bool {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	_, ok := s.waiting[nodeID]
+	return ok
+}
+
+// checkRenewalKey returns an error if csr is for a different public key than the
+// node's current certificate. While a renewal is pending, the node has no
+// certificate recorded, so the pending CSR, which has already been checked, is
+// used instead. If the node has neither, any key is accepted.
+func checkRenewalKey(current api.Certificate, csr []byte) error {
+	var currentKey []byte
+	switch {
+	case len(current.Certificate) > 0:
+		certs, err := helpers.ParseCertificatesPEM(current.Certificate)
+		if err != nil || len(certs) == 0 {
+			return errors.New("unable to parse the node's current certificate")
+		}
+		currentKey = certs[0].RawSubjectPublicKeyInfo
+	case len(current.CSR) > 0:
+		pendingCSR, err := helpers.ParseCSRPEM(current.CSR)
+		if err != nil {
+			return errors.New("unable to parse the node's pending CSR")
+		}
+		currentKey = pendingCSR.RawSubjectPublicKeyInfo
+	default:
+		return nil
+	}
+
+	renewalCSR, err := helpers.ParseCSRPEM(csr)
+	if err != nil {
+		return errors.New("unable to parse the renewal CSR")
+	}
+	if !bytes.Equal(currentKey, renewalCSR.RawSubjectPublicKeyInfo) {
+		return errors.New("the CSR's public key does not match the node's current certificate")
+	}
+	return nil
+}
+
+// GetRootCACertificate returns the certificate of the Root CA. It is used as a convenience for distributing
+// the root of trust for the swarm. Clients should be using the CA hash to verify if they weren't target to
+// a MiTM. If they fail to do so, node bootstrap works with TOFU semantics. If the request's IfNoneMatch is the
+// hash of the current root CA certificate, the certificate is omitted and the response is marked not modified.
+func (s *Server) GetRootCACertificate(ctx context.Context, request *api.GetRootCACertificateRequest) (*api.GetRootCACertificateResponse, error) {
+	log.G(ctx).WithFields(logrus.Fields{
+		"method": "GetRootCACertificate",
+	})
+
+	rootCA := s.securityConfig.RootCA()
+	hash := rootCA.Digest.String()
+	if request.IfNoneMatch != "" && request.IfNoneMatch == hash {
+		return &api.GetRootCACertificateResponse{
+			Hash:        hash,
+			NotModified: true,
+		}, nil
+	}
+
+	return &api.GetRootCACertificateResponse{
+		Certificate: rootCA.Certs,
+		Hash:        hash,
+	}, nil
+}
+
+// RootRotationOptions configures a root rotation started by BeginRootRotation.
+type RootRotationOptions struct {
+	// CN is the common name of the new root certificate.  If empty, DefaultRootCN is used.
+	CN string
+
+	// Queue, if a root rotation is already in progress, queues the rotation to the new root to begin once
+	// the in-progress rotation and any rotations queued before it have completed.
+	Queue bool
+
+	// Replace, if a root rotation is already in progress, abandons it along with any queued rotations and
+	// begins rotating to the new root immediately.
+	Replace bool
+}
+
+// BeginRootRotation generates a new root key and certificate, cross-signs the new root with the current
+// root, and installs the resulting RootRotation on the cluster in a single transaction.  The root rotation
+// reconciler then converges the nodes onto the new root.  It returns the digest of the new root certificate.
+// Unless opts requests queueing or replacement, it fails if a root rotation is already in progress.  It also
+// fails if the key of the root being rotated from is not available to cross-sign the new root.
+func (s *Server) BeginRootRotation(ctx context.Context, opts RootRotationOptions) (digest.Digest, error) {
+	if opts.Queue && opts.Replace {
+		return "", grpc.Errorf(codes.InvalidArgument, "a root rotation cannot be both queued and replace the current one")
+	}
+	cn := opts.CN
+	if cn == "" {
+		cn = DefaultRootCN
+	}
+	newRootCA, err := CreateRootCA(cn)
+	if err != nil {
+		return "", grpc.Errorf(codes.Internal, "unable to generate a new root CA: %v", err)
+	}
+	signer, err := newRootCA.Signer()
+	if err != nil {
+		return "", grpc.Errorf(codes.Internal, "unable to generate a new root CA: %v", err)
+	}
+
+	return s.beginRootRotation(ctx, "(*Server).BeginRootRotation", opts, func(prevCert, prevKey []byte) (*api.RootRotation, error) {
+		if len(prevKey) == 0 {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "the root CA being rotated from has no signing key with which to cross-sign a new root")
+		}
+		prevRootCA, err := NewRootCA(prevCert, prevCert, prevKey, DefaultNodeCertExpiration, nil)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Internal, "invalid root CA being rotated from: %v", err)
+		}
+		prevRootCA.rand = s.rand
+		crossSignedCert, err := prevRootCA.CrossSignCACertificate(signer.Cert)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Internal, "unable to cross-sign the new root CA: %v", err)
+		}
+		return &api.RootRotation{
+			CACert:            signer.Cert,
+			CAKey:             signer.Key,
+			CrossSignedCACert: NormalizePEMs(crossSignedCert),
+		}, nil
+	})
+}
+
+// BeginRootRotationWithCert starts a root rotation to a root certificate that was generated elsewhere,
+// such as in an offline HSM ceremony.  crossSignedPEM must be the new root cross-signed by the current
+// root; it is rejected unless it carries the new root's subject and public key and chains up to the
+// current root.  No key is stored for the new root, so an external CA able to sign with it must be
+// configured on the cluster.  It returns the digest of the new root certificate, and fails if a root
+// rotation is already in progress.
+func (s *Server) BeginRootRotationWithCert(ctx context.Context, newRootPEM, crossSignedPEM []byte) (digest.Digest, error) {
+	newRootPEM = NormalizePEMs(newRootPEM)
+	if _, err := NewRootCA(newRootPEM, nil, nil, DefaultNodeCertExpiration, nil); err != nil {
+		return "", grpc.Errorf(codes.InvalidArgument, "invalid new root CA certificate: %v", err)
+	}
+	newRoot, err := helpers.ParseCertificatePEM(newRootPEM)
+	if err != nil {
+		return "", grpc.Errorf(codes.InvalidArgument, "the new root CA must be a single certificate: %v", err)
+	}
+	crossSignedPEM = NormalizePEMs(crossSignedPEM)
+	crossSigned, err := helpers.ParseCertificatePEM(crossSignedPEM)
+	if err != nil {
+		return "", grpc.Errorf(codes.InvalidArgument, "the cross-signed certificate must be a single certificate: %v", err)
+	}
+	if !bytes.Equal(crossSigned.RawSubject, newRoot.RawSubject) ||
+		!bytes.Equal(crossSigned.RawSubjectPublicKeyInfo, newRoot.RawSubjectPublicKeyInfo) {
+		return "", grpc.Errorf(codes.InvalidArgument, "the cross-signed certificate does not match the new root CA certificate")
+	}
+
+	return s.beginRootRotation(ctx, "(*Server).BeginRootRotationWithCert", RootRotationOptions{}, func(prevCert, _ []byte) (*api.RootRotation, error) {
+		currentRoots := x509.NewCertPool()
+		if !currentRoots.AppendCertsFromPEM(prevCert) {
+			return nil, grpc.Errorf(codes.Internal, "invalid current root CA certificate")
+		}
+		if _, _, err := ValidateCertChain(currentRoots, crossSignedPEM, false); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "the cross-signed certificate does not chain to the current root CA: %v", err)
+		}
+		return &api.RootRotation{
+			CACert:            newRootPEM,
+			CrossSignedCACert: crossSignedPEM,
+		}, nil
+	})
+}
+
+// RotateIntermediateSigner generates a new signing key and an intermediate CA certificate for it, issued by
+// the current root, and installs them on the cluster.  Node certificates are then signed with the new key, and
+// chain through the intermediate to the unchanged root, so nodes don't need new certificates.  It returns the
+// digest of the new intermediate certificate.  The intermediate's CN is cn, or DefaultIntermediateCN if it is
+// empty, and must differ from the root's.  It fails if a root rotation is in progress, or if the root key
+// is not available to issue the intermediate.
+func (s *Server) RotateIntermediateSigner(ctx context.Context, cn string) (digest.Digest, error) {
+	if cn == "" {
+		cn = DefaultIntermediateCN
+	}
+	newCA, err := CreateRootCA(cn)
+	if err != nil {
+		return "", grpc.Errorf(codes.Internal, "unable to generate a new signing key: %v", err)
+	}
+	signer, err := newCA.Signer()
+	if err != nil {
+		return "", grpc.Errorf(codes.Internal, "unable to generate a new signing key: %v", err)
+	}
+
+	clusterID := s.securityConfig.ClientTLSCreds.Organization()
+	var intermediate []byte
+	err = s.store.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, clusterID)
+		if cluster == nil {
+			return grpc.Errorf(codes.NotFound, "cluster %s not found", clusterID)
+		}
+		rootCA := &cluster.RootCA
+		if rootCA.RootRotation != nil {
+			return grpc.Errorf(codes.FailedPrecondition, "cannot rotate the intermediate signer during a root rotation")
+		}
+		if len(rootCA.CAKey) == 0 {
+			return grpc.Errorf(codes.FailedPrecondition, "the root CA has no signing key with which to issue an intermediate")
+		}
+		currentRootCA, err := NewRootCA(rootCA.CACert, rootCA.CACert, rootCA.CAKey, DefaultNodeCertExpiration, nil)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "invalid root CA: %v", err)
+		}
+		// an intermediate with the root's subject can't be told apart from it
+		roots, err := helpers.ParseCertificatesPEM(rootCA.CACert)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "invalid root CA: %v", err)
+		}
+		for _, root := range roots {
+			if root.Subject.CommonName == cn {
+				return grpc.Errorf(codes.InvalidArgument, "the intermediate's CN %q is the root's", cn)
+			}
+		}
+		currentRootCA.rand = s.rand
+		crossSignedCert, err := currentRootCA.CrossSignCACertificate(signer.Cert)
+		if err != nil {
+			return grpc.Errorf(codes.Internal, "unable to issue the intermediate CA certificate: %v", err)
+		}
+		intermediate = NormalizePEMs(crossSignedCert)
+		rootCA.IntermediateCACert = intermediate
+		rootCA.IntermediateCAKey = signer.Key
+		return store.UpdateCluster(tx, cluster)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	intermediateDigest := digest.FromBytes(intermediate)
+	log.G(ctx).WithFields(logrus.Fields{
+		"cluster.id":        clusterID,
+		"intermediate.hash": intermediateDigest,
+		"method":            "(*Server).RotateIntermediateSigner",
+	}).Info("intermediate signer rotated")
+	return intermediateDigest, nil
 }
 
-// issueRenewCertificate receives a nodeID and a CSR and modifies the node's certificate entry with the new CSR
-// and changes the state to RENEW, so it can be picked up and signed by the signing reconciliation loop
-func (s *Server) issueRenewCertificate(ctx context.Context, nodeID string, csr []byte) (*api.IssueNodeCertificateResponse, error) {
+// beginRootRotation installs the RootRotation returned by newRotation on the cluster.  If a root rotation is
+// already in progress, it is refused unless opts asks for the new rotation to be queued behind it, or to
+// replace it.  newRotation is called within the store transaction with the cert and key of the root that the
+// new rotation will rotate from: the last queued root when queueing, and the current root otherwise.
+func (s *Server) beginRootRotation(ctx context.Context, method string, opts RootRotationOptions, newRotation func(prevCert, prevKey []byte) (*api.RootRotation, error)) (digest.Digest, error) {
 	var (
-		cert api.Certificate
-		node *api.Node
+		clusterID = s.securityConfig.ClientTLSCreds.Organization()
+		rotation  *api.RootRotation
+		queued    bool
 	)
 	err := s.store.Update(func(tx store.Tx) error {
-		// Attempt to retrieve the node with nodeID
-		node = store.GetNode(tx, nodeID)
-		if node == nil {
-			log.G(ctx).WithFields(logrus.Fields{
-				"node.id": nodeID,
-				"method":  "issueRenewCertificate",
-			}).Warnf("node does not exist")
-			// If this node doesn't exist, we shouldn't be renewing a certificate for it
-			return grpc.Errorf(codes.NotFound, "node %s not found when attempting to renew certificate", nodeID)
+		cluster := store.GetCluster(tx, clusterID)
+		if cluster == nil {
+			return grpc.Errorf(codes.NotFound, "cluster %s not found", clusterID)
 		}
-
-		// Create a new Certificate entry for this node with the new CSR and a RENEW state
-		cert = api.Certificate{
-			CSR:  csr,
-			CN:   node.ID,
-			Role: node.Role,
-			Status: api.IssuanceStatus{
-				State: api.IssuanceStateRenew,
-			},
+		rootCA := &cluster.RootCA
+		prevCert, prevKey := rootCA.CACert, rootCA.CAKey
+		if rootCA.RootRotation != nil {
+			switch {
+			case opts.Replace:
+				rootCA.QueuedRotations = nil
+			case opts.Queue:
+				last := rootCA.RootRotation
+				if n := len(rootCA.QueuedRotations); n > 0 {
+					last = rootCA.QueuedRotations[n-1]
+				}
+				prevCert, prevKey = last.CACert, last.CAKey
+				queued = true
+			default:
+				return grpc.Errorf(codes.FailedPrecondition, "a root rotation is already in progress")
+			}
 		}
 
-		node.Certificate = cert
-		return store.UpdateNode(tx, node)
+		var err error
+		rotation, err = newRotation(prevCert, prevKey)
+		if err != nil {
+			return err
+		}
+		if queued {
+			rootCA.QueuedRotations = append(rootCA.QueuedRotations, rotation)
+		} else {
+			rootCA.RootRotation = rotation
+		}
+		return store.UpdateCluster(tx, cluster)
 	})
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	log.G(ctx).WithFields(logrus.Fields{
-		"cert.cn":   cert.CN,
-		"cert.role": cert.Role,
-		"method":    "issueRenewCertificate",
-	}).Debugf("node certificate updated")
-
-	return &api.IssueNodeCertificateResponse{
-		NodeID:         nodeID,
-		NodeMembership: node.Spec.Membership,
-	}, nil
-}
-
-// GetRootCACertificate returns the certificate of the Root CA. It is used as a convenience for distributing
-// the root of trust for the swarm. Clients should be using the CA hash to verify if they weren't target to
-// a MiTM. If they fail to do so, node bootstrap works with TOFU semantics.
-func (s *Server) GetRootCACertificate(ctx context.Context, request *api.GetRootCACertificateRequest) (*api.GetRootCACertificateResponse, error) {
-	log.G(ctx).WithFields(logrus.Fields{
-		"method": "GetRootCACertificate",
+	newRootDigest := digest.FromBytes(rotation.CACert)
+	logger := log.G(ctx).WithFields(logrus.Fields{
+		"cluster.id": clusterID,
+		"root.hash":  newRootDigest,
+		"method":     method,
 	})
-
-	return &api.GetRootCACertificateResponse{
-		Certificate: s.securityConfig.RootCA().Certs,
-	}, nil
+	if queued {
+		logger.Info("root rotation queued")
+	} else {
+		logger.Info("root rotation started")
+	}
+	return newRootDigest, nil
 }
 
 // Run runs the CA signer main loop.
@@ -411,108 +1828,665 @@ func (s *Server) Run(ctx context.Context) error {
 	ctx = s.ctx
 	// we need to set it on the server, because `Server.UpdateRootCA` can be called from outside the Run function
 	s.rootReconciler = &rootRotationReconciler{
-		ctx:                 log.WithField(ctx, "method", "(*Server).rootRotationReconciler"),
-		clusterID:           s.securityConfig.ClientTLSCreds.Organization(),
-		store:               s.store,
-		batchUpdateInterval: s.rootReconciliationRetryInterval,
+		ctx:                       log.WithField(ctx, "method", "(*Server).rootRotationReconciler"),
+		clusterID:                 s.securityConfig.ClientTLSCreds.Organization(),
+		store:                     s.store,
+		batchUpdateInterval:       s.rootReconciliationRetryInterval,
+		events:                    s.events,
+		rotationCompleted:         s.rotationCompleted,
+		nilTLSInfoGracePeriod:     s.nilTLSInfoGracePeriod,
+		expiredIntermediatePolicy: s.expiredIntermediatePolicy,
+		debugEvents:               s.reconciliationDebugEvents,
+		rand:                      s.rand,
+		startAfter:                time.Now().Add(s.rootReconciliationStartupDelay),
+	}
+	rootReconciler := s.rootReconciler
+	s.rotating = make(map[string]*rotatingNode)
+	s.hostnames = nil
+	if s.checkDuplicateHostnames {
+		s.hostnames = newHostnameTracker()
+	}
+	s.mu.Unlock()
+	defer s.wg.Done()
+	defer func() {
+		s.mu.Lock()
+		s.rootReconciler = nil
+		s.mu.Unlock()
+	}()
+
+	// Retrieve the channels to keep track of changes in the cluster
+	// Retrieve all the currently registered nodes
+	var nodes []*api.Node
+
+	updates, cancel, err := store.ViewAndWatch(
+		s.store,
+		func(readTx store.ReadTx) error {
+			clusters, err := store.FindClusters(readTx, store.ByName(store.DefaultClusterName))
+			if err != nil {
+				return err
+			}
+			if len(clusters) != 1 {
+				return errors.New("could not find cluster object")
+			}
+			s.UpdateRootCA(ctx, clusters[0]) // call once to ensure that the join tokens are always set
+			nodes, err = store.FindNodes(readTx, store.All)
+			return err
+		},
+		api.EventCreateNode{},
+		api.EventUpdateNode{},
+		api.EventDeleteNode{},
+	)
+
+	// Do this after updateCluster has been called, so isRunning never
+	// returns true without joinTokens being set correctly.
+	s.mu.Lock()
+	close(s.started)
+	s.mu.Unlock()
+
+	if err != nil {
+		log.G(ctx).WithFields(logrus.Fields{
+			"method": "(*Server).Run",
+		}).WithError(err).Errorf("snapshot store view failed")
+		return err
+	}
+	defer cancel()
+
+	// With an issuance queue, certificates are signed by a worker that
+	// takes them from the queue, so that the Run loop keeps filling the
+	// queue's lanes in the meantime.
+	if s.issuanceQueue != nil {
+		workerDone := make(chan struct{})
+		go func() {
+			defer close(workerDone)
+			s.issuanceQueue.run(ctx, s.evaluateAndSignNodeCert)
+		}()
+		defer func() { <-workerDone }()
+	}
+
+	if s.inventory != nil {
+		exporterDone := make(chan struct{})
+		go func() {
+			defer close(exporterDone)
+			s.inventory.run(ctx)
+		}()
+		defer func() { <-exporterDone }()
+	}
+
+	for _, node := range nodes {
+		s.trackRotation(node)
+		s.trackHostname(ctx, node)
+	}
+
+	// We might have missed some updates if there was a leader election,
+	// so let's pick up the slack.
+	if err := s.reconcileNodeCertificates(ctx, nodes); err != nil {
+		// We don't return here because that means the Run loop would
+		// never run. Log an error instead.
+		log.G(ctx).WithFields(logrus.Fields{
+			"method": "(*Server).Run",
+		}).WithError(err).Errorf("error attempting to reconcile certificates")
+	}
+
+	ticker := time.NewTicker(s.reconciliationRetryInterval)
+	defer ticker.Stop()
+
+	// Watch for new nodes being created, new nodes being updated, and changes
+	// to the cluster
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		select {
+		case event := <-updates:
+			s.handleNodeEvent(ctx, rootReconciler, event)
+
+		case <-ticker.C:
+			for _, node := range s.pendingNodes() {
+				if s.issuanceQueue != nil {
+					// the issuance queue's worker retries them
+					s.issuanceQueue.push(node)
+					continue
+				}
+				if err := s.evaluateAndSignNodeCert(ctx, node); err != nil {
+					// If this sign operation did not succeed, the rest are
+					// unlikely to. Yield so that we don't hammer an external CA.
+					// Since the map iteration order is randomized, there is no
+					// risk of getting stuck on a problematic CSR.
+					break
+				}
+			}
+			s.handleStuckRotations(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleNodeEvent signs, or queues for signing, the certificate of a node that
+// was created or updated, and keeps track of it for root and stuck rotations.
+func (s *Server) handleNodeEvent(ctx context.Context, rootReconciler *rootRotationReconciler, event events.Event) {
+	switch v := event.(type) {
+	case api.EventCreateNode:
+		s.signOrQueueNodeCert(ctx, v.Node)
+		rootReconciler.UpdateNode(v.Node)
+		s.trackRotation(v.Node)
+		s.trackHostname(ctx, v.Node)
+	case api.EventUpdateNode:
+		// If this certificate is already at a final state
+		// no need to evaluate and sign it.
+		if !isFinalState(v.Node.Certificate.Status) {
+			s.signOrQueueNodeCert(ctx, v.Node)
+		}
+		rootReconciler.UpdateNode(v.Node)
+		s.trackRotation(v.Node)
+		s.trackHostname(ctx, v.Node)
+	case api.EventDeleteNode:
+		if s.issuanceQueue != nil {
+			s.issuanceQueue.remove(v.Node.ID)
+		}
+		s.pendingMu.Lock()
+		delete(s.waiting, v.Node.ID)
+		s.pendingMu.Unlock()
+		rootReconciler.DeleteNode(v.Node)
+		delete(s.rotating, v.Node.ID)
+		if s.hostnames != nil {
+			s.hostnames.remove(v.Node.ID)
+		}
+	}
+}
+
+// signOrQueueNodeCert adds the node to the issuance queue if there is one, and
+// otherwise signs its certificate straight away.
+func (s *Server) signOrQueueNodeCert(ctx context.Context, node *api.Node) {
+	if s.issuanceQueue != nil {
+		s.issuanceQueue.push(node)
+		return
+	}
+	s.evaluateAndSignNodeCert(ctx, node)
+}
+
+// Lanes of the issuance queue.
+const (
+	laneRenewal = iota
+	laneNew
+	numLanes
+)
+
+// issuanceQueue holds the nodes whose certificates are waiting to be signed,
+// in a lane for renewals and a lane for new nodes, and hands them out in
+// weighted turns. A node is queued at most once; queueing it again replaces
+// the copy waiting to be signed.
+//
+// Nodes reach the queue through store events, some time after the request that
+// created or updated them. Requests reserve a place in their lane for their node
+// beforehand, so that a burst of requests can't overfill it in the meantime.
+// The reservation is given back when the node is queued.
+type issuanceQueue struct {
+	mu       sync.Mutex
+	capacity int
+	weights  [numLanes]int
+
+	lanes  [numLanes][]string
+	counts [numLanes]int
+	queued map[string]queuedNode
+
+	// reserved is the number of places reserved in each lane, by node
+	// ID, and numReserved their total for each lane
+	reserved    [numLanes]map[string]int
+	numReserved [numLanes]int
+
+	// ready is signalled when a node is queued
+	ready chan struct{}
+
+	// lane is the lane currently being served, and served is how many of
+	// its nodes have been handed out in this turn
+	lane   int
+	served int
+}
+
+type queuedNode struct {
+	node *api.Node
+	lane int
+}
+
+func newIssuanceQueue(capacity, renewalWeight, newWeight int) *issuanceQueue {
+	q := &issuanceQueue{
+		capacity: capacity,
+		queued:   make(map[string]queuedNode),
+		ready:    make(chan struct{}, 1),
+	}
+	for lane := range q.reserved {
+		q.reserved[lane] = make(map[string]int)
+	}
+	q.weights[laneRenewal] = renewalWeight
+	q.weights[laneNew] = newWeight
+	return q
+}
+
+// reserve sets aside a place in lane for the node with the given ID, which is
+// about to be queued. It returns false if the lane is full.
+func (q *issuanceQueue) reserve(lane int, nodeID string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.counts[lane]+q.numReserved[lane] >= q.capacity {
+		return false
+	}
+	q.reserved[lane][nodeID]++
+	q.numReserved[lane]++
+	return true
+}
+
+// unreserve gives back a place reserved in lane for a node that won't be
+// queued after all.
+func (q *issuanceQueue) unreserve(lane int, nodeID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.release(lane, nodeID)
+}
+
+// release gives back one of the places reserved in lane for the node, if it
+// has any. q.mu must be held.
+func (q *issuanceQueue) release(lane int, nodeID string) {
+	n, ok := q.reserved[lane][nodeID]
+	if !ok {
+		return
+	}
+	if n > 1 {
+		q.reserved[lane][nodeID] = n - 1
+	} else {
+		delete(q.reserved[lane], nodeID)
+	}
+	q.numReserved[lane]--
+}
+
+// push queues the node's certificate, unless there is nothing to sign.
+func (q *issuanceQueue) push(node *api.Node) {
+	lane := laneNew
+	switch {
+	case node.Certificate.Status.State == api.IssuanceStateRenew:
+		lane = laneRenewal
+	case node.Certificate.Status.State == api.IssuanceStatePending && node.Spec.Membership == api.NodeMembershipAccepted:
+	default:
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	// Only the node's own reservation is given back, so that pushing a
+	// node again, or one nobody reserved a place for, doesn't free the
+	// places other requests hold.
+	q.release(lane, node.ID)
+	queued, ok := q.queued[node.ID]
+	q.queued[node.ID] = queuedNode{node: node, lane: lane}
+	if ok && queued.lane == lane {
+		return
+	}
+	if ok {
+		q.counts[queued.lane]--
+	}
+	q.lanes[lane] = append(q.lanes[lane], node.ID)
+	q.counts[lane]++
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// remove drops the node, and the places reserved for it, from the queue.
+func (q *issuanceQueue) remove(nodeID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for lane := range q.reserved {
+		q.numReserved[lane] -= q.reserved[lane][nodeID]
+		delete(q.reserved[lane], nodeID)
+	}
+	if queued, ok := q.queued[nodeID]; ok {
+		q.counts[queued.lane]--
+		delete(q.queued, nodeID)
+	}
+}
+
+// pop returns the next node whose certificate should be signed, or nil if
+// the queue is empty. The current lane is served until it has had its
+// weight's worth of nodes or runs out, and then the other lane gets a turn.
+func (q *issuanceQueue) pop() *api.Node {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.queued) > 0 {
+		if q.counts[q.lane] == 0 {
+			// anything left in the lane is stale
+			q.lanes[q.lane] = nil
+		}
+		if q.counts[q.lane] == 0 || q.served >= q.weights[q.lane] {
+			q.lane = (q.lane + 1) % numLanes
+			q.served = 0
+			continue
+		}
+		nodeID := q.lanes[q.lane][0]
+		q.lanes[q.lane] = q.lanes[q.lane][1:]
+		// IDs of nodes that were removed, or moved to the other lane,
+		// are left behind in the lane and skipped here
+		queued, ok := q.queued[nodeID]
+		if !ok || queued.lane != q.lane {
+			continue
+		}
+		delete(q.queued, nodeID)
+		q.counts[q.lane]--
+		q.served++
+		return queued.node
+	}
+	return nil
+}
+
+// run calls sign on every node taken from the queue, until ctx is cancelled.
+func (q *issuanceQueue) run(ctx context.Context, sign func(context.Context, *api.Node) error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if node := q.pop(); node != nil {
+			sign(ctx, node)
+			continue
+		}
+
+		select {
+		case <-q.ready:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// rotatingNode records when a node's certificate was first seen in the rotate state.
+type rotatingNode struct {
+	since   time.Time
+	flagged bool
+}
+
+// IssuanceFailureSpike is passed to the issuance failure alert when the
+// number of failed certificate signing attempts within the window exceeds the
+// threshold.
+type IssuanceFailureSpike struct {
+	// Failures is the number of failures within the window.
+	Failures int
+	Window   time.Duration
+	// Reasons are the errors of the failures within the window, oldest
+	// first.
+	Reasons []string
+}
+
+type issuanceFailure struct {
+	at     time.Time
+	reason string
+}
+
+// issuanceFailureAlert counts certificate signing failures over a sliding
+// window. Failures are recorded wherever certificates are signed, which is the
+// issuance queue's worker if there is an issuance queue, so it is guarded by
+// mu.
+type issuanceFailureAlert struct {
+	threshold int
+	window    time.Duration
+	alert     func(IssuanceFailureSpike)
+
+	mu       sync.Mutex
+	failures []issuanceFailure
+	// fired is set once the alert has been called, until the failures in
+	// the window drop back to the threshold.
+	fired bool
+}
+
+// record adds a failure at the given time, and calls the alert if this takes
+// the failures within the window over the threshold.
+func (a *issuanceFailureAlert) record(now time.Time, reason string) {
+	if spike, ok := a.add(now, reason); ok {
+		a.alert(spike)
+	}
+}
+
+// add adds a failure at the given time, and returns the spike to alert about
+// if this takes the failures within the window over the threshold.
+func (a *issuanceFailureAlert) add(now time.Time, reason string) (IssuanceFailureSpike, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.failures = append(a.failures, issuanceFailure{at: now, reason: reason})
+	cutoff := now.Add(-a.window)
+	i := 0
+	for i < len(a.failures) && !a.failures[i].at.After(cutoff) {
+		i++
+	}
+	a.failures = a.failures[i:]
+
+	if len(a.failures) <= a.threshold {
+		a.fired = false
+		return IssuanceFailureSpike{}, false
+	}
+	if a.fired {
+		return IssuanceFailureSpike{}, false
+	}
+	a.fired = true
+	reasons := make([]string, 0, len(a.failures))
+	for _, f := range a.failures {
+		reasons = append(reasons, f.reason)
+	}
+	return IssuanceFailureSpike{
+		Failures: len(a.failures),
+		Window:   a.window,
+		Reasons:  reasons,
+	}, true
+}
+
+// CertificateExpiryClamped is published by the CA server when it cuts a node
+// certificate's validity short, so that it expires before the signing
+// certificate and the intermediates it chains through.
+type CertificateExpiryClamped struct {
+	NodeID string
+	// NotAfter is the expiry the certificate was issued with.
+	NotAfter time.Time
+}
+
+// CertificateIssuanceWaiting is published by the CA server when it leaves a
+// node's certificate pending until the node meets a policy, such as the
+// required node labels, instead of signing it.
+type CertificateIssuanceWaiting struct {
+	NodeID string
+	// Reason is why the certificate can't be signed yet.
+	Reason string
+}
+
+// ExternalCAFallback is published by the CA server, if the local fallback is
+// enabled, when it renews a node's certificate with the local signer because
+// the cluster's external CAs are unavailable.
+type ExternalCAFallback struct {
+	NodeID string
+	// Err is the error signing with the external CAs failed with.
+	Err string
+}
+
+// RootCANotPersisted is published by the CA server, with
+// RootPersistenceBestEffort, when it starts using a cluster root CA it couldn't
+// save to disk.
+type RootCANotPersisted struct {
+	// RootCerts is the PEM encoded bundle of root certificates in use.
+	RootCerts []byte
+}
+
+// NodeStuckInRotation is published by the CA server when a node's certificate has been in the rotate state for
+// longer than the stuck rotation timeout, and the node has not reported a certificate from the current issuer.
+type NodeStuckInRotation struct {
+	NodeID string
+	// Since is when the node was first seen in the rotate state.
+	Since time.Time
+}
+
+// DuplicateHostname is published by the CA server, if duplicate hostname checks are enabled, when several accepted
+// nodes report the same hostname, and again whenever another node comes to share it.
+type DuplicateHostname struct {
+	// Hostname is the shared hostname, in lower case.
+	Hostname string
+	// NodeIDs are the IDs of the accepted nodes reporting the hostname, sorted.
+	NodeIDs []string
+}
+
+// trackHostname records the hostname of an accepted node, and flags the hostname if other accepted nodes report it
+// too.
+func (s *Server) trackHostname(ctx context.Context, node *api.Node) {
+	if s.hostnames == nil {
+		return
+	}
+	hostname, added := s.hostnames.update(node)
+	if !added {
+		return
+	}
+	nodeIDs := s.hostnames.nodes(hostname)
+	if len(nodeIDs) < 2 {
+		return
 	}
-	rootReconciler := s.rootReconciler
-	s.mu.Unlock()
-	defer s.wg.Done()
-	defer func() {
-		s.mu.Lock()
-		s.rootReconciler = nil
-		s.mu.Unlock()
-	}()
+	log.G(ctx).WithFields(logrus.Fields{
+		"hostname": hostname,
+		"node.ids": strings.Join(nodeIDs, ","),
+	}).Warn("several accepted nodes report the same hostname")
+	s.events.Publish(DuplicateHostname{Hostname: hostname, NodeIDs: nodeIDs})
+}
 
-	// Retrieve the channels to keep track of changes in the cluster
-	// Retrieve all the currently registered nodes
-	var nodes []*api.Node
+// hostnameTracker indexes the accepted nodes that report a hostname by that hostname, in lower case, like the
+// store's hostname index.
+type hostnameTracker struct {
+	byNode     map[string]string
+	byHostname map[string]map[string]struct{}
+}
 
-	updates, cancel, err := store.ViewAndWatch(
-		s.store,
-		func(readTx store.ReadTx) error {
-			clusters, err := store.FindClusters(readTx, store.ByName(store.DefaultClusterName))
-			if err != nil {
-				return err
-			}
-			if len(clusters) != 1 {
-				return errors.New("could not find cluster object")
-			}
-			s.UpdateRootCA(ctx, clusters[0]) // call once to ensure that the join tokens are always set
-			nodes, err = store.FindNodes(readTx, store.All)
-			return err
-		},
-		api.EventCreateNode{},
-		api.EventUpdateNode{},
-		api.EventDeleteNode{},
-	)
+func newHostnameTracker() *hostnameTracker {
+	return &hostnameTracker{
+		byNode:     make(map[string]string),
+		byHostname: make(map[string]map[string]struct{}),
+	}
+}
 
-	// Do this after updateCluster has been called, so isRunning never
-	// returns true without joinTokens being set correctly.
-	s.mu.Lock()
-	close(s.started)
-	s.mu.Unlock()
+// update records the node's current hostname, or forgets the node if it is not an accepted node reporting a
+// hostname. It returns the hostname, and whether the node was newly added to it.
+func (h *hostnameTracker) update(node *api.Node) (string, bool) {
+	var hostname string
+	if node.Spec.Membership == api.NodeMembershipAccepted && node.Description != nil {
+		hostname = strings.ToLower(node.Description.Hostname)
+	}
+	if previous, ok := h.byNode[node.ID]; ok {
+		if previous == hostname {
+			return hostname, false
+		}
+		h.remove(node.ID)
+	}
+	if hostname == "" {
+		return "", false
+	}
+	h.byNode[node.ID] = hostname
+	if h.byHostname[hostname] == nil {
+		h.byHostname[hostname] = make(map[string]struct{})
+	}
+	h.byHostname[hostname][node.ID] = struct{}{}
+	return hostname, true
+}
 
-	if err != nil {
-		log.G(ctx).WithFields(logrus.Fields{
-			"method": "(*Server).Run",
-		}).WithError(err).Errorf("snapshot store view failed")
-		return err
+// remove forgets the node.
+func (h *hostnameTracker) remove(nodeID string) {
+	hostname, ok := h.byNode[nodeID]
+	if !ok {
+		return
 	}
-	defer cancel()
+	delete(h.byNode, nodeID)
+	delete(h.byHostname[hostname], nodeID)
+	if len(h.byHostname[hostname]) == 0 {
+		delete(h.byHostname, hostname)
+	}
+}
 
-	// We might have missed some updates if there was a leader election,
-	// so let's pick up the slack.
-	if err := s.reconcileNodeCertificates(ctx, nodes); err != nil {
-		// We don't return here because that means the Run loop would
-		// never run. Log an error instead.
-		log.G(ctx).WithFields(logrus.Fields{
-			"method": "(*Server).Run",
-		}).WithError(err).Errorf("error attempting to reconcile certificates")
+// nodes returns the sorted IDs of the nodes reporting the hostname.
+func (h *hostnameTracker) nodes(hostname string) []string {
+	var nodeIDs []string
+	for nodeID := range h.byHostname[hostname] {
+		nodeIDs = append(nodeIDs, nodeID)
 	}
+	sort.Strings(nodeIDs)
+	return nodeIDs
+}
 
-	ticker := time.NewTicker(s.reconciliationRetryInterval)
-	defer ticker.Stop()
+// trackRotation records when a node enters the rotate state, and forgets it once it leaves.
+func (s *Server) trackRotation(node *api.Node) {
+	if s.stuckRotationTimeout == 0 {
+		return
+	}
+	if node.Certificate.Status.State != api.IssuanceStateRotate {
+		delete(s.rotating, node.ID)
+		return
+	}
+	if _, ok := s.rotating[node.ID]; !ok {
+		s.rotating[node.ID] = &rotatingNode{since: time.Now()}
+	}
+}
 
-	// Watch for new nodes being created, new nodes being updated, and changes
-	// to the cluster
-	for {
-		select {
-		case <-ctx.Done():
-			return nil
-		default:
+// handleStuckRotations resets nodes that have been in the rotate state for longer than the stuck rotation timeout
+// to the issued state if they already have a certificate from the current issuer, and flags the rest.
+func (s *Server) handleStuckRotations(ctx context.Context) {
+	if s.stuckRotationTimeout == 0 || len(s.rotating) == 0 {
+		return
+	}
+	s.secConfigMu.Lock()
+	rootCA := s.lastSeenClusterRootCA
+	s.secConfigMu.Unlock()
+	if rootCA == nil {
+		return
+	}
+	issuer, err := IssuerFromAPIRootCA(rootCA)
+	if err != nil {
+		log.G(ctx).WithError(err).Error("unable to determine the current issuer to check nodes stuck in rotation")
+		return
+	}
+
+	now := time.Now()
+	for nodeID, rotating := range s.rotating {
+		if rotating.flagged || now.Sub(rotating.since) < s.stuckRotationTimeout {
+			continue
 		}
+		logger := log.G(ctx).WithFields(logrus.Fields{
+			"node.id": nodeID,
+			"method":  "(*Server).handleStuckRotations",
+		})
 
-		select {
-		case event := <-updates:
-			switch v := event.(type) {
-			case api.EventCreateNode:
-				s.evaluateAndSignNodeCert(ctx, v.Node)
-				rootReconciler.UpdateNode(v.Node)
-			case api.EventUpdateNode:
-				// If this certificate is already at a final state
-				// no need to evaluate and sign it.
-				if !isFinalState(v.Node.Certificate.Status) {
-					s.evaluateAndSignNodeCert(ctx, v.Node)
-				}
-				rootReconciler.UpdateNode(v.Node)
-			case api.EventDeleteNode:
-				rootReconciler.DeleteNode(v.Node)
+		var found, reset bool
+		err := s.store.Update(func(tx store.Tx) error {
+			node := store.GetNode(tx, nodeID)
+			if node == nil || node.Certificate.Status.State != api.IssuanceStateRotate {
+				return nil
 			}
-
-		case <-ticker.C:
-			for _, node := range s.pending {
-				if err := s.evaluateAndSignNodeCert(ctx, node); err != nil {
-					// If this sign operation did not succeed, the rest are
-					// unlikely to. Yield so that we don't hammer an external CA.
-					// Since the map iteration order is randomized, there is no
-					// risk of getting stuck on a problematic CSR.
-					break
-				}
+			found = true
+			if !hasIssuer(node, issuer) || !reportedIssuerMatchesCert(node) {
+				return nil
 			}
-		case <-ctx.Done():
-			return nil
+			node.Certificate.Status.State = api.IssuanceStateIssued
+			reset = true
+			return store.UpdateNode(tx, node)
+		})
+		if err != nil {
+			logger.WithError(err).Error("failed to reset node stuck in rotation")
+			continue
+		}
+
+		switch {
+		case !found:
+			delete(s.rotating, nodeID)
+		case reset:
+			logger.Info("reset node stuck in rotation, which already has a certificate from the current issuer")
+			delete(s.rotating, nodeID)
+		default:
+			logger.Warnf("node has been in the rotate state since %s", rotating.since)
+			rotating.flagged = true
+			s.events.Publish(NodeStuckInRotation{NodeID: nodeID, Since: rotating.since})
 		}
 	}
 }
@@ -532,6 +2506,12 @@ func (s *Server) Stop() error {
 	// Wait for Run to complete
 	s.wg.Wait()
 
+	// end the watches opened until now
+	s.mu.Lock()
+	close(s.watchesDone)
+	s.watchesDone = make(chan struct{})
+	s.mu.Unlock()
+
 	return nil
 }
 
@@ -565,6 +2545,38 @@ func (s *Server) isRunning() bool {
 	return true
 }
 
+// HandoffReconciliation is called on the CA server of a manager that is about
+// to give up the leadership, such as when it is demoted or stopped, while it
+// can still write to the store, so that its root rotation reconciliation loop
+// and the new leader's don't both tell nodes to rotate at once. It
+// stops this server's loop, waiting for it to finish writing any batch of
+// nodes it is telling to rotate, and keeps the loop from being restarted until
+// Run is called again. When the loop last told a batch of nodes to rotate is
+// recorded in the cluster object, so that the new leader's loop waits out the
+// rest of the reconciliation interval rather than telling another batch to
+// rotate straight away. It does nothing if the server isn't running.
+func (s *Server) HandoffReconciliation() error {
+	s.mu.Lock()
+	reconciler := s.rootReconciler
+	s.mu.Unlock()
+	if reconciler == nil {
+		return nil
+	}
+
+	cursor := reconciler.handoff()
+	if cursor == nil {
+		return nil
+	}
+	return s.store.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, reconciler.clusterID)
+		if cluster == nil {
+			return errors.Errorf("unable to get cluster %s", reconciler.clusterID)
+		}
+		cluster.RootRotationCursor = cursor
+		return store.UpdateCluster(tx, cluster)
+	})
+}
+
 // UpdateRootCA is called when there are cluster changes, and it ensures that the local RootCA is
 // always aware of changes in clusterExpiry and the Root CA key material - this can be called by
 // anything to update the root CA material
@@ -581,7 +2593,8 @@ func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 	s.secConfigMu.Lock()
 	defer s.secConfigMu.Unlock()
 	firstSeenCluster := s.lastSeenClusterRootCA == nil && s.lastSeenExternalCAs == nil
-	rootCAChanged := len(rCA.CACert) != 0 && !equality.RootCAEqualStable(s.lastSeenClusterRootCA, rCA)
+	rootCAChanged := len(rCA.CACert) != 0 && (!equality.RootCAEqualStable(s.lastSeenClusterRootCA, rCA) ||
+		!s.lastSeenNodeCertExpiry.Equal(cluster.Spec.CAConfig.NodeCertExpiry))
 	externalCAChanged := !equality.ExternalCAsEqualStable(s.lastSeenExternalCAs, cluster.Spec.CAConfig.ExternalCAs)
 	logger := log.G(ctx).WithFields(logrus.Fields{
 		"cluster.id": cluster.ID,
@@ -591,7 +2604,7 @@ func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 	if rootCAChanged {
 		setOrUpdate := "set"
 		if !firstSeenCluster {
-			logger.Debug("Updating security config due to change in cluster Root CA")
+			logger.Debug("Updating security config due to change in cluster Root CA or node certificate expiry")
 			setOrUpdate = "updated"
 		}
 		expiry := DefaultNodeCertExpiration
@@ -616,6 +2629,10 @@ func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 			signingCert = rCA.RootRotation.CrossSignedCACert
 			signingKey = rCA.RootRotation.CAKey
 			intermediates = rCA.RootRotation.CrossSignedCACert
+		} else if len(rCA.IntermediateCAKey) > 0 {
+			signingCert = rCA.IntermediateCACert
+			signingKey = rCA.IntermediateCAKey
+			intermediates = rCA.IntermediateCACert
 		}
 		if signingKey == nil {
 			signingCert = nil
@@ -624,8 +2641,13 @@ func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 		if err != nil {
 			return errors.Wrap(err, "invalid Root CA object in cluster")
 		}
+		notPersisted := false
 		if err := SaveRootCA(updatedRootCA, s.rootPaths); err != nil {
-			return errors.Wrap(err, "unable to save new root CA certificates")
+			if s.rootPersistencePolicy != RootPersistenceBestEffort {
+				return errors.Wrap(err, "unable to save new root CA certificates")
+			}
+			logger.WithError(err).Error("unable to save new root CA certificates, using them without saving them")
+			notPersisted = true
 		}
 
 		externalCARootPool := updatedRootCA.Pool
@@ -643,6 +2665,11 @@ func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 		// only update the server cache if we've successfully updated the root CA
 		logger.Debugf("Root CA %s successfully", setOrUpdate)
 		s.lastSeenClusterRootCA = rCA
+		s.lastSeenNodeCertExpiry = cluster.Spec.CAConfig.Copy().NodeCertExpiry
+		s.rootNotPersisted = notPersisted
+		if notPersisted {
+			s.events.Publish(RootCANotPersisted{RootCerts: updatedRootCA.Certs})
+		}
 	}
 
 	// we want to update if the external CA changed, or if the root CA changed because the root CA could affect what
@@ -689,6 +2716,200 @@ func (s *Server) UpdateRootCA(ctx context.Context, cluster *api.Cluster) error {
 	return nil
 }
 
+// Diagnostics describes the CA server's current configuration, for debugging
+// TLS problems. It holds no key material.
+type Diagnostics struct {
+	// RootCerts is the PEM encoded bundle of trusted root certificates.
+	RootCerts []byte
+	// RootDigest is the digest of RootCerts.
+	RootDigest digest.Digest
+	// Intermediates is the PEM encoded bundle of intermediate certificates
+	// appended to issued certificates.
+	Intermediates []byte
+	// HasSigner is whether certificates can be signed locally.
+	HasSigner bool
+	// ExternalCAs are the external CA servers certificates are sent to for
+	// signing, with the outcome of the last request to each.
+	ExternalCAs []ExternalCAHealth
+	// RootRotation describes the root rotation in progress, if any.
+	RootRotation *RootRotationDiagnostics
+	// RootNotPersisted is whether RootCerts couldn't be saved to disk, with
+	// RootPersistenceBestEffort.
+	RootNotPersisted bool
+}
+
+// RootRotationDiagnostics describes a root rotation in progress.
+type RootRotationDiagnostics struct {
+	// CACert is the PEM encoded root certificate being rotated to.
+	CACert []byte
+	// CrossSignedCACert is the new root certificate cross-signed by the
+	// current root.
+	CrossSignedCACert []byte
+	// HasKey is whether the key of the new root is stored in the cluster.
+	HasKey bool
+	// Queued is the number of rotations queued behind this one.
+	Queued int
+}
+
+// DiagnosticsSnapshot returns the CA server's current root CA, signer and
+// external CA configuration, and the root rotation in progress, if any.
+func (s *Server) DiagnosticsSnapshot() Diagnostics {
+	rootCA := s.securityConfig.RootCA()
+	_, signerErr := rootCA.Signer()
+	d := Diagnostics{
+		RootCerts:     rootCA.Certs,
+		RootDigest:    rootCA.Digest,
+		Intermediates: rootCA.Intermediates,
+		HasSigner:     signerErr == nil,
+		ExternalCAs:   s.securityConfig.externalCA.Health(),
+	}
+
+	s.secConfigMu.Lock()
+	clusterRootCA := s.lastSeenClusterRootCA
+	d.RootNotPersisted = s.rootNotPersisted
+	s.secConfigMu.Unlock()
+	if clusterRootCA != nil && clusterRootCA.RootRotation != nil {
+		d.RootRotation = &RootRotationDiagnostics{
+			CACert:            clusterRootCA.RootRotation.CACert,
+			CrossSignedCACert: clusterRootCA.RootRotation.CrossSignedCACert,
+			HasKey:            len(clusterRootCA.RootRotation.CAKey) > 0,
+			Queued:            len(clusterRootCA.QueuedRotations),
+		}
+	}
+	return d
+}
+
+// CertificateAudit is the result of checking a node certificate against the
+// CA server's current configuration and policy, with AuditCertificate.
+type CertificateAudit struct {
+	// NodeID is the ID of the node the certificate was issued to, from its
+	// CN.
+	NodeID string
+	// Deviations describes each way the certificate doesn't comply with
+	// the current policy. It is empty if the certificate complies.
+	Deviations []string
+}
+
+// Compliant returns whether the certificate complies with the policy.
+func (a *CertificateAudit) Compliant() bool {
+	return len(a.Deviations) == 0
+}
+
+// AuditCertificate checks a node certificate issued by the CA against the CA
+// server's current configuration and policy, for example to find the live
+// certificates that no longer comply once the policy has been tightened. The
+// certificate must chain to the current root and be valid for both server and
+// client authentication. Its key must meet the key strength policy, and it
+// must pass the rules of the issuance policy. Its validity must be no longer
+// than the signer's expiration, and no shorter than the minimum expiration,
+// unless it was cut short to expire before the signing certificate. Its IP
+// SANs must be within the allowed ranges, and it must have the configured URI
+// SAN and the node's current role. Every check is made, so that all the
+// deviations are reported. It returns an error only if certPEM holds no
+// certificate.
+func (s *Server) AuditCertificate(certPEM []byte) (*CertificateAudit, error) {
+	certs, err := helpers.ParseCertificatesPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate to audit")
+	}
+	cert := certs[0]
+	audit := &CertificateAudit{NodeID: cert.Subject.CommonName}
+	deviate := func(format string, args ...interface{}) {
+		audit.Deviations = append(audit.Deviations, fmt.Sprintf(format, args...))
+	}
+
+	rootCA := s.securityConfig.RootCA()
+	if _, err := rootCA.VerifyCertificate(certPEM); err != nil {
+		deviate("%s", err)
+	}
+
+	var serverAuth, clientAuth bool
+	for _, usage := range cert.ExtKeyUsage {
+		switch usage {
+		case x509.ExtKeyUsageServerAuth:
+			serverAuth = true
+		case x509.ExtKeyUsageClientAuth:
+			clientAuth = true
+		}
+	}
+	if !serverAuth || !clientAuth {
+		deviate("certificate is not valid for both server and client authentication")
+	}
+
+	// The signer's expiration, and the minimum, include the backdate.
+	validity := cert.NotAfter.Sub(cert.NotBefore)
+	if signer, err := rootCA.Signer(); err == nil {
+		maxExpiry, _ := signingExpiry(signer, s.minCertExpiry)
+		if validity > maxExpiry {
+			deviate("certificate is valid for %s, longer than the current expiration of %s", validity-CertBackdate, maxExpiry-CertBackdate)
+		}
+		clamped := !cert.NotAfter.Before(rootCA.signerNotAfter(signer).Add(-s.signerExpiryMargin))
+		if s.minCertExpiry != 0 && validity < s.minCertExpiry+CertBackdate && !clamped {
+			deviate("certificate is valid for %s, shorter than the minimum expiration of %s", validity-CertBackdate, s.minCertExpiry)
+		}
+	}
+
+	for _, ip := range cert.IPAddresses {
+		allowed := false
+		for _, r := range s.addressSANRanges {
+			if r.Contains(ip) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			deviate("IP address SAN %s is not within the allowed ranges", ip)
+		}
+	}
+
+	var node *api.Node
+	s.store.View(func(tx store.ReadTx) {
+		node = store.GetNode(tx, audit.NodeID)
+	})
+	if node == nil {
+		deviate("node %s does not exist", audit.NodeID)
+		return audit, nil
+	}
+
+	if role, err := ParseRole(node.Role); err == nil {
+		if len(cert.Subject.OrganizationalUnit) == 0 || roleFromOUs(cert.Subject.OrganizationalUnit) != role {
+			deviate("certificate does not have the node's role %s", role)
+		}
+	}
+	if s.uriSANTemplate != "" {
+		expected := expandURISANTemplate(s.uriSANTemplate, s.trustDomain, node.ID, node.Certificate.Role)
+		found := false
+		for _, uri := range cert.URIs {
+			if uri.String() == expected {
+				found = true
+				break
+			}
+		}
+		if !found {
+			deviate("certificate does not have the URI SAN %s", expected)
+		}
+	}
+
+	csr := &x509.CertificateRequest{
+		Subject:            cert.Subject,
+		PublicKeyAlgorithm: cert.PublicKeyAlgorithm,
+		PublicKey:          cert.PublicKey,
+		DNSNames:           cert.DNSNames,
+		EmailAddresses:     cert.EmailAddresses,
+		IPAddresses:        cert.IPAddresses,
+		URIs:               cert.URIs,
+	}
+	for _, rule := range s.issuanceRules() {
+		if err := rule.Check(node, csr); err != nil {
+			deviate("%s: %s", rule.Name, err)
+		}
+	}
+	return audit, nil
+}
+
 // evaluateAndSignNodeCert implements the logic of which certificates to sign
 func (s *Server) evaluateAndSignNodeCert(ctx context.Context, node *api.Node) error {
 	// If the desired membership and actual state are in sync, there's
@@ -713,11 +2934,63 @@ func (s *Server) evaluateAndSignNodeCert(ctx context.Context, node *api.Node) er
 	return nil
 }
 
+// hasExternalCAs returns whether the cluster has any external CAs configured.
+func (s *Server) hasExternalCAs() bool {
+	s.secConfigMu.Lock()
+	defer s.secConfigMu.Unlock()
+	return len(s.lastSeenExternalCAs) > 0
+}
+
+// issuanceReason returns why a certificate is being issued to node: a node that
+// has never been issued one is joining, and one renewing during a root rotation
+// is rotating.
+func (s *Server) issuanceReason(node *api.Node) api.IssuanceRecord_Reason {
+	if node.Certificate.LastIssued == nil {
+		return api.IssuanceReasonJoin
+	}
+	s.secConfigMu.Lock()
+	defer s.secConfigMu.Unlock()
+	if s.lastSeenClusterRootCA != nil && s.lastSeenClusterRootCA.RootRotation != nil {
+		return api.IssuanceReasonRotation
+	}
+	return api.IssuanceReasonRenewal
+}
+
+// appendIssuanceRecord appends record to history, dropping the oldest records
+// beyond MaxIssuanceHistory.
+func appendIssuanceRecord(history []*api.IssuanceRecord, record *api.IssuanceRecord) []*api.IssuanceRecord {
+	history = append(history, record)
+	if len(history) > MaxIssuanceHistory {
+		history = append([]*api.IssuanceRecord(nil), history[len(history)-MaxIssuanceHistory:]...)
+	}
+	return history
+}
+
+// Prime prepares the server's signer, so that the first certificate it issues
+// doesn't pay for setting it up. If external CAs are configured, it connects to
+// them. Otherwise, it signs a throwaway certificate with the local root CA.
+// Prime can be called any number of times.
+func (s *Server) Prime(ctx context.Context) error {
+	err := s.securityConfig.externalCA.Prime(ctx)
+	if err != ErrNoExternalCAURLs {
+		return err
+	}
+
+	csr, _, err := GenerateNewCSR()
+	if err != nil {
+		return err
+	}
+	rootCA := s.securityConfig.RootCA()
+	if _, err := rootCA.ParseValidateAndSignCSR(csr, identity.NewID(), WorkerRole, s.securityConfig.ClientTLSCreds.Organization()); err != nil {
+		return errors.Wrap(err, "failed to sign priming certificate")
+	}
+	return nil
+}
+
 // signNodeCert does the bulk of the work for signing a certificate
 func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 	rootCA := s.securityConfig.RootCA()
 	externalCA := s.securityConfig.externalCA
-
 	node = node.Copy()
 	nodeID := node.ID
 	// Convert the role from proto format
@@ -730,7 +3003,69 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 		return errors.New("failed to parse role")
 	}
 
-	s.pending[node.ID] = node
+	configured := *rootCA
+	configured.serialBits = s.serialBits
+	configured.clockSkew = s.clockSkew
+	configured.extraOU = s.roleOU(node.Certificate.Role, role)
+	if s.uriSANTemplate != "" {
+		configured.uriSAN = expandURISANTemplate(s.uriSANTemplate, s.trustDomain, node.ID, node.Certificate.Role)
+	}
+	configured.dnsSANs = s.nodeDNSSANs(ctx, node)
+	configured.ipSANs = s.nodeAddressSANs(node)
+	if s.metadataExtensionOID != nil {
+		value := strings.NewReplacer(
+			"{id}", node.ID,
+			"{role}", strings.ToLower(node.Certificate.Role.String()),
+			"{org}", s.securityConfig.ClientTLSCreds.Organization(),
+		).Replace(s.metadataExtensionTemplate)
+		ext, err := utf8StringExtension(s.metadataExtensionOID, value)
+		if err != nil {
+			return err
+		}
+		configured.extensions = []cfsigner.Extension{ext}
+	}
+	configured.ocspURL = s.ocspResponderURL
+	configured.expiryMargin = s.signerExpiryMargin
+	configured.minExpiry = s.minCertExpiry
+	configured.expiryRaised = func(expiry time.Duration) {
+		log.G(ctx).WithFields(logrus.Fields{
+			"node.id": nodeID,
+			"expiry":  expiry,
+			"method":  "(*Server).signNodeCert",
+		}).Warn("node certificate expiration raised to the configured minimum")
+	}
+	if s.rand != nil {
+		configured.rand = s.rand
+	}
+	configured.expiryClamped = func(notAfter time.Time) {
+		log.G(ctx).WithFields(logrus.Fields{
+			"node.id":   nodeID,
+			"not_after": notAfter,
+			"method":    "(*Server).signNodeCert",
+		}).Warn("node certificate validity cut short to expire before the signing certificate")
+		s.events.Publish(CertificateExpiryClamped{NodeID: nodeID, NotAfter: notAfter})
+	}
+	rootCA = &configured
+
+	// A node lacking a required label is left waiting rather than failed, so
+	// that labelling it later updates it and triggers signing again.
+	if labelErr := s.checkRequiredNodeLabels(node); labelErr != nil {
+		log.G(ctx).WithFields(logrus.Fields{
+			"node.id": nodeID,
+			"method":  "(*Server).signNodeCert",
+		}).WithError(labelErr).Info("node certificate waiting for required labels")
+		s.pendingMu.Lock()
+		s.waiting[node.ID] = struct{}{}
+		s.pendingMu.Unlock()
+		s.events.Publish(CertificateIssuanceWaiting{NodeID: nodeID, Reason: labelErr.Error()})
+		return nil
+	}
+
+	// node is modified below, so keep a copy of it for retries
+	s.pendingMu.Lock()
+	delete(s.waiting, node.ID)
+	s.pending[node.ID] = node.Copy()
+	s.pendingMu.Unlock()
 
 	// Attempt to sign the CSR
 	var (
@@ -740,11 +3075,54 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 		org    = s.securityConfig.ClientTLSCreds.Organization()
 	)
 
-	// Try using the external CA first.
-	cert, err := externalCA.Sign(ctx, PrepareCSR(rawCSR, cn, ou, org))
-	if err == ErrNoExternalCAURLs {
-		// No external CA servers configured. Try using the local CA.
-		cert, err = rootCA.ParseValidateAndSignCSR(rawCSR, cn, ou, org)
+	var cert []byte
+	if s.attestationVerifier != nil {
+		if verifyErr := s.attestationVerifier.VerifyAttestation(ctx, nodeID, rawCSR, node.Certificate.Attestation); verifyErr != nil {
+			err = errors.Wrap(verifyErr, policyRejected)
+		}
+	}
+	if err == nil {
+		// A CSR that doesn't parse is left for the signer to reject
+		if block, _ := pem.Decode(rawCSR); block != nil {
+			if csr, parseErr := x509.ParseCertificateRequest(block.Bytes); parseErr == nil {
+				if policyErr := NewIssuancePolicy(s.issuanceRules()...).check(node, csr); policyErr != nil {
+					err = errors.Wrap(policyErr, policyRejected)
+				}
+			}
+		}
+	}
+	if err == nil {
+		// Try using the external CA first.
+		req := PrepareCSR(rawCSR, cn, ou, org)
+		if configured.extraOU != "" {
+			req.Subject.Names = append(req.Subject.Names, cfcsr.Name{OU: configured.extraOU})
+		}
+		cert, err = externalCA.Sign(ctx, req)
+		if err == ErrNoExternalCAURLs {
+			switch {
+			case !s.hasExternalCAs() || s.unmatchedExternalCAPolicy == UnmatchedExternalCALocal:
+				// No external CA servers configured, or none for the
+				// current signing certificate. Try using the local CA.
+				cert, err = rootCA.ParseValidateAndSignCSR(rawCSR, cn, ou, org)
+			case s.unmatchedExternalCAPolicy == UnmatchedExternalCAFail:
+				err = errNoMatchingExternalCA
+			default:
+				err = recoverableErr{err: errNoMatchingExternalCA}
+			}
+		} else if _, unavailable := err.(recoverableErr); unavailable && s.externalCALocalFallback && node.Certificate.LastIssued != nil {
+			// The external CAs are down, but this is a renewal, and
+			// the local signer can sign it.
+			if _, signerErr := rootCA.Signer(); signerErr == nil {
+				externalErr := err
+				if cert, err = rootCA.ParseValidateAndSignCSR(rawCSR, cn, ou, org); err == nil {
+					log.G(ctx).WithFields(logrus.Fields{
+						"node.id": nodeID,
+						"method":  "(*Server).signNodeCert",
+					}).WithError(externalErr).Warn("external CA unavailable, renewed node certificate with the local signer")
+					s.events.Publish(ExternalCAFallback{NodeID: nodeID, Err: externalErr.Error()})
+				}
+			}
+		}
 	}
 
 	if err != nil {
@@ -753,16 +3131,23 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 			"method":  "(*Server).signNodeCert",
 		}).WithError(err).Errorf("failed to sign CSR")
 
+		if s.failureAlert != nil {
+			s.failureAlert.record(time.Now(), err.Error())
+		}
+
 		// If the current state is already Failed, no need to change it
 		if node.Certificate.Status.State == api.IssuanceStateFailed {
-			delete(s.pending, node.ID)
+			s.deletePending(node.ID)
 			return errors.New("failed to sign CSR")
 		}
 
 		if _, ok := err.(recoverableErr); ok {
-			// Return without changing the state of the certificate. We may
-			// retry signing it in the future.
-			return errors.New("failed to sign CSR")
+			if !s.failFastIssuance {
+				// Return without changing the state of the certificate. We may
+				// retry signing it in the future.
+				return errors.New("failed to sign CSR")
+			}
+			err = errors.Wrap(err, signerUnavailable)
 		}
 
 		// We failed to sign this CSR, change the state to FAILED
@@ -786,10 +3171,32 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 			}).WithError(err).Errorf("transaction failed when setting state to FAILED")
 		}
 
-		delete(s.pending, node.ID)
+		s.deletePending(node.ID)
 		return errors.New("failed to sign CSR")
 	}
 
+	// Record the expiry and serial of the new certificate, so nodes can be
+	// found by them
+	var (
+		notAfter *gogotypes.Timestamp
+		serial   string
+		leaf     *x509.Certificate
+	)
+	if parsed, err := helpers.ParseCertificatesPEM(cert); err == nil && len(parsed) > 0 {
+		leaf = parsed[0]
+		notAfter = ptypes.MustTimestampProto(leaf.NotAfter)
+		serial = leaf.SerialNumber.Text(16)
+	}
+	record := &api.IssuanceRecord{
+		Timestamp: ptypes.MustTimestampProto(time.Now()),
+		Serial:    serial,
+		Reason:    s.issuanceReason(node),
+		NotAfter:  notAfter,
+	}
+	if leaf != nil {
+		record.Issuer = leaf.Issuer.String()
+	}
+
 	// We were able to successfully sign the new CSR. Let's try to update the nodeStore
 	for {
 		err = s.store.Update(func(tx store.Tx) error {
@@ -797,6 +3204,10 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 			node.Certificate.Status = api.IssuanceStatus{
 				State: api.IssuanceStateIssued,
 			}
+			node.Certificate.LastIssued = record.Timestamp
+			node.Certificate.NotAfter = notAfter
+			node.Certificate.Serial = serial
+			node.Certificate.History = appendIssuanceRecord(node.Certificate.History, record)
 
 			err := store.UpdateNode(tx, node)
 			if err != nil {
@@ -813,7 +3224,16 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 				"node.role": node.Certificate.Role,
 				"method":    "(*Server).signNodeCert",
 			}).Debugf("certificate issued")
-			delete(s.pending, node.ID)
+			s.deletePending(node.ID)
+			if s.inventory != nil && leaf != nil {
+				s.inventory.add(IssuedCertificate{
+					NodeID:   node.ID,
+					Role:     node.Certificate.Role,
+					Serial:   serial,
+					Subject:  leaf.Subject.String(),
+					NotAfter: leaf.NotAfter,
+				})
+			}
 			break
 		}
 		if err == store.ErrSequenceConflict {
@@ -833,12 +3253,29 @@ func (s *Server) signNodeCert(ctx context.Context, node *api.Node) error {
 // nodes.
 func (s *Server) reconcileNodeCertificates(ctx context.Context, nodes []*api.Node) error {
 	for _, node := range nodes {
-		s.evaluateAndSignNodeCert(ctx, node)
+		s.signOrQueueNodeCert(ctx, node)
 	}
 
 	return nil
 }
 
+// pendingNodes returns the nodes with pending certificates.
+func (s *Server) pendingNodes() []*api.Node {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	nodes := make([]*api.Node, 0, len(s.pending))
+	for _, node := range s.pending {
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+func (s *Server) deletePending(nodeID string) {
+	s.pendingMu.Lock()
+	delete(s.pending, nodeID)
+	s.pendingMu.Unlock()
+}
+
 // A successfully issued certificate and a failed certificate are our current final states
 func isFinalState(status api.IssuanceStatus) bool {
 	if status.State == api.IssuanceStateIssued || status.State == api.IssuanceStateFailed ||
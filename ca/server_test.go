@@ -2,12 +2,25 @@ package ca_test
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
 	"fmt"
 	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,6 +31,7 @@ import (
 	cautils "github.com/docker/swarmkit/ca/testutils"
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/docker/swarmkit/testutils"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/opencontainers/go-digest"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -39,6 +53,30 @@ func TestGetRootCACertificate(t *testing.T) {
 	assert.NotEmpty(t, resp.Certificate)
 }
 
+func TestGetRootCACertificateNotModified(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	resp, err := tc.CAClients[0].GetRootCACertificate(context.Background(), &api.GetRootCACertificateRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Certificate)
+	require.Equal(t, digest.FromBytes(resp.Certificate).String(), resp.Hash)
+	require.False(t, resp.NotModified)
+
+	// the root hasn't changed, so the certificate is not sent again
+	resp2, err := tc.CAClients[0].GetRootCACertificate(context.Background(), &api.GetRootCACertificateRequest{IfNoneMatch: resp.Hash})
+	require.NoError(t, err)
+	require.True(t, resp2.NotModified)
+	require.Empty(t, resp2.Certificate)
+	require.Equal(t, resp.Hash, resp2.Hash)
+
+	// a stale hash gets the full certificate
+	resp3, err := tc.CAClients[0].GetRootCACertificate(context.Background(), &api.GetRootCACertificateRequest{IfNoneMatch: "stale"})
+	require.NoError(t, err)
+	require.False(t, resp3.NotModified)
+	require.Equal(t, resp.Certificate, resp3.Certificate)
+}
+
 func TestRestartRootCA(t *testing.T) {
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
@@ -76,6 +114,28 @@ func TestIssueNodeCertificate(t *testing.T) {
 	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
 	assert.NotNil(t, statusResponse.Certificate.Certificate)
 	assert.Equal(t, api.NodeRoleWorker, statusResponse.Certificate.Role)
+
+	// the acceptance of the node is recorded
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		node := store.GetNode(tx, issueResponse.NodeID)
+		require.NotNil(t, node)
+		require.NotNil(t, node.MembershipTransition)
+		assert.Equal(t, api.NodeMembershipPending, node.MembershipTransition.From)
+		assert.Equal(t, api.NodeMembershipAccepted, node.MembershipTransition.To)
+	})
+
+	// as is the expiry of its certificate
+	certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+	require.NoError(t, err)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		nodes, err := store.FindNodes(tx, store.ByCertExpiringBefore(certs[0].NotAfter.Add(time.Second)))
+		require.NoError(t, err)
+		var ids []string
+		for _, n := range nodes {
+			ids = append(ids, n.ID)
+		}
+		assert.Contains(t, ids, issueResponse.NodeID)
+	})
 }
 
 func TestForceRotationIsNoop(t *testing.T) {
@@ -153,567 +213,2622 @@ func TestIssueNodeCertificateBrokenCA(t *testing.T) {
 
 }
 
-func TestIssueNodeCertificateWithInvalidCSR(t *testing.T) {
+func TestIssueNodeCertificateBrokenCAFailFast(t *testing.T) {
+	if cautils.External {
+		// the test sets up its own external CA
+		return
+	}
+
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
 
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: []byte("random garbage"), Token: tc.WorkerToken}
-	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.NoError(t, err)
-	assert.NotNil(t, issueResponse.NodeID)
-	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
-
-	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
-	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+	externalServer, err := cautils.NewExternalSigningServer(tc.RootCA, tc.TempDir)
 	require.NoError(t, err)
-	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
-	assert.Contains(t, statusResponse.Status.Err, "CSR Decode failed")
-	assert.Nil(t, statusResponse.Certificate.Certificate)
-}
+	defer externalServer.Stop()
 
-func TestIssueNodeCertificateWorkerRenewal(t *testing.T) {
-	tc := cautils.NewTestCA(t)
-	defer tc.Stop()
+	tc.CAServer.Stop()
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, tc.Organization)
+		cluster.Spec.CAConfig.ExternalCAs = []*api.ExternalCA{{
+			Protocol: api.ExternalCA_CAProtocolCFSSL,
+			URL:      externalServer.URL,
+		}}
+		return store.UpdateCluster(tx, cluster)
+	}))
+	tc.CAServer.SetFailFastIssuance(true)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	// while the external CA is unavailable, joining and renewing fail straight away
+	externalServer.Flake()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 
 	csr, _, err := ca.GenerateNewCSR()
-	assert.NoError(t, err)
+	require.NoError(t, err)
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(ctx, &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
 
-	role := api.NodeRoleWorker
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
-	issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.NoError(t, err)
-	assert.NotNil(t, issueResponse.NodeID)
-	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
+	_, err = tc.NodeCAClients[1].IssueNodeCertificate(ctx, &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker})
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, grpc.Code(err))
 
-	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
-	statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+	// once it is back, issuance succeeds
+	externalServer.Deflake()
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(ctx, &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+	require.NoError(t, err)
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(ctx, &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
 	require.NoError(t, err)
 	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
-	assert.NotNil(t, statusResponse.Certificate.Certificate)
-	assert.Equal(t, role, statusResponse.Certificate.Role)
 }
 
-func TestIssueNodeCertificateManagerRenewal(t *testing.T) {
+func TestIssueNodeCertificateFailFastRequiredNodeLabels(t *testing.T) {
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
 
-	csr, _, err := ca.GenerateNewCSR()
-	assert.NoError(t, err)
-	assert.NotNil(t, csr)
+	tc.CAServer.Stop()
+	tc.CAServer.SetFailFastIssuance(true)
+	tc.CAServer.SetRequiredNodeLabels(map[string]string{"approved": "true"})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
 
-	role := api.NodeRoleManager
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
-	issueResponse, err := tc.NodeCAClients[2].IssueNodeCertificate(context.Background(), issueRequest)
+	// a joining node has no labels yet, so the join returns without waiting
+	// for a certificate that can't be signed until the node is labelled
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	csr, _, err := ca.GenerateNewCSR()
 	require.NoError(t, err)
-	assert.NotNil(t, issueResponse.NodeID)
-	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(ctx, &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+	require.NoError(t, err)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		node := store.GetNode(tx, issueResponse.NodeID)
+		require.NotNil(t, node)
+		assert.Equal(t, api.IssuanceStatePending, node.Certificate.Status.State)
+	})
 
-	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
-	statusResponse, err := tc.NodeCAClients[2].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		node := store.GetNode(tx, issueResponse.NodeID)
+		node.Spec.Annotations.Labels = map[string]string{"approved": "true"}
+		return store.UpdateNode(tx, node)
+	}))
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(ctx, &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
 	require.NoError(t, err)
 	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
-	assert.NotNil(t, statusResponse.Certificate.Certificate)
-	assert.Equal(t, role, statusResponse.Certificate.Role)
 }
 
-func TestIssueNodeCertificateWorkerFromDifferentOrgRenewal(t *testing.T) {
+func TestIssueNodeCertificateExternalCALocalFallback(t *testing.T) {
+	if cautils.External {
+		// the test sets up its own external CA, alongside the cluster's local key
+		return
+	}
+
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
 
+	externalServer, err := cautils.NewExternalSigningServer(tc.RootCA, tc.TempDir)
+	require.NoError(t, err)
+	defer externalServer.Stop()
+
+	// the cluster has an external CA, and the server has the root's key
+	tc.CAServer.Stop()
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, tc.Organization)
+		require.NotEmpty(t, cluster.RootCA.CAKey)
+		cluster.Spec.CAConfig.ExternalCAs = []*api.ExternalCA{{
+			Protocol: api.ExternalCA_CAProtocolCFSSL,
+			URL:      externalServer.URL,
+		}}
+		return store.UpdateCluster(tx, cluster)
+	}))
+	tc.CAServer.SetExternalCALocalFallback(true)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	renew := func() string {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+		issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+		return issueResponse.NodeID
+	}
+
+	// while the external CA is up, it signs the renewals
+	nodeID := renew()
+	select {
+	case event := <-eventq:
+		require.FailNow(t, "unexpected event", "%#v", event)
+	default:
+	}
+
+	// once it's down, the renewals are signed locally
+	externalServer.Flake()
+	defer externalServer.Deflake()
+	require.Equal(t, nodeID, renew())
+	select {
+	case event := <-eventq:
+		fallback, ok := event.(ca.ExternalCAFallback)
+		require.True(t, ok, "unexpected event %#v", event)
+		require.Equal(t, nodeID, fallback.NodeID)
+		require.NotEmpty(t, fallback.Err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no fallback event")
+	}
+
+	// a node joining the cluster still waits for the external CA
 	csr, _, err := ca.GenerateNewCSR()
-	assert.NoError(t, err)
+	require.NoError(t, err)
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	require.NoError(t, err)
+	ctx, cancelStatus := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancelStatus()
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	_, err = tc.NodeCAClients[0].NodeCertificateStatus(ctx, statusRequest)
+	require.Error(t, err)
+}
 
-	// Since we're using a client that has a different Organization, this request will be treated
-	// as a new certificate request, not allowing auto-renewal. Therefore, the request will fail.
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr}
-	_, err = tc.NodeCAClients[3].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.Error(t, err)
+func TestIssueNodeCertificateUnmatchedExternalCA(t *testing.T) {
+	if cautils.External {
+		// the rotation is to a root with a local key and an external CA that doesn't match it
+		return
+	}
+
+	for _, policy := range []ca.UnmatchedExternalCAPolicy{
+		ca.UnmatchedExternalCALocal,
+		ca.UnmatchedExternalCAFail,
+		ca.UnmatchedExternalCARetry,
+	} {
+		// each policy gets its own test CA, stopped at the end of its iteration
+		func() {
+			tc := cautils.NewTestCA(t)
+			defer tc.Stop()
+			tc.CAServer.Stop()
+			tc.CAServer.SetUnmatchedExternalCAPolicy(policy)
+			tc.CAServer.SetReconciliationRetryInterval(50 * time.Millisecond)
+			// keep the root rotation reconciler from telling the new node to rotate
+			// while its certificate is being checked
+			tc.CAServer.SetRootReconciliationInterval(time.Hour)
+			go tc.CAServer.Run(tc.Context)
+			<-tc.CAServer.Ready()
+
+			// rotate to a new root, while the only external CA still signs with the old one
+			crossSigned, _ := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+			require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+				cluster := store.GetCluster(tx, tc.Organization)
+				cluster.RootCA.RootRotation = &api.RootRotation{
+					CACert:            cautils.ECDSA256SHA256Cert,
+					CAKey:             cautils.ECDSA256Key,
+					CrossSignedCACert: crossSigned,
+				}
+				cluster.Spec.CAConfig.ExternalCAs = []*api.ExternalCA{{
+					Protocol: api.ExternalCA_CAProtocolCFSSL,
+					URL:      "https://127.0.0.1:1",
+					CACert:   tc.RootCA.Certs,
+				}}
+				return store.UpdateCluster(tx, cluster)
+			}))
+			require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+				if len(tc.ServingSecurityConfig.RootCA().Intermediates) == 0 {
+					return errors.New("the CA server has not picked up the rotation yet")
+				}
+				return nil
+			}, 5*time.Second))
+
+			csr, _, err := ca.GenerateNewCSR()
+			require.NoError(t, err)
+			issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+			require.NoError(t, err)
+			getNode := func() *api.Node {
+				var node *api.Node
+				tc.MemoryStore.View(func(tx store.ReadTx) {
+					node = store.GetNode(tx, issueResponse.NodeID)
+				})
+				return node
+			}
+
+			switch policy {
+			case ca.UnmatchedExternalCALocal:
+				// the new root's key is available, so the certificate is signed locally
+				statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
+				require.NoError(t, err)
+				require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+			case ca.UnmatchedExternalCAFail:
+				statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
+				require.NoError(t, err)
+				require.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+				require.Contains(t, statusResponse.Status.Err, "no external CA matches")
+			case ca.UnmatchedExternalCARetry:
+				// the node is left pending through several retries...
+				time.Sleep(200 * time.Millisecond)
+				require.Equal(t, api.IssuanceStatePending, getNode().Certificate.Status.State)
+
+				// ...until the external CA is removed, after which it is signed locally
+				require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+					cluster := store.GetCluster(tx, tc.Organization)
+					cluster.Spec.CAConfig.ExternalCAs = nil
+					return store.UpdateCluster(tx, cluster)
+				}))
+				require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+					if state := getNode().Certificate.Status.State; state != api.IssuanceStateIssued {
+						return errors.Errorf("node is in state %s", state)
+					}
+					return nil
+				}, 5*time.Second))
+			}
+		}()
+	}
 }
 
-func TestNodeCertificateRenewalsDoNotRequireToken(t *testing.T) {
+func TestIssueNodeCertificateWithInvalidCSR(t *testing.T) {
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
 
-	csr, _, err := ca.GenerateNewCSR()
-	assert.NoError(t, err)
-
-	role := api.NodeRoleManager
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
-	issueResponse, err := tc.NodeCAClients[2].IssueNodeCertificate(context.Background(), issueRequest)
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: []byte("random garbage"), Token: tc.WorkerToken}
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
 	assert.NoError(t, err)
 	assert.NotNil(t, issueResponse.NodeID)
 	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
 
 	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
-	statusResponse, err := tc.NodeCAClients[2].NodeCertificateStatus(context.Background(), statusRequest)
-	assert.NoError(t, err)
-	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
-	assert.NotNil(t, statusResponse.Certificate.Certificate)
-	assert.Equal(t, role, statusResponse.Certificate.Role)
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+	assert.Contains(t, statusResponse.Status.Err, "CSR Decode failed")
+	assert.Nil(t, statusResponse.Certificate.Certificate)
+}
 
-	role = api.NodeRoleWorker
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
-	issueResponse, err = tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+func TestIssueNodeCertificateSerialBitLength(t *testing.T) {
+	if cautils.External {
+		return // serial numbers are chosen by the external CA
+	}
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetSerialBitLength(ca.MaxSerialBitLength+1))
+	require.NoError(t, tc.CAServer.SetSerialBitLength(ca.MinSerialBitLength))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	csr, _, err := ca.GenerateNewCSR()
 	require.NoError(t, err)
-	assert.NotNil(t, issueResponse.NodeID)
-	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
 
-	statusRequest = &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
-	statusResponse, err = tc.NodeCAClients[2].NodeCertificateStatus(context.Background(), statusRequest)
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
 	require.NoError(t, err)
-	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
-	assert.NotNil(t, statusResponse.Certificate.Certificate)
-	assert.Equal(t, role, statusResponse.Certificate.Role)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+
+	certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+	require.NoError(t, err)
+	require.NotEmpty(t, certs)
+	require.Equal(t, 1, certs[0].SerialNumber.Sign())
+	require.True(t, certs[0].SerialNumber.BitLen() <= ca.MinSerialBitLength)
 }
 
-func TestNewNodeCertificateRequiresToken(t *testing.T) {
-	t.Parallel()
+func TestIssueNodeCertificateURISAN(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the extensions of the certificates it signs
+	}
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetURISANTemplate("{role}/{id}", "example.org"))
+	require.NoError(t, tc.CAServer.SetURISANTemplate("spiffe://{trust_domain}/{role}/{id}", "example.org"))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	issueAndGetCert := func(client api.NodeCAClient, issueRequest *api.IssueNodeCertificateRequest) (string, *x509.Certificate) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueRequest.CSR = csr
+		issueResponse, err := client.IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := client.NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
 
+		certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+		require.NoError(t, err)
+		require.NotEmpty(t, certs)
+		return issueResponse.NodeID, certs[0]
+	}
+
+	// a node joining with a token gets a URI with its new node ID
+	nodeID, cert := issueAndGetCert(tc.NodeCAClients[0], &api.IssueNodeCertificateRequest{Token: tc.WorkerToken})
+	require.Len(t, cert.URIs, 1)
+	require.Equal(t, "spiffe://example.org/worker/"+nodeID, cert.URIs[0].String())
+	require.Contains(t, cert.DNSNames, ca.WorkerRole)
+	require.Contains(t, cert.DNSNames, nodeID)
+
+	// renewing a certificate produces the same URI each time
+	nodeID, cert = issueAndGetCert(tc.NodeCAClients[1], &api.IssueNodeCertificateRequest{Role: api.NodeRoleWorker})
+	require.Len(t, cert.URIs, 1)
+	uri := cert.URIs[0].String()
+	require.Equal(t, "spiffe://example.org/worker/"+nodeID, uri)
+
+	_, cert = issueAndGetCert(tc.NodeCAClients[1], &api.IssueNodeCertificateRequest{Role: api.NodeRoleWorker})
+	require.Len(t, cert.URIs, 1)
+	require.Equal(t, uri, cert.URIs[0].String())
+}
+
+func TestIssueNodeCertificateAddressSANs(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the extensions of the certificates it signs
+	}
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
 
-	csr, _, err := ca.GenerateNewCSR()
-	assert.NoError(t, err)
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetNodeAddressSANs([]string{"10.0.0.1"}))
+	require.NoError(t, tc.CAServer.SetNodeAddressSANs([]string{"10.0.0.0/8", "fd00::/8"}))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
 
-	// Issuance fails if no secret is provided
-	role := api.NodeRoleManager
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+	renew := func() (string, *x509.Certificate) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+		issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
 
-	role = api.NodeRoleWorker
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
 
-	// Issuance fails if wrong secret is provided
-	role = api.NodeRoleManager
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+		certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+		require.NoError(t, err)
+		require.NotEmpty(t, certs)
+		return issueResponse.NodeID, certs[0]
+	}
+	setAddr := func(nodeID, addr string) {
+		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+			node := store.GetNode(tx, nodeID)
+			node.Status.Addr = addr
+			return store.UpdateNode(tx, node)
+		}))
+	}
 
-	role = api.NodeRoleWorker
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+	// the node hasn't reported an address yet
+	nodeID, cert := renew()
+	require.Empty(t, cert.IPAddresses)
 
-	// Issuance succeeds if correct token is provided
-	role = api.NodeRoleManager
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.ManagerToken}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.NoError(t, err)
+	// addresses outside the allowed ranges are left out
+	setAddr(nodeID, "192.168.1.5")
+	_, cert = renew()
+	require.Empty(t, cert.IPAddresses)
 
-	role = api.NodeRoleWorker
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.WorkerToken}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.NoError(t, err)
+	setAddr(nodeID, "10.1.2.3")
+	_, cert = renew()
+	require.Len(t, cert.IPAddresses, 1)
+	require.Equal(t, "10.1.2.3", cert.IPAddresses[0].String())
+	require.Contains(t, cert.DNSNames, nodeID)
 
-	// Rotate manager and worker tokens
-	var (
-		newManagerToken string
-		newWorkerToken  string
-	)
-	assert.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
-		clusters, _ := store.FindClusters(tx, store.ByName(store.DefaultClusterName))
-		newWorkerToken = ca.GenerateJoinToken(&tc.RootCA)
-		clusters[0].RootCA.JoinTokens.Worker = newWorkerToken
-		newManagerToken = ca.GenerateJoinToken(&tc.RootCA)
-		clusters[0].RootCA.JoinTokens.Manager = newManagerToken
-		return store.UpdateCluster(tx, clusters[0])
-	}))
+	// the addresses are kept alongside a URI SAN
+	tc.CAServer.Stop()
+	require.NoError(t, tc.CAServer.SetURISANTemplate("spiffe://{trust_domain}/{role}/{id}", "example.org"))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+	setAddr(nodeID, "fd00::1")
+	_, cert = renew()
+	require.Len(t, cert.IPAddresses, 1)
+	require.Equal(t, "fd00::1", cert.IPAddresses[0].String())
+	require.Len(t, cert.URIs, 1)
+	require.Contains(t, cert.DNSNames, nodeID)
+}
 
-	// updating the join token may take a little bit in order to register on the CA server, so poll
-	assert.NoError(t, testutils.PollFunc(nil, func() error {
-		// Old token should fail
-		role = api.NodeRoleManager
-		issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.ManagerToken}
-		_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-		if err == nil {
-			return fmt.Errorf("join token not updated yet")
+func TestIssueNodeCertificateClusterDomainSAN(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the extensions of the certificates it signs
+	}
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetClusterDomain("-example.org"))
+	require.Error(t, tc.CAServer.SetClusterDomain("example..org"))
+	require.Error(t, tc.CAServer.SetClusterDomain("example.org_"))
+	require.NoError(t, tc.CAServer.SetClusterDomain("Swarm.Example.org"))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	renew := func() (string, *x509.Certificate) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+		issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+
+		certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+		require.NoError(t, err)
+		require.NotEmpty(t, certs)
+		return issueResponse.NodeID, certs[0]
+	}
+	setHostname := func(nodeID, hostname string) {
+		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+			node := store.GetNode(tx, nodeID)
+			node.Description = &api.NodeDescription{Hostname: hostname}
+			return store.UpdateNode(tx, node)
+		}))
+	}
+
+	// the node hasn't reported its hostname yet
+	nodeID, cert := renew()
+	require.Equal(t, []string{ca.WorkerRole, nodeID}, cert.DNSNames)
+
+	setHostname(nodeID, "Worker-1")
+	_, cert = renew()
+	require.Equal(t, []string{ca.WorkerRole, nodeID, "worker-1.swarm.example.org"}, cert.DNSNames)
+
+	// every renewal gets the name again
+	_, cert = renew()
+	require.Contains(t, cert.DNSNames, "worker-1.swarm.example.org")
+
+	// a hostname that isn't a legal DNS label gets no name
+	setHostname(nodeID, "worker_1")
+	_, cert = renew()
+	require.Equal(t, []string{ca.WorkerRole, nodeID}, cert.DNSNames)
+	setHostname(nodeID, "worker.local")
+	_, cert = renew()
+	require.Equal(t, []string{ca.WorkerRole, nodeID}, cert.DNSNames)
+}
+
+func TestIssueNodeCertificateMetadataExtension(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the extensions of the certificates it signs
+	}
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	const oid = "1.3.6.1.4.1.99999.1"
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetNodeMetadataExtension("1", "{role}"))
+	require.Error(t, tc.CAServer.SetNodeMetadataExtension("1.3.x", "{role}"))
+	require.Error(t, tc.CAServer.SetNodeMetadataExtension("2.5.29.17", "{role}"))
+	require.NoError(t, tc.CAServer.SetNodeMetadataExtension(oid, "{role}/{org}/{id}"))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	extensionValue := func(certPEM []byte) []byte {
+		certs, err := helpers.ParseCertificatesPEM(certPEM)
+		require.NoError(t, err)
+		require.NotEmpty(t, certs)
+		for _, ext := range certs[0].Extensions {
+			if ext.Id.String() == oid {
+				require.False(t, ext.Critical)
+				return ext.Value
+			}
 		}
+		require.FailNow(t, "certificate has no node metadata extension")
 		return nil
-	}))
-
-	// Old token should fail
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+	}
 
-	role = api.NodeRoleWorker
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.WorkerToken}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+	nodeID, cert := issueWorkerCertificate(t, tc)
+	value := extensionValue(cert)
+	var decoded string
+	rest, err := asn1.UnmarshalWithParams(value, &decoded, "utf8")
+	require.NoError(t, err)
+	require.Empty(t, rest)
+	require.Equal(t, "worker/"+tc.Organization+"/"+nodeID, decoded)
 
-	// New token should succeed
-	role = api.NodeRoleManager
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: newManagerToken}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.NoError(t, err)
+	// a renewed certificate carries the same extension
+	renew := func() (string, []byte) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+		issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
 
-	role = api.NodeRoleWorker
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: newWorkerToken}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.NoError(t, err)
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+		return issueResponse.NodeID, statusResponse.Certificate.Certificate
+	}
+	renewedID, first := renew()
+	_, second := renew()
+	require.Equal(t, extensionValue(first), extensionValue(second))
+	rest, err = asn1.UnmarshalWithParams(extensionValue(second), &decoded, "utf8")
+	require.NoError(t, err)
+	require.Empty(t, rest)
+	require.Equal(t, "worker/"+tc.Organization+"/"+renewedID, decoded)
 }
 
-func TestNewNodeCertificateBadToken(t *testing.T) {
+func TestIssueNodeCertificateOCSPResponderURL(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the extensions of the certificates it signs
+	}
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
 
-	csr, _, err := ca.GenerateNewCSR()
-	assert.NoError(t, err)
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetOCSPResponderURL("ocsp.example.org"))
+	require.Error(t, tc.CAServer.SetOCSPResponderURL("ldap://ocsp.example.org"))
+	require.NoError(t, tc.CAServer.SetOCSPResponderURL("http://ocsp.example.org/swarm"))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
 
-	// Issuance fails if wrong secret is provided
-	role := api.NodeRoleManager
-	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+	_, cert := issueWorkerCertificate(t, tc)
+	certs, err := helpers.ParseCertificatesPEM(cert)
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://ocsp.example.org/swarm"}, certs[0].OCSPServer)
+}
 
-	role = api.NodeRoleWorker
-	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
-	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
-	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+func TestIssueNodeCertificateClampedToSignerExpiry(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the validity of the certificates it signs
+	}
+
+	// a signing certificate that expires long before the default node certificate expiration
+	now := time.Now()
+	rootCert, rootKey, err := cautils.CreateRootCertAndKey("short-lived-root")
+	require.NoError(t, err)
+	rootCert = cautils.ReDateCert(t, rootCert, rootCert, rootKey, now.Add(-time.Hour), now.Add(24*time.Hour))
+	rootCA, err := ca.NewRootCA(rootCert, rootCert, rootKey, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+
+	tempdir, err := ioutil.TempDir("", "test-clamped-to-signer-expiry")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempdir)
+
+	tc := cautils.NewTestCAFromRootCA(t, tempdir, rootCA, nil)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetSignerExpiryMargin(-time.Hour))
+	require.NoError(t, tc.CAServer.SetSignerExpiryMargin(time.Hour))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	nodeID, certPEM := issueWorkerCertificate(t, tc)
+	cert, err := helpers.ParseCertificatePEM(certPEM)
+	require.NoError(t, err)
+	require.False(t, cert.NotAfter.After(now.Add(23*time.Hour)), "certificate expires at %s", cert.NotAfter)
+	require.True(t, cert.NotAfter.After(now.Add(22*time.Hour)), "certificate expires at %s", cert.NotAfter)
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-eventq:
+			clamped, ok := event.(ca.CertificateExpiryClamped)
+			if !ok || clamped.NodeID != nodeID {
+				continue
+			}
+			require.WithinDuration(t, cert.NotAfter, clamped.NotAfter, time.Second)
+			return
+		case <-timeout:
+			t.Fatal("expected an event for the clamped certificate")
+		}
+	}
 }
 
-func TestGetUnlockKey(t *testing.T) {
-	t.Parallel()
+func TestIssueNodeCertificateMinExpiry(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the validity of the certificates it signs
+	}
 
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
 
-	var cluster *api.Cluster
-	tc.MemoryStore.View(func(tx store.ReadTx) {
-		clusters, err := store.FindClusters(tx, store.ByName(store.DefaultClusterName))
-		require.NoError(t, err)
-		cluster = clusters[0]
-	})
+	// a minimum above the cluster's configured expiration, which is the default
+	minExpiry := 2 * ca.DefaultNodeCertExpiration
+	_, certPEM := issueWorkerCertificate(t, tc)
+	cert, err := helpers.ParseCertificatePEM(certPEM)
+	require.NoError(t, err)
+	require.True(t, cert.NotAfter.Sub(cert.NotBefore) < minExpiry)
 
-	resp, err := tc.CAClients[0].GetUnlockKey(context.Background(), &api.GetUnlockKeyRequest{})
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetMinCertExpiry(-time.Hour))
+	require.NoError(t, tc.CAServer.SetMinCertExpiry(minExpiry))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	// the signer rounds the start of the validity period to the minute
+	now := time.Now().Add(-time.Minute)
+	_, certPEM = issueWorkerCertificate(t, tc)
+	cert, err = helpers.ParseCertificatePEM(certPEM)
 	require.NoError(t, err)
-	require.Nil(t, resp.UnlockKey)
-	require.Equal(t, cluster.Meta.Version, resp.Version)
+	require.False(t, cert.NotAfter.Before(now.Add(minExpiry)), "certificate expires at %s", cert.NotAfter)
+	require.True(t, cert.NotAfter.Sub(cert.NotBefore) >= minExpiry)
 
-	// Update the unlock key
+	// a cluster expiration too short to use is raised to the minimum, instead
+	// of being replaced by the default
+	tc.CAServer.Stop()
+	var cluster *api.Cluster
 	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
-		cluster = store.GetCluster(tx, cluster.ID)
-		cluster.Spec.EncryptionConfig.AutoLockManagers = true
-		cluster.UnlockKeys = []*api.EncryptionKey{{
-			Subsystem: ca.ManagerRole,
-			Key:       []byte("secret"),
-		}}
+		cluster = store.GetCluster(tx, tc.Organization)
+		cluster.Spec.CAConfig.NodeCertExpiry = gogotypes.DurationProto(time.Second)
 		return store.UpdateCluster(tx, cluster)
 	}))
+	// don't wait for the cluster watch to pick up the new expiration
+	require.NoError(t, tc.CAServer.UpdateRootCA(tc.Context, cluster))
+	require.NoError(t, tc.CAServer.SetMinCertExpiry(time.Minute))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
 
-	tc.MemoryStore.View(func(tx store.ReadTx) {
-		cluster = store.GetCluster(tx, cluster.ID)
-	})
-
-	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
-		resp, err = tc.CAClients[0].GetUnlockKey(context.Background(), &api.GetUnlockKeyRequest{})
-		if err != nil {
-			return fmt.Errorf("get unlock key: %v", err)
-		}
-		if !bytes.Equal(resp.UnlockKey, []byte("secret")) {
-			return fmt.Errorf("secret hasn't rotated yet")
-		}
-		if cluster.Meta.Version.Index > resp.Version.Index {
-			return fmt.Errorf("hasn't updated to the right version yet")
-		}
-		return nil
-	}, 250*time.Millisecond))
+	_, certPEM = issueWorkerCertificate(t, tc)
+	cert, err = helpers.ParseCertificatePEM(certPEM)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute+ca.CertBackdate, cert.NotAfter.Sub(cert.NotBefore))
 }
 
-type clusterObjToUpdate struct {
-	clusterObj           *api.Cluster
-	rootCARoots          []byte
-	rootCASigningCert    []byte
-	rootCASigningKey     []byte
-	rootCAIntermediates  []byte
-	externalCertSignedBy []byte
-}
+func TestIssueNodeCertificateRoleOU(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
 
-func TestCAServerUpdateRootCA(t *testing.T) {
-	// this one needs both external CA servers for testing
-	if !cautils.External {
-		return
-	}
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetRoleOUFormat("acme-manager"))
+	require.NoError(t, tc.CAServer.SetRoleOUFormat("acme-{role}"))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
 
-	fakeClusterSpec := func(rootCerts, key []byte, rotation *api.RootRotation, externalCAs []*api.ExternalCA) *api.Cluster {
-		return &api.Cluster{
-			RootCA: api.RootCA{
-				CACert:     rootCerts,
-				CAKey:      key,
-				CACertHash: "hash",
-				JoinTokens: api.JoinTokens{
-					Worker:  "SWMTKN-1-worker",
-					Manager: "SWMTKN-1-manager",
-				},
-				RootRotation: rotation,
-			},
-			Spec: api.ClusterSpec{
-				CAConfig: api.CAConfig{
-					ExternalCAs: externalCAs,
-				},
-			},
-		}
+	issueAndGetCert := func(client api.NodeCAClient, issueRequest *api.IssueNodeCertificateRequest) (string, *x509.Certificate) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueRequest.CSR = csr
+		issueResponse, err := client.IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := client.NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+
+		certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+		require.NoError(t, err)
+		require.NotEmpty(t, certs)
+		return issueResponse.NodeID, certs[0]
 	}
+	// the order of the OUs in a certificate isn't preserved
+	sortedOUs := func(cert *x509.Certificate) []string {
+		ous := append([]string(nil), cert.Subject.OrganizationalUnit...)
+		sort.Strings(ous)
+		return ous
+	}
+
+	// the role OU the swarm authorizes on is kept
+	_, cert := issueAndGetCert(tc.NodeCAClients[0], &api.IssueNodeCertificateRequest{Token: tc.ManagerToken})
+	require.Equal(t, []string{"acme-manager", ca.ManagerRole}, sortedOUs(cert))
+	require.Equal(t, []string{tc.Organization}, cert.Subject.Organization)
+	verified, err := tc.RootCA.VerifyCertificate(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	require.NoError(t, err)
+	require.Equal(t, ca.ManagerRole, verified.Role)
+
+	_, cert = issueAndGetCert(tc.NodeCAClients[0], &api.IssueNodeCertificateRequest{Token: tc.WorkerToken})
+	require.Equal(t, []string{"acme-worker", ca.WorkerRole}, sortedOUs(cert))
+
+	// renewals get the same OUs
+	nodeID, cert := issueAndGetCert(tc.NodeCAClients[2], &api.IssueNodeCertificateRequest{Role: api.NodeRoleManager})
+	require.Equal(t, nodeID, cert.Subject.CommonName)
+	require.Equal(t, []string{"acme-manager", ca.ManagerRole}, sortedOUs(cert))
+
+	// a format that expands to the role OU doesn't add it twice
+	tc.CAServer.Stop()
+	require.NoError(t, tc.CAServer.SetRoleOUFormat("swarm-{role}"))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	_, cert = issueAndGetCert(tc.NodeCAClients[0], &api.IssueNodeCertificateRequest{Token: tc.ManagerToken})
+	require.Equal(t, []string{ca.ManagerRole}, cert.Subject.OrganizationalUnit)
+}
 
+func TestPrime(t *testing.T) {
 	tc := cautils.NewTestCA(t)
-	require.NoError(t, tc.CAServer.Stop())
 	defer tc.Stop()
 
-	cert, key, err := cautils.CreateRootCertAndKey("new root to rotate to")
-	require.NoError(t, err)
-	newRootCA, err := ca.NewRootCA(append(tc.RootCA.Certs, cert...), cert, key, ca.DefaultNodeCertExpiration, nil)
+	// priming can be repeated
+	require.NoError(t, tc.CAServer.Prime(tc.Context))
+	require.NoError(t, tc.CAServer.Prime(tc.Context))
+
+	csr, _, err := ca.GenerateNewCSR()
 	require.NoError(t, err)
-	externalServer, err := cautils.NewExternalSigningServer(newRootCA, tc.TempDir)
+
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
 	require.NoError(t, err)
-	defer externalServer.Stop()
-	crossSigned, err := tc.RootCA.CrossSignCACertificate(cert)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
 	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	require.NotNil(t, statusResponse.Certificate.Certificate)
+}
 
-	for i, testCase := range []clusterObjToUpdate{
-		{
-			clusterObj: fakeClusterSpec(tc.RootCA.Certs, nil, nil, []*api.ExternalCA{{
-				Protocol: api.ExternalCA_CAProtocolCFSSL,
-				URL:      tc.ExternalSigningServer.URL,
-				// without a CA cert, the URL gets successfully added, and there should be no error connecting to it
-			}}),
-			rootCARoots:          tc.RootCA.Certs,
-			externalCertSignedBy: tc.RootCA.Certs,
-		},
-		{
-			clusterObj: fakeClusterSpec(tc.RootCA.Certs, nil, &api.RootRotation{
-				CACert:            cert,
-				CAKey:             key,
-				CrossSignedCACert: crossSigned,
-			}, []*api.ExternalCA{
-				{
-					Protocol: api.ExternalCA_CAProtocolCFSSL,
-					URL:      tc.ExternalSigningServer.URL,
-					// without a CA cert, we count this as the old tc.RootCA.Certs, and this should be ignored because we want the new root
-				},
-			}),
-			rootCARoots:         tc.RootCA.Certs,
-			rootCASigningCert:   crossSigned,
-			rootCASigningKey:    key,
-			rootCAIntermediates: crossSigned,
-		},
-		{
-			clusterObj: fakeClusterSpec(tc.RootCA.Certs, nil, &api.RootRotation{
-				CACert:            cert,
-				CrossSignedCACert: crossSigned,
-			}, []*api.ExternalCA{
-				{
-					Protocol: api.ExternalCA_CAProtocolCFSSL,
-					URL:      tc.ExternalSigningServer.URL,
-					// without a CA cert, we count this as the old tc.RootCA.Certs
-				},
-				{
-					Protocol: api.ExternalCA_CAProtocolCFSSL,
-					URL:      externalServer.URL,
-					CACert:   append(cert, '\n'),
-				},
-			}),
-			rootCARoots:          tc.RootCA.Certs,
-			rootCAIntermediates:  crossSigned,
-			externalCertSignedBy: cert,
-		},
-	} {
-		require.NoError(t, tc.CAServer.UpdateRootCA(context.Background(), testCase.clusterObj))
+type testAttestationVerifier struct{}
 
-		rootCA := tc.ServingSecurityConfig.RootCA()
-		require.Equal(t, testCase.rootCARoots, rootCA.Certs)
-		var signingCert, signingKey []byte
-		if s, err := rootCA.Signer(); err == nil {
-			signingCert, signingKey = s.Cert, s.Key
-		}
-		require.Equal(t, testCase.rootCARoots, rootCA.Certs)
-		require.Equal(t, testCase.rootCASigningCert, signingCert, "%d", i)
-		require.Equal(t, testCase.rootCASigningKey, signingKey, "%d", i)
-		require.Equal(t, testCase.rootCAIntermediates, rootCA.Intermediates)
+func (testAttestationVerifier) VerifyAttestation(ctx context.Context, nodeID string, csr, attestation []byte) error {
+	if string(attestation) != "good" {
+		return errors.New("attestation does not verify")
+	}
+	return nil
+}
 
-		externalCA := tc.ServingSecurityConfig.ExternalCA()
+func TestIssueNodeCertificateAttestation(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	tc.CAServer.SetAttestationVerifier(testAttestationVerifier{})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	issue := func(attestation string) *api.NodeCertificateStatusResponse {
 		csr, _, err := ca.GenerateNewCSR()
 		require.NoError(t, err)
-		signedCert, err := externalCA.Sign(context.Background(), ca.PrepareCSR(csr, "cn", ca.ManagerRole, tc.Organization))
 
-		if testCase.externalCertSignedBy != nil {
-			require.NoError(t, err)
-			parsed, err := helpers.ParseCertificatePEM(signedCert)
-			require.NoError(t, err)
-			rootPool := x509.NewCertPool()
-			rootPool.AppendCertsFromPEM(testCase.externalCertSignedBy)
-			_, err = parsed.Verify(x509.VerifyOptions{Roots: rootPool})
-			require.NoError(t, err)
-		} else {
-			require.Equal(t, ca.ErrNoExternalCAURLs, err)
-		}
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken, Attestation: []byte(attestation)}
+		issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		return statusResponse
 	}
 
-	// If we can't save the root cert, we can't update the root CA even if it's completely valid
-	require.NoError(t, os.RemoveAll(tc.TempDir))
-	require.NoError(t, ioutil.WriteFile(tc.TempDir, []byte("cant create directory if this is file"), 0700))
-	tc.CAServer.UpdateRootCA(context.Background(), fakeClusterSpec(cautils.ECDSA256SHA256Cert, cautils.ECDSA256Key, nil, nil))
-	require.Equal(t, tc.RootCA.Certs, tc.ServingSecurityConfig.RootCA().Certs)
-}
+	statusResponse := issue("bad")
+	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+	assert.Contains(t, statusResponse.Status.Err, "policy rejected")
+	assert.Contains(t, statusResponse.Status.Err, "attestation does not verify")
+	assert.Empty(t, statusResponse.Certificate.Certificate)
 
-type rootRotationTester struct {
-	tc *cautils.TestCA
-	t  *testing.T
+	statusResponse = issue("good")
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.NotEmpty(t, statusResponse.Certificate.Certificate)
 }
 
-// go through all the nodes and update/create the ones we want, and delete the ones
-// we don't
-func (r *rootRotationTester) convergeWantedNodes(wantNodes map[string]*api.Node, descr string) {
-	// update existing and create new nodes first before deleting nodes, else a root rotation
-	// may finish early if all the nodes get deleted when the root rotation happens
-	require.NoError(r.t, r.tc.MemoryStore.Update(func(tx store.Tx) error {
-		for nodeID, wanted := range wantNodes {
-			node := store.GetNode(tx, nodeID)
-			if node == nil {
-				if err := store.CreateNode(tx, wanted); err != nil {
-					return err
-				}
-				continue
-			}
-			node.Description = wanted.Description
-			node.Certificate = wanted.Certificate
-			if err := store.UpdateNode(tx, node); err != nil {
-				return err
-			}
-		}
-		nodes, err := store.FindNodes(tx, store.All)
-		if err != nil {
-			return err
-		}
-		for _, node := range nodes {
-			if _, inWanted := wantNodes[node.ID]; !inWanted {
-				if err := store.DeleteNode(tx, node.ID); err != nil {
-					return err
-				}
-			}
-		}
-		return nil
-	}), descr)
-}
+func TestIssueNodeCertificateKeyStrengthPolicy(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
 
-func (r *rootRotationTester) convergeRootCA(wantRootCA *api.RootCA, descr string) {
-	require.NoError(r.t, r.tc.MemoryStore.Update(func(tx store.Tx) error {
-		clusters, err := store.FindClusters(tx, store.All)
-		if err != nil || len(clusters) != 1 {
-			return errors.Wrap(err, "unable to find cluster")
-		}
-		clusters[0].RootCA = *wantRootCA
-		return store.UpdateCluster(tx, clusters[0])
-	}), descr)
+	tc.CAServer.Stop()
+	tc.CAServer.SetKeyStrengthPolicy(ca.KeyStrengthPolicy{MinRSABits: 2048, Curves: []string{"P-256"}})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	issue := func(key interface{}) *api.NodeCertificateStatusResponse {
+		der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			Subject: pkix.Name{CommonName: "swarm-test-CN"},
+		}, key)
+		require.NoError(t, err)
+		csr := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+		issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		return statusResponse
+	}
+
+	weakRSA, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	statusResponse := issue(weakRSA)
+	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+	assert.Contains(t, statusResponse.Status.Err, "policy rejected")
+	assert.Contains(t, statusResponse.Status.Err, "RSA key size 1024")
+	assert.Empty(t, statusResponse.Certificate.Certificate)
+
+	otherCurve, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	statusResponse = issue(otherCurve)
+	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+	assert.Contains(t, statusResponse.Status.Err, "policy rejected")
+	assert.Contains(t, statusResponse.Status.Err, "P-384")
+
+	strongRSA, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	statusResponse = issue(strongRSA)
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.NotEmpty(t, statusResponse.Certificate.Certificate)
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+	require.NoError(t, err)
+	statusResponse, err = tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
+	require.NoError(t, err)
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
 }
 
-func getFakeAPINode(t *testing.T, id string, state api.IssuanceStatus_State, tlsInfo *api.NodeTLSInfo, member bool) *api.Node {
-	node := &api.Node{
-		ID: id,
-		Certificate: api.Certificate{
-			Status: api.IssuanceStatus{
-				State: state,
-			},
-		},
-		Spec: api.NodeSpec{
-			Membership: api.NodeMembershipAccepted,
+func TestIssueNodeCertificateIssuancePolicy(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	policy := ca.NewIssuancePolicy(ca.KeyStrengthRule(ca.KeyStrengthPolicy{MinRSABits: 2048}))
+	policy.Register(ca.IssuanceRule{
+		Name: "SAN limit",
+		Check: func(node *api.Node, csr *x509.CertificateRequest) error {
+			if len(csr.DNSNames) > 1 {
+				return errors.Errorf("%d DNS names requested, at most 1 allowed", len(csr.DNSNames))
+			}
+			return nil
 		},
+	})
+	tc.CAServer.Stop()
+	tc.CAServer.SetIssuancePolicy(policy)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	issue := func(bits int, dnsNames ...string) *api.NodeCertificateStatusResponse {
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		require.NoError(t, err)
+		der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			Subject:  pkix.Name{CommonName: "swarm-test-CN"},
+			DNSNames: dnsNames,
+		}, key)
+		require.NoError(t, err)
+		csr := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+
+		issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+		require.NoError(t, err)
+		statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
+		require.NoError(t, err)
+		return statusResponse
 	}
-	if !member {
-		node.Spec.Membership = api.NodeMembershipPending
-	}
-	// the CA server will immediately pick these up, so generate CSRs for the CA server to sign
-	if state == api.IssuanceStateRenew || state == api.IssuanceStatePending {
+
+	// a request failing either rule fails with that rule's reason
+	statusResponse := issue(1024)
+	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+	assert.Contains(t, statusResponse.Status.Err, "policy rejected: key strength: RSA key size 1024")
+
+	statusResponse = issue(2048, "a.example.com", "b.example.com")
+	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+	assert.Contains(t, statusResponse.Status.Err, "policy rejected: SAN limit: 2 DNS names requested")
+
+	// the rules are evaluated in order, so the first one to fail gives the reason
+	statusResponse = issue(1024, "a.example.com", "b.example.com")
+	assert.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
+	assert.Contains(t, statusResponse.Status.Err, "key strength")
+
+	statusResponse = issue(2048, "a.example.com")
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.NotEmpty(t, statusResponse.Certificate.Certificate)
+}
+
+func TestIssueNodeCertificateFailureAlert(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	var (
+		mu     sync.Mutex
+		spikes []ca.IssuanceFailureSpike
+	)
+	tc.CAServer.Stop()
+	tc.CAServer.SetAttestationVerifier(testAttestationVerifier{})
+	tc.CAServer.SetIssuanceFailureAlert(2, time.Minute, func(spike ca.IssuanceFailureSpike) {
+		mu.Lock()
+		defer mu.Unlock()
+		spikes = append(spikes, spike)
+	})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	// every issuance fails, because no attestation is presented
+	for i := 0; i < 5; i++ {
 		csr, _, err := ca.GenerateNewCSR()
 		require.NoError(t, err)
-		node.Certificate.CSR = csr
-	}
-	if tlsInfo != nil {
-		node.Description = &api.NodeDescription{TLSInfo: tlsInfo}
+
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+		issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+		statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateFailed, statusResponse.Status.State)
 	}
-	return node
-}
 
-func startCAServer(caServer *ca.Server) {
-	alreadyRunning := make(chan struct{})
-	go func() {
-		if err := caServer.Run(context.Background()); err != nil {
-			close(alreadyRunning)
-		}
-	}()
-	select {
-	case <-caServer.Ready():
-	case <-alreadyRunning:
+	// the alert fired once, when the third failure crossed the threshold
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, spikes, 1)
+	assert.Equal(t, 3, spikes[0].Failures)
+	assert.Equal(t, time.Minute, spikes[0].Window)
+	require.Len(t, spikes[0].Reasons, 3)
+	for _, reason := range spikes[0].Reasons {
+		assert.Contains(t, reason, "policy rejected")
 	}
 }
 
-func getRotationInfo(t *testing.T, rotationCert []byte, rootCA *ca.RootCA) ([]byte, *api.NodeTLSInfo) {
-	parsedNewRoot, err := helpers.ParseCertificatePEM(rotationCert)
-	require.NoError(t, err)
-	crossSigned, err := rootCA.CrossSignCACertificate(rotationCert)
-	require.NoError(t, err)
-	return crossSigned, &api.NodeTLSInfo{
-		TrustRoot:           rootCA.Certs,
-		CertIssuerPublicKey: parsedNewRoot.RawSubjectPublicKeyInfo,
-		CertIssuerSubject:   parsedNewRoot.RawSubject,
-	}
+// slowAttestationVerifier accepts every attestation, but takes its time, so that
+// certificates back up waiting to be signed.
+type slowAttestationVerifier struct {
+	delay time.Duration
 }
 
-// These are the root rotation test cases where we expect there to be a change in the FindNodes
-// or root CA values after converging.
-func TestRootRotationReconciliationWithChanges(t *testing.T) {
-	t.Parallel()
-	if cautils.External {
-		// the external CA functionality is unrelated to testing the reconciliation loop
-		return
-	}
+func (v slowAttestationVerifier) VerifyAttestation(ctx context.Context, nodeID string, csr, attestation []byte) error {
+	time.Sleep(v.delay)
+	return nil
+}
 
+func TestIssueNodeCertificateIssuanceQueue(t *testing.T) {
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
-	rt := rootRotationTester{
-		tc: tc,
-		t:  t,
-	}
 
-	rotationCerts := [][]byte{cautils.ECDSA256SHA256Cert, cautils.ECDSACertChain[2]}
-	rotationKeys := [][]byte{cautils.ECDSA256Key, cautils.ECDSACertChainKeys[2]}
-	var (
-		rotationCrossSigned [][]byte
-		rotationTLSInfo     []*api.NodeTLSInfo
-	)
-	for _, cert := range rotationCerts {
-		cross, info := getRotationInfo(t, cert, &tc.RootCA)
-		rotationCrossSigned = append(rotationCrossSigned, cross)
-		rotationTLSInfo = append(rotationTLSInfo, info)
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetIssuanceQueue(0, 1, 1))
+	require.Error(t, tc.CAServer.SetIssuanceQueue(10, 0, 1))
+	require.Error(t, tc.CAServer.SetIssuanceQueue(10, 1, -1))
+	require.NoError(t, tc.CAServer.SetIssuanceQueue(100, 1, 1))
+	tc.CAServer.SetAttestationVerifier(slowAttestationVerifier{delay: 20 * time.Millisecond})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	// generating CSRs is slow, so do it ahead of the flood
+	var csrs [][]byte
+	for i := 0; i < 51; i++ {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		csrs = append(csrs, csr)
 	}
 
-	oldNodeTLSInfo := &api.NodeTLSInfo{
-		TrustRoot:           tc.RootCA.Certs,
-		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
-		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+	// flood the CA with joining nodes
+	var joined []string
+	for _, csr := range csrs[1:] {
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+		issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+		require.NoError(t, err)
+		joined = append(joined, issueResponse.NodeID)
 	}
 
-	var startCluster *api.Cluster
+	// a renewal behind them is issued without waiting for all of them
+	require.NoError(t, renewWithCSR(t, tc, csrs[0]))
+
+	var pending int
 	tc.MemoryStore.View(func(tx store.ReadTx) {
-		startCluster = store.GetCluster(tx, tc.Organization)
+		for _, nodeID := range joined {
+			node := store.GetNode(tx, nodeID)
+			require.NotNil(t, node)
+			if node.Certificate.Status.State == api.IssuanceStatePending {
+				pending++
+			}
+		}
 	})
-	require.NotNil(t, startCluster)
+	assert.True(t, pending > len(joined)/2, "only %d of %d joining nodes were still pending", pending, len(joined))
 
-	testcases := []struct {
-		nodes           map[string]*api.Node // what nodes we should start with
-		rootCA          *api.RootCA          // what root CA we should start with
-		expectedNodes   map[string]*api.Node // what nodes we expect in the end, if nil, then unchanged from the start
-		expectedRootCA  *api.RootCA          // what root CA we expect in the end, if nil, then unchanged from the start
+	// and the joining nodes are all issued eventually
+	for _, nodeID := range joined {
+		statusRequest := &api.NodeCertificateStatusRequest{NodeID: nodeID}
+		statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	}
+}
+
+func TestIssueNodeCertificateIssuanceQueueFull(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	require.NoError(t, tc.CAServer.SetIssuanceQueue(5, 1, 1))
+	tc.CAServer.SetAttestationVerifier(slowAttestationVerifier{delay: 100 * time.Millisecond})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	var exhausted int
+	for i := 0; i < 20; i++ {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+		_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+		if err != nil {
+			require.Equal(t, codes.ResourceExhausted, grpc.Code(err))
+			exhausted++
+		}
+	}
+	assert.True(t, exhausted > 0, "no join request was rejected")
+}
+
+func TestIssueNodeCertificateCSRTooLarge(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetMaxCSRSize(0))
+	require.Error(t, tc.CAServer.SetMaxCSRSize(-1))
+	require.NoError(t, tc.CAServer.SetMaxCSRSize(len(csr)-1))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	var nodesBefore []*api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		nodesBefore, err = store.FindNodes(tx, store.All)
+	})
+	require.NoError(t, err)
+
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// the request was rejected outright, so no node was created, and none entered the failed state
+	var nodesAfter []*api.Node
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		nodesAfter, err = store.FindNodes(tx, store.All)
+	})
+	require.NoError(t, err)
+	assert.Len(t, nodesAfter, len(nodesBefore))
+	for _, n := range nodesAfter {
+		assert.NotEqual(t, api.IssuanceStateFailed, n.Certificate.Status.State)
+	}
+
+	tc.CAServer.Stop()
+	require.NoError(t, tc.CAServer.SetMaxCSRSize(len(csr)))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.NoError(t, err)
+}
+
+func TestIssueNodeCertificateWorkerRenewal(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+
+	role := api.NodeRoleWorker
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
+	issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.NoError(t, err)
+	assert.NotNil(t, issueResponse.NodeID)
+	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.NotNil(t, statusResponse.Certificate.Certificate)
+	assert.Equal(t, role, statusResponse.Certificate.Role)
+}
+
+func TestIssueNodeCertificateRenewalKeyPolicy(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	renew := func() ([]byte, error) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		return csr, renewWithCSR(t, tc, csr)
+	}
+
+	// by default, a renewing node may change its key
+	_, err := renew()
+	require.NoError(t, err)
+	csr, err := renew()
+	require.NoError(t, err)
+
+	// when the key is pinned, the node may only renew with its current key
+	tc.CAServer.Stop()
+	tc.CAServer.SetRenewalKeyPolicy(ca.RenewalKeyPinned)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+	_, err = renew()
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+	require.NoError(t, renewWithCSR(t, tc, csr))
+}
+
+func TestIssueNodeCertificateRenewalStatePolicy(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	tc.CAServer.SetRenewalStatePolicy(ca.RenewalStatePolicy{
+		Availabilities: []api.NodeSpec_Availability{api.NodeAvailabilityDrain},
+		States:         []api.NodeStatus_State{api.NodeStatus_DOWN},
+	})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	renew := func(client api.NodeCAClient, role api.NodeRole) (string, error) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueResponse, err := client.IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Role: role})
+		if err != nil {
+			return "", err
+		}
+		return issueResponse.NodeID, nil
+	}
+	updateNode := func(nodeID string, update func(*api.Node)) {
+		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+			node := store.GetNode(tx, nodeID)
+			require.NotNil(t, node)
+			update(node)
+			return store.UpdateNode(tx, node)
+		}))
+	}
+
+	// active nodes renew their certificates
+	workerID, err := renew(tc.NodeCAClients[1], api.NodeRoleWorker)
+	require.NoError(t, err)
+	managerID, err := renew(tc.NodeCAClients[2], api.NodeRoleManager)
+	require.NoError(t, err)
+
+	// a drained worker may not renew, but the manager still may
+	updateNode(workerID, func(node *api.Node) {
+		node.Spec.Availability = api.NodeAvailabilityDrain
+	})
+	_, err = renew(tc.NodeCAClients[1], api.NodeRoleWorker)
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+	_, err = renew(tc.NodeCAClients[2], api.NodeRoleManager)
+	require.NoError(t, err)
+
+	// nor may a node that is down
+	updateNode(managerID, func(node *api.Node) {
+		node.Status.State = api.NodeStatus_DOWN
+	})
+	_, err = renew(tc.NodeCAClients[2], api.NodeRoleManager)
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+
+	// new nodes can still join
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+	require.NoError(t, err)
+}
+
+func TestIssueNodeCertificateRequiredNodeLabels(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	renew := func() (string, error) {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker})
+		if err != nil {
+			return "", err
+		}
+		return issueResponse.NodeID, nil
+	}
+	setLabels := func(nodeID string, labels map[string]string) {
+		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+			node := store.GetNode(tx, nodeID)
+			require.NotNil(t, node)
+			node.Spec.Annotations.Labels = labels
+			return store.UpdateNode(tx, node)
+		}))
+	}
+
+	nodeID, err := renew()
+	require.NoError(t, err)
+
+	tc.CAServer.Stop()
+	tc.CAServer.SetRequiredNodeLabels(map[string]string{"approved": "true", "zone": ""})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	// a node lacking the labels, or with the wrong value, may not renew
+	for _, labels := range []map[string]string{
+		nil,
+		{"approved": "true"},
+		{"approved": "false", "zone": "a"},
+	} {
+		setLabels(nodeID, labels)
+		_, err = renew()
+		require.Error(t, err)
+		require.Equal(t, codes.FailedPrecondition, grpc.Code(err), "labels %v", labels)
+	}
+
+	setLabels(nodeID, map[string]string{"approved": "true", "zone": "a"})
+	_, err = renew()
+	require.NoError(t, err)
+	statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: nodeID})
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+
+	// a new node lacking the labels waits for its first certificate
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	_, err = tc.NodeCAClients[0].NodeCertificateStatus(ctx, &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
+	cancel()
+	require.Error(t, err)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		node := store.GetNode(tx, issueResponse.NodeID)
+		require.NotNil(t, node)
+		require.Equal(t, api.IssuanceStatePending, node.Certificate.Status.State)
+	})
+
+	// and gets it once it is labelled
+	setLabels(issueResponse.NodeID, map[string]string{"approved": "true", "zone": "c"})
+	statusResponse, err = tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	require.NotEmpty(t, statusResponse.Certificate.Certificate)
+
+	// but one labelled before it is accepted gets it
+	labelled := getFakeAPINode(t, "labelled", api.IssuanceStatePending, nil, true)
+	labelled.Spec.Annotations.Labels = map[string]string{"approved": "true", "zone": "b"}
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		return store.CreateNode(tx, labelled)
+	}))
+	statusResponse, err = tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: "labelled"})
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+}
+
+func TestDuplicateHostnames(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	tc.CAServer.SetDuplicateHostnameCheck(true)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	nodeWithHostname := func(id, hostname string, member bool) *api.Node {
+		node := getFakeAPINode(t, id, api.IssuanceStatePending, nil, member)
+		node.Description = &api.NodeDescription{Hostname: hostname}
+		return node
+	}
+	// hostnames are compared case-insensitively, and only accepted nodes count
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		for _, node := range []*api.Node{
+			nodeWithHostname("dup-a", "Host-A", true),
+			nodeWithHostname("pending", "host-a", false),
+			nodeWithHostname("other", "host-b", true),
+			nodeWithHostname("dup-b", "host-a", true),
+		} {
+			if err := store.CreateNode(tx, node); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	timeout := time.After(5 * time.Second)
+	for flagged := false; !flagged; {
+		select {
+		case event := <-eventq:
+			if duplicate, ok := event.(ca.DuplicateHostname); ok {
+				require.Equal(t, ca.DuplicateHostname{Hostname: "host-a", NodeIDs: []string{"dup-a", "dup-b"}}, duplicate)
+				flagged = true
+			}
+		case <-timeout:
+			t.Fatal("expected the duplicate hostname to be flagged")
+		}
+	}
+
+	// the duplicates are still issued certificates
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		var err error
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			for _, nodeID := range []string{"dup-a", "dup-b"} {
+				if node := store.GetNode(tx, nodeID); node.Certificate.Status.State != api.IssuanceStateIssued {
+					err = errors.Errorf("node %s is in state %s", nodeID, node.Certificate.Status.State)
+				}
+			}
+		})
+		return err
+	}, 5*time.Second))
+}
+
+// renewWithCSR renews the certificate of the worker node used by
+// tc.NodeCAClients[1], and waits for the new certificate to be issued.
+func renewWithCSR(t *testing.T, tc *cautils.TestCA, csr []byte) error {
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+	issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+	if err != nil {
+		return err
+	}
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	return nil
+}
+
+func TestNodeCertificateStatusRenewAfter(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	require.Error(t, tc.CAServer.SetRenewalFraction(0))
+	require.Error(t, tc.CAServer.SetRenewalFraction(1.5))
+	require.NoError(t, tc.CAServer.SetRenewalFraction(0.25))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+	issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+	require.NoError(t, err)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	require.NotNil(t, statusResponse.RenewAfter)
+
+	certs, err := helpers.ParseCertificatesPEM(statusResponse.Certificate.Certificate)
+	require.NoError(t, err)
+	require.NotEmpty(t, certs)
+	renewAfter, err := gogotypes.TimestampFromProto(statusResponse.RenewAfter)
+	require.NoError(t, err)
+	validity := certs[0].NotAfter.Sub(certs[0].NotBefore)
+	require.True(t, renewAfter.Equal(certs[0].NotBefore.Add(validity/4)))
+}
+
+func TestIssueNodeCertificateRenewalUpdatesLastIssued(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+	issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+	require.NoError(t, err)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	require.NotNil(t, statusResponse.Certificate.LastIssued)
+	firstIssued, err := gogotypes.TimestampFromProto(statusResponse.Certificate.LastIssued)
+	require.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	csr, _, err = ca.GenerateNewCSR()
+	require.NoError(t, err)
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker}
+	_, err = tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+	require.NoError(t, err)
+
+	statusResponse, err = tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	require.NotNil(t, statusResponse.Certificate.LastIssued)
+	secondIssued, err := gogotypes.TimestampFromProto(statusResponse.Certificate.LastIssued)
+	require.NoError(t, err)
+	assert.True(t, secondIssued.After(firstIssued))
+}
+
+func TestLookupCertificateBySerial(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	nodeID, certPEM := issueWorkerCertificate(t, tc)
+	cert, err := helpers.ParseCertificatePEM(certPEM)
+	require.NoError(t, err)
+	rootCert, err := helpers.ParseCertificatePEM(tc.RootCA.Certs)
+	require.NoError(t, err)
+
+	serial := cert.SerialNumber.Text(16)
+	// the serial as openssl prints it
+	var colonSerial []string
+	for _, b := range cert.SerialNumber.Bytes() {
+		colonSerial = append(colonSerial, fmt.Sprintf("%02X", b))
+	}
+
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		for _, s := range []string{serial, strings.Join(colonSerial, ":")} {
+			info, err := store.LookupCertificateBySerial(tx, s)
+			require.NoError(t, err)
+			assert.Equal(t, nodeID, info.NodeID)
+			assert.Equal(t, api.NodeRoleWorker, info.Role)
+			assert.Equal(t, cert.Subject.String(), info.Subject)
+			assert.Equal(t, rootCert.Subject.String(), info.Issuer)
+			assert.True(t, cert.NotAfter.Equal(info.NotAfter))
+		}
+
+		_, err := store.LookupCertificateBySerial(tx, "abcdef")
+		assert.Equal(t, store.ErrNotExist, err)
+	})
+
+	// a certificate is still found while its node renews it, and afterwards
+	// from the node's issuance history
+	renew := func() string {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		issueResponse, err := tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr, Role: api.NodeRoleWorker})
+		require.NoError(t, err)
+		return issueResponse.NodeID
+	}
+	waitIssued := func(nodeID string) *api.Certificate {
+		statusResponse, err := tc.NodeCAClients[1].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: nodeID})
+		require.NoError(t, err)
+		require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+		var node *api.Node
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			node = store.GetNode(tx, nodeID)
+		})
+		require.NotNil(t, node)
+		return &node.Certificate
+	}
+	workerID := renew()
+	oldCert := waitIssued(workerID)
+	oldNotAfter, err := gogotypes.TimestampFromProto(oldCert.NotAfter)
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	tc.CAServer.Stop()
+	tc.CAServer.SetAttestationVerifier(blockingAttestationVerifier(release))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	lookupOld := func() {
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			info, err := store.LookupCertificateBySerial(tx, oldCert.Serial)
+			require.NoError(t, err)
+			assert.Equal(t, workerID, info.NodeID)
+			assert.Equal(t, rootCert.Subject.String(), info.Issuer)
+			assert.True(t, oldNotAfter.Equal(info.NotAfter))
+		})
+	}
+	renew()
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		node := store.GetNode(tx, workerID)
+		require.Equal(t, api.IssuanceStateRenew, node.Certificate.Status.State)
+		assert.Equal(t, oldCert.Serial, node.Certificate.Serial)
+		assert.Equal(t, oldCert.NotAfter, node.Certificate.NotAfter)
+	})
+	lookupOld()
+
+	close(release)
+	newCert := waitIssued(workerID)
+	require.NotEqual(t, oldCert.Serial, newCert.Serial)
+	lookupOld()
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		info, err := store.LookupCertificateBySerial(tx, newCert.Serial)
+		require.NoError(t, err)
+		assert.Equal(t, workerID, info.NodeID)
+		assert.NotEmpty(t, info.Subject)
+	})
+}
+
+// blockingAttestationVerifier accepts every attestation, once it is closed.
+type blockingAttestationVerifier chan struct{}
+
+func (v blockingAttestationVerifier) VerifyAttestation(ctx context.Context, nodeID string, csr, attestation []byte) error {
+	<-v
+	return nil
+}
+
+func TestIssueNodeCertificateManagerRenewal(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+	assert.NotNil(t, csr)
+
+	role := api.NodeRoleManager
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
+	issueResponse, err := tc.NodeCAClients[2].IssueNodeCertificate(context.Background(), issueRequest)
+	require.NoError(t, err)
+	assert.NotNil(t, issueResponse.NodeID)
+	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[2].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.NotNil(t, statusResponse.Certificate.Certificate)
+	assert.Equal(t, role, statusResponse.Certificate.Role)
+}
+
+func TestIssueNodeCertificateWorkerFromDifferentOrgRenewal(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+
+	// Since we're using a client that has a different Organization, this request will be treated
+	// as a new certificate request, not allowing auto-renewal. Therefore, the request will fail.
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr}
+	_, err = tc.NodeCAClients[3].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.Error(t, err)
+}
+
+func TestNodeCertificateRenewalsDoNotRequireToken(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+
+	role := api.NodeRoleManager
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
+	issueResponse, err := tc.NodeCAClients[2].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.NoError(t, err)
+	assert.NotNil(t, issueResponse.NodeID)
+	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[2].NodeCertificateStatus(context.Background(), statusRequest)
+	assert.NoError(t, err)
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.NotNil(t, statusResponse.Certificate.Certificate)
+	assert.Equal(t, role, statusResponse.Certificate.Role)
+
+	role = api.NodeRoleWorker
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
+	issueResponse, err = tc.NodeCAClients[1].IssueNodeCertificate(context.Background(), issueRequest)
+	require.NoError(t, err)
+	assert.NotNil(t, issueResponse.NodeID)
+	assert.Equal(t, api.NodeMembershipAccepted, issueResponse.NodeMembership)
+
+	statusRequest = &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err = tc.NodeCAClients[2].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	assert.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.NotNil(t, statusResponse.Certificate.Certificate)
+	assert.Equal(t, role, statusResponse.Certificate.Role)
+}
+
+func TestNewNodeCertificateRequiresToken(t *testing.T) {
+	t.Parallel()
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+
+	// Issuance fails if no secret is provided
+	role := api.NodeRoleManager
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+
+	role = api.NodeRoleWorker
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+
+	// Issuance fails if wrong secret is provided
+	role = api.NodeRoleManager
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+
+	role = api.NodeRoleWorker
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+
+	// Issuance succeeds if correct token is provided
+	role = api.NodeRoleManager
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.ManagerToken}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.NoError(t, err)
+
+	role = api.NodeRoleWorker
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.WorkerToken}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.NoError(t, err)
+
+	// Rotate manager and worker tokens
+	var (
+		newManagerToken string
+		newWorkerToken  string
+	)
+	assert.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		clusters, _ := store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+		newWorkerToken = ca.GenerateJoinToken(&tc.RootCA)
+		clusters[0].RootCA.JoinTokens.Worker = newWorkerToken
+		newManagerToken = ca.GenerateJoinToken(&tc.RootCA)
+		clusters[0].RootCA.JoinTokens.Manager = newManagerToken
+		return store.UpdateCluster(tx, clusters[0])
+	}))
+
+	// updating the join token may take a little bit in order to register on the CA server, so poll
+	assert.NoError(t, testutils.PollFunc(nil, func() error {
+		// Old token should fail
+		role = api.NodeRoleManager
+		issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.ManagerToken}
+		_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+		if err == nil {
+			return fmt.Errorf("join token not updated yet")
+		}
+		return nil
+	}))
+
+	// Old token should fail
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+
+	role = api.NodeRoleWorker
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: tc.WorkerToken}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+
+	// New token should succeed
+	role = api.NodeRoleManager
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: newManagerToken}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.NoError(t, err)
+
+	role = api.NodeRoleWorker
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: newWorkerToken}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.NoError(t, err)
+}
+
+func TestNewNodeCertificateSHA512Token(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	token, err := ca.GenerateJoinTokenWithAlgorithm(&tc.RootCA, digest.SHA512)
+	require.NoError(t, err)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, tc.Organization)
+		cluster.RootCA.JoinTokens.Worker = token
+		return store.UpdateCluster(tx, cluster)
+	}))
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+
+	// updating the join token may take a little bit in order to register on the CA server, so poll
+	var issueResponse *api.IssueNodeCertificateResponse
+	require.NoError(t, testutils.PollFunc(nil, func() error {
+		issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: token}
+		issueResponse, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+		return err
+	}))
+
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	require.NotEmpty(t, statusResponse.Certificate.Certificate)
+}
+
+func TestNewNodeCertificateBadToken(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	csr, _, err := ca.GenerateNewCSR()
+	assert.NoError(t, err)
+
+	// Issuance fails if wrong secret is provided
+	role := api.NodeRoleManager
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+
+	role = api.NodeRoleWorker
+	issueRequest = &api.IssueNodeCertificateRequest{CSR: csr, Role: role, Token: "invalid-secret"}
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), issueRequest)
+	assert.EqualError(t, err, "rpc error: code = 3 desc = A valid join token is necessary to join this cluster")
+}
+
+func TestGetUnlockKey(t *testing.T) {
+	t.Parallel()
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	var cluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		clusters, err := store.FindClusters(tx, store.ByName(store.DefaultClusterName))
+		require.NoError(t, err)
+		cluster = clusters[0]
+	})
+
+	resp, err := tc.CAClients[0].GetUnlockKey(context.Background(), &api.GetUnlockKeyRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp.UnlockKey)
+	require.Equal(t, cluster.Meta.Version, resp.Version)
+
+	// Update the unlock key
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster = store.GetCluster(tx, cluster.ID)
+		cluster.Spec.EncryptionConfig.AutoLockManagers = true
+		cluster.UnlockKeys = []*api.EncryptionKey{{
+			Subsystem: ca.ManagerRole,
+			Key:       []byte("secret"),
+		}}
+		return store.UpdateCluster(tx, cluster)
+	}))
+
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, cluster.ID)
+	})
+
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		resp, err = tc.CAClients[0].GetUnlockKey(context.Background(), &api.GetUnlockKeyRequest{})
+		if err != nil {
+			return fmt.Errorf("get unlock key: %v", err)
+		}
+		if !bytes.Equal(resp.UnlockKey, []byte("secret")) {
+			return fmt.Errorf("secret hasn't rotated yet")
+		}
+		if cluster.Meta.Version.Index > resp.Version.Index {
+			return fmt.Errorf("hasn't updated to the right version yet")
+		}
+		return nil
+	}, 250*time.Millisecond))
+}
+
+func TestGetNodeCertificateChain(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// cross-signing the rotation root requires the current root's key
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	var rootCA api.RootCA
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		rootCA = store.GetCluster(tx, tc.Organization).RootCA
+	})
+	crossSigned, _ := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+	rootCA.RootRotation = &api.RootRotation{
+		CACert:            cautils.ECDSA256SHA256Cert,
+		CAKey:             cautils.ECDSA256Key,
+		CrossSignedCACert: crossSigned,
+	}
+	rt.convergeRootCA(&rootCA, "start a root rotation")
+
+	// during the rotation, certificates are signed by the new root and issued with the cross-signed intermediate
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		if len(tc.ServingSecurityConfig.RootCA().Intermediates) == 0 {
+			return errors.New("the CA server has not picked up the rotation yet")
+		}
+		return nil
+	}, 5*time.Second))
+	nodeID, _ := issueWorkerCertificate(t, tc)
+
+	resp, err := tc.CAClients[2].GetNodeCertificateChain(context.Background(), &api.GetNodeCertificateChainRequest{NodeID: nodeID})
+	require.NoError(t, err)
+	leaf, err := helpers.ParseCertificatesPEM(resp.Certificate)
+	require.NoError(t, err)
+	require.Len(t, leaf, 1)
+	intermediates, err := helpers.ParseCertificatesPEM(resp.Intermediates)
+	require.NoError(t, err)
+	require.Len(t, intermediates, 1)
+
+	// the chain verifies against the old root, which the rest of the cluster still trusts
+	intermediatePool := x509.NewCertPool()
+	intermediatePool.AddCert(intermediates[0])
+	_, err = leaf[0].Verify(x509.VerifyOptions{
+		Roots:         tc.RootCA.Pool,
+		Intermediates: intermediatePool,
+	})
+	require.NoError(t, err)
+
+	_, err = tc.CAClients[2].GetNodeCertificateChain(context.Background(), &api.GetNodeCertificateChainRequest{NodeID: "nonexistent"})
+	require.Equal(t, codes.NotFound, grpc.Code(err))
+
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		return store.CreateNode(tx, getFakeAPINode(t, "pending", api.IssuanceStatePending, nil, false))
+	}))
+	_, err = tc.CAClients[2].GetNodeCertificateChain(context.Background(), &api.GetNodeCertificateChainRequest{NodeID: "pending"})
+	require.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+}
+
+func TestGetNodeIssuanceHistory(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	_, err := tc.CAClients[2].GetNodeIssuanceHistory(context.Background(), &api.GetNodeIssuanceHistoryRequest{})
+	require.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	_, err = tc.CAClients[2].GetNodeIssuanceHistory(context.Background(), &api.GetNodeIssuanceHistoryRequest{NodeID: "nonexistent"})
+	require.Equal(t, codes.NotFound, grpc.Code(err))
+
+	nodeID, certPEM := issueWorkerCertificate(t, tc)
+	serials := []string{}
+	addSerial := func(certPEM []byte) {
+		cert, err := helpers.ParseCertificatePEM(certPEM)
+		require.NoError(t, err)
+		serials = append(serials, cert.SerialNumber.Text(16))
+	}
+	addSerial(certPEM)
+
+	// renew the node's certificate, and wait for the new one
+	renew := func() {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+			node := store.GetNode(tx, nodeID)
+			node.Certificate.CSR = csr
+			node.Certificate.Status.State = api.IssuanceStateRenew
+			return store.UpdateNode(tx, node)
+		}))
+		var node *api.Node
+		require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+			tc.MemoryStore.View(func(tx store.ReadTx) {
+				node = store.GetNode(tx, nodeID)
+			})
+			if node.Certificate.Status.State != api.IssuanceStateIssued {
+				return errors.New("certificate not renewed yet")
+			}
+			return nil
+		}, 5*time.Second))
+		addSerial(node.Certificate.Certificate)
+	}
+	renew()
+
+	rootCert, err := helpers.ParseCertificatePEM(tc.RootCA.Certs)
+	require.NoError(t, err)
+	resp, err := tc.CAClients[2].GetNodeIssuanceHistory(context.Background(), &api.GetNodeIssuanceHistoryRequest{NodeID: nodeID})
+	require.NoError(t, err)
+	require.Len(t, resp.History, 2)
+	require.Equal(t, api.IssuanceReasonJoin, resp.History[0].Reason)
+	require.Equal(t, api.IssuanceReasonRenewal, resp.History[1].Reason)
+	for i, record := range resp.History {
+		require.Equal(t, serials[i], record.Serial)
+		require.Equal(t, rootCert.Subject.String(), record.Issuer)
+		require.NotNil(t, record.Timestamp)
+	}
+	first, err := gogotypes.TimestampFromProto(resp.History[0].Timestamp)
+	require.NoError(t, err)
+	second, err := gogotypes.TimestampFromProto(resp.History[1].Timestamp)
+	require.NoError(t, err)
+	require.False(t, second.Before(first))
+
+	// only the most recent records are kept
+	for i := 0; i < ca.MaxIssuanceHistory; i++ {
+		renew()
+	}
+	resp, err = tc.CAClients[2].GetNodeIssuanceHistory(context.Background(), &api.GetNodeIssuanceHistoryRequest{NodeID: nodeID})
+	require.NoError(t, err)
+	require.Len(t, resp.History, ca.MaxIssuanceHistory)
+	for i, record := range resp.History {
+		require.Equal(t, serials[len(serials)-ca.MaxIssuanceHistory+i], record.Serial)
+		require.Equal(t, api.IssuanceReasonRenewal, record.Reason)
+	}
+}
+
+type clusterObjToUpdate struct {
+	clusterObj           *api.Cluster
+	rootCARoots          []byte
+	rootCASigningCert    []byte
+	rootCASigningKey     []byte
+	rootCAIntermediates  []byte
+	externalCertSignedBy []byte
+}
+
+func TestCAServerUpdateRootCA(t *testing.T) {
+	// this one needs both external CA servers for testing
+	if !cautils.External {
+		return
+	}
+
+	fakeClusterSpec := func(rootCerts, key []byte, rotation *api.RootRotation, externalCAs []*api.ExternalCA) *api.Cluster {
+		return &api.Cluster{
+			RootCA: api.RootCA{
+				CACert:     rootCerts,
+				CAKey:      key,
+				CACertHash: "hash",
+				JoinTokens: api.JoinTokens{
+					Worker:  "SWMTKN-1-worker",
+					Manager: "SWMTKN-1-manager",
+				},
+				RootRotation: rotation,
+			},
+			Spec: api.ClusterSpec{
+				CAConfig: api.CAConfig{
+					ExternalCAs: externalCAs,
+				},
+			},
+		}
+	}
+
+	tc := cautils.NewTestCA(t)
+	require.NoError(t, tc.CAServer.Stop())
+	defer tc.Stop()
+
+	cert, key, err := cautils.CreateRootCertAndKey("new root to rotate to")
+	require.NoError(t, err)
+	newRootCA, err := ca.NewRootCA(append(tc.RootCA.Certs, cert...), cert, key, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	externalServer, err := cautils.NewExternalSigningServer(newRootCA, tc.TempDir)
+	require.NoError(t, err)
+	defer externalServer.Stop()
+	crossSigned, err := tc.RootCA.CrossSignCACertificate(cert)
+	require.NoError(t, err)
+
+	for i, testCase := range []clusterObjToUpdate{
+		{
+			clusterObj: fakeClusterSpec(tc.RootCA.Certs, nil, nil, []*api.ExternalCA{{
+				Protocol: api.ExternalCA_CAProtocolCFSSL,
+				URL:      tc.ExternalSigningServer.URL,
+				// without a CA cert, the URL gets successfully added, and there should be no error connecting to it
+			}}),
+			rootCARoots:          tc.RootCA.Certs,
+			externalCertSignedBy: tc.RootCA.Certs,
+		},
+		{
+			clusterObj: fakeClusterSpec(tc.RootCA.Certs, nil, &api.RootRotation{
+				CACert:            cert,
+				CAKey:             key,
+				CrossSignedCACert: crossSigned,
+			}, []*api.ExternalCA{
+				{
+					Protocol: api.ExternalCA_CAProtocolCFSSL,
+					URL:      tc.ExternalSigningServer.URL,
+					// without a CA cert, we count this as the old tc.RootCA.Certs, and this should be ignored because we want the new root
+				},
+			}),
+			rootCARoots:         tc.RootCA.Certs,
+			rootCASigningCert:   crossSigned,
+			rootCASigningKey:    key,
+			rootCAIntermediates: crossSigned,
+		},
+		{
+			clusterObj: fakeClusterSpec(tc.RootCA.Certs, nil, &api.RootRotation{
+				CACert:            cert,
+				CrossSignedCACert: crossSigned,
+			}, []*api.ExternalCA{
+				{
+					Protocol: api.ExternalCA_CAProtocolCFSSL,
+					URL:      tc.ExternalSigningServer.URL,
+					// without a CA cert, we count this as the old tc.RootCA.Certs
+				},
+				{
+					Protocol: api.ExternalCA_CAProtocolCFSSL,
+					URL:      externalServer.URL,
+					CACert:   append(cert, '\n'),
+				},
+			}),
+			rootCARoots:          tc.RootCA.Certs,
+			rootCAIntermediates:  crossSigned,
+			externalCertSignedBy: cert,
+		},
+	} {
+		require.NoError(t, tc.CAServer.UpdateRootCA(context.Background(), testCase.clusterObj))
+
+		rootCA := tc.ServingSecurityConfig.RootCA()
+		require.Equal(t, testCase.rootCARoots, rootCA.Certs)
+		var signingCert, signingKey []byte
+		if s, err := rootCA.Signer(); err == nil {
+			signingCert, signingKey = s.Cert, s.Key
+		}
+		require.Equal(t, testCase.rootCARoots, rootCA.Certs)
+		require.Equal(t, testCase.rootCASigningCert, signingCert, "%d", i)
+		require.Equal(t, testCase.rootCASigningKey, signingKey, "%d", i)
+		require.Equal(t, testCase.rootCAIntermediates, rootCA.Intermediates)
+
+		externalCA := tc.ServingSecurityConfig.ExternalCA()
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		signedCert, err := externalCA.Sign(context.Background(), ca.PrepareCSR(csr, "cn", ca.ManagerRole, tc.Organization))
+
+		if testCase.externalCertSignedBy != nil {
+			require.NoError(t, err)
+			parsed, err := helpers.ParseCertificatePEM(signedCert)
+			require.NoError(t, err)
+			rootPool := x509.NewCertPool()
+			rootPool.AppendCertsFromPEM(testCase.externalCertSignedBy)
+			_, err = parsed.Verify(x509.VerifyOptions{Roots: rootPool})
+			require.NoError(t, err)
+		} else {
+			require.Equal(t, ca.ErrNoExternalCAURLs, err)
+		}
+	}
+
+	// If we can't save the root cert, we can't update the root CA even if it's completely valid
+	require.NoError(t, os.RemoveAll(tc.TempDir))
+	require.NoError(t, ioutil.WriteFile(tc.TempDir, []byte("cant create directory if this is file"), 0700))
+	tc.CAServer.UpdateRootCA(context.Background(), fakeClusterSpec(cautils.ECDSA256SHA256Cert, cautils.ECDSA256Key, nil, nil))
+	require.Equal(t, tc.RootCA.Certs, tc.ServingSecurityConfig.RootCA().Certs)
+}
+
+func TestCAServerUpdateRootCAUnpersisted(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	require.NoError(t, tc.CAServer.Stop())
+	defer tc.Stop()
+
+	clusterWithRoot := func(name string) *api.Cluster {
+		cert, key, err := cautils.CreateRootCertAndKey(name)
+		require.NoError(t, err)
+		return &api.Cluster{
+			RootCA: api.RootCA{
+				CACert:     cert,
+				CAKey:      key,
+				CACertHash: "hash",
+			},
+		}
+	}
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	// the root certs can't be saved if the directory can't be created
+	require.NoError(t, os.RemoveAll(tc.TempDir))
+	require.NoError(t, ioutil.WriteFile(tc.TempDir, []byte("cant create directory if this is file"), 0700))
+
+	// by default, the root CA isn't updated
+	unsaved := clusterWithRoot("unsaved root")
+	require.Error(t, tc.CAServer.UpdateRootCA(context.Background(), unsaved))
+	require.Equal(t, tc.RootCA.Certs, tc.ServingSecurityConfig.RootCA().Certs)
+	require.False(t, tc.CAServer.DiagnosticsSnapshot().RootNotPersisted)
+
+	// with best effort persistence, the root CA is used without being saved
+	tc.CAServer.SetRootPersistencePolicy(ca.RootPersistenceBestEffort)
+	require.NoError(t, tc.CAServer.UpdateRootCA(context.Background(), unsaved))
+	require.Equal(t, unsaved.RootCA.CACert, tc.ServingSecurityConfig.RootCA().Certs)
+	_, err := tc.ServingSecurityConfig.RootCA().Signer()
+	require.NoError(t, err)
+	require.True(t, tc.CAServer.DiagnosticsSnapshot().RootNotPersisted)
+	select {
+	case event := <-eventq:
+		require.Equal(t, ca.RootCANotPersisted{RootCerts: unsaved.RootCA.CACert}, event)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no RootCANotPersisted event")
+	}
+
+	// further updates to the cluster are handled as usual, and the root CA
+	// stays unsaved until it changes again
+	unsaved.Spec.CAConfig.ExternalCAs = []*api.ExternalCA{{Protocol: api.ExternalCA_CAProtocolCFSSL, URL: "https://unused"}}
+	require.NoError(t, tc.CAServer.UpdateRootCA(context.Background(), unsaved))
+	require.True(t, tc.CAServer.DiagnosticsSnapshot().RootNotPersisted)
+
+	require.NoError(t, os.Remove(tc.TempDir))
+	saved := clusterWithRoot("saved root")
+	require.NoError(t, tc.CAServer.UpdateRootCA(context.Background(), saved))
+	require.Equal(t, saved.RootCA.CACert, tc.ServingSecurityConfig.RootCA().Certs)
+	require.False(t, tc.CAServer.DiagnosticsSnapshot().RootNotPersisted)
+	select {
+	case event := <-eventq:
+		t.Fatalf("unexpected event %#v", event)
+	default:
+	}
+}
+
+type rootRotationTester struct {
+	tc *cautils.TestCA
+	t  *testing.T
+}
+
+// go through all the nodes and update/create the ones we want, and delete the ones
+// we don't
+func (r *rootRotationTester) convergeWantedNodes(wantNodes map[string]*api.Node, descr string) {
+	// update existing and create new nodes first before deleting nodes, else a root rotation
+	// may finish early if all the nodes get deleted when the root rotation happens
+	require.NoError(r.t, r.tc.MemoryStore.Update(func(tx store.Tx) error {
+		for _, wanted := range wantNodes {
+			node, err := store.CreateOrGetNode(tx, wanted)
+			if err != nil {
+				return err
+			}
+			if node == wanted {
+				continue
+			}
+			node.Description = wanted.Description
+			node.Certificate = wanted.Certificate
+			if err := store.UpdateNode(tx, node); err != nil {
+				return err
+			}
+		}
+		nodes, err := store.FindNodes(tx, store.All)
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes {
+			if _, inWanted := wantNodes[node.ID]; !inWanted {
+				if err := store.DeleteNode(tx, node.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}), descr)
+}
+
+func (r *rootRotationTester) convergeRootCA(wantRootCA *api.RootCA, descr string) {
+	require.NoError(r.t, r.tc.MemoryStore.Update(func(tx store.Tx) error {
+		return store.UpdateClusterRootCA(tx, r.tc.Organization, wantRootCA)
+	}), descr)
+}
+
+func getFakeAPINode(t *testing.T, id string, state api.IssuanceStatus_State, tlsInfo *api.NodeTLSInfo, member bool) *api.Node {
+	node := &api.Node{
+		ID: id,
+		Certificate: api.Certificate{
+			Status: api.IssuanceStatus{
+				State: state,
+			},
+		},
+		Spec: api.NodeSpec{
+			Membership: api.NodeMembershipAccepted,
+		},
+	}
+	if !member {
+		node.Spec.Membership = api.NodeMembershipPending
+	}
+	// the CA server will immediately pick these up, so generate CSRs for the CA server to sign
+	if state == api.IssuanceStateRenew || state == api.IssuanceStatePending {
+		csr, _, err := ca.GenerateNewCSR()
+		require.NoError(t, err)
+		node.Certificate.CSR = csr
+	}
+	if tlsInfo != nil {
+		node.Description = &api.NodeDescription{TLSInfo: tlsInfo}
+	}
+	return node
+}
+
+func startCAServer(caServer *ca.Server) {
+	alreadyRunning := make(chan struct{})
+	go func() {
+		if err := caServer.Run(context.Background()); err != nil {
+			close(alreadyRunning)
+		}
+	}()
+	select {
+	case <-caServer.Ready():
+	case <-alreadyRunning:
+	}
+}
+
+func getRotationInfo(t *testing.T, rotationCert []byte, rootCA *ca.RootCA) ([]byte, *api.NodeTLSInfo) {
+	parsedNewRoot, err := helpers.ParseCertificatePEM(rotationCert)
+	require.NoError(t, err)
+	crossSigned, err := rootCA.CrossSignCACertificate(rotationCert)
+	require.NoError(t, err)
+	return crossSigned, &api.NodeTLSInfo{
+		TrustRoot:           rootCA.Certs,
+		CertIssuerPublicKey: parsedNewRoot.RawSubjectPublicKeyInfo,
+		CertIssuerSubject:   parsedNewRoot.RawSubject,
+	}
+}
+
+// These are the root rotation test cases where we expect there to be a change in the FindNodes
+// or root CA values after converging.
+func TestRootRotationReconciliationWithChanges(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	rotationCerts := [][]byte{cautils.ECDSA256SHA256Cert, cautils.ECDSACertChain[2]}
+	rotationKeys := [][]byte{cautils.ECDSA256Key, cautils.ECDSACertChainKeys[2]}
+	var (
+		rotationCrossSigned [][]byte
+		rotationTLSInfo     []*api.NodeTLSInfo
+	)
+	for _, cert := range rotationCerts {
+		cross, info := getRotationInfo(t, cert, &tc.RootCA)
+		rotationCrossSigned = append(rotationCrossSigned, cross)
+		rotationTLSInfo = append(rotationTLSInfo, info)
+	}
+
+	oldNodeTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           tc.RootCA.Certs,
+		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
+		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+	}
+
+	var startCluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		startCluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, startCluster)
+
+	testcases := []struct {
+		nodes           map[string]*api.Node // what nodes we should start with
+		rootCA          *api.RootCA          // what root CA we should start with
+		expectedNodes   map[string]*api.Node // what nodes we expect in the end, if nil, then unchanged from the start
+		expectedRootCA  *api.RootCA          // what root CA we expect in the end, if nil, then unchanged from the start
 		caServerRestart bool                 // whether to stop the CA server before making the node and root changes and restart after
 		descr           string
 	}{
 		{
-			descr: ("If there is no TLS info, the reconciliation cycle tells the nodes to rotate if they're not already getting " +
-				"a new cert.  Any renew/pending nodes will have certs issued, but because the TLS info is nil, they will " +
-				`go "rotate" state`),
+			descr: ("If there is no TLS info, the reconciliation cycle tells the nodes to rotate if they're not already getting " +
+				"a new cert.  Any renew/pending nodes will have certs issued, but because the TLS info is nil, they will " +
+				`go "rotate" state`),
+			nodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, nil, true),
+				"2": getFakeAPINode(t, "2", api.IssuanceStateRenew, nil, true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, nil, true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStatePending, nil, true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateFailed, nil, true),
+			},
+			rootCA: &api.RootCA{
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
+				RootRotation: &api.RootRotation{
+					CACert:            rotationCerts[0],
+					CAKey:             rotationKeys[0],
+					CrossSignedCACert: rotationCrossSigned[0],
+				},
+			},
+			expectedNodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateRotate, nil, true),
+				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, nil, true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, nil, true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateRotate, nil, true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateRotate, nil, true),
+			},
+		},
+		{
+			descr: ("Assume all of the nodes have gotten certs, but some of them are the wrong cert " +
+				"(going by the TLS info), which shouldn't really happen.  the rotation reconciliation " +
+				"will tell the wrong ones to rotate a second time"),
+			nodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"2": getFakeAPINode(t, "2", api.IssuanceStateIssued, oldNodeTLSInfo, true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, oldNodeTLSInfo, true),
+			},
+			rootCA: &api.RootCA{ // no change in root CA from previous
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
+				RootRotation: &api.RootRotation{
+					CACert:            rotationCerts[0],
+					CAKey:             rotationKeys[0],
+					CrossSignedCACert: rotationCrossSigned[0],
+				},
+			},
+			expectedNodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, oldNodeTLSInfo, true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateRotate, oldNodeTLSInfo, true),
+			},
+		},
+		{
+			descr: ("New nodes that are added will also be picked up and told to rotate"),
+			nodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"6": getFakeAPINode(t, "6", api.IssuanceStateRenew, nil, true),
+			},
+			rootCA: &api.RootCA{ // no change in root CA from previous
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
+				RootRotation: &api.RootRotation{
+					CACert:            rotationCerts[0],
+					CAKey:             rotationKeys[0],
+					CrossSignedCACert: rotationCrossSigned[0],
+				},
+			},
+			expectedNodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"6": getFakeAPINode(t, "6", api.IssuanceStateRotate, nil, true),
+			},
+		},
+		{
+			descr: ("Even if root rotation isn't finished, if the root changes again to a " +
+				"different cert, all the nodes with the old root rotation cert will be told " +
+				"to rotate again."),
+			nodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, oldNodeTLSInfo, true),
+				"6": getFakeAPINode(t, "6", api.IssuanceStateIssued, rotationTLSInfo[0], true),
+			},
+			rootCA: &api.RootCA{ // new root rotation
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
+				RootRotation: &api.RootRotation{
+					CACert:            rotationCerts[1],
+					CAKey:             rotationKeys[1],
+					CrossSignedCACert: rotationCrossSigned[1],
+				},
+			},
+			expectedNodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateRotate, rotationTLSInfo[0], true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateRotate, rotationTLSInfo[0], true),
+				"5": getFakeAPINode(t, "5", api.IssuanceStateRotate, oldNodeTLSInfo, true),
+				"6": getFakeAPINode(t, "6", api.IssuanceStateRotate, rotationTLSInfo[0], true),
+			},
+		},
+		{
+			descr: ("Once all nodes have rotated to their desired TLS info (even if it's because " +
+				"a node with the wrong TLS info has been removed, the root rotation is completed."),
+			nodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"6": getFakeAPINode(t, "6", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+			},
+			rootCA: &api.RootCA{
+				// no change in root CA from previous - even if root rotation gets completed after
+				// the nodes are first set, and we just add the root rotation again because of this
+				// test order, because the TLS info is correct for all nodes it will be completed again
+				// anyway)
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
+				RootRotation: &api.RootRotation{
+					CACert:            rotationCerts[1],
+					CAKey:             rotationKeys[1],
+					CrossSignedCACert: rotationCrossSigned[1],
+				},
+			},
+			expectedRootCA: &api.RootCA{
+				CACert:     rotationCerts[1],
+				CAKey:      rotationKeys[1],
+				CACertHash: digest.FromBytes(rotationCerts[1]).String(),
+				// ignore the join tokens - we aren't comparing them
+			},
+		},
+		{
+			descr: ("If a root rotation happens when the CA server is down, so long as it saw the change " +
+				"it will start reconciling the nodes as soon as it's started up again"),
+			caServerRestart: true,
+			nodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+				"6": getFakeAPINode(t, "6", api.IssuanceStateIssued, rotationTLSInfo[1], true),
+			},
+			rootCA: &api.RootCA{
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
+				RootRotation: &api.RootRotation{
+					CACert:            rotationCerts[0],
+					CAKey:             rotationKeys[0],
+					CrossSignedCACert: rotationCrossSigned[0],
+				},
+			},
+			expectedNodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateRotate, rotationTLSInfo[1], true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, rotationTLSInfo[1], true),
+				"4": getFakeAPINode(t, "4", api.IssuanceStateRotate, rotationTLSInfo[1], true),
+				"6": getFakeAPINode(t, "6", api.IssuanceStateRotate, rotationTLSInfo[1], true),
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		if testcase.caServerRestart {
+			rt.tc.CAServer.Stop()
+		}
+
+		rt.convergeRootCA(testcase.rootCA, testcase.descr)
+		rt.convergeWantedNodes(testcase.nodes, testcase.descr)
+
+		if testcase.expectedNodes == nil {
+			testcase.expectedNodes = testcase.nodes
+		}
+		if testcase.expectedRootCA == nil {
+			testcase.expectedRootCA = testcase.rootCA
+		}
+
+		if testcase.caServerRestart {
+			startCAServer(rt.tc.CAServer)
+		}
+
+		require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+			var (
+				nodes   []*api.Node
+				cluster *api.Cluster
+				err     error
+			)
+			tc.MemoryStore.View(func(tx store.ReadTx) {
+				nodes, err = store.FindNodes(tx, store.All)
+				cluster = store.GetCluster(tx, tc.Organization)
+			})
+			if err != nil {
+				return err
+			}
+			if cluster == nil {
+				return errors.New("no cluster found")
+			}
+
+			if !equality.RootCAEqualStable(&cluster.RootCA, testcase.expectedRootCA) {
+				return fmt.Errorf("root CAs not equal:\n\texpected: %v\n\tactual: %v", *testcase.expectedRootCA, cluster.RootCA)
+			}
+			if len(nodes) != len(testcase.expectedNodes) {
+				return fmt.Errorf("number of expected nodes (%d) does not equal number of actual nodes (%d)",
+					len(testcase.expectedNodes), len(nodes))
+			}
+			for _, node := range nodes {
+				expected, ok := testcase.expectedNodes[node.ID]
+				if !ok {
+					return fmt.Errorf("node %s is present and was unexpected", node.ID)
+				}
+				if !reflect.DeepEqual(expected.Description, node.Description) {
+					return fmt.Errorf("the node description of node %s is not expected:\n\texpected: %v\n\tactual: %v", node.ID,
+						expected.Description, node.Description)
+				}
+				if !reflect.DeepEqual(expected.Certificate.Status, node.Certificate.Status) {
+					return fmt.Errorf("the certificate status of node %s is not expected:\n\texpected: %v\n\tactual: %v", node.ID,
+						expected.Certificate, node.Certificate)
+				}
+
+				// ensure that the security config's root CA object has the same expected key
+				expectedKey := testcase.expectedRootCA.CAKey
+				if testcase.expectedRootCA.RootRotation != nil {
+					expectedKey = testcase.expectedRootCA.RootRotation.CAKey
+				}
+				s, err := rt.tc.ServingSecurityConfig.RootCA().Signer()
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(s.Key, expectedKey) {
+					return fmt.Errorf("the security config has not been updated correctly")
+				}
+			}
+			return nil
+		}, 5*time.Second), testcase.descr)
+	}
+}
+
+func TestStuckRotationTimeout(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to handling nodes in the rotate state
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	// the timeout has to be set before the server runs
+	tc.CAServer.Stop()
+	tc.CAServer.SetReconciliationRetryInterval(50 * time.Millisecond)
+	tc.CAServer.SetStuckRotationTimeout(200 * time.Millisecond)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	currentTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           tc.RootCA.Certs,
+		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
+		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+	}
+	_, otherTLSInfo := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+	start := time.Now()
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		if err := store.CreateNode(tx, getFakeAPINode(t, "current", api.IssuanceStateRotate, currentTLSInfo, true)); err != nil {
+			return err
+		}
+		return store.CreateNode(tx, getFakeAPINode(t, "stuck", api.IssuanceStateRotate, otherTLSInfo, true))
+	}))
+
+	// the node that is stuck without a certificate from the current issuer is flagged
+	select {
+	case event := <-eventq:
+		stuck, ok := event.(ca.NodeStuckInRotation)
+		require.True(t, ok)
+		require.Equal(t, "stuck", stuck.NodeID)
+		require.True(t, time.Since(stuck.Since) >= 200*time.Millisecond)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the node stuck in rotation to be flagged")
+	}
+	require.True(t, time.Since(start) >= 200*time.Millisecond)
+
+	// the node that already has a certificate from the current issuer is reset
+	var current, stuck *api.Node
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			current = store.GetNode(tx, "current")
+			stuck = store.GetNode(tx, "stuck")
+		})
+		if current.Certificate.Status.State != api.IssuanceStateIssued {
+			return fmt.Errorf("expected node to be reset to issued, but its state is %s", current.Certificate.Status.State)
+		}
+		return nil
+	}, 5*time.Second))
+	require.Equal(t, api.IssuanceStateRotate, stuck.Certificate.Status.State)
+}
+
+// These are the root rotation test cases where we expect there to be no changes made to either
+// the nodes or the root CA object
+func TestRootRotationReconciliationNoChanges(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	rotationCert := cautils.ECDSA256SHA256Cert
+	rotationKey := cautils.ECDSA256Key
+	rotationCrossSigned, rotationTLSInfo := getRotationInfo(t, rotationCert, &tc.RootCA)
+
+	oldNodeTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           tc.RootCA.Certs,
+		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
+		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+	}
+
+	var startCluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		startCluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, startCluster)
+
+	testcases := []struct {
+		nodes           map[string]*api.Node // what nodes we should start with
+		rootCA          *api.RootCA          // what root CA we should start with
+		descr           string
+		caServerStopped bool // if the server is running, only then will a reconciliation loop happen
+	}{
+		{
+			descr: ("If the CA server is not running no reconciliation happens even if a root rotation " +
+				"is in progress"),
+			caServerStopped: true,
 			nodes: map[string]*api.Node{
 				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, nil, true),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, oldNodeTLSInfo, true),
 				"2": getFakeAPINode(t, "2", api.IssuanceStateRenew, nil, true),
 				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, nil, true),
 				"4": getFakeAPINode(t, "4", api.IssuanceStatePending, nil, true),
@@ -724,251 +2839,1206 @@ func TestRootRotationReconciliationWithChanges(t *testing.T) {
 				CAKey:      startCluster.RootCA.CAKey,
 				CACertHash: startCluster.RootCA.CACertHash,
 				RootRotation: &api.RootRotation{
-					CACert:            rotationCerts[0],
-					CAKey:             rotationKeys[0],
-					CrossSignedCACert: rotationCrossSigned[0],
+					CACert:            rotationCert,
+					CAKey:             rotationKey,
+					CrossSignedCACert: rotationCrossSigned,
 				},
 			},
-			expectedNodes: map[string]*api.Node{
+		},
+		{
+			descr: ("If all nodes have the right TLS info or are already rotated (or are not members), " +
+				"there will be no changes needed"),
+			nodes: map[string]*api.Node{
 				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateRotate, nil, true),
-				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, nil, true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, nil, true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateRotate, nil, true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateRotate, nil, true),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo, true),
+				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, oldNodeTLSInfo, true),
+				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, rotationTLSInfo, true),
+			},
+			rootCA: &api.RootCA{ // no change in root CA from previous
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
+				RootRotation: &api.RootRotation{
+					CACert:            rotationCert,
+					CAKey:             rotationKey,
+					CrossSignedCACert: rotationCrossSigned,
+				},
+			},
+		},
+		{
+			descr: ("Nodes already in rotate state, even if they currently have the correct TLS issuer, will be " +
+				"left in the rotate state even if root rotation is aborted because we don't know if they're already " +
+				"in the process of getting a new cert.  Even if they're issued by a different issuer, they will be " +
+				"left alone because they'll have an interemdiate that chains up to the old issuer."),
+			nodes: map[string]*api.Node{
+				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
+				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo, true),
+				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, oldNodeTLSInfo, true),
+			},
+			rootCA: &api.RootCA{ // no change in root CA from previous
+				CACert:     startCluster.RootCA.CACert,
+				CAKey:      startCluster.RootCA.CAKey,
+				CACertHash: startCluster.RootCA.CACertHash,
 			},
 		},
-		{
-			descr: ("Assume all of the nodes have gotten certs, but some of them are the wrong cert " +
-				"(going by the TLS info), which shouldn't really happen.  the rotation reconciliation " +
-				"will tell the wrong ones to rotate a second time"),
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"2": getFakeAPINode(t, "2", api.IssuanceStateIssued, oldNodeTLSInfo, true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, oldNodeTLSInfo, true),
-			},
-			rootCA: &api.RootCA{ // no change in root CA from previous
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-				RootRotation: &api.RootRotation{
-					CACert:            rotationCerts[0],
-					CAKey:             rotationKeys[0],
-					CrossSignedCACert: rotationCrossSigned[0],
-				},
-			},
-			expectedNodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, oldNodeTLSInfo, true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateRotate, oldNodeTLSInfo, true),
-			},
+	}
+
+	for _, testcase := range testcases {
+		if testcase.caServerStopped {
+			rt.tc.CAServer.Stop()
+		} else {
+			startCAServer(rt.tc.CAServer)
+		}
+
+		rt.convergeRootCA(testcase.rootCA, testcase.descr)
+		rt.convergeWantedNodes(testcase.nodes, testcase.descr)
+
+		time.Sleep(500 * time.Millisecond)
+
+		var (
+			nodes   []*api.Node
+			cluster *api.Cluster
+			err     error
+		)
+
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			nodes, err = store.FindNodes(tx, store.All)
+			cluster = store.GetCluster(tx, tc.Organization)
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cluster)
+		require.Equal(t, cluster.RootCA, *testcase.rootCA, testcase.descr)
+		require.Len(t, nodes, len(testcase.nodes), testcase.descr)
+		for _, node := range nodes {
+			expected, ok := testcase.nodes[node.ID]
+			require.True(t, ok, "node %s: %s", node.ID, testcase.descr)
+			require.Equal(t, expected.Description, node.Description, "node %s: %s", node.ID, testcase.descr)
+			require.Equal(t, expected.Certificate.Status, node.Certificate.Status, "node %s: %s", node.ID, testcase.descr)
+		}
+
+		// ensure that the security config's root CA object has the same expected key
+		expectedKey := testcase.rootCA.CAKey
+		if testcase.rootCA.RootRotation != nil {
+			expectedKey = testcase.rootCA.RootRotation.CAKey
+		}
+		s, err := rt.tc.ServingSecurityConfig.RootCA().Signer()
+		require.NoError(t, err, testcase.descr)
+		require.Equal(t, s.Key, expectedKey, testcase.descr)
+	}
+}
+
+// Tests that a root rotation whose target is the current root is cleared without asking any nodes to rotate.
+func TestRootRotationReconciliationNoopRotation(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	var startCluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		startCluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, startCluster)
+
+	oldNodeTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           tc.RootCA.Certs,
+		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
+		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+	}
+	otherTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           cautils.ECDSA256SHA256Cert,
+		CertIssuerPublicKey: []byte("other issuer key"),
+		CertIssuerSubject:   []byte("other issuer subject"),
+	}
+	nodes := map[string]*api.Node{
+		"0": getFakeAPINode(t, "0", api.IssuanceStateIssued, oldNodeTLSInfo, true),
+		"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, otherTLSInfo, true),
+		"2": getFakeAPINode(t, "2", api.IssuanceStatePending, nil, false),
+	}
+	rt.convergeWantedNodes(nodes, "start with nodes that haven't rotated")
+
+	expectedRootCA := startCluster.RootCA
+	rootCA := startCluster.RootCA
+	rootCA.RootRotation = &api.RootRotation{
+		CACert: startCluster.RootCA.CACert,
+		CAKey:  startCluster.RootCA.CAKey,
+	}
+	rt.convergeRootCA(&rootCA, "rotate to the current root")
+
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		var cluster *api.Cluster
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			cluster = store.GetCluster(tx, tc.Organization)
+		})
+		if cluster == nil {
+			return errors.New("no cluster found")
+		}
+		if cluster.RootCA.RootRotation != nil {
+			return errors.New("root rotation has not been cleared")
+		}
+		if !equality.RootCAEqualStable(&expectedRootCA, &cluster.RootCA) {
+			return errors.New("root CA has changed")
+		}
+		return nil
+	}, 5*time.Second))
+
+	// give the reconciler a chance to (wrongly) request rotations
+	time.Sleep(500 * time.Millisecond)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		for id, expected := range nodes {
+			node := store.GetNode(tx, id)
+			require.NotNil(t, node)
+			require.Equal(t, expected.Certificate.Status, node.Certificate.Status, "node %s", id)
+		}
+	})
+}
+
+// Nodes that report no TLS info are given a grace period to report it before they're told to rotate.
+func TestRootRotationReconciliationNilTLSInfoGracePeriod(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	tc.CAServer.Stop()
+	tc.CAServer.SetNilTLSInfoGracePeriod(time.Second)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	var startCluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		startCluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, startCluster)
+
+	rotationCrossSigned, rotationTLSInfo := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+	rt.convergeWantedNodes(map[string]*api.Node{
+		"0": getFakeAPINode(t, "0", api.IssuanceStateIssued, nil, true),
+		"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, nil, true),
+	}, "start with nodes that have not reported TLS info")
+
+	rootCA := startCluster.RootCA
+	rootCA.RootRotation = &api.RootRotation{
+		CACert:            cautils.ECDSA256SHA256Cert,
+		CAKey:             cautils.ECDSA256Key,
+		CrossSignedCACert: rotationCrossSigned,
+	}
+	rt.convergeRootCA(&rootCA, "start a root rotation")
+
+	// within the grace period, neither node has been told to rotate yet
+	time.Sleep(200 * time.Millisecond)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		for _, id := range []string{"0", "1"} {
+			node := store.GetNode(tx, id)
+			require.NotNil(t, node)
+			require.Equal(t, api.IssuanceStateIssued, node.Certificate.Status.State, "node %s", id)
+		}
+	})
+
+	// node 0 reports that it already has a certificate from the new root
+	rt.convergeWantedNodes(map[string]*api.Node{
+		"0": getFakeAPINode(t, "0", api.IssuanceStateIssued, rotationTLSInfo, true),
+		"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, nil, true),
+	}, "node 0 reports its TLS info")
+
+	// once the grace period is over, node 1, which still hasn't reported its TLS info, is told to rotate, but
+	// node 0 isn't
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		var node *api.Node
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			node = store.GetNode(tx, "1")
+		})
+		if node.Certificate.Status.State != api.IssuanceStateRotate {
+			return errors.Errorf("node 1 is in state %s", node.Certificate.Status.State)
+		}
+		return nil
+	}, 5*time.Second))
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		node := store.GetNode(tx, "0")
+		require.NotNil(t, node)
+		require.Equal(t, api.IssuanceStateIssued, node.Certificate.Status.State)
+	})
+}
+
+func TestRootRotationReconciliationDebugEvents(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	tc.CAServer.Stop()
+	tc.CAServer.SetReconciliationDebugEvents(true)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	var startCluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		startCluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, startCluster)
+
+	oldNodeTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           tc.RootCA.Certs,
+		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
+		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+	}
+	rotationCrossSigned, rotationTLSInfo := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+	rt.convergeWantedNodes(map[string]*api.Node{
+		"0": getFakeAPINode(t, "0", api.IssuanceStateIssued, oldNodeTLSInfo, true),
+		"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, nil, true),
+		"2": getFakeAPINode(t, "2", api.IssuanceStateIssued, rotationTLSInfo, true),
+	}, "start with nodes from the old root, no TLS info and the new root")
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	rootCA := startCluster.RootCA
+	rootCA.RootRotation = &api.RootRotation{
+		CACert:            cautils.ECDSA256SHA256Cert,
+		CAKey:             cautils.ECDSA256Key,
+		CrossSignedCACert: rotationCrossSigned,
+	}
+	rt.convergeRootCA(&rootCA, "start a root rotation")
+
+	// the nodes that haven't converged are moved to the rotate state, and node 2, which has, isn't considered
+	expected := map[string]ca.ReconciliationDecision{
+		"0": {
+			NodeID:   "0",
+			OldState: api.IssuanceStateIssued,
+			NewState: api.IssuanceStateRotate,
+			Reason:   "node's certificate is not from the target root",
+		},
+		"1": {
+			NodeID:   "1",
+			OldState: api.IssuanceStateIssued,
+			NewState: api.IssuanceStateRotate,
+			Reason:   "node has not reported its TLS info",
 		},
-		{
-			descr: ("New nodes that are added will also be picked up and told to rotate"),
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"6": getFakeAPINode(t, "6", api.IssuanceStateRenew, nil, true),
-			},
-			rootCA: &api.RootCA{ // no change in root CA from previous
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-				RootRotation: &api.RootRotation{
-					CACert:            rotationCerts[0],
-					CAKey:             rotationKeys[0],
-					CrossSignedCACert: rotationCrossSigned[0],
-				},
-			},
-			expectedNodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"6": getFakeAPINode(t, "6", api.IssuanceStateRotate, nil, true),
-			},
+	}
+	decisions := make(map[string]ca.ReconciliationDecision)
+	timeout := time.After(5 * time.Second)
+	for len(decisions) < len(expected) {
+		select {
+		case event := <-eventq:
+			decision, ok := event.(ca.ReconciliationDecision)
+			if !ok {
+				continue
+			}
+			require.NotEqual(t, "2", decision.NodeID)
+			if _, ok := expected[decision.NodeID]; ok {
+				if _, seen := decisions[decision.NodeID]; !seen {
+					decisions[decision.NodeID] = decision
+				}
+			}
+		case <-timeout:
+			t.Fatalf("expected reconciliation decisions for nodes 0 and 1, got %v", decisions)
+		}
+	}
+	require.Equal(t, expected, decisions)
+}
+
+func TestSummarizeNodeIssuers(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	var startCluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		startCluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, startCluster)
+
+	currentIssuer := tc.ServingSecurityConfig.IssuerInfo()
+	oldNodeTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           tc.RootCA.Certs,
+		CertIssuerPublicKey: currentIssuer.PublicKey,
+		CertIssuerSubject:   currentIssuer.Subject,
+	}
+	rotationCrossSigned, rotationTLSInfo := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+	rootCA := startCluster.RootCA
+	rootCA.RootRotation = &api.RootRotation{
+		CACert:            cautils.ECDSA256SHA256Cert,
+		CAKey:             cautils.ECDSA256Key,
+		CrossSignedCACert: rotationCrossSigned,
+	}
+	rotationIssuer, err := ca.IssuerFromAPIRootCA(&rootCA)
+	require.NoError(t, err)
+
+	summarize := func() ca.NodeIssuerSummary {
+		var (
+			summary ca.NodeIssuerSummary
+			err     error
+		)
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			summary, err = ca.SummarizeNodeIssuers(tx, currentIssuer, rotationIssuer)
+		})
+		require.NoError(t, err)
+		return summary
+	}
+
+	// nodes part of the way through a rotation, which replace the test CA's own nodes
+	rt.convergeWantedNodes(map[string]*api.Node{
+		"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo, true),
+		"2": getFakeAPINode(t, "2", api.IssuanceStateIssued, oldNodeTLSInfo, true),
+		"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo, true),
+		"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo, true),
+		"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, oldNodeTLSInfo, true),
+		"6": getFakeAPINode(t, "6", api.IssuanceStateIssued, nil, true),
+		"7": getFakeAPINode(t, "7", api.IssuanceStateIssued, rotationTLSInfo, false),
+	}, "nodes on the old root, on the new root, and without TLS info")
+	rt.convergeRootCA(&rootCA, "start a root rotation")
+
+	require.Equal(t, ca.NodeIssuerSummary{
+		CurrentRoot:  2,
+		RotationRoot: 3,
+		Unknown:      1,
+	}, summarize())
+}
+
+// Tests if the root rotation changes while the reconciliation loop is going, eventually the root rotation will finish
+// successfully (even if there's a competing reconciliation loop, for instance if there's a bug during leadership handoff).
+func TestRootRotationReconciliationRace(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	rt := rootRotationTester{
+		tc: tc,
+		t:  t,
+	}
+
+	tempDir, err := ioutil.TempDir("", "competing-ca-server")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	var otherServers []*ca.Server
+	var secConfigs []*ca.SecurityConfig
+	for i := 0; i < 3; i++ { // to make sure we get some collision
+		// start a competing CA server
+		competingSecConfig, err := tc.NewNodeConfig(ca.ManagerRole)
+		require.NoError(t, err)
+		secConfigs = append(secConfigs, competingSecConfig)
+
+		paths := ca.NewConfigPaths(filepath.Join(tempDir, fmt.Sprintf("%d", i)))
+
+		otherServer := ca.NewServer(tc.MemoryStore, competingSecConfig, paths.RootCA)
+		// offset each server's reconciliation interval somewhat so that some will
+		// pre-empt others
+		otherServer.SetRootReconciliationInterval(time.Millisecond * time.Duration((i+1)*10))
+		startCAServer(otherServer)
+		defer otherServer.Stop()
+		otherServers = append(otherServers, otherServer)
+	}
+	clusterWatch, clusterWatchCancel, err := store.ViewAndWatch(
+		tc.MemoryStore, func(tx store.ReadTx) error {
+			// don't bother getting the cluster - the CA serverß have already done that when first running
+			return nil
 		},
-		{
-			descr: ("Even if root rotation isn't finished, if the root changes again to a " +
-				"different cert, all the nodes with the old root rotation cert will be told " +
-				"to rotate again."),
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateIssued, oldNodeTLSInfo, true),
-				"6": getFakeAPINode(t, "6", api.IssuanceStateIssued, rotationTLSInfo[0], true),
-			},
-			rootCA: &api.RootCA{ // new root rotation
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-				RootRotation: &api.RootRotation{
-					CACert:            rotationCerts[1],
-					CAKey:             rotationKeys[1],
-					CrossSignedCACert: rotationCrossSigned[1],
-				},
-			},
-			expectedNodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateRotate, rotationTLSInfo[0], true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateRotate, rotationTLSInfo[0], true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateRotate, oldNodeTLSInfo, true),
-				"6": getFakeAPINode(t, "6", api.IssuanceStateRotate, rotationTLSInfo[0], true),
-			},
+		api.EventUpdateCluster{
+			Cluster: &api.Cluster{ID: tc.Organization},
+			Checks:  []api.ClusterCheckFunc{api.ClusterCheckID},
 		},
-		{
-			descr: ("Once all nodes have rotated to their desired TLS info (even if it's because " +
-				"a node with the wrong TLS info has been removed, the root rotation is completed."),
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"6": getFakeAPINode(t, "6", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-			},
-			rootCA: &api.RootCA{
-				// no change in root CA from previous - even if root rotation gets completed after
-				// the nodes are first set, and we just add the root rotation again because of this
-				// test order, because the TLS info is correct for all nodes it will be completed again
-				// anyway)
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-				RootRotation: &api.RootRotation{
-					CACert:            rotationCerts[1],
-					CAKey:             rotationKeys[1],
-					CrossSignedCACert: rotationCrossSigned[1],
-				},
-			},
-			expectedRootCA: &api.RootCA{
-				CACert:     rotationCerts[1],
-				CAKey:      rotationKeys[1],
-				CACertHash: digest.FromBytes(rotationCerts[1]).String(),
-				// ignore the join tokens - we aren't comparing them
-			},
+	)
+	require.NoError(t, err)
+	defer clusterWatchCancel()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case event := <-clusterWatch:
+				clusterEvent := event.(api.EventUpdateCluster)
+				for _, s := range otherServers {
+					s.UpdateRootCA(context.Background(), clusterEvent.Cluster)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	oldNodeTLSInfo := &api.NodeTLSInfo{
+		TrustRoot:           tc.RootCA.Certs,
+		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
+		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+	}
+
+	nodes := make(map[string]*api.Node)
+	for i := 0; i < 5; i++ {
+		nodeID := fmt.Sprintf("%d", i)
+		nodes[nodeID] = getFakeAPINode(t, nodeID, api.IssuanceStateIssued, oldNodeTLSInfo, true)
+	}
+	rt.convergeWantedNodes(nodes, "setting up nodes for root rotation race condition test")
+
+	var rotationCert, rotationKey []byte
+	for i := 0; i < 10; i++ {
+		var (
+			rotationCrossSigned []byte
+			rotationTLSInfo     *api.NodeTLSInfo
+		)
+		rotationCert, rotationKey, err = cautils.CreateRootCertAndKey(fmt.Sprintf("root cn %d", i))
+		require.NoError(t, err)
+		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+			cluster := store.GetCluster(tx, tc.Organization)
+			if cluster == nil {
+				return errors.New("cluster has disappeared")
+			}
+			rootCA := cluster.RootCA.Copy()
+			caRootCA, err := ca.NewRootCA(rootCA.CACert, rootCA.CACert, rootCA.CAKey, ca.DefaultNodeCertExpiration, nil)
+			if err != nil {
+				return err
+			}
+			rotationCrossSigned, rotationTLSInfo = getRotationInfo(t, rotationCert, &caRootCA)
+			rootCA.RootRotation = &api.RootRotation{
+				CACert:            rotationCert,
+				CAKey:             rotationKey,
+				CrossSignedCACert: rotationCrossSigned,
+			}
+			return store.UpdateClusterRootCA(tx, cluster.ID, rootCA)
+		}))
+		for _, node := range nodes {
+			node.Description.TLSInfo = rotationTLSInfo
+		}
+		rt.convergeWantedNodes(nodes, fmt.Sprintf("iteration %d", i))
+	}
+
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		var cluster *api.Cluster
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			cluster = store.GetCluster(tx, tc.Organization)
+		})
+		if cluster == nil {
+			return errors.New("cluster has disappeared")
+		}
+		if cluster.RootCA.RootRotation != nil {
+			return errors.New("root rotation is still present")
+		}
+		if !bytes.Equal(cluster.RootCA.CACert, rotationCert) {
+			return errors.New("expected root cert is wrong")
+		}
+		if !bytes.Equal(cluster.RootCA.CAKey, rotationKey) {
+			return errors.New("expected root key is wrong")
+		}
+		for _, secConfig := range secConfigs {
+			s, err := secConfig.RootCA().Signer()
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(s.Key, rotationKey) {
+				return errors.New("all the sec configs haven't been updated yet")
+			}
+		}
+		return nil
+	}, 5*time.Second))
+
+	// all of the ca servers have the appropriate cert and key
+}
+
+// If there are a lot of nodes, we only update a small number of them at once.
+func TestRootRotationReconciliationThrottled(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	// immediately stop the CA server - we want to run our down
+	tc.CAServer.Stop()
+
+	caServer := ca.NewServer(tc.MemoryStore, tc.ServingSecurityConfig, tc.Paths.RootCA)
+	// set the reconciliation interval to something ridiculous, so we can make sure the first
+	// batch does update all of them
+	caServer.SetRootReconciliationInterval(time.Hour)
+	startCAServer(caServer)
+	defer caServer.Stop()
+
+	var nodes []*api.Node
+	clusterWatch, clusterWatchCancel, err := store.ViewAndWatch(
+		tc.MemoryStore, func(tx store.ReadTx) error {
+			// don't bother getting the cluster - the CA server has already done that when first running
+			var err error
+			nodes, err = store.FindNodes(tx, store.ByMembership(api.NodeMembershipAccepted))
+			return err
 		},
-		{
-			descr: ("If a root rotation happens when the CA server is down, so long as it saw the change " +
-				"it will start reconciling the nodes as soon as it's started up again"),
-			caServerRestart: true,
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-				"6": getFakeAPINode(t, "6", api.IssuanceStateIssued, rotationTLSInfo[1], true),
-			},
-			rootCA: &api.RootCA{
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-				RootRotation: &api.RootRotation{
-					CACert:            rotationCerts[0],
-					CAKey:             rotationKeys[0],
-					CrossSignedCACert: rotationCrossSigned[0],
-				},
-			},
-			expectedNodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateRotate, rotationTLSInfo[1], true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, rotationTLSInfo[1], true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStateRotate, rotationTLSInfo[1], true),
-				"6": getFakeAPINode(t, "6", api.IssuanceStateRotate, rotationTLSInfo[1], true),
-			},
+		api.EventUpdateCluster{
+			Cluster: &api.Cluster{ID: tc.Organization},
+			Checks:  []api.ClusterCheckFunc{api.ClusterCheckID},
 		},
+	)
+	require.NoError(t, err)
+	defer clusterWatchCancel()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case event := <-clusterWatch:
+				clusterEvent := event.(api.EventUpdateCluster)
+				caServer.UpdateRootCA(context.Background(), clusterEvent.Cluster)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	// create twice the batch size of nodes
+	_, err = tc.MemoryStore.Batch(func(batch *store.Batch) error {
+		for i := len(nodes); i < ca.IssuanceStateRotateMaxBatchSize*2; i++ {
+			nodeID := fmt.Sprintf("%d", i)
+			err := batch.Update(func(tx store.Tx) error {
+				return store.CreateNode(tx, getFakeAPINode(t, nodeID, api.IssuanceStateIssued, nil, true))
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	rotationCert := cautils.ECDSA256SHA256Cert
+	rotationKey := cautils.ECDSA256Key
+	rotationCrossSigned, _ := getRotationInfo(t, rotationCert, &tc.RootCA)
+
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, tc.Organization)
+		if cluster == nil {
+			return errors.New("cluster has disappeared")
+		}
+		rootCA := cluster.RootCA.Copy()
+		rootCA.RootRotation = &api.RootRotation{
+			CACert:            rotationCert,
+			CAKey:             rotationKey,
+			CrossSignedCACert: rotationCrossSigned,
+		}
+		return store.UpdateClusterRootCA(tx, cluster.ID, rootCA)
+	}))
+
+	checkRotationNumber := func() error {
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			nodes, err = store.FindNodes(tx, store.All)
+		})
+		var issuanceRotate int
+		for _, n := range nodes {
+			if n.Certificate.Status.State == api.IssuanceStateRotate {
+				issuanceRotate += 1
+			}
+		}
+		if issuanceRotate != ca.IssuanceStateRotateMaxBatchSize {
+			return fmt.Errorf("expected %d, got %d", ca.IssuanceStateRotateMaxBatchSize, issuanceRotate)
+		}
+		return nil
 	}
 
-	for _, testcase := range testcases {
-		if testcase.caServerRestart {
-			rt.tc.CAServer.Stop()
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, checkRotationNumber, 5*time.Second))
+	// prove that it's not just because the updates haven't finished
+	time.Sleep(time.Second)
+	require.NoError(t, checkRotationNumber())
+}
+
+// When the leadership moves, the outgoing CA server hands reconciliation off to
+// the incoming one, which keeps to its pace instead of both telling nodes to
+// rotate at once.
+func TestRootRotationReconciliationHandoff(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	// rotations returns how many times each node was told to rotate, and how
+	// many times the nodes were written in all, when the leadership moves
+	// after the outgoing CA server's first batch, with or without a handoff
+	rotations := func(handoff bool) (map[string]int, int) {
+		tc := cautils.NewTestCA(t)
+		defer tc.Stop()
+		tc.CAServer.Stop()
+
+		tempDir, err := ioutil.TempDir("", "handoff-ca-server")
+		require.NoError(t, err)
+		defer os.RemoveAll(tempDir)
+
+		_, err = tc.MemoryStore.Batch(func(batch *store.Batch) error {
+			for i := 0; i < ca.IssuanceStateRotateMaxBatchSize*2; i++ {
+				nodeID := fmt.Sprintf("%d", i)
+				if err := batch.Update(func(tx store.Tx) error {
+					return store.CreateNode(tx, getFakeAPINode(t, nodeID, api.IssuanceStateIssued, nil, true))
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		rotationCrossSigned, _ := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+			cluster := store.GetCluster(tx, tc.Organization)
+			rootCA := cluster.RootCA.Copy()
+			rootCA.RootRotation = &api.RootRotation{
+				CACert:            cautils.ECDSA256SHA256Cert,
+				CAKey:             cautils.ECDSA256Key,
+				CrossSignedCACert: rotationCrossSigned,
+			}
+			return store.UpdateClusterRootCA(tx, cluster.ID, rootCA)
+		}))
+
+		var (
+			mu     sync.Mutex
+			counts = make(map[string]int)
+			writes int
+		)
+		total := func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			var n int
+			for _, c := range counts {
+				n += c
+			}
+			return n
+		}
+		nodeWatch, nodeWatchCancel, err := store.ViewAndWatch(tc.MemoryStore, func(tx store.ReadTx) error {
+			return nil
+		}, api.EventUpdateNode{})
+		require.NoError(t, err)
+		defer nodeWatchCancel()
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for {
+				select {
+				case event := <-nodeWatch:
+					update := event.(api.EventUpdateNode)
+					mu.Lock()
+					writes++
+					if update.Node.Certificate.Status.State == api.IssuanceStateRotate &&
+						update.OldNode.Certificate.Status.State != api.IssuanceStateRotate {
+						counts[update.Node.ID]++
+					}
+					mu.Unlock()
+				case <-done:
+					return
+				}
+			}
+		}()
+
+		// with a long interval, each loop only tells a batch of nodes to
+		// rotate when it starts
+		outgoing := ca.NewServer(tc.MemoryStore, tc.ServingSecurityConfig, tc.Paths.RootCA)
+		outgoing.SetRootReconciliationInterval(time.Hour)
+		startCAServer(outgoing)
+		defer outgoing.Stop()
+		require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+			if n := total(); n != ca.IssuanceStateRotateMaxBatchSize {
+				return fmt.Errorf("%d nodes told to rotate", n)
+			}
+			return nil
+		}, 5*time.Second))
+
+		if handoff {
+			require.NoError(t, outgoing.HandoffReconciliation())
+		}
+		incomingSecConfig, err := tc.NewNodeConfig(ca.ManagerRole)
+		require.NoError(t, err)
+		incoming := ca.NewServer(tc.MemoryStore, incomingSecConfig, ca.NewConfigPaths(tempDir).RootCA)
+		incoming.SetRootReconciliationInterval(time.Hour)
+		startCAServer(incoming)
+		defer incoming.Stop()
+
+		if !handoff {
+			require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+				if n := total(); n != ca.IssuanceStateRotateMaxBatchSize*2 {
+					return fmt.Errorf("%d nodes told to rotate", n)
+				}
+				return nil
+			}, 5*time.Second))
+		}
+		// let any further updates land
+		time.Sleep(500 * time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		result := make(map[string]int, len(counts))
+		for nodeID, c := range counts {
+			result[nodeID] = c
+		}
+		return result, writes
+	}
+
+	abrupt, abruptWrites := rotations(false)
+	handedOff, handedOffWrites := rotations(true)
+
+	// no node is told to rotate twice either way, but without the handoff the
+	// incoming server tells another batch to rotate straight away
+	for nodeID, c := range abrupt {
+		require.Equal(t, 1, c, nodeID)
+	}
+	for nodeID, c := range handedOff {
+		require.Equal(t, 1, c, nodeID)
+	}
+	require.Len(t, handedOff, ca.IssuanceStateRotateMaxBatchSize)
+	require.Len(t, abrupt, ca.IssuanceStateRotateMaxBatchSize*2)
+	require.True(t, len(handedOff) < len(abrupt))
+
+	// and the handoff saves the incoming server's writes to the nodes
+	require.True(t, handedOffWrites < abruptWrites, "%d writes with a handoff, %d without", handedOffWrites, abruptWrites)
+	require.Equal(t, ca.IssuanceStateRotateMaxBatchSize, handedOffWrites)
+	require.Equal(t, ca.IssuanceStateRotateMaxBatchSize*2, abruptWrites)
+}
+
+// A CA server with a startup delay doesn't tell any nodes to rotate until the
+// delay is over, but is ready straight away.
+func TestRootRotationReconciliationStartupDelay(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+	tc.CAServer.Stop()
+
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		for i := 0; i < 5; i++ {
+			if err := store.CreateNode(tx, getFakeAPINode(t, fmt.Sprintf("%d", i), api.IssuanceStateIssued, nil, true)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	rotationCrossSigned, _ := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, tc.Organization)
+		rootCA := cluster.RootCA.Copy()
+		rootCA.RootRotation = &api.RootRotation{
+			CACert:            cautils.ECDSA256SHA256Cert,
+			CAKey:             cautils.ECDSA256Key,
+			CrossSignedCACert: rotationCrossSigned,
+		}
+		return store.UpdateClusterRootCA(tx, cluster.ID, rootCA)
+	}))
+
+	rotating := func() int {
+		var nodes []*api.Node
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			nodes, _ = store.FindNodes(tx, store.All)
+		})
+		var n int
+		for _, node := range nodes {
+			if node.Certificate.Status.State == api.IssuanceStateRotate {
+				n++
+			}
+		}
+		return n
+	}
+
+	const delay = time.Second
+	caServer := ca.NewServer(tc.MemoryStore, tc.ServingSecurityConfig, tc.Paths.RootCA)
+	caServer.SetRootReconciliationInterval(10 * time.Millisecond)
+	caServer.SetRootReconciliationStartupDelay(delay)
+	start := time.Now()
+	startCAServer(caServer)
+	defer caServer.Stop()
+	require.True(t, time.Since(start) < delay/2, "the server took %s to be ready", time.Since(start))
+
+	// nothing is reconciled within the delay
+	time.Sleep(delay/2 - time.Since(start))
+	require.Equal(t, 0, rotating())
+
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		if rotating() == 0 {
+			return errors.New("no nodes told to rotate")
+		}
+		return nil
+	}, 5*time.Second))
+	require.True(t, time.Since(start) >= delay)
+}
+
+func TestBeginRootRotation(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// cross-signing the generated root requires the current root's key
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		return store.CreateNode(tx, getFakeAPINode(t, "1", api.IssuanceStateIssued, nil, true))
+	}))
+
+	newRootDigest, err := tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{})
+	require.NoError(t, err)
+
+	var cluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, cluster)
+	require.NotNil(t, cluster.RootCA.RootRotation)
+	require.Equal(t, newRootDigest, digest.FromBytes(cluster.RootCA.RootRotation.CACert))
+	require.NotEmpty(t, cluster.RootCA.RootRotation.CAKey)
+
+	// the generated key must match the new root, and the cross-signed cert must chain up to the current root
+	_, err = ca.NewRootCA(cluster.RootCA.RootRotation.CACert, cluster.RootCA.RootRotation.CACert,
+		cluster.RootCA.RootRotation.CAKey, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	_, _, err = ca.ValidateCertChain(tc.RootCA.Pool, cluster.RootCA.RootRotation.CrossSignedCACert, false)
+	require.NoError(t, err)
+
+	// a second rotation is refused while the first is still in progress
+	_, err = tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+
+	// the manager feeds cluster updates to the CA server; once it sees the rotation, the reconciler
+	// tells nodes that are not yet on the new root to rotate
+	require.NoError(t, tc.CAServer.UpdateRootCA(tc.Context, cluster))
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		var node *api.Node
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			node = store.GetNode(tx, "1")
+		})
+		if node.Certificate.Status.State != api.IssuanceStateRotate {
+			return fmt.Errorf("expected node to be told to rotate, but its state is %s", node.Certificate.Status.State)
+		}
+		return nil
+	}, 5*time.Second))
+}
+
+func TestRotateIntermediateSigner(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// issuing the intermediate requires the root's key, and signing has to happen locally
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	intermediateDigest, err := tc.CAServer.RotateIntermediateSigner(tc.Context, "intermediateCN")
+	require.NoError(t, err)
+
+	var cluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, cluster)
+	require.Nil(t, cluster.RootCA.RootRotation)
+	require.Equal(t, tc.RootCA.Certs, cluster.RootCA.CACert)
+	require.Equal(t, intermediateDigest, digest.FromBytes(cluster.RootCA.IntermediateCACert))
+	require.NotEmpty(t, cluster.RootCA.IntermediateCAKey)
+
+	require.NoError(t, tc.CAServer.UpdateRootCA(tc.Context, cluster))
+
+	csr, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	issueRequest := &api.IssueNodeCertificateRequest{CSR: csr, Token: tc.WorkerToken}
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(tc.Context, issueRequest)
+	require.NoError(t, err)
+	statusRequest := &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID}
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(tc.Context, statusRequest)
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+
+	// the new certificate is issued by the intermediate, and chains through it to the unchanged root
+	chain, _, err := ca.ValidateCertChain(tc.RootCA.Pool, statusResponse.Certificate.Certificate, false)
+	require.NoError(t, err)
+	require.Len(t, chain, 2)
+	require.Equal(t, "intermediateCN", chain[0].Issuer.CommonName)
+	intermediate, err := helpers.ParseCertificatePEM(cluster.RootCA.IntermediateCACert)
+	require.NoError(t, err)
+	require.NoError(t, chain[0].CheckSignatureFrom(intermediate))
+
+	// by default the intermediate gets its own CN, and it can't take the root's
+	_, err = tc.CAServer.RotateIntermediateSigner(tc.Context, "")
+	require.NoError(t, err)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, tc.Organization)
+	})
+	intermediate, err = helpers.ParseCertificatePEM(cluster.RootCA.IntermediateCACert)
+	require.NoError(t, err)
+	require.Equal(t, ca.DefaultIntermediateCN, intermediate.Subject.CommonName)
+	root, err := helpers.ParseCertificatePEM(tc.RootCA.Certs)
+	require.NoError(t, err)
+	require.NotEqual(t, root.Subject.CommonName, intermediate.Subject.CommonName)
+	_, err = tc.CAServer.RotateIntermediateSigner(tc.Context, root.Subject.CommonName)
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// the intermediate can't be rotated during a root rotation
+	_, err = tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{})
+	require.NoError(t, err)
+	_, err = tc.CAServer.RotateIntermediateSigner(tc.Context, "")
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition, grpc.Code(err))
+}
+
+func TestBeginRootRotationWithCert(t *testing.T) {
+	t.Parallel()
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	cert, _, err := cautils.CreateRootCertAndKey("externally generated root")
+	require.NoError(t, err)
+
+	// a cross-signed cert from some other root does not chain to the current root, and is rejected
+	otherRootCA, err := ca.CreateRootCA("some other root")
+	require.NoError(t, err)
+	badCrossSigned, err := otherRootCA.CrossSignCACertificate(cert)
+	require.NoError(t, err)
+	_, err = tc.CAServer.BeginRootRotationWithCert(tc.Context, cert, badCrossSigned)
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// a cross-signed cert for a different root is rejected, even if the current root signed it
+	otherCert, _, err := cautils.CreateRootCertAndKey("yet another root")
+	require.NoError(t, err)
+	mismatchedCrossSigned, err := tc.RootCA.CrossSignCACertificate(otherCert)
+	require.NoError(t, err)
+	_, err = tc.CAServer.BeginRootRotationWithCert(tc.Context, cert, mismatchedCrossSigned)
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	var cluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, cluster)
+	require.Nil(t, cluster.RootCA.RootRotation)
+
+	crossSigned, err := tc.RootCA.CrossSignCACertificate(cert)
+	require.NoError(t, err)
+	newRootDigest, err := tc.CAServer.BeginRootRotationWithCert(tc.Context, cert, crossSigned)
+	require.NoError(t, err)
+	require.Equal(t, digest.FromBytes(ca.NormalizePEMs(cert)), newRootDigest)
+
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, cluster.RootCA.RootRotation)
+	require.Equal(t, ca.NormalizePEMs(cert), cluster.RootCA.RootRotation.CACert)
+	require.Equal(t, ca.NormalizePEMs(crossSigned), cluster.RootCA.RootRotation.CrossSignedCACert)
+	require.Empty(t, cluster.RootCA.RootRotation.CAKey)
+}
+
+func TestDiagnosticsSnapshot(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA is configured explicitly below
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	snapshot := tc.CAServer.DiagnosticsSnapshot()
+	require.Equal(t, tc.RootCA.Certs, snapshot.RootCerts)
+	require.Equal(t, tc.RootCA.Digest, snapshot.RootDigest)
+	require.True(t, snapshot.HasSigner)
+	require.Empty(t, snapshot.ExternalCAs)
+	require.Nil(t, snapshot.RootRotation)
+
+	// rotate to a root whose key is only held by an external CA
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	cert, _, err := cautils.CreateRootCertAndKey("externally generated root")
+	require.NoError(t, err)
+	crossSigned, err := tc.RootCA.CrossSignCACertificate(cert)
+	require.NoError(t, err)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		cluster := store.GetCluster(tx, tc.Organization)
+		cluster.Spec.CAConfig.ExternalCAs = []*api.ExternalCA{{
+			Protocol: api.ExternalCA_CAProtocolCFSSL,
+			URL:      server.URL,
+			CACert:   cert,
+		}}
+		return store.UpdateCluster(tx, cluster)
+	}))
+	_, err = tc.CAServer.BeginRootRotationWithCert(tc.Context, cert, crossSigned)
+	require.NoError(t, err)
+
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		snapshot = tc.CAServer.DiagnosticsSnapshot()
+		if snapshot.RootRotation == nil || len(snapshot.ExternalCAs) == 0 {
+			return errors.New("root rotation not reflected in the diagnostics yet")
 		}
+		return nil
+	}, 5*time.Second))
+	require.Equal(t, tc.RootCA.Certs, snapshot.RootCerts)
+	require.Equal(t, ca.NormalizePEMs(crossSigned), snapshot.Intermediates)
+	require.False(t, snapshot.HasSigner)
+	require.Equal(t, []ca.ExternalCAHealth{{URL: server.URL}}, snapshot.ExternalCAs)
+	require.Equal(t, &ca.RootRotationDiagnostics{
+		CACert:            ca.NormalizePEMs(cert),
+		CrossSignedCACert: ca.NormalizePEMs(crossSigned),
+	}, snapshot.RootRotation)
+
+	// contacting the external CA is reflected in its health
+	require.NoError(t, tc.CAServer.Prime(tc.Context))
+	snapshot = tc.CAServer.DiagnosticsSnapshot()
+	require.Len(t, snapshot.ExternalCAs, 1)
+	require.False(t, snapshot.ExternalCAs[0].Checked.IsZero())
+	require.Empty(t, snapshot.ExternalCAs[0].Err)
+}
+
+func TestBeginRootRotationQueued(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// cross-signing the generated roots requires the current root's key
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	getCluster := func() *api.Cluster {
+		var cluster *api.Cluster
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			cluster = store.GetCluster(tx, tc.Organization)
+		})
+		require.NotNil(t, cluster)
+		return cluster
+	}
+	// none of the test CA's nodes report TLS info, so a rotation can't converge until they all have certs from,
+	// and report, the expected issuer
+	convergeNodes := func(rotation *api.RootRotation) {
+		issueRotatedCerts(t, tc, rotation.CACert, rotation.CAKey, true)
+	}
+
+	_, err := tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{Queue: true, Replace: true})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	firstDigest, err := tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{})
+	require.NoError(t, err)
+	secondDigest, err := tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{Queue: true})
+	require.NoError(t, err)
 
-		rt.convergeRootCA(testcase.rootCA, testcase.descr)
-		rt.convergeWantedNodes(testcase.nodes, testcase.descr)
+	cluster := getCluster()
+	require.NotNil(t, cluster.RootCA.RootRotation)
+	firstRotation := cluster.RootCA.RootRotation
+	firstRoot := firstRotation.CACert
+	require.Equal(t, firstDigest, digest.FromBytes(firstRoot))
+	require.Len(t, cluster.RootCA.QueuedRotations, 1)
+	secondRotation := cluster.RootCA.QueuedRotations[0]
+	secondRoot := secondRotation.CACert
+	require.Equal(t, secondDigest, digest.FromBytes(secondRoot))
+
+	// the queued rotation is cross-signed by the root it will rotate from
+	firstRootCA, err := ca.NewRootCA(firstRoot, nil, nil, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	_, _, err = ca.ValidateCertChain(firstRootCA.Pool, cluster.RootCA.QueuedRotations[0].CrossSignedCACert, false)
+	require.NoError(t, err)
 
-		if testcase.expectedNodes == nil {
-			testcase.expectedNodes = testcase.nodes
+	// once the first rotation converges, the second one begins
+	convergeNodes(firstRotation)
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		cluster := getCluster()
+		if !bytes.Equal(cluster.RootCA.CACert, firstRoot) {
+			return errors.New("first root rotation has not completed")
 		}
-		if testcase.expectedRootCA == nil {
-			testcase.expectedRootCA = testcase.rootCA
+		if cluster.RootCA.RootRotation == nil || !bytes.Equal(cluster.RootCA.RootRotation.CACert, secondRoot) {
+			return errors.New("second root rotation has not begun")
 		}
-
-		if testcase.caServerRestart {
-			startCAServer(rt.tc.CAServer)
+		if len(cluster.RootCA.QueuedRotations) != 0 {
+			return errors.New("second root rotation is still queued")
 		}
+		return nil
+	}, 5*time.Second))
 
-		require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
-			var (
-				nodes   []*api.Node
-				cluster *api.Cluster
-				err     error
-			)
-			tc.MemoryStore.View(func(tx store.ReadTx) {
-				nodes, err = store.FindNodes(tx, store.All)
-				cluster = store.GetCluster(tx, tc.Organization)
-			})
-			if err != nil {
-				return err
-			}
-			if cluster == nil {
-				return errors.New("no cluster found")
-			}
-
-			if !equality.RootCAEqualStable(&cluster.RootCA, testcase.expectedRootCA) {
-				return fmt.Errorf("root CAs not equal:\n\texpected: %v\n\tactual: %v", *testcase.expectedRootCA, cluster.RootCA)
-			}
-			if len(nodes) != len(testcase.expectedNodes) {
-				return fmt.Errorf("number of expected nodes (%d) does not equal number of actual nodes (%d)",
-					len(testcase.expectedNodes), len(nodes))
-			}
-			for _, node := range nodes {
-				expected, ok := testcase.expectedNodes[node.ID]
-				if !ok {
-					return fmt.Errorf("node %s is present and was unexpected", node.ID)
-				}
-				if !reflect.DeepEqual(expected.Description, node.Description) {
-					return fmt.Errorf("the node description of node %s is not expected:\n\texpected: %v\n\tactual: %v", node.ID,
-						expected.Description, node.Description)
-				}
-				if !reflect.DeepEqual(expected.Certificate.Status, node.Certificate.Status) {
-					return fmt.Errorf("the certificate status of node %s is not expected:\n\texpected: %v\n\tactual: %v", node.ID,
-						expected.Certificate, node.Certificate)
-				}
+	convergeNodes(secondRotation)
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		cluster := getCluster()
+		if !bytes.Equal(cluster.RootCA.CACert, secondRoot) || cluster.RootCA.RootRotation != nil {
+			return errors.New("second root rotation has not completed")
+		}
+		return nil
+	}, 5*time.Second))
+}
 
-				// ensure that the security config's root CA object has the same expected key
-				expectedKey := testcase.expectedRootCA.CAKey
-				if testcase.expectedRootCA.RootRotation != nil {
-					expectedKey = testcase.expectedRootCA.RootRotation.CAKey
-				}
-				s, err := rt.tc.ServingSecurityConfig.RootCA().Signer()
+// issueRotatedCerts makes every node in the test CA's store report that it has a certificate from the given root.
+// If issue is true, the certificate the CA has recorded for each node is also replaced with one issued by that root.
+func issueRotatedCerts(t *testing.T, tc *cautils.TestCA, rootCert, rootKey []byte, issue bool) {
+	parsed, err := helpers.ParseCertificatePEM(rootCert)
+	require.NoError(t, err)
+	rootCA, err := ca.NewRootCA(rootCert, rootCert, rootKey, ca.DefaultNodeCertExpiration, nil)
+	require.NoError(t, err)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		nodes, err := store.FindNodes(tx, store.All)
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes {
+			if issue {
+				csr, _, err := ca.GenerateNewCSR()
 				if err != nil {
 					return err
 				}
-				if !bytes.Equal(s.Key, expectedKey) {
-					return fmt.Errorf("the security config has not been updated correctly")
+				node.Certificate.Certificate, err = rootCA.ParseValidateAndSignCSR(csr, node.ID, ca.WorkerRole, tc.Organization)
+				if err != nil {
+					return err
 				}
 			}
-			return nil
-		}, 5*time.Second), testcase.descr)
-	}
+			node.Description = &api.NodeDescription{TLSInfo: &api.NodeTLSInfo{
+				TrustRoot:           rootCert,
+				CertIssuerPublicKey: parsed.RawSubjectPublicKeyInfo,
+				CertIssuerSubject:   parsed.RawSubject,
+			}}
+			node.Certificate.Status.State = api.IssuanceStateIssued
+			if err := store.UpdateNode(tx, node); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
 }
 
-// These are the root rotation test cases where we expect there to be no changes made to either
-// the nodes or the root CA object
-func TestRootRotationReconciliationNoChanges(t *testing.T) {
+func TestRootRotationReconciliationTLSInfoMismatch(t *testing.T) {
 	t.Parallel()
 	if cautils.External {
 		// the external CA functionality is unrelated to testing the reconciliation loop
@@ -977,141 +4047,232 @@ func TestRootRotationReconciliationNoChanges(t *testing.T) {
 
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
-	rt := rootRotationTester{
-		tc: tc,
-		t:  t,
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	_, err := tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{})
+	require.NoError(t, err)
+	var cluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, cluster)
+	rotation := cluster.RootCA.RootRotation
+	require.NotNil(t, rotation)
+
+	// The nodes claim to have certs from the new root, but the CA only issued them certs from the old root
+	issueRotatedCerts(t, tc, rotation.CACert, rotation.CAKey, false)
+
+	parsed, err := helpers.ParseCertificatePEM(rotation.CACert)
+	require.NoError(t, err)
+	flagged := make(map[string]int)
+	collectMismatches := func(d time.Duration) {
+		timeout := time.After(d)
+		for {
+			select {
+			case event := <-eventq:
+				mismatch, ok := event.(ca.TLSInfoMismatch)
+				require.True(t, ok)
+				require.NotEmpty(t, mismatch.NodeID)
+				require.Equal(t, parsed.RawSubject, mismatch.Reported.Subject)
+				flagged[mismatch.NodeID]++
+			case <-timeout:
+				return
+			}
+		}
 	}
+	collectMismatches(time.Second)
+	require.NotEmpty(t, flagged, "expected a TLS info mismatch to be flagged")
+
+	// the nodes haven't converged, so the root rotation must not complete, and reporting the same TLS info again
+	// doesn't flag them again
+	issueRotatedCerts(t, tc, rotation.CACert, rotation.CAKey, false)
+	collectMismatches(500 * time.Millisecond)
+	for nodeID, count := range flagged {
+		require.Equal(t, 1, count, "node %s flagged %d times", nodeID, count)
+	}
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, cluster.RootCA.RootRotation)
 
-	rotationCert := cautils.ECDSA256SHA256Cert
-	rotationKey := cautils.ECDSA256Key
-	rotationCrossSigned, rotationTLSInfo := getRotationInfo(t, rotationCert, &tc.RootCA)
+	// once the CA has actually issued them certs from the new root, the rotation completes
+	issueRotatedCerts(t, tc, rotation.CACert, rotation.CAKey, true)
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+		tc.MemoryStore.View(func(tx store.ReadTx) {
+			cluster = store.GetCluster(tx, tc.Organization)
+		})
+		if cluster.RootCA.RootRotation != nil {
+			return errors.New("root rotation has not completed")
+		}
+		return nil
+	}, 5*time.Second))
+}
 
-	oldNodeTLSInfo := &api.NodeTLSInfo{
-		TrustRoot:           tc.RootCA.Certs,
-		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
-		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
+func TestWatchEndsOnStop(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	require.NoError(t, tc.CAServer.Stop())
+	select {
+	case _, ok := <-eventq:
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch not closed when the server stopped")
 	}
 
-	var startCluster *api.Cluster
+	// a watch can be cancelled more than once
+	eventq, cancel = tc.CAServer.Watch()
+	cancel()
+	cancel()
+	select {
+	case _, ok := <-eventq:
+		require.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch not closed when cancelled")
+	}
+}
+
+func TestRootRotationCompletedNotification(t *testing.T) {
+	t.Parallel()
+	if cautils.External {
+		// the external CA functionality is unrelated to testing the reconciliation loop
+		return
+	}
+
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	// the callback has to be registered before the server runs
+	var (
+		mu        sync.Mutex
+		completed []ca.RootRotationCompleted
+	)
+	tc.CAServer.Stop()
+	tc.CAServer.SetRootRotationCompletedCallback(func(c ca.RootRotationCompleted) {
+		mu.Lock()
+		defer mu.Unlock()
+		completed = append(completed, c)
+	})
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	_, err := tc.CAServer.BeginRootRotation(tc.Context, ca.RootRotationOptions{})
+	require.NoError(t, err)
+	var cluster *api.Cluster
 	tc.MemoryStore.View(func(tx store.ReadTx) {
-		startCluster = store.GetCluster(tx, tc.Organization)
+		cluster = store.GetCluster(tx, tc.Organization)
 	})
-	require.NotNil(t, startCluster)
+	require.NotNil(t, cluster)
+	oldRoot := cluster.RootCA.CACert
+	rotation := cluster.RootCA.RootRotation
+	require.NotNil(t, rotation)
 
-	testcases := []struct {
-		nodes           map[string]*api.Node // what nodes we should start with
-		rootCA          *api.RootCA          // what root CA we should start with
-		descr           string
-		caServerStopped bool // if the server is running, only then will a reconciliation loop happen
-	}{
-		{
-			descr: ("If the CA server is not running no reconciliation happens even if a root rotation " +
-				"is in progress"),
-			caServerStopped: true,
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, oldNodeTLSInfo, true),
-				"2": getFakeAPINode(t, "2", api.IssuanceStateRenew, nil, true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, nil, true),
-				"4": getFakeAPINode(t, "4", api.IssuanceStatePending, nil, true),
-				"5": getFakeAPINode(t, "5", api.IssuanceStateFailed, nil, true),
-			},
-			rootCA: &api.RootCA{
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-				RootRotation: &api.RootRotation{
-					CACert:            rotationCert,
-					CAKey:             rotationKey,
-					CrossSignedCACert: rotationCrossSigned,
-				},
-			},
-		},
-		{
-			descr: ("If all nodes have the right TLS info or are already rotated (or are not members), " +
-				"there will be no changes needed"),
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo, true),
-				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, oldNodeTLSInfo, true),
-				"3": getFakeAPINode(t, "3", api.IssuanceStateRotate, rotationTLSInfo, true),
-			},
-			rootCA: &api.RootCA{ // no change in root CA from previous
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-				RootRotation: &api.RootRotation{
-					CACert:            rotationCert,
-					CAKey:             rotationKey,
-					CrossSignedCACert: rotationCrossSigned,
-				},
-			},
-		},
-		{
-			descr: ("Nodes already in rotate state, even if they currently have the correct TLS issuer, will be " +
-				"left in the rotate state even if root rotation is aborted because we don't know if they're already " +
-				"in the process of getting a new cert.  Even if they're issued by a different issuer, they will be " +
-				"left alone because they'll have an interemdiate that chains up to the old issuer."),
-			nodes: map[string]*api.Node{
-				"0": getFakeAPINode(t, "0", api.IssuanceStatePending, nil, false),
-				"1": getFakeAPINode(t, "1", api.IssuanceStateIssued, rotationTLSInfo, true),
-				"2": getFakeAPINode(t, "2", api.IssuanceStateRotate, oldNodeTLSInfo, true),
-			},
-			rootCA: &api.RootCA{ // no change in root CA from previous
-				CACert:     startCluster.RootCA.CACert,
-				CAKey:      startCluster.RootCA.CAKey,
-				CACertHash: startCluster.RootCA.CACertHash,
-			},
-		},
+	issueRotatedCerts(t, tc, rotation.CACert, rotation.CAKey, true)
+
+	expected := ca.RootRotationCompleted{
+		OldRoot: digest.FromBytes(oldRoot),
+		NewRoot: digest.FromBytes(rotation.CACert),
+	}
+	select {
+	case event := <-eventq:
+		require.Equal(t, expected, event)
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the root rotation to complete")
 	}
 
-	for _, testcase := range testcases {
-		if testcase.caServerStopped {
-			rt.tc.CAServer.Stop()
-		} else {
-			startCAServer(rt.tc.CAServer)
-		}
+	// give a duplicate notification a chance to show up
+	time.Sleep(500 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []ca.RootRotationCompleted{expected}, completed)
+}
 
-		rt.convergeRootCA(testcase.rootCA, testcase.descr)
-		rt.convergeWantedNodes(testcase.nodes, testcase.descr)
+func TestAuditCertificate(t *testing.T) {
+	if cautils.External {
+		return // the external CA chooses the validity of its certificates
+	}
 
-		time.Sleep(500 * time.Millisecond)
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	// issued under the default, lax policy, the certificate complies
+	nodeID, cert := issueWorkerCertificate(t, tc)
+	audit, err := tc.CAServer.AuditCertificate(cert)
+	require.NoError(t, err)
+	assert.Equal(t, nodeID, audit.NodeID)
+	assert.True(t, audit.Compliant(), "unexpected deviations: %v", audit.Deviations)
+
+	// once the policy is tightened, the same certificate no longer complies
+	tc.CAServer.Stop()
+	tc.CAServer.SetKeyStrengthPolicy(ca.KeyStrengthPolicy{Curves: []string{"P-384"}})
+	require.NoError(t, tc.CAServer.SetMinCertExpiry(2*ca.DefaultNodeCertExpiration))
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	audit, err = tc.CAServer.AuditCertificate(cert)
+	require.NoError(t, err)
+	assert.False(t, audit.Compliant())
+	require.Len(t, audit.Deviations, 2)
+	assert.Contains(t, audit.Deviations[0], "shorter than the minimum expiration")
+	assert.Contains(t, audit.Deviations[1], "key strength: ")
+	assert.Contains(t, audit.Deviations[1], "P-256")
+
+	_, err = tc.CAServer.AuditCertificate([]byte("not a certificate"))
+	assert.Error(t, err)
+}
+
+func TestIssueNodeCertificateApprovedKey(t *testing.T) {
+	tc := cautils.NewTestCA(t)
+	defer tc.Stop()
+
+	tc.CAServer.Stop()
+	tc.CAServer.SetJoinAuthorization(ca.JoinWithApprovedKey)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
+	csr, keyPEM, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	key, err := helpers.ParsePrivateKeyPEM(keyPEM)
+	require.NoError(t, err)
+	fingerprint, err := ca.PublicKeyFingerprint(key.Public())
+	require.NoError(t, err)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		return store.CreateApprovedKey(tx, &api.ApprovedKey{ID: fingerprint, Role: api.NodeRoleWorker})
+	}))
 
-		var (
-			nodes   []*api.Node
-			cluster *api.Cluster
-			err     error
-		)
+	// the approved key joins without a token
+	issueResponse, err := tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr})
+	require.NoError(t, err)
+	statusResponse, err := tc.NodeCAClients[0].NodeCertificateStatus(context.Background(), &api.NodeCertificateStatusRequest{NodeID: issueResponse.NodeID})
+	require.NoError(t, err)
+	require.Equal(t, api.IssuanceStateIssued, statusResponse.Status.State)
+	assert.Equal(t, api.NodeRoleWorker, statusResponse.Certificate.Role)
 
-		tc.MemoryStore.View(func(tx store.ReadTx) {
-			nodes, err = store.FindNodes(tx, store.All)
-			cluster = store.GetCluster(tx, tc.Organization)
-		})
-		require.NoError(t, err)
-		require.NotNil(t, cluster)
-		require.Equal(t, cluster.RootCA, *testcase.rootCA, testcase.descr)
-		require.Len(t, nodes, len(testcase.nodes), testcase.descr)
-		for _, node := range nodes {
-			expected, ok := testcase.nodes[node.ID]
-			require.True(t, ok, "node %s: %s", node.ID, testcase.descr)
-			require.Equal(t, expected.Description, node.Description, "node %s: %s", node.ID, testcase.descr)
-			require.Equal(t, expected.Certificate.Status, node.Certificate.Status, "node %s: %s", node.ID, testcase.descr)
-		}
+	// the approval was consumed
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		assert.Nil(t, store.GetApprovedKey(tx, fingerprint))
+	})
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: csr})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
 
-		// ensure that the security config's root CA object has the same expected key
-		expectedKey := testcase.rootCA.CAKey
-		if testcase.rootCA.RootRotation != nil {
-			expectedKey = testcase.rootCA.RootRotation.CAKey
-		}
-		s, err := rt.tc.ServingSecurityConfig.RootCA().Signer()
-		require.NoError(t, err, testcase.descr)
-		require.Equal(t, s.Key, expectedKey, testcase.descr)
-	}
+	// a key that isn't approved can't join, even with a join token
+	otherCSR, _, err := ca.GenerateNewCSR()
+	require.NoError(t, err)
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: otherCSR})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
+	_, err = tc.NodeCAClients[0].IssueNodeCertificate(context.Background(), &api.IssueNodeCertificateRequest{CSR: otherCSR, Token: tc.WorkerToken})
+	assert.Equal(t, codes.PermissionDenied, grpc.Code(err))
 }
 
-// Tests if the root rotation changes while the reconciliation loop is going, eventually the root rotation will finish
-// successfully (even if there's a competing reconciliation loop, for instance if there's a bug during leadership handoff).
-func TestRootRotationReconciliationRace(t *testing.T) {
+func TestRootRotationReconciliationExpiredIntermediate(t *testing.T) {
 	t.Parallel()
 	if cautils.External {
 		// the external CA functionality is unrelated to testing the reconciliation loop
@@ -1120,242 +4281,137 @@ func TestRootRotationReconciliationRace(t *testing.T) {
 
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
+	tc.CAServer.Stop()
+	// only the first batch is sent before the test ends
+	tc.CAServer.SetRootReconciliationInterval(time.Hour)
+	go tc.CAServer.Run(tc.Context)
+	<-tc.CAServer.Ready()
+
 	rt := rootRotationTester{
 		tc: tc,
 		t:  t,
 	}
 
-	tempDir, err := ioutil.TempDir("", "competing-ca-server")
-	require.NoError(t, err)
-	defer os.RemoveAll(tempDir)
-
-	var otherServers []*ca.Server
-	var secConfigs []*ca.SecurityConfig
-	for i := 0; i < 3; i++ { // to make sure we get some collision
-		// start a competing CA server
-		competingSecConfig, err := tc.NewNodeConfig(ca.ManagerRole)
-		require.NoError(t, err)
-		secConfigs = append(secConfigs, competingSecConfig)
-
-		paths := ca.NewConfigPaths(filepath.Join(tempDir, fmt.Sprintf("%d", i)))
+	var startCluster *api.Cluster
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		startCluster = store.GetCluster(tx, tc.Organization)
+	})
+	require.NotNil(t, startCluster)
 
-		otherServer := ca.NewServer(tc.MemoryStore, competingSecConfig, paths.RootCA)
-		// offset each server's reconciliation interval somewhat so that some will
-		// pre-empt others
-		otherServer.SetRootReconciliationInterval(time.Millisecond * time.Duration((i+1)*10))
-		startCAServer(otherServer)
-		defer otherServer.Stop()
-		otherServers = append(otherServers, otherServer)
+	// an intermediate that expired an hour ago
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "expired cross-signed root"},
+		NotBefore:             time.Now().Add(-2 * time.Hour),
+		NotAfter:              time.Now().Add(-time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
 	}
-	clusterWatch, clusterWatchCancel, err := store.ViewAndWatch(
-		tc.MemoryStore, func(tx store.ReadTx) error {
-			// don't bother getting the cluster - the CA serverß have already done that when first running
-			return nil
-		},
-		api.EventUpdateCluster{
-			Cluster: &api.Cluster{ID: tc.Organization},
-			Checks:  []api.ClusterCheckFunc{api.ClusterCheckID},
-		},
-	)
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
 	require.NoError(t, err)
-	defer clusterWatchCancel()
-
-	done := make(chan struct{})
-	defer close(done)
-	go func() {
-		for {
-			select {
-			case event := <-clusterWatch:
-				clusterEvent := event.(api.EventUpdateCluster)
-				for _, s := range otherServers {
-					s.UpdateRootCA(context.Background(), clusterEvent.Cluster)
-				}
-			case <-done:
-				return
-			}
-		}
-	}()
+	expiredIntermediate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
 
 	oldNodeTLSInfo := &api.NodeTLSInfo{
 		TrustRoot:           tc.RootCA.Certs,
 		CertIssuerPublicKey: tc.ServingSecurityConfig.IssuerInfo().PublicKey,
 		CertIssuerSubject:   tc.ServingSecurityConfig.IssuerInfo().Subject,
 	}
-
-	nodes := make(map[string]*api.Node)
-	for i := 0; i < 5; i++ {
-		nodeID := fmt.Sprintf("%d", i)
-		nodes[nodeID] = getFakeAPINode(t, nodeID, api.IssuanceStateIssued, oldNodeTLSInfo, true)
+	// many more nodes than fit in a batch, so that the expired node is unlikely to be in the first batch by chance
+	wantNodes := make(map[string]*api.Node)
+	for i := 0; i < 5*ca.IssuanceStateRotateMaxBatchSize; i++ {
+		id := fmt.Sprintf("%d", i)
+		wantNodes[id] = getFakeAPINode(t, id, api.IssuanceStateIssued, oldNodeTLSInfo, true)
 	}
-	rt.convergeWantedNodes(nodes, "setting up nodes for root rotation race condition test")
-
-	var rotationCert, rotationKey []byte
-	for i := 0; i < 10; i++ {
-		var (
-			rotationCrossSigned []byte
-			rotationTLSInfo     *api.NodeTLSInfo
-		)
-		rotationCert, rotationKey, err = cautils.CreateRootCertAndKey(fmt.Sprintf("root cn %d", i))
-		require.NoError(t, err)
-		require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
-			cluster := store.GetCluster(tx, tc.Organization)
-			if cluster == nil {
-				return errors.New("cluster has disappeared")
-			}
-			rootCA := cluster.RootCA.Copy()
-			caRootCA, err := ca.NewRootCA(rootCA.CACert, rootCA.CACert, rootCA.CAKey, ca.DefaultNodeCertExpiration, nil)
-			if err != nil {
-				return err
-			}
-			rotationCrossSigned, rotationTLSInfo = getRotationInfo(t, rotationCert, &caRootCA)
-			rootCA.RootRotation = &api.RootRotation{
-				CACert:            rotationCert,
-				CAKey:             rotationKey,
-				CrossSignedCACert: rotationCrossSigned,
+	expiredNode := getFakeAPINode(t, "expired", api.IssuanceStateIssued, oldNodeTLSInfo, true)
+	expiredNode.Certificate.Certificate = append(append([]byte{}, tc.RootCA.Certs...), expiredIntermediate...)
+	wantNodes["expired"] = expiredNode
+	rt.convergeWantedNodes(wantNodes, "start with nodes from the old root")
+
+	eventq, cancel := tc.CAServer.Watch()
+	defer cancel()
+
+	rotationCrossSigned, _ := getRotationInfo(t, cautils.ECDSA256SHA256Cert, &tc.RootCA)
+	rootCA := startCluster.RootCA
+	rootCA.RootRotation = &api.RootRotation{
+		CACert:            cautils.ECDSA256SHA256Cert,
+		CAKey:             cautils.ECDSA256Key,
+		CrossSignedCACert: rotationCrossSigned,
+	}
+	rt.convergeRootCA(&rootCA, "start a root rotation")
+
+	// the expired node is flagged
+	timeout := time.After(5 * time.Second)
+	for flagged := false; !flagged; {
+		select {
+		case event := <-eventq:
+			if expired, ok := event.(ca.ExpiredIntermediate); ok {
+				require.Equal(t, "expired", expired.NodeID)
+				require.True(t, expired.NotAfter.Equal(template.NotAfter.Truncate(time.Second)))
+				flagged = true
 			}
-			cluster.RootCA = *rootCA
-			return store.UpdateCluster(tx, cluster)
-		}))
-		for _, node := range nodes {
-			node.Description.TLSInfo = rotationTLSInfo
+		case <-timeout:
+			t.Fatal("expected an ExpiredIntermediate event")
 		}
-		rt.convergeWantedNodes(nodes, fmt.Sprintf("iteration %d", i))
 	}
 
+	// and is in the first batch, ahead of the other nodes
 	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
-		var cluster *api.Cluster
+		var rotating int
 		tc.MemoryStore.View(func(tx store.ReadTx) {
-			cluster = store.GetCluster(tx, tc.Organization)
-		})
-		if cluster == nil {
-			return errors.New("cluster has disappeared")
-		}
-		if cluster.RootCA.RootRotation != nil {
-			return errors.New("root rotation is still present")
-		}
-		if !bytes.Equal(cluster.RootCA.CACert, rotationCert) {
-			return errors.New("expected root cert is wrong")
-		}
-		if !bytes.Equal(cluster.RootCA.CAKey, rotationKey) {
-			return errors.New("expected root key is wrong")
-		}
-		for _, secConfig := range secConfigs {
-			s, err := secConfig.RootCA().Signer()
-			if err != nil {
-				return err
-			}
-			if !bytes.Equal(s.Key, rotationKey) {
-				return errors.New("all the sec configs haven't been updated yet")
+			nodes, err := store.FindNodes(tx, store.All)
+			require.NoError(t, err)
+			for _, n := range nodes {
+				if n.Certificate.Status.State == api.IssuanceStateRotate {
+					rotating++
+				}
 			}
+		})
+		if rotating != ca.IssuanceStateRotateMaxBatchSize {
+			return errors.Errorf("%d nodes are rotating", rotating)
 		}
 		return nil
 	}, 5*time.Second))
-
-	// all of the ca servers have the appropriate cert and key
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		require.Equal(t, api.IssuanceStateRotate, store.GetNode(tx, "expired").Certificate.Status.State)
+	})
 }
 
-// If there are a lot of nodes, we only update a small number of them at once.
-func TestRootRotationReconciliationThrottled(t *testing.T) {
-	t.Parallel()
-	if cautils.External {
-		// the external CA functionality is unrelated to testing the reconciliation loop
-		return
-	}
-
+func TestAcceptNodeIssuesCertificate(t *testing.T) {
 	tc := cautils.NewTestCA(t)
 	defer tc.Stop()
-	// immediately stop the CA server - we want to run our down
-	tc.CAServer.Stop()
-
-	caServer := ca.NewServer(tc.MemoryStore, tc.ServingSecurityConfig, tc.Paths.RootCA)
-	// set the reconciliation interval to something ridiculous, so we can make sure the first
-	// batch does update all of them
-	caServer.SetRootReconciliationInterval(time.Hour)
-	startCAServer(caServer)
-	defer caServer.Stop()
-
-	var nodes []*api.Node
-	clusterWatch, clusterWatchCancel, err := store.ViewAndWatch(
-		tc.MemoryStore, func(tx store.ReadTx) error {
-			// don't bother getting the cluster - the CA server has already done that when first running
-			var err error
-			nodes, err = store.FindNodes(tx, store.ByMembership(api.NodeMembershipAccepted))
-			return err
-		},
-		api.EventUpdateCluster{
-			Cluster: &api.Cluster{ID: tc.Organization},
-			Checks:  []api.ClusterCheckFunc{api.ClusterCheckID},
-		},
-	)
-	require.NoError(t, err)
-	defer clusterWatchCancel()
 
-	done := make(chan struct{})
-	defer close(done)
-	go func() {
-		for {
-			select {
-			case event := <-clusterWatch:
-				clusterEvent := event.(api.EventUpdateCluster)
-				caServer.UpdateRootCA(context.Background(), clusterEvent.Cluster)
-			case <-done:
-				return
-			}
-		}
-	}()
-
-	// create twice the batch size of nodes
-	_, err = tc.MemoryStore.Batch(func(batch *store.Batch) error {
-		for i := len(nodes); i < ca.IssuanceStateRotateMaxBatchSize*2; i++ {
-			nodeID := fmt.Sprintf("%d", i)
-			err := batch.Update(func(tx store.Tx) error {
-				return store.CreateNode(tx, getFakeAPINode(t, nodeID, api.IssuanceStateIssued, nil, true))
-			})
-			if err != nil {
-				return err
-			}
-		}
-		return nil
+	// a pending node is not issued a certificate
+	node := getFakeAPINode(t, "pending", api.IssuanceStatePending, nil, false)
+	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
+		return store.CreateNode(tx, node)
+	}))
+	time.Sleep(100 * time.Millisecond)
+	tc.MemoryStore.View(func(tx store.ReadTx) {
+		require.Equal(t, api.IssuanceStatePending, store.GetNode(tx, "pending").Certificate.Status.State)
 	})
-	require.NoError(t, err)
-
-	rotationCert := cautils.ECDSA256SHA256Cert
-	rotationKey := cautils.ECDSA256Key
-	rotationCrossSigned, _ := getRotationInfo(t, rotationCert, &tc.RootCA)
 
+	// once accepted as a manager, it is issued a manager certificate
 	require.NoError(t, tc.MemoryStore.Update(func(tx store.Tx) error {
-		cluster := store.GetCluster(tx, tc.Organization)
-		if cluster == nil {
-			return errors.New("cluster has disappeared")
-		}
-		rootCA := cluster.RootCA.Copy()
-		rootCA.RootRotation = &api.RootRotation{
-			CACert:            rotationCert,
-			CAKey:             rotationKey,
-			CrossSignedCACert: rotationCrossSigned,
-		}
-		cluster.RootCA = *rootCA
-		return store.UpdateCluster(tx, cluster)
+		return store.AcceptNode(tx, "pending", api.NodeRoleManager)
 	}))
-
-	checkRotationNumber := func() error {
+	var issued *api.Node
+	require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
 		tc.MemoryStore.View(func(tx store.ReadTx) {
-			nodes, err = store.FindNodes(tx, store.All)
+			issued = store.GetNode(tx, "pending")
 		})
-		var issuanceRotate int
-		for _, n := range nodes {
-			if n.Certificate.Status.State == api.IssuanceStateRotate {
-				issuanceRotate += 1
-			}
-		}
-		if issuanceRotate != ca.IssuanceStateRotateMaxBatchSize {
-			return fmt.Errorf("expected %d, got %d", ca.IssuanceStateRotateMaxBatchSize, issuanceRotate)
+		if issued.Certificate.Status.State != api.IssuanceStateIssued {
+			return errors.Errorf("node's certificate is in state %s", issued.Certificate.Status.State)
 		}
 		return nil
-	}
+	}, 5*time.Second))
 
-	require.NoError(t, testutils.PollFuncWithTimeout(nil, checkRotationNumber, 5*time.Second))
-	// prove that it's not just because the updates haven't finished
-	time.Sleep(time.Second)
-	require.NoError(t, checkRotationNumber())
+	parsed, err := helpers.ParseCertificatesPEM(issued.Certificate.Certificate)
+	require.NoError(t, err)
+	require.NotEmpty(t, parsed)
+	assert.Equal(t, "pending", parsed[0].Subject.CommonName)
+	assert.Equal(t, []string{ca.ManagerRole}, parsed[0].Subject.OrganizationalUnit)
+	assert.Equal(t, api.NodeRoleManager, issued.Role)
 }
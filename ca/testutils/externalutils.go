@@ -180,7 +180,11 @@ func (h *signHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	clientSub := r.TLS.PeerCertificates[0].Subject
 
 	// The client certificate OU should be for a swarm manager.
-	if len(clientSub.OrganizationalUnit) == 0 || clientSub.OrganizationalUnit[0] != ca.ManagerRole {
+	isManager := false
+	for _, ou := range clientSub.OrganizationalUnit {
+		isManager = isManager || ou == ca.ManagerRole
+	}
+	if !isManager {
 		cfsslErr := cfsslerrors.New(cfsslerrors.APIClientError, cfsslerrors.AuthenticationFailure)
 		errResponse := api.NewErrorResponse(fmt.Sprintf("client certificate OU must be %q", ca.ManagerRole), cfsslErr.ErrorCode)
 		json.NewEncoder(w).Encode(errResponse)
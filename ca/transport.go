@@ -143,12 +143,25 @@ func (c *MutableTLSCreds) Config() *tls.Config {
 	return c.config
 }
 
-// Role returns the OU for the certificate encapsulated in this TransportCredentials
+// Role returns the role OU for the certificate encapsulated in this TransportCredentials
 func (c *MutableTLSCreds) Role() string {
 	c.Lock()
 	defer c.Unlock()
 
-	return c.subject.OrganizationalUnit[0]
+	return roleFromOUs(c.subject.OrganizationalUnit)
+}
+
+// roleFromOUs returns the OU holding the node's role. A certificate may have
+// other OUs besides the role (see (*Server).SetRoleOUFormat), and their order
+// in the certificate isn't preserved, so the role is the first OU that is
+// ManagerRole or WorkerRole, or the first OU if there is no such OU.
+func roleFromOUs(ous []string) string {
+	for _, ou := range ous {
+		if ou == ManagerRole || ou == WorkerRole {
+			return ou
+		}
+	}
+	return ous[0]
 }
 
 // Organization returns the O for the certificate encapsulated in this TransportCredentials
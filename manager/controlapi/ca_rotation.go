@@ -84,6 +84,8 @@ func newRootRotationObject(ctx context.Context, securityConfig *ca.SecurityConfi
 	}
 
 	copied := apiRootCA.Copy()
+	// the new rotation replaces any in progress, and anything queued behind it was cross-signed for the old one
+	copied.QueuedRotations = nil
 	copied.RootRotation = &api.RootRotation{
 		CACert:            rootCert,
 		CAKey:             rootKey,
@@ -265,6 +267,7 @@ func validateCAConfig(ctx context.Context, securityConfig *ca.SecurityConfig, cl
 		copied := cluster.RootCA.Copy()
 		copied.CAKey = newConfig.SigningCAKey
 		copied.RootRotation = nil
+		copied.QueuedRotations = nil
 		copied.LastForcedRotation = newConfig.ForceRotate
 		return copied, nil
 	}
@@ -37,6 +37,10 @@ func validateClusterSpec(spec *api.ClusterSpec) error {
 		}
 	}
 
+	if _, err := ca.JoinTokenHashAlgorithm(spec.CAConfig.JoinTokenHashAlgorithm); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, err.Error())
+	}
+
 	// Validate that AcceptancePolicies only include Secrets that are bcrypted
 	// TODO(diogo): Add a global list of acceptance algorithms. We only support bcrypt for now.
 	if len(spec.AcceptancePolicy.Policies) > 0 {
@@ -85,6 +89,48 @@ func (s *Server) GetCluster(ctx context.Context, request *api.GetClusterRequest)
 	}, nil
 }
 
+// ListJoinTokenInfo returns the root CA certificate hash and a fingerprint of
+// the secret of each of the join tokens of the Cluster referenced by
+// ClusterID, so that they can be identified without being revealed. Join
+// tokens don't expire and can be used any number of times until they are
+// rotated, so no TTL or single-use state is returned.
+// - Returns `NotFound` if the Cluster is not found.
+// - Returns `InvalidArgument` if ClusterID is empty.
+// - Returns an error if the join tokens can't be parsed.
+func (s *Server) ListJoinTokenInfo(ctx context.Context, request *api.ListJoinTokenInfoRequest) (*api.ListJoinTokenInfoResponse, error) {
+	if request.ClusterID == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, errInvalidArgument.Error())
+	}
+
+	var cluster *api.Cluster
+	s.store.View(func(tx store.ReadTx) {
+		cluster = store.GetCluster(tx, request.ClusterID)
+	})
+	if cluster == nil {
+		return nil, grpc.Errorf(codes.NotFound, "cluster %s not found", request.ClusterID)
+	}
+
+	response := &api.ListJoinTokenInfoResponse{}
+	for _, token := range []struct {
+		role  api.NodeRole
+		token string
+	}{
+		{role: api.NodeRoleWorker, token: cluster.RootCA.JoinTokens.Worker},
+		{role: api.NodeRoleManager, token: cluster.RootCA.JoinTokens.Manager},
+	} {
+		caHash, fingerprint, err := ca.JoinTokenFingerprint(token.token)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Internal, "invalid %s join token: %v", strings.ToLower(token.role.String()), err)
+		}
+		response.Tokens = append(response.Tokens, &api.JoinTokenInfo{
+			Role:        token.role,
+			CACertHash:  caHash.String(),
+			Fingerprint: fingerprint.String(),
+		})
+	}
+	return response, nil
+}
+
 // UpdateCluster updates a Cluster referenced by ClusterID with the given ClusterSpec.
 // - Returns `NotFound` if the Cluster is not found.
 // - Returns `InvalidArgument` if the ClusterSpec is malformed.
@@ -115,17 +161,32 @@ func (s *Server) UpdateCluster(ctx context.Context, request *api.UpdateClusterRe
 			return grpc.Errorf(codes.Internal, "could not update security config")
 		}
 		rootCA := s.securityConfig.RootCA()
+		if s.rand != nil {
+			tokenRootCA := *rootCA
+			tokenRootCA.SetRandSource(s.rand)
+			rootCA = &tokenRootCA
+		}
 
 		cluster.Meta.Version = *request.ClusterVersion
 		cluster.Spec = *request.Spec.Copy()
 
 		expireBlacklistedCerts(cluster)
 
+		// the spec has been validated, so the algorithm is supported
+		tokenAlgorithm, _ := ca.JoinTokenHashAlgorithm(cluster.Spec.CAConfig.JoinTokenHashAlgorithm)
 		if request.Rotation.WorkerJoinToken {
-			cluster.RootCA.JoinTokens.Worker = ca.GenerateJoinToken(rootCA)
+			token, err := ca.GenerateJoinTokenWithAlgorithm(rootCA, tokenAlgorithm)
+			if err != nil {
+				return grpc.Errorf(codes.Internal, "could not generate join token: %v", err)
+			}
+			cluster.RootCA.JoinTokens.Worker = token
 		}
 		if request.Rotation.ManagerJoinToken {
-			cluster.RootCA.JoinTokens.Manager = ca.GenerateJoinToken(rootCA)
+			token, err := ca.GenerateJoinTokenWithAlgorithm(rootCA, tokenAlgorithm)
+			if err != nil {
+				return grpc.Errorf(codes.Internal, "could not generate join token: %v", err)
+			}
+			cluster.RootCA.JoinTokens.Manager = token
 		}
 
 		updatedRootCA, err := validateCAConfig(ctx, s.securityConfig, cluster)
@@ -250,6 +311,7 @@ func redactClusters(clusters []*api.Cluster) []*api.Cluster {
 
 		redactedRootCA := cluster.RootCA.Copy()
 		redactedRootCA.CAKey = nil
+		redactedRootCA.IntermediateCAKey = nil
 		if r := redactedRootCA.RootRotation; r != nil {
 			r.CAKey = nil
 		}
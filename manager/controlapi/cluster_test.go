@@ -2,6 +2,8 @@ package controlapi
 
 import (
 	"fmt"
+	mathrand "math/rand"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/docker/swarmkit/manager/state/store"
 	"github.com/docker/swarmkit/protobuf/ptypes"
 	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/opencontainers/go-digest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
@@ -119,7 +122,12 @@ func TestGetCluster(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, codes.NotFound, grpc.Code(err))
 
-	cluster := createCluster(t, ts, "name", "name", api.AcceptancePolicy{}, ts.Server.securityConfig.RootCA())
+	cluster := createClusterObj("name", "name", api.AcceptancePolicy{}, ts.Server.securityConfig.RootCA())
+	cluster.RootCA.IntermediateCACert = []byte("intermediate cert")
+	cluster.RootCA.IntermediateCAKey = []byte("intermediate key")
+	assert.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		return store.CreateCluster(tx, cluster)
+	}))
 	r, err := ts.Client.GetCluster(context.Background(), &api.GetClusterRequest{ClusterID: cluster.ID})
 	assert.NoError(t, err)
 	cluster.Meta.Version = r.Cluster.Meta.Version
@@ -129,8 +137,10 @@ func TestGetCluster(t *testing.T) {
 	assert.Equal(t, cluster.Spec, r.Cluster.Spec)
 	assert.Equal(t, cluster.RootCA.CACert, r.Cluster.RootCA.CACert)
 	assert.Equal(t, cluster.RootCA.CACertHash, r.Cluster.RootCA.CACertHash)
-	// CAKey and network keys should be nil
+	assert.Equal(t, cluster.RootCA.IntermediateCACert, r.Cluster.RootCA.IntermediateCACert)
+	// CA keys and network keys should be nil
 	assert.Nil(t, r.Cluster.RootCA.CAKey)
+	assert.Nil(t, r.Cluster.RootCA.IntermediateCAKey)
 	assert.Nil(t, r.Cluster.NetworkBootstrapKeys)
 }
 
@@ -156,6 +166,64 @@ func TestGetClusterWithSecret(t *testing.T) {
 	assert.NotNil(t, r.Cluster.Spec.AcceptancePolicy.Policies[0].Secret.Data)
 }
 
+func TestListJoinTokenInfo(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+	_, err := ts.Client.ListJoinTokenInfo(context.Background(), &api.ListJoinTokenInfoRequest{})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	_, err = ts.Client.ListJoinTokenInfo(context.Background(), &api.ListJoinTokenInfoRequest{ClusterID: "invalid"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+
+	rootCA := ts.Server.securityConfig.RootCA()
+	cluster := createCluster(t, ts, "name", "name", api.AcceptancePolicy{}, rootCA)
+	r, err := ts.Client.ListJoinTokenInfo(context.Background(), &api.ListJoinTokenInfoRequest{ClusterID: cluster.ID})
+	require.NoError(t, err)
+	require.Len(t, r.Tokens, 2)
+
+	tokens := map[api.NodeRole]string{
+		api.NodeRoleWorker:  cluster.RootCA.JoinTokens.Worker,
+		api.NodeRoleManager: cluster.RootCA.JoinTokens.Manager,
+	}
+	fingerprints := make(map[string]bool)
+	for _, info := range r.Tokens {
+		token, ok := tokens[info.Role]
+		require.True(t, ok)
+		_, fingerprint, err := ca.JoinTokenFingerprint(token)
+		require.NoError(t, err)
+		assert.Equal(t, fingerprint.String(), info.Fingerprint)
+		assert.Equal(t, rootCA.Digest.String(), info.CACertHash)
+		fingerprints[info.Fingerprint] = true
+
+		// neither the token nor its secret appear in the response
+		secret := token[strings.LastIndex(token, "-")+1:]
+		assert.NotContains(t, r.String(), token)
+		assert.NotContains(t, r.String(), secret)
+	}
+	assert.Len(t, fingerprints, 2)
+
+	// rotating a token changes its fingerprint
+	_, err = ts.Client.UpdateCluster(context.Background(), &api.UpdateClusterRequest{
+		ClusterID:      cluster.ID,
+		Spec:           &cluster.Spec,
+		ClusterVersion: &cluster.Meta.Version,
+		Rotation:       api.KeyRotation{WorkerJoinToken: true},
+	})
+	require.NoError(t, err)
+	rotated, err := ts.Client.ListJoinTokenInfo(context.Background(), &api.ListJoinTokenInfoRequest{ClusterID: cluster.ID})
+	require.NoError(t, err)
+	require.Len(t, rotated.Tokens, 2)
+	for i, info := range rotated.Tokens {
+		if info.Role == api.NodeRoleWorker {
+			assert.NotEqual(t, r.Tokens[i].Fingerprint, info.Fingerprint)
+		} else {
+			assert.Equal(t, r.Tokens[i].Fingerprint, info.Fingerprint)
+		}
+	}
+}
+
 func TestUpdateCluster(t *testing.T) {
 	ts := newTestServer(t)
 	defer ts.Stop()
@@ -317,6 +385,64 @@ func TestUpdateClusterRotateToken(t *testing.T) {
 	assert.Len(t, r.Clusters, 1)
 	assert.NotEqual(t, workerToken, r.Clusters[0].RootCA.JoinTokens.Worker)
 	assert.NotEqual(t, managerToken, r.Clusters[0].RootCA.JoinTokens.Manager)
+
+	// Rotate the worker token to one with a SHA-512 CA hash
+	spec := r.Clusters[0].Spec.Copy()
+	spec.CAConfig.JoinTokenHashAlgorithm = "md5"
+	_, err = ts.Client.UpdateCluster(context.Background(), &api.UpdateClusterRequest{
+		ClusterID:      cluster.ID,
+		Spec:           spec,
+		ClusterVersion: &r.Clusters[0].Meta.Version,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	spec.CAConfig.JoinTokenHashAlgorithm = "sha512"
+	_, err = ts.Client.UpdateCluster(context.Background(), &api.UpdateClusterRequest{
+		ClusterID:      cluster.ID,
+		Spec:           spec,
+		ClusterVersion: &r.Clusters[0].Meta.Version,
+		Rotation: api.KeyRotation{
+			WorkerJoinToken: true,
+		},
+	})
+	assert.NoError(t, err)
+
+	r, err = ts.Client.ListClusters(context.Background(), &api.ListClustersRequest{
+		Filters: &api.ListClustersRequest_Filters{
+			NamePrefixes: []string{"name"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, r.Clusters, 1)
+	assert.Len(t, strings.Split(r.Clusters[0].RootCA.JoinTokens.Worker, "-")[2], 100)
+	assert.Len(t, strings.Split(r.Clusters[0].RootCA.JoinTokens.Manager, "-")[2], 50)
+
+	// Rotated tokens take their secrets from the injected source of randomness
+	ts.Server.SetRandSource(mathrand.New(mathrand.NewSource(1)))
+	_, err = ts.Client.UpdateCluster(context.Background(), &api.UpdateClusterRequest{
+		ClusterID:      cluster.ID,
+		Spec:           spec,
+		ClusterVersion: &r.Clusters[0].Meta.Version,
+		Rotation: api.KeyRotation{
+			WorkerJoinToken: true,
+		},
+	})
+	assert.NoError(t, err)
+
+	expectedRootCA := *ts.Server.securityConfig.RootCA()
+	expectedRootCA.SetRandSource(mathrand.New(mathrand.NewSource(1)))
+	expectedToken, err := ca.GenerateJoinTokenWithAlgorithm(&expectedRootCA, digest.SHA512)
+	require.NoError(t, err)
+
+	r, err = ts.Client.ListClusters(context.Background(), &api.ListClustersRequest{
+		Filters: &api.ListClustersRequest_Filters{
+			NamePrefixes: []string{"name"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, r.Clusters, 1)
+	assert.Equal(t, expectedToken, r.Clusters[0].RootCA.JoinTokens.Worker)
 }
 
 func TestUpdateClusterRotateUnlockKey(t *testing.T) {
@@ -192,7 +192,7 @@ func (s *Server) ListNodes(ctx context.Context, request *api.ListNodesRequest) (
 
 // UpdateNode updates a Node referenced by NodeID with the given NodeSpec.
 // - Returns `NotFound` if the Node is not found.
-// - Returns `InvalidArgument` if the NodeSpec is malformed.
+// - Returns `InvalidArgument` if the NodeSpec is malformed or makes an illegal membership change.
 // - Returns an error if the update fails.
 func (s *Server) UpdateNode(ctx context.Context, request *api.UpdateNodeRequest) (*api.UpdateNodeResponse, error) {
 	if request.NodeID == "" || request.NodeVersion == nil {
@@ -235,8 +235,20 @@ func (s *Server) UpdateNode(ctx context.Context, request *api.UpdateNodeRequest)
 			}
 		}
 
+		membership := node.Spec.Membership
 		node.Meta.Version = *request.NodeVersion
 		node.Spec = *request.Spec.Copy()
+		// Membership changes go through the legal transitions, and are
+		// recorded in the node's MembershipTransition
+		node.Spec.Membership = membership
+		if request.Spec.Membership != membership {
+			if err := store.TransitionNodeMembership(node, request.Spec.Membership); err != nil {
+				if _, ok := err.(store.MembershipTransitionError); ok {
+					return grpc.Errorf(codes.InvalidArgument, "%v", err)
+				}
+				return err
+			}
+		}
 		return store.UpdateNode(tx, node)
 	})
 	if err != nil {
@@ -533,6 +533,40 @@ func TestUpdateNode(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUpdateNodeMembership(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	createNode(t, ts, "id1", api.NodeRoleWorker, api.NodeMembershipPending, api.NodeStatus_READY)
+	r, err := ts.Client.GetNode(context.Background(), &api.GetNodeRequest{NodeID: "id1"})
+	require.NoError(t, err)
+
+	// accepting the node records the transition
+	spec := r.Node.Spec.Copy()
+	spec.Membership = api.NodeMembershipAccepted
+	_, err = ts.Client.UpdateNode(context.Background(), &api.UpdateNodeRequest{NodeID: "id1", Spec: spec, NodeVersion: &r.Node.Meta.Version})
+	require.NoError(t, err)
+	r, err = ts.Client.GetNode(context.Background(), &api.GetNodeRequest{NodeID: "id1"})
+	require.NoError(t, err)
+	assert.Equal(t, api.NodeMembershipAccepted, r.Node.Spec.Membership)
+	require.NotNil(t, r.Node.MembershipTransition)
+	assert.Equal(t, api.NodeMembershipPending, r.Node.MembershipTransition.From)
+	assert.Equal(t, api.NodeMembershipAccepted, r.Node.MembershipTransition.To)
+
+	// but an accepted node can't be moved back to pending
+	spec = r.Node.Spec.Copy()
+	spec.Membership = api.NodeMembershipPending
+	_, err = ts.Client.UpdateNode(context.Background(), &api.UpdateNodeRequest{NodeID: "id1", Spec: spec, NodeVersion: &r.Node.Meta.Version})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+	// updates that leave the membership alone are unaffected
+	spec = r.Node.Spec.Copy()
+	spec.Availability = api.NodeAvailabilityDrain
+	_, err = ts.Client.UpdateNode(context.Background(), &api.UpdateNodeRequest{NodeID: "id1", Spec: spec, NodeVersion: &r.Node.Meta.Version})
+	assert.NoError(t, err)
+}
+
 func testUpdateNodeDemote(t *testing.T) {
 	tc := cautils.NewTestCA(nil)
 	defer tc.Stop()
@@ -2,6 +2,7 @@ package controlapi
 
 import (
 	"errors"
+	"io"
 
 	"github.com/docker/docker/pkg/plugingetter"
 	"github.com/docker/swarmkit/ca"
@@ -21,6 +22,10 @@ type Server struct {
 	securityConfig *ca.SecurityConfig
 	scu            ca.APISecurityConfigUpdater
 	pg             plugingetter.PluginGetter
+
+	// rand is the source of randomness for rotated join tokens. nil means
+	// crypto/rand.
+	rand io.Reader
 }
 
 // NewServer creates a Cluster API server.
@@ -34,3 +39,10 @@ func NewServer(store *store.MemoryStore, raft *raft.Node, securityConfig *ca.Sec
 		pg:             pg,
 	}
 }
+
+// SetRandSource makes the server read the secrets of the join tokens it
+// rotates from r instead of crypto/rand. See (*ca.RootCA).SetRandSource. This
+// function must be called before the server starts serving requests.
+func (s *Server) SetRandSource(r io.Reader) {
+	s.rand = r
+}
@@ -550,6 +550,11 @@ func (m *Manager) Run(parent context.Context) error {
 
 const stopTimeout = 8 * time.Second
 
+// rootRotationHandoffTimeout bounds how long Stop waits for the CA's root
+// rotation reconciliation progress to be recorded. The write is small, so
+// it only takes longer if it can't be committed at all.
+const rootRotationHandoffTimeout = time.Second
+
 // Stop stops the manager. It immediately closes all open connections and
 // active RPCs as well as stopping the scheduler. If clearData is set, the
 // raft logs, snapshots, and keys will be erased.
@@ -578,6 +583,23 @@ func (m *Manager) Stop(ctx context.Context, clearData bool) {
 		close(localSrvDone)
 	}()
 
+	// Hand off the CA's root rotation reconciliation while the progress of
+	// its loop can still be recorded, if this manager is the leader. Without
+	// a quorum the write won't complete, so don't wait on it for long.
+	if m.raftNode.ReadyForProposals() {
+		handoffDone := make(chan struct{})
+		go func() {
+			if err := m.caserver.HandoffReconciliation(); err != nil {
+				log.G(ctx).WithError(err).Debug("unable to record root rotation reconciliation progress")
+			}
+			close(handoffDone)
+		}()
+		select {
+		case <-handoffDone:
+		case <-time.After(rootRotationHandoffTimeout):
+		}
+	}
+
 	m.raftNode.Cancel()
 
 	m.dispatcher.Stop()
@@ -945,7 +967,15 @@ func (m *Manager) becomeLeader(ctx context.Context) {
 	m.taskReaper = taskreaper.New(s)
 	m.scheduler = scheduler.New(s)
 	m.keyManager = keymanager.New(s, keymanager.DefaultConfig())
-	m.roleManager = newRoleManager(s, m.raftNode)
+	// Hand off the CA's root rotation reconciliation before a demoted
+	// leader gives up the leadership, while the progress of its loop can
+	// still be recorded. If the transfer fails, the demotion removes this
+	// manager from the raft instead, so the loop isn't needed any more.
+	m.roleManager = newRoleManager(s, m.raftNode, func() {
+		if err := m.caserver.HandoffReconciliation(); err != nil {
+			log.G(ctx).WithError(err).Warn("unable to record root rotation reconciliation progress")
+		}
+	})
 
 	// TODO(stevvooe): Allocate a context that can be used to
 	// shutdown underlying manager processes when leadership is
@@ -984,6 +1014,14 @@ func (m *Manager) becomeLeader(ctx context.Context) {
 		}
 	}(m.caserver)
 
+	// Prepare the signer now, so the first node to request a certificate
+	// from this leader doesn't have to wait for it.
+	go func(server *ca.Server) {
+		if err := server.Prime(ctx); err != nil {
+			log.G(ctx).WithError(err).Warn("failed to prime CA signer")
+		}
+	}(m.caserver)
+
 	// Start all sub-components in separate goroutines.
 	// TODO(aluzzardi): This should have some kind of error handling so that
 	// any component that goes down would bring the entire manager down.
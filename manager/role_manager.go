@@ -21,21 +21,27 @@ type roleManager struct {
 	raft     *raft.Node
 	doneChan chan struct{}
 
+	// beforeTransfer, if set, is called when this manager is demoted,
+	// before it transfers the leadership away, while it can still write
+	// to the store.
+	beforeTransfer func()
+
 	// pending contains changed nodes that have not yet been reconciled in
 	// the raft member list.
 	pending map[string]*api.Node
 }
 
-// newRoleManager creates a new roleManager.
-func newRoleManager(store *store.MemoryStore, raftNode *raft.Node) *roleManager {
+// newRoleManager creates a new roleManager. beforeTransfer may be nil.
+func newRoleManager(store *store.MemoryStore, raftNode *raft.Node, beforeTransfer func()) *roleManager {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &roleManager{
-		ctx:      ctx,
-		cancel:   cancel,
-		store:    store,
-		raft:     raftNode,
-		doneChan: make(chan struct{}),
-		pending:  make(map[string]*api.Node),
+		ctx:            ctx,
+		cancel:         cancel,
+		store:          store,
+		raft:           raftNode,
+		doneChan:       make(chan struct{}),
+		pending:        make(map[string]*api.Node),
+		beforeTransfer: beforeTransfer,
 	}
 }
 
@@ -140,6 +146,9 @@ func (rm *roleManager) reconcileRole(ctx context.Context, node *api.Node) {
 				// Don't use rmCtx, because we expect to lose
 				// leadership, which will cancel this context.
 				log.G(ctx).Info("demoted; transferring leadership")
+				if rm.beforeTransfer != nil {
+					rm.beforeTransfer()
+				}
 				err := rm.raft.TransferLeadership(context.Background())
 				if err == nil {
 					return
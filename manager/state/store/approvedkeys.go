@@ -0,0 +1,101 @@
+package store
+
+import (
+	"github.com/docker/swarmkit/api"
+	memdb "github.com/hashicorp/go-memdb"
+)
+
+const tableApprovedKey = "approved_key"
+
+func init() {
+	register(ObjectStoreConfig{
+		Table: &memdb.TableSchema{
+			Name: tableApprovedKey,
+			Indexes: map[string]*memdb.IndexSchema{
+				indexID: {
+					Name:    indexID,
+					Unique:  true,
+					Indexer: api.ApprovedKeyIndexerByID{},
+				},
+			},
+		},
+		Selectors: []string{SelectorIDPrefix},
+		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
+			var err error
+			snapshot.ApprovedKeys, err = FindApprovedKeys(tx, All)
+			return err
+		},
+		Restore: func(tx Tx, snapshot *api.StoreSnapshot) error {
+			approvedKeys, err := FindApprovedKeys(tx, All)
+			if err != nil {
+				return err
+			}
+			for _, k := range approvedKeys {
+				if err := DeleteApprovedKey(tx, k.ID); err != nil {
+					return err
+				}
+			}
+			for _, k := range snapshot.ApprovedKeys {
+				if err := CreateApprovedKey(tx, k); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		ApplyStoreAction: func(tx Tx, sa api.StoreAction) error {
+			switch v := sa.Target.(type) {
+			case *api.StoreAction_ApprovedKey:
+				obj := v.ApprovedKey
+				switch sa.Action {
+				case api.StoreActionKindCreate:
+					return CreateApprovedKey(tx, obj)
+				case api.StoreActionKindUpdate:
+					return UpdateApprovedKey(tx, obj)
+				case api.StoreActionKindRemove:
+					return DeleteApprovedKey(tx, obj.ID)
+				}
+			}
+			return errUnknownStoreAction
+		},
+	})
+}
+
+// CreateApprovedKey adds a new approved key to the store. Its ID is the key's
+// fingerprint.
+// Returns ErrExist if the key has already been approved.
+func CreateApprovedKey(tx Tx, k *api.ApprovedKey) error {
+	return tx.create(tableApprovedKey, k)
+}
+
+// UpdateApprovedKey updates an existing approved key in the store.
+// Returns ErrNotExist if the key isn't approved.
+func UpdateApprovedKey(tx Tx, k *api.ApprovedKey) error {
+	return tx.update(tableApprovedKey, k)
+}
+
+// DeleteApprovedKey removes an approved key from the store.
+// Returns ErrNotExist if the key isn't approved.
+func DeleteApprovedKey(tx Tx, id string) error {
+	return tx.delete(tableApprovedKey, id)
+}
+
+// GetApprovedKey looks up an approved key by fingerprint.
+// Returns nil if the key isn't approved.
+func GetApprovedKey(tx ReadTx, id string) *api.ApprovedKey {
+	k := tx.get(tableApprovedKey, id)
+	if k == nil {
+		return nil
+	}
+	return k.(*api.ApprovedKey)
+}
+
+// FindApprovedKeys selects a set of approved keys and returns them.
+func FindApprovedKeys(tx ReadTx, by By) ([]*api.ApprovedKey, error) {
+	approvedKeyList := []*api.ApprovedKey{}
+	appendResult := func(o api.StoreObject) {
+		approvedKeyList = append(approvedKeyList, o.(*api.ApprovedKey))
+	}
+
+	err := tx.find(tableApprovedKey, by, selectorChecker(tableApprovedKey), appendResult)
+	return approvedKeyList, err
+}
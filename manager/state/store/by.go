@@ -1,6 +1,37 @@
 package store
 
-import "github.com/docker/swarmkit/api"
+import (
+	"fmt"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+)
+
+// Selector kinds, as reported by SupportedSelectors. Each kind corresponds to
+// one of the By constructors below. All and Or are accepted by every table
+// and have no kind.
+const (
+	SelectorIDPrefix           = "id_prefix"
+	SelectorName               = "name"
+	SelectorNamePrefix         = "name_prefix"
+	SelectorRuntime            = "runtime"
+	SelectorService            = "service"
+	SelectorNode               = "node"
+	SelectorSlot               = "slot"
+	SelectorDesiredState       = "desired_state"
+	SelectorTaskState          = "task_state"
+	SelectorRole               = "role"
+	SelectorMembership         = "membership"
+	SelectorCertIssuer         = "cert_issuer"
+	SelectorCertExpiringBefore = "cert_expiring_before"
+	SelectorCertSerial         = "cert_serial"
+	SelectorReferencedNetwork  = "referenced_network"
+	SelectorReferencedSecret   = "referenced_secret"
+	SelectorReferencedConfig   = "referenced_config"
+	SelectorKind               = "kind"
+	SelectorCustom             = "custom"
+	SelectorCustomPrefix       = "custom_prefix"
+)
 
 // By is an interface type passed to Find methods. Implementations must be
 // defined in this package.
@@ -132,6 +163,56 @@ func ByMembership(membership api.NodeSpec_Membership) By {
 	return byMembership(membership)
 }
 
+type byCertIssuer string
+
+func (b byCertIssuer) isBy() {
+}
+
+// ByCertIssuer creates an object to pass to Find to select nodes whose TLS
+// info reports a certificate issued by the given raw subject. Nodes that
+// haven't reported TLS info are never selected.
+func ByCertIssuer(subject []byte) By {
+	return byCertIssuer(subject)
+}
+
+type byCertExpiringBefore time.Time
+
+func (b byCertExpiringBefore) isBy() {
+}
+
+// ByCertExpiringBefore creates an object to pass to Find to select nodes
+// whose issued certificate expires before t. Nodes without an issued
+// certificate are never selected.
+func ByCertExpiringBefore(t time.Time) By {
+	return byCertExpiringBefore(t)
+}
+
+type byCertSerial string
+
+func (b byCertSerial) isBy() {
+}
+
+// ByCertSerial creates an object to pass to Find to select nodes whose issued
+// certificate, or one of the certificates in their issuance history, has the
+// given serial number, in lowercase hex.
+func ByCertSerial(serial string) By {
+	return byCertSerial(serial)
+}
+
+type byModifiedSince uint64
+
+func (b byModifiedSince) isBy() {
+}
+
+// ByModifiedSince creates an object to pass to Find to select objects that
+// were created or last updated at a version index greater than index. Like
+// All, it is accepted by every table. Objects are returned in the order of
+// the version index they were last modified at. Selecting them takes time
+// linear in the size of the table, however few objects are selected.
+func ByModifiedSince(index uint64) By {
+	return byModifiedSince(index)
+}
+
 type byReferencedNetworkID string
 
 func (b byReferencedNetworkID) isBy() {
@@ -212,3 +293,105 @@ func ByCustomPrefix(objType, index, value string) By {
 		value:   value,
 	}
 }
+
+type byRegistered struct {
+	kind string
+	args []interface{}
+}
+
+func (b byRegistered) isBy() {
+}
+
+// registeredSelectors maps each table to the selector kinds registered for
+// it with RegisterSelector, and each kind to the index it looks up.
+var registeredSelectors = map[string]map[string]string{}
+
+// RegisterSelector adds a selector of the given kind to a table. It selects
+// the objects whose entry in the named index matches the arguments passed to
+// BySelector. This lets other packages query the table's existing indexes
+// without changes to the find functions. Like tables, selectors should be
+// registered from init functions.
+// Returns UnknownTableError if the table is not registered, and an error if
+// the table has no such index or already supports the kind.
+func RegisterSelector(table, kind, index string) error {
+	os := lookupObjectStorer(table)
+	if os == nil {
+		return UnknownTableError{Table: table}
+	}
+	if _, ok := os.Table.Indexes[index]; !ok {
+		return fmt.Errorf("table %q has no index %q", table, index)
+	}
+	for _, s := range os.Selectors {
+		if s == kind {
+			return fmt.Errorf("table %q already supports selector %q", table, kind)
+		}
+	}
+
+	os.Selectors = append(os.Selectors, kind)
+	if registeredSelectors[table] == nil {
+		registeredSelectors[table] = make(map[string]string)
+	}
+	registeredSelectors[table][kind] = index
+	return nil
+}
+
+// BySelector creates an object to pass to Find to select by a selector
+// registered with RegisterSelector. The arguments are passed to the lookup of
+// the selector's index.
+func BySelector(kind string, args ...interface{}) By {
+	return byRegistered{
+		kind: kind,
+		args: args,
+	}
+}
+
+// selectorKind returns the selector kind of a By, or an empty string for
+// generic selectors such as All and Or.
+func selectorKind(by By) string {
+	switch v := by.(type) {
+	case byIDPrefix:
+		return SelectorIDPrefix
+	case byName:
+		return SelectorName
+	case byNamePrefix:
+		return SelectorNamePrefix
+	case byRuntime:
+		return SelectorRuntime
+	case byService:
+		return SelectorService
+	case byNode:
+		return SelectorNode
+	case bySlot:
+		return SelectorSlot
+	case byDesiredState:
+		return SelectorDesiredState
+	case byTaskState:
+		return SelectorTaskState
+	case byRole:
+		return SelectorRole
+	case byMembership:
+		return SelectorMembership
+	case byCertIssuer:
+		return SelectorCertIssuer
+	case byCertExpiringBefore:
+		return SelectorCertExpiringBefore
+	case byCertSerial:
+		return SelectorCertSerial
+	case byReferencedNetworkID:
+		return SelectorReferencedNetwork
+	case byReferencedSecretID:
+		return SelectorReferencedSecret
+	case byReferencedConfigID:
+		return SelectorReferencedConfig
+	case byKind:
+		return SelectorKind
+	case byCustom:
+		return SelectorCustom
+	case byCustomPrefix:
+		return SelectorCustomPrefix
+	case byRegistered:
+		return v.kind
+	default:
+		return ""
+	}
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"bytes"
 	"strings"
 
 	"github.com/docker/swarmkit/api"
@@ -37,6 +38,7 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{SelectorName, SelectorNamePrefix, SelectorIDPrefix, SelectorCustom, SelectorCustomPrefix},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Clusters, err = FindClusters(tx, All)
@@ -101,6 +103,60 @@ func UpdateCluster(tx Tx, c *api.Cluster) error {
 	return tx.update(tableCluster, c)
 }
 
+// UpdateClusterRootCA replaces the root CA of an existing cluster with
+// rootCA, after checking that rootCA is valid.
+// Returns ErrNotExist if the cluster doesn't exist, and an InvalidRootCAError
+// if rootCA is invalid.
+func UpdateClusterRootCA(tx Tx, clusterID string, rootCA *api.RootCA) error {
+	if err := validateRootCA(rootCA); err != nil {
+		return err
+	}
+	cluster := GetCluster(tx, clusterID)
+	if cluster == nil {
+		return ErrNotExist
+	}
+	cluster.RootCA = *rootCA.Copy()
+	return UpdateCluster(tx, cluster)
+}
+
+// InvalidRootCAError is returned by UpdateClusterRootCA when the new root CA
+// breaks one of the invariants of a cluster's root CA.
+type InvalidRootCAError struct {
+	Reason string
+}
+
+func (e InvalidRootCAError) Error() string {
+	return "invalid root CA: " + e.Reason
+}
+
+// validateRootCA checks that rootCA has a CA certificate, that rotations are
+// only queued behind a root rotation in progress, and that every root
+// rotation has a CA certificate and, unless it is a rotation to the root
+// before it, the new root cross-signed by the root before it.
+func validateRootCA(rootCA *api.RootCA) error {
+	if len(rootCA.CACert) == 0 {
+		return InvalidRootCAError{Reason: "no CA certificate"}
+	}
+	if rootCA.RootRotation == nil {
+		if len(rootCA.QueuedRotations) > 0 {
+			return InvalidRootCAError{Reason: "root rotations are queued, but no root rotation is in progress"}
+		}
+		return nil
+	}
+	// each rotation's new root is cross-signed by the root before it
+	prev := rootCA.CACert
+	for _, rotation := range append([]*api.RootRotation{rootCA.RootRotation}, rootCA.QueuedRotations...) {
+		if rotation == nil || len(rotation.CACert) == 0 {
+			return InvalidRootCAError{Reason: "root rotation has no CA certificate"}
+		}
+		if len(rotation.CrossSignedCACert) == 0 && !bytes.Equal(rotation.CACert, prev) {
+			return InvalidRootCAError{Reason: "root rotation has no cross-signed CA certificate"}
+		}
+		prev = rotation.CACert
+	}
+	return nil
+}
+
 // DeleteCluster removes a cluster from the store.
 // Returns ErrNotExist if the cluster doesn't exist.
 func DeleteCluster(tx Tx, id string) error {
@@ -119,20 +175,11 @@ func GetCluster(tx ReadTx, id string) *api.Cluster {
 
 // FindClusters selects a set of clusters and returns them.
 func FindClusters(tx ReadTx, by By) ([]*api.Cluster, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	clusterList := []*api.Cluster{}
 	appendResult := func(o api.StoreObject) {
 		clusterList = append(clusterList, o.(*api.Cluster))
 	}
 
-	err := tx.find(tableCluster, by, checkType, appendResult)
+	err := tx.find(tableCluster, by, selectorChecker(tableCluster), appendResult)
 	return clusterList, err
 }
@@ -31,6 +31,7 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{SelectorName, SelectorNamePrefix, SelectorIDPrefix, SelectorCustom, SelectorCustomPrefix},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Configs, err = FindConfigs(tx, All)
@@ -113,20 +114,11 @@ func GetConfig(tx ReadTx, id string) *api.Config {
 
 // FindConfigs selects a set of configs and returns them.
 func FindConfigs(tx ReadTx, by By) ([]*api.Config, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	configList := []*api.Config{}
 	appendResult := func(o api.StoreObject) {
 		configList = append(configList, o.(*api.Config))
 	}
 
-	err := tx.find(tableConfig, by, checkType, appendResult)
+	err := tx.find(tableConfig, by, selectorChecker(tableConfig), appendResult)
 	return configList, err
 }
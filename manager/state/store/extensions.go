@@ -32,6 +32,7 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{SelectorIDPrefix, SelectorName, SelectorCustom, SelectorCustomPrefix},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Extensions, err = FindExtensions(tx, All)
@@ -135,21 +136,12 @@ func GetExtension(tx ReadTx, id string) *api.Extension {
 
 // FindExtensions selects a set of extensions and returns them.
 func FindExtensions(tx ReadTx, by By) ([]*api.Extension, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byIDPrefix, byName, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	extensionList := []*api.Extension{}
 	appendResult := func(o api.StoreObject) {
 		extensionList = append(extensionList, o.(extensionEntry).Extension)
 	}
 
-	err := tx.find(tableExtension, by, checkType, appendResult)
+	err := tx.find(tableExtension, by, selectorChecker(tableExtension), appendResult)
 	return extensionList, err
 }
 
@@ -1,8 +1,14 @@
 package store
 
 import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"runtime"
 	"strconv"
 	"strings"
@@ -13,8 +19,10 @@ import (
 	"github.com/docker/go-events"
 	"github.com/docker/swarmkit/api"
 	pb "github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/encryption"
 	"github.com/docker/swarmkit/manager/state"
 	"github.com/docker/swarmkit/watch"
+	"github.com/gogo/protobuf/proto"
 	gogotypes "github.com/gogo/protobuf/types"
 	memdb "github.com/hashicorp/go-memdb"
 	"golang.org/x/net/context"
@@ -31,11 +39,15 @@ const (
 	indexTaskState    = "taskstate"
 	indexRole         = "role"
 	indexMembership   = "membership"
+	indexCertIssuer   = "certissuer"
+	indexCertExpiry   = "certexpiry"
+	indexCertSerial   = "certserial"
 	indexNetwork      = "network"
 	indexSecret       = "secret"
 	indexConfig       = "config"
 	indexKind         = "kind"
 	indexCustom       = "custom"
+	indexVersion      = "version"
 
 	prefix = "_prefix"
 
@@ -45,6 +57,10 @@ const (
 
 	// MaxTransactionBytes is the maximum serialized transaction size.
 	MaxTransactionBytes = 1.5 * 1024 * 1024
+
+	// nodePurgeInterval is the longest time between checks for soft-deleted
+	// nodes whose retention has passed.
+	nodePurgeInterval = time.Minute
 )
 
 var (
@@ -67,6 +83,10 @@ var (
 	// whose sequence information does not match the object in the store's.
 	ErrSequenceConflict = errors.New("update out of sequence")
 
+	// ErrSnapshotCorrupt is returned by RestoreSerialized if the snapshot
+	// doesn't match its checksum.
+	ErrSnapshotCorrupt = errors.New("store snapshot is corrupt")
+
 	objectStorers []ObjectStoreConfig
 	schema        = &memdb.DBSchema{
 		Tables: map[string]*memdb.TableSchema{},
@@ -79,10 +99,63 @@ var (
 )
 
 func register(os ObjectStoreConfig) {
+	// Every table is indexed by version, for ByModifiedSince
+	os.Table.Indexes[indexVersion] = &memdb.IndexSchema{
+		Name:    indexVersion,
+		Indexer: versionIndexer{},
+	}
 	objectStorers = append(objectStorers, os)
 	schema.Tables[os.Table.Name] = os.Table
 }
 
+func lookupObjectStorer(table string) *ObjectStoreConfig {
+	for i := range objectStorers {
+		if objectStorers[i].Table.Name == table {
+			return &objectStorers[i]
+		}
+	}
+	return nil
+}
+
+// RegisteredTables returns the names of all tables registered with the
+// store, in registration order. The returned slice is a copy and may be
+// modified by the caller.
+func RegisteredTables() []string {
+	tables := make([]string, 0, len(objectStorers))
+	for _, os := range objectStorers {
+		tables = append(tables, os.Table.Name)
+	}
+	return tables
+}
+
+// SupportedSelectors returns the kinds of By selectors accepted by Find
+// operations on the given table, as declared in the table's
+// ObjectStoreConfig. All, Or and ModifiedSince are accepted by every table
+// and are not included. It returns nil if the table is not registered.
+func SupportedSelectors(table string) []string {
+	os := lookupObjectStorer(table)
+	if os == nil {
+		return nil
+	}
+	return append([]string(nil), os.Selectors...)
+}
+
+// selectorChecker returns a type check function for find that only accepts
+// the selectors declared for the given table.
+func selectorChecker(table string) func(By) error {
+	return func(by By) error {
+		if os := lookupObjectStorer(table); os != nil {
+			kind := selectorKind(by)
+			for _, s := range os.Selectors {
+				if s == kind {
+					return nil
+				}
+			}
+		}
+		return ErrInvalidFindBy
+	}
+}
+
 // timedMutex wraps a sync.Mutex, and keeps track of how long it has been
 // locked.
 type timedMutex struct {
@@ -118,6 +191,35 @@ type MemoryStore struct {
 	queue *watch.Queue
 
 	proposer state.Proposer
+
+	// snapshotEncrypter and snapshotDecrypter are used by SaveSerialized
+	// and RestoreSerialized to protect snapshots at rest. They are nil
+	// unless the store was created with a snapshot key.
+	snapshotEncrypter encryption.Encrypter
+	snapshotDecrypter encryption.Decrypter
+
+	// nodeRetention is how long soft-deleted nodes are kept as tombstones
+	// before they are purged. Zero means DeleteNode removes nodes
+	// immediately.
+	nodeRetention time.Duration
+	stopPurge     chan struct{}
+	stopPurgeOnce sync.Once
+
+	// suppressRestoreEvents makes restores publish a single
+	// state.EventStoreRestored instead of an event for each object.
+	suppressRestoreEvents bool
+
+	// views are the registered materialized views. They are guarded by
+	// updateLock.
+	views []*registeredView
+
+	// batchLimits are the per-table limits on the transactions started by
+	// Batch, by table name.
+	batchLimits map[string]BatchLimit
+
+	// tableSizes records the history of the size of each table, if
+	// EnableTableSizeSampling was called.
+	tableSizes *tableSizeSampler
 }
 
 // NewMemoryStore returns an in-memory store. The argument is an optional
@@ -137,8 +239,147 @@ func NewMemoryStore(proposer state.Proposer) *MemoryStore {
 	}
 }
 
+// NewMemoryStoreWithSnapshotKey returns an in-memory store whose serialized
+// snapshots are encrypted with the given key, using the same scheme as the
+// other encrypted-at-rest data in the manager. The key can be generated with
+// encryption.GenerateSecretKey.
+func NewMemoryStoreWithSnapshotKey(proposer state.Proposer, key []byte) *MemoryStore {
+	s := NewMemoryStore(proposer)
+	s.snapshotEncrypter, s.snapshotDecrypter = encryption.Defaults(key)
+	return s
+}
+
+// EnableNodeSoftDelete makes DeleteNode mark nodes as deleted instead of
+// removing them. Soft-deleted nodes are hidden from normal reads, but can be
+// seen through IncludeDeleted. They are purged in the background once they
+// have been deleted for longer than retention, until StopPurgingDeletedNodes
+// or Close is called. Tombstones replicated from another member are only kept
+// by stores with soft-delete enabled; other stores remove the node outright.
+// Creating a node with the ID of a soft-deleted node replaces the tombstone.
+//
+// Purges are proposed like other changes, so in a cluster only the leader
+// purges, by its own clock and retention, and the other members remove the
+// tombstones when they apply the purge. Members should still all have
+// soft-delete enabled, so that each keeps the tombstones it may have to
+// purge once it becomes leader.
+// This function must be called before the store is used.
+func (s *MemoryStore) EnableNodeSoftDelete(retention time.Duration) error {
+	if retention <= 0 {
+		return fmt.Errorf("node retention must be positive, got %s", retention)
+	}
+	s.nodeRetention = retention
+	s.stopPurge = make(chan struct{})
+	go s.purgeDeletedNodesLoop()
+	return nil
+}
+
+// StopPurgingDeletedNodes stops the background purge of soft-deleted nodes
+// started by EnableNodeSoftDelete. Nodes deleted afterwards are still
+// soft-deleted, but their tombstones are kept until the store is closed. It
+// may be called more than once.
+func (s *MemoryStore) StopPurgingDeletedNodes() {
+	if s.stopPurge == nil {
+		return
+	}
+	s.stopPurgeOnce.Do(func() {
+		close(s.stopPurge)
+	})
+}
+
+// SuppressRestoreEvents makes Restore, RestoreTable, RestoreSerialized and
+// RestoreStream publish a single state.EventStoreRestored, followed by a
+// state.EventCommit, instead of an event for each object they delete and
+// create, so that watchers aren't flooded when a large snapshot is restored.
+// Watchers that filter events must ask for state.EventStoreRestored to
+// receive it.
+// This function must be called before the store is used.
+func (s *MemoryStore) SuppressRestoreEvents() {
+	s.suppressRestoreEvents = true
+}
+
+// BatchLimit limits the changes to a table that each transaction started by
+// Batch may hold. A transaction is committed, and a new one started, once the
+// changes to the table reach either limit. Zero means no limit.
+type BatchLimit struct {
+	// Changes is the number of changes to the table.
+	Changes int
+	// Bytes is the approximate serialized size of the changes to the table.
+	Bytes int
+}
+
+// SetBatchLimit sets the limit on the changes to table that each transaction
+// started by Batch may hold, so that tables with large objects, such as nodes
+// with large descriptions, are committed in smaller transactions than tables
+// with small ones. MaxChangesPerTransaction and MaxTransactionBytes still
+// apply to each transaction as a whole. A zero BatchLimit removes the table's
+// limit.
+// This function must be called before the store is used.
+func (s *MemoryStore) SetBatchLimit(table string, limit BatchLimit) error {
+	if lookupObjectStorer(table) == nil {
+		return fmt.Errorf("unknown table %q", table)
+	}
+	if limit.Changes < 0 || limit.Bytes < 0 {
+		return fmt.Errorf("batch limit for table %q must not be negative", table)
+	}
+	if limit == (BatchLimit{}) {
+		delete(s.batchLimits, table)
+		return nil
+	}
+	if s.batchLimits == nil {
+		s.batchLimits = make(map[string]BatchLimit)
+	}
+	s.batchLimits[table] = limit
+	return nil
+}
+
+func (s *MemoryStore) purgeDeletedNodesLoop() {
+	interval := s.nodeRetention
+	if interval > nodePurgeInterval {
+		interval = nodePurgeInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.purgeDeletedNodes(time.Now().Add(-s.nodeRetention))
+		case <-s.stopPurge:
+			return
+		}
+	}
+}
+
+// purgeDeletedNodes removes the soft-deleted nodes that were deleted before
+// the given time. The removal is proposed, so it fails on members that aren't
+// the leader; see EnableNodeSoftDelete.
+func (s *MemoryStore) purgeDeletedNodes(deletedBefore time.Time) error {
+	return s.Update(func(tx Tx) error {
+		nodes, err := FindNodes(IncludeDeleted(tx), All)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if n.DeletedAt == nil {
+				continue
+			}
+			deletedAt, err := gogotypes.TimestampFromProto(n.DeletedAt)
+			if err != nil || deletedAt.Before(deletedBefore) {
+				if err := tx.purge(tableNode, n.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
 // Close closes the memory store and frees its associated resources.
 func (s *MemoryStore) Close() error {
+	s.StopPurgingDeletedNodes()
+	if s.tableSizes != nil {
+		close(s.tableSizes.stop)
+	}
 	return s.queue.Close()
 }
 
@@ -177,13 +418,44 @@ type ReadTx interface {
 	lookup(table, index, id string) api.StoreObject
 	get(table, id string) api.StoreObject
 	find(table string, by By, checkType func(By) error, appendResult func(api.StoreObject)) error
+	walk(table string, by By, checkType func(By) error, cb func(api.StoreObject) error) error
+	count(table string, by By, checkType func(By) error) (int, error)
+	findIDs(table string, by By, checkType func(By) error) ([]string, error)
+	verifyIndexes(table string) ([]IndexDiscrepancy, error)
 }
 
 type readTx struct {
 	memDBTx *memdb.Txn
+	// includeDeleted makes soft-deleted objects visible to get and walk.
+	includeDeleted bool
+}
+
+// IncludeDeleted returns a read transaction with the same view of the store
+// as t, in which soft-deleted objects are not hidden.
+func IncludeDeleted(t ReadTx) ReadTx {
+	switch v := t.(type) {
+	case *tx:
+		return readTx{memDBTx: v.memDBTx, includeDeleted: true}
+	case readTx:
+		v.includeDeleted = true
+		return v
+	}
+	return t
+}
+
+// isTombstone returns true if o has been soft-deleted.
+func isTombstone(o api.StoreObject) bool {
+	n, ok := o.(*api.Node)
+	return ok && n.DeletedAt != nil
 }
 
-// View executes a read transaction.
+// View executes a read transaction. The transaction observes a consistent
+// point-in-time snapshot of the store: updates committed by other
+// transactions while cb is running, including in the middle of a Find, are
+// not visible to it. The snapshot spans all the tables, so reads of different
+// object types in one View, such as FindNodes and GetCluster, see either all
+// of the changes of another transaction or none of them, never some without
+// the others.
 func (s *MemoryStore) View(cb func(ReadTx)) {
 	memDBTx := s.memDB.Txn(false)
 
@@ -203,12 +475,23 @@ type Tx interface {
 	create(table string, o api.StoreObject) error
 	update(table string, o api.StoreObject) error
 	delete(table, id string) error
+	tombstone(table string, o api.StoreObject) error
+	purge(table, id string) error
+	softDeletesNodes() bool
 }
 
 type tx struct {
 	readTx
 	curVersion *api.Version
 	changelist []api.Event
+	// changeTables holds the table of each change in changelist.
+	changeTables []string
+	// purged holds the tombstones removed by purge. They are proposed to
+	// the other members with the changelist, but produce no events.
+	purged []api.StoreObject
+	// softDeleteNodes makes DeleteNode leave a tombstone instead of
+	// removing the node.
+	softDeleteNodes bool
 }
 
 // changelistBetweenVersions returns the changes after "from" up to and
@@ -247,6 +530,7 @@ func (s *MemoryStore) ApplyStoreActions(actions []api.StoreAction) error {
 		readTx: readTx{
 			memDBTx: memDBTx,
 		},
+		softDeleteNodes: s.nodeRetention != 0,
 	}
 
 	for _, sa := range actions {
@@ -258,6 +542,7 @@ func (s *MemoryStore) ApplyStoreActions(actions []api.StoreAction) error {
 	}
 
 	memDBTx.Commit()
+	s.applyToViews(tx.changelist)
 
 	for _, c := range tx.changelist {
 		s.queue.Publish(c)
@@ -280,7 +565,11 @@ func applyStoreAction(tx Tx, sa api.StoreAction) error {
 	return errors.New("unrecognized action type")
 }
 
-func (s *MemoryStore) update(proposer state.Proposer, cb func(Tx) error) error {
+// update runs cb in a read/write transaction. If restore is set, the
+// transaction replaces the contents of the store, and its events may be
+// summarized as a single state.EventStoreRestored. The transaction's
+// state.EventCommit carries correlationID.
+func (s *MemoryStore) update(proposer state.Proposer, restore bool, correlationID string, cb func(Tx) error) error {
 	s.updateLock.Lock()
 	memDBTx := s.memDB.Txn(true)
 
@@ -292,6 +581,7 @@ func (s *MemoryStore) update(proposer state.Proposer, cb func(Tx) error) error {
 
 	var tx tx
 	tx.init(memDBTx, curVersion)
+	tx.softDeleteNodes = s.nodeRetention != 0
 
 	err := cb(&tx)
 
@@ -315,15 +605,22 @@ func (s *MemoryStore) update(proposer state.Proposer, cb func(Tx) error) error {
 	}
 
 	if err == nil {
-		for _, c := range tx.changelist {
-			s.queue.Publish(c)
+		s.applyToViews(tx.changelist)
+		summarize := restore && s.suppressRestoreEvents
+		if !summarize {
+			for _, c := range tx.changelist {
+				s.queue.Publish(c)
+			}
 		}
 		if len(tx.changelist) != 0 {
 			if proposer != nil {
 				curVersion = proposer.GetVersion()
 			}
 
-			s.queue.Publish(state.EventCommit{Version: curVersion})
+			if summarize {
+				s.queue.Publish(state.EventStoreRestored{Version: curVersion})
+			}
+			s.queue.Publish(state.EventCommit{Version: curVersion, CorrelationID: correlationID})
 		}
 	} else {
 		memDBTx.Abort()
@@ -334,18 +631,54 @@ func (s *MemoryStore) update(proposer state.Proposer, cb func(Tx) error) error {
 }
 
 func (s *MemoryStore) updateLocal(cb func(Tx) error) error {
-	return s.update(nil, cb)
+	return s.update(nil, false, "", cb)
+}
+
+// restoreLocal is like updateLocal, for a transaction that replaces the
+// contents of the store.
+func (s *MemoryStore) restoreLocal(cb func(Tx) error) error {
+	return s.update(nil, true, "", cb)
 }
 
 // Update executes a read/write transaction.
 func (s *MemoryStore) Update(cb func(Tx) error) error {
-	return s.update(s.proposer, cb)
+	return s.update(s.proposer, false, "", cb)
+}
+
+// UpdateContext is like Update, but the state.EventCommit published for the
+// transaction carries the correlation ID from ctx, if it has one. Only the
+// commit is tagged, not the object events before it: watchers attribute the
+// changes to the request that made them through the commit that closes the
+// transaction.
+func (s *MemoryStore) UpdateContext(ctx context.Context, cb func(Tx) error) error {
+	return s.update(s.proposer, false, CorrelationID(ctx), cb)
+}
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of ctx carrying the correlation ID id, for
+// the commits of the transactions run with UpdateContext and BatchContext. The
+// ID is only seen by the watchers of this store: it isn't replicated to the
+// other managers, and the commits ApplyStoreActions publishes for replicated
+// transactions carry none.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the correlation ID carried by ctx, or an empty string
+// if it has none.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
 }
 
 // Batch provides a mechanism to batch updates to a store.
 type Batch struct {
 	tx    tx
 	store *MemoryStore
+	// correlationID is carried by the state.EventCommit of each
+	// transaction
+	correlationID string
 	// applied counts the times Update has run successfully
 	applied int
 	// committed is the number of times Update had run successfully as of
@@ -357,7 +690,11 @@ type Batch struct {
 	// changelistLen is the last known length of the transaction's
 	// changelist.
 	changelistLen int
-	err           error
+	// tableChanges and tableSizeEstimates count the changes in the current
+	// transaction to each table with a batch limit, and their size.
+	tableChanges       map[string]int
+	tableSizeEstimates map[string]int
+	err                error
 }
 
 // Update adds a single change to a batch. Each call to Update is atomic, but
@@ -379,11 +716,16 @@ func (batch *Batch) Update(cb func(Tx) error) error {
 		if err != nil {
 			return err
 		}
-		batch.transactionSizeEstimate += sa.Size()
+		size := sa.Size()
+		batch.transactionSizeEstimate += size
+		if table := batch.tx.changeTables[batch.changelistLen]; batch.store.batchLimits[table] != (BatchLimit{}) {
+			batch.tableChanges[table]++
+			batch.tableSizeEstimates[table] += size
+		}
 		batch.changelistLen++
 	}
 
-	if batch.changelistLen >= MaxChangesPerTransaction || batch.transactionSizeEstimate >= (MaxTransactionBytes*3)/4 {
+	if batch.full() {
 		if err := batch.commit(); err != nil {
 			return err
 		}
@@ -399,6 +741,23 @@ func (batch *Batch) Update(cb func(Tx) error) error {
 	return nil
 }
 
+// full returns true if the current transaction has reached the overall limits
+// or the limit of any table.
+func (batch *Batch) full() bool {
+	if batch.changelistLen >= MaxChangesPerTransaction || batch.transactionSizeEstimate >= (MaxTransactionBytes*3)/4 {
+		return true
+	}
+	for table, limit := range batch.store.batchLimits {
+		if limit.Changes != 0 && batch.tableChanges[table] >= limit.Changes {
+			return true
+		}
+		if limit.Bytes != 0 && batch.tableSizeEstimates[table] >= limit.Bytes {
+			return true
+		}
+	}
+	return false
+}
+
 func (batch *Batch) newTx() {
 	var curVersion *api.Version
 
@@ -407,8 +766,11 @@ func (batch *Batch) newTx() {
 	}
 
 	batch.tx.init(batch.store.memDB.Txn(true), curVersion)
+	batch.tx.softDeleteNodes = batch.store.nodeRetention != 0
 	batch.transactionSizeEstimate = 0
 	batch.changelistLen = 0
+	batch.tableChanges = make(map[string]int)
+	batch.tableSizeEstimates = make(map[string]int)
 }
 
 func (batch *Batch) commit() error {
@@ -435,12 +797,13 @@ func (batch *Batch) commit() error {
 	}
 
 	batch.committed = batch.applied
+	batch.store.applyToViews(batch.tx.changelist)
 
 	for _, c := range batch.tx.changelist {
 		batch.store.queue.Publish(c)
 	}
 	if len(batch.tx.changelist) != 0 {
-		batch.store.queue.Publish(state.EventCommit{})
+		batch.store.queue.Publish(state.EventCommit{CorrelationID: batch.correlationID})
 	}
 
 	return nil
@@ -464,10 +827,22 @@ func (batch *Batch) commit() error {
 // Batch returns the number of calls to batch.Update whose changes were
 // successfully committed to the store.
 func (s *MemoryStore) Batch(cb func(*Batch) error) (int, error) {
+	return s.batch("", cb)
+}
+
+// BatchContext is like Batch, but the state.EventCommit published for each of
+// the batch's transactions carries the correlation ID from ctx, if it has one.
+// As with UpdateContext, the object events aren't tagged.
+func (s *MemoryStore) BatchContext(ctx context.Context, cb func(*Batch) error) (int, error) {
+	return s.batch(CorrelationID(ctx), cb)
+}
+
+func (s *MemoryStore) batch(correlationID string, cb func(*Batch) error) (int, error) {
 	s.updateLock.Lock()
 
 	batch := Batch{
-		store: s,
+		store:         s,
+		correlationID: correlationID,
 	}
 	batch.newTx()
 
@@ -486,6 +861,8 @@ func (tx *tx) init(memDBTx *memdb.Txn, curVersion *api.Version) {
 	tx.memDBTx = memDBTx
 	tx.curVersion = curVersion
 	tx.changelist = nil
+	tx.changeTables = nil
+	tx.purged = nil
 }
 
 func (tx tx) changelistStoreActions() ([]api.StoreAction, error) {
@@ -498,6 +875,13 @@ func (tx tx) changelistStoreActions() ([]api.StoreAction, error) {
 		}
 		actions = append(actions, sa)
 	}
+	for _, o := range tx.purged {
+		sa, err := api.NewStoreAction(o.EventDelete())
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, sa)
+	}
 
 	return actions, nil
 }
@@ -514,11 +898,22 @@ func (tx readTx) lookup(table, index, id string) api.StoreObject {
 	return nil
 }
 
-// create adds a new object to the store.
+func (tx *tx) recordChange(table string, event api.Event) {
+	tx.changelist = append(tx.changelist, event)
+	tx.changeTables = append(tx.changeTables, table)
+}
+
+// create adds a new object to the store. A live object replaces a tombstone
+// with the same ID, like an object that was never there.
 // Returns ErrExist if the ID is already taken.
 func (tx *tx) create(table string, o api.StoreObject) error {
-	if tx.lookup(table, indexID, o.GetID()) != nil {
-		return ErrExist
+	if existing := tx.lookup(table, indexID, o.GetID()); existing != nil {
+		if !isTombstone(existing) || isTombstone(o) {
+			return ErrExist
+		}
+		if err := tx.memDBTx.Delete(table, existing); err != nil {
+			return err
+		}
 	}
 
 	copy := o.CopyStoreObject()
@@ -530,7 +925,11 @@ func (tx *tx) create(table string, o api.StoreObject) error {
 
 	err := tx.memDBTx.Insert(table, copy)
 	if err == nil {
-		tx.changelist = append(tx.changelist, copy.EventCreate())
+		// Tombstones are only created when a snapshot is restored, and are
+		// invisible to watchers like they are to readers.
+		if !isTombstone(copy) {
+			tx.recordChange(table, copy.EventCreate())
+		}
 		o.SetMeta(meta)
 	}
 	return err
@@ -540,7 +939,7 @@ func (tx *tx) create(table string, o api.StoreObject) error {
 // Returns ErrNotExist if the object doesn't exist.
 func (tx *tx) update(table string, o api.StoreObject) error {
 	oldN := tx.lookup(table, indexID, o.GetID())
-	if oldN == nil {
+	if oldN == nil || isTombstone(oldN) {
 		return ErrNotExist
 	}
 
@@ -560,13 +959,14 @@ func (tx *tx) update(table string, o api.StoreObject) error {
 
 	err := tx.memDBTx.Insert(table, copy)
 	if err == nil {
-		tx.changelist = append(tx.changelist, copy.EventUpdate(oldN))
+		tx.recordChange(table, copy.EventUpdate(oldN))
 		o.SetMeta(meta)
 	}
 	return err
 }
 
-// Delete removes an object from the store.
+// Delete removes an object from the store. Deleting a soft-deleted object
+// purges it, without producing another delete event.
 // Returns ErrNotExist if the object doesn't exist.
 func (tx *tx) delete(table, id string) error {
 	n := tx.lookup(table, indexID, id)
@@ -575,17 +975,61 @@ func (tx *tx) delete(table, id string) error {
 	}
 
 	err := tx.memDBTx.Delete(table, n)
+	if err == nil && !isTombstone(n) {
+		tx.recordChange(table, n.EventDelete())
+	}
+	return err
+}
+
+// tombstone replaces an existing object with o, which has been marked as
+// soft-deleted, and records a delete event for it.
+// Returns ErrNotExist if the object doesn't exist or is already deleted.
+func (tx *tx) tombstone(table string, o api.StoreObject) error {
+	oldN := tx.lookup(table, indexID, o.GetID())
+	if oldN == nil || isTombstone(oldN) {
+		return ErrNotExist
+	}
+
+	copy := o.CopyStoreObject()
+	meta := copy.GetMeta()
+	if err := touchMeta(&meta, tx.curVersion); err != nil {
+		return err
+	}
+	copy.SetMeta(meta)
+
+	err := tx.memDBTx.Insert(table, copy)
 	if err == nil {
-		tx.changelist = append(tx.changelist, n.EventDelete())
+		tx.recordChange(table, copy.EventDelete())
+		o.SetMeta(meta)
 	}
 	return err
 }
 
+// purge removes the tombstone with the given ID. Unlike delete, it records no
+// event, since watchers already saw the object deleted, but the removal is
+// proposed to the other members.
+// Returns ErrNotExist if there is no tombstone with this ID.
+func (tx *tx) purge(table, id string) error {
+	o := tx.lookup(table, indexID, id)
+	if o == nil || !isTombstone(o) {
+		return ErrNotExist
+	}
+	if err := tx.memDBTx.Delete(table, o); err != nil {
+		return err
+	}
+	tx.purged = append(tx.purged, o)
+	return nil
+}
+
+func (tx *tx) softDeletesNodes() bool {
+	return tx.softDeleteNodes
+}
+
 // Get looks up an object by ID.
 // Returns nil if the object doesn't exist.
 func (tx readTx) get(table, id string) api.StoreObject {
 	o := tx.lookup(table, indexID, id)
-	if o == nil {
+	if o == nil || (isTombstone(o) && !tx.includeDeleted) {
 		return nil
 	}
 	return o.CopyStoreObject()
@@ -595,7 +1039,7 @@ func (tx readTx) get(table, id string) api.StoreObject {
 // iterators provides the result of the query.
 func (tx readTx) findIterators(table string, by By, checkType func(By) error) ([]memdb.ResultIterator, error) {
 	switch by.(type) {
-	case byAll, orCombinator: // generic types
+	case byAll, orCombinator, byModifiedSince: // generic types
 	default: // all other types
 		if err := checkType(by); err != nil {
 			return nil, err
@@ -685,6 +1129,30 @@ func (tx readTx) findIterators(table string, by By, checkType func(By) error) ([
 			return nil, err
 		}
 		return []memdb.ResultIterator{it}, nil
+	case byCertIssuer:
+		it, err := tx.memDBTx.Get(table, indexCertIssuer, hex.EncodeToString([]byte(v)))
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{it}, nil
+	case byModifiedSince:
+		it, err := tx.memDBTx.Get(table, indexVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{&modifiedSinceIterator{it: it, since: uint64(v)}}, nil
+	case byCertExpiringBefore:
+		it, err := tx.memDBTx.Get(table, indexCertExpiry)
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{&certExpiringBeforeIterator{it: it, before: time.Time(v)}}, nil
+	case byCertSerial:
+		it, err := tx.memDBTx.Get(table, indexCertSerial, string(v))
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{it}, nil
 	case byReferencedNetworkID:
 		it, err := tx.memDBTx.Get(table, indexNetwork, string(v))
 		if err != nil {
@@ -733,39 +1201,118 @@ func (tx readTx) findIterators(table string, by By, checkType func(By) error) ([
 			return nil, err
 		}
 		return []memdb.ResultIterator{it}, nil
+	case byRegistered:
+		index, ok := registeredSelectors[table][v.kind]
+		if !ok {
+			return nil, ErrInvalidFindBy
+		}
+		it, err := tx.memDBTx.Get(table, index, v.args...)
+		if err != nil {
+			return nil, err
+		}
+		return []memdb.ResultIterator{it}, nil
 	default:
 		return nil, ErrInvalidFindBy
 	}
 }
 
 // find selects a set of objects calls a callback for each matching object.
+// The iterators read from the transaction's memdb snapshot, so appendResult
+// sees the same set of objects regardless of updates committed concurrently.
 func (tx readTx) find(table string, by By, checkType func(By) error, appendResult func(api.StoreObject)) error {
-	fromResultIterators := func(its ...memdb.ResultIterator) {
-		ids := make(map[string]struct{})
-		for _, it := range its {
-			for {
-				obj := it.Next()
-				if obj == nil {
-					break
-				}
-				o := obj.(api.StoreObject)
-				id := o.GetID()
-				if _, exists := ids[id]; !exists {
-					appendResult(o.CopyStoreObject())
-					ids[id] = struct{}{}
+	return tx.walk(table, by, checkType, func(o api.StoreObject) error {
+		appendResult(o)
+		return nil
+	})
+}
+
+// walk selects a set of objects and calls a callback for each matching
+// object, without collecting them. If the callback returns an error, the walk
+// stops and the error is returned.
+func (tx readTx) walk(table string, by By, checkType func(By) error, cb func(api.StoreObject) error) error {
+	iters, err := tx.findIterators(table, by, checkType)
+	if err != nil {
+		return err
+	}
+
+	ids := make(map[string]struct{})
+	for _, it := range iters {
+		for {
+			obj := it.Next()
+			if obj == nil {
+				break
+			}
+			o := obj.(api.StoreObject)
+			if isTombstone(o) && !tx.includeDeleted {
+				continue
+			}
+			id := o.GetID()
+			if _, exists := ids[id]; !exists {
+				if err := cb(o.CopyStoreObject()); err != nil {
+					return err
 				}
+				ids[id] = struct{}{}
 			}
 		}
 	}
 
+	return nil
+}
+
+// count returns the number of objects matching by, without copying them.
+func (tx readTx) count(table string, by By, checkType func(By) error) (int, error) {
 	iters, err := tx.findIterators(table, by, checkType)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	ids := make(map[string]struct{})
+	for _, it := range iters {
+		for {
+			obj := it.Next()
+			if obj == nil {
+				break
+			}
+			o := obj.(api.StoreObject)
+			if isTombstone(o) && !tx.includeDeleted {
+				continue
+			}
+			ids[o.GetID()] = struct{}{}
+		}
 	}
 
-	fromResultIterators(iters...)
+	return len(ids), nil
+}
 
-	return nil
+// findIDs returns the IDs of the objects matching by, in the order find would
+// return the objects, without copying them.
+func (tx readTx) findIDs(table string, by By, checkType func(By) error) ([]string, error) {
+	iters, err := tx.findIterators(table, by, checkType)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	seen := make(map[string]struct{})
+	for _, it := range iters {
+		for {
+			obj := it.Next()
+			if obj == nil {
+				break
+			}
+			o := obj.(api.StoreObject)
+			if isTombstone(o) && !tx.includeDeleted {
+				continue
+			}
+			id := o.GetID()
+			if _, exists := seen[id]; !exists {
+				ids = append(ids, id)
+				seen[id] = struct{}{}
+			}
+		}
+	}
+
+	return ids, nil
 }
 
 // Save serializes the data in the store.
@@ -783,7 +1330,7 @@ func (s *MemoryStore) Save(tx ReadTx) (*pb.StoreSnapshot, error) {
 // Restore sets the contents of the store to the serialized data in the
 // argument.
 func (s *MemoryStore) Restore(snapshot *pb.StoreSnapshot) error {
-	return s.updateLocal(func(tx Tx) error {
+	return s.restoreLocal(func(tx Tx) error {
 		for _, os := range objectStorers {
 			if err := os.Restore(tx, snapshot); err != nil {
 				return err
@@ -793,6 +1340,283 @@ func (s *MemoryStore) Restore(snapshot *pb.StoreSnapshot) error {
 	})
 }
 
+// FindModifiedSince returns the objects in table that were created or last
+// updated at a version index greater than index, in the order of the version
+// index they were last modified at. It walks the whole table, skipping the
+// older objects without copying them. It returns an UnknownTableError if the
+// table is not registered.
+func FindModifiedSince(tx ReadTx, table string, index uint64) ([]api.StoreObject, error) {
+	if lookupObjectStorer(table) == nil {
+		return nil, UnknownTableError{Table: table}
+	}
+	var objects []api.StoreObject
+	err := tx.find(table, ByModifiedSince(index), selectorChecker(table), func(o api.StoreObject) {
+		objects = append(objects, o)
+	})
+	return objects, err
+}
+
+// versionIndexer indexes objects by the version index they were last
+// modified at. The keys are big-endian, so they sort in version order.
+type versionIndexer struct{}
+
+func (vi versionIndexer) FromArgs(args ...interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("must provide only a single argument")
+	}
+	index, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("argument must be a uint64: %#v", args[0])
+	}
+	return versionKey(index), nil
+}
+
+func (vi versionIndexer) FromObject(obj interface{}) (bool, []byte, error) {
+	return true, versionKey(obj.(api.StoreObject).GetMeta().Version.Index), nil
+}
+
+func versionKey(index uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, index)
+	return key
+}
+
+// modifiedSinceIterator walks the version index, which is ordered by version,
+// and skips the objects that were last modified at or before since. The
+// skipped objects are not copied, but they are still visited: the vendored
+// go-memdb can't seek an index to a lower bound, so every lookup walks the
+// index from the start, and costs time linear in the size of the table.
+type modifiedSinceIterator struct {
+	it    memdb.ResultIterator
+	since uint64
+}
+
+func (i *modifiedSinceIterator) Next() interface{} {
+	for {
+		obj := i.it.Next()
+		if obj == nil || obj.(api.StoreObject).GetMeta().Version.Index > i.since {
+			return obj
+		}
+	}
+}
+
+// UnknownTableError is returned by SaveTable and RestoreTable when the named
+// table is not registered with the store.
+type UnknownTableError struct {
+	Table string
+}
+
+func (e UnknownTableError) Error() string {
+	return fmt.Sprintf("unknown table %q", e.Table)
+}
+
+// SaveTable serializes the data in a single table of the store. The result is
+// a StoreSnapshot in which only that table's objects are set.
+func (s *MemoryStore) SaveTable(tx ReadTx, table string) (proto.Message, error) {
+	os := lookupObjectStorer(table)
+	if os == nil {
+		return nil, UnknownTableError{Table: table}
+	}
+
+	var snapshot pb.StoreSnapshot
+	if err := os.Save(tx, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// RestoreTable sets the contents of a single table of the store to the data
+// produced by SaveTable. The other tables are left unchanged.
+func (s *MemoryStore) RestoreTable(table string, snapshot proto.Message) error {
+	os := lookupObjectStorer(table)
+	if os == nil {
+		return UnknownTableError{Table: table}
+	}
+	storeSnapshot, ok := snapshot.(*pb.StoreSnapshot)
+	if !ok {
+		return fmt.Errorf("table snapshot must be a StoreSnapshot, not %T", snapshot)
+	}
+
+	return s.restoreLocal(func(tx Tx) error {
+		return os.Restore(tx, storeSnapshot)
+	})
+}
+
+// SaveSerialized serializes the data in the store into a marshalled
+// StoreSnapshot, ending with a checksum of the rest of it. If the store was
+// created with a snapshot key, the result is encrypted.
+func (s *MemoryStore) SaveSerialized(tx ReadTx) ([]byte, error) {
+	snapshot, err := s.Save(tx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	checksum := sha256.Sum256(data)
+	trailer, err := proto.Marshal(&pb.StoreSnapshot{Checksum: checksum[:]})
+	if err != nil {
+		return nil, err
+	}
+	data = append(data, trailer...)
+	if s.snapshotEncrypter == nil {
+		return data, nil
+	}
+	return encryption.Encrypt(data, s.snapshotEncrypter)
+}
+
+// RestoreSerialized sets the contents of the store to the data produced by
+// SaveSerialized. Plaintext snapshots are always accepted, so that existing
+// snapshots can be migrated to a store with a snapshot key. It returns
+// ErrSnapshotCorrupt if the snapshot doesn't match its checksum. Snapshots
+// without a checksum are restored without being checked.
+func (s *MemoryStore) RestoreSerialized(data []byte) error {
+	// A marshalled StoreSnapshot never sets field 1 as a varint, so it can't
+	// be mistaken for an encrypted record.
+	var record pb.MaybeEncryptedRecord
+	if err := proto.Unmarshal(data, &record); err == nil && record.Algorithm != pb.MaybeEncryptedRecord_NotEncrypted {
+		var err error
+		data, err = encryption.Decrypt(data, s.snapshotDecrypter)
+		if err != nil {
+			return err
+		}
+	}
+
+	var snapshot pb.StoreSnapshot
+	if err := proto.Unmarshal(data, &snapshot); err != nil {
+		// The corruption may have made the snapshot unreadable
+		if _, hasChecksum := splitSnapshotChecksum(data); hasChecksum {
+			return ErrSnapshotCorrupt
+		}
+		return errors.New("unable to unmarshal store snapshot")
+	}
+	if len(snapshot.Checksum) != 0 {
+		content, _ := splitSnapshotChecksum(data)
+		checksum := sha256.Sum256(content)
+		if !bytes.Equal(checksum[:], snapshot.Checksum) {
+			return ErrSnapshotCorrupt
+		}
+	}
+	return s.Restore(&snapshot)
+}
+
+// snapshotChecksumLen is the length of a marshalled StoreSnapshot holding
+// only a SHA-256 checksum: a one byte tag, a one byte length and the digest.
+const snapshotChecksumLen = 2 + sha256.Size
+
+// splitSnapshotChecksum returns the part of a serialized snapshot covered by
+// its checksum, and whether the snapshot appears to end with a checksum.
+func splitSnapshotChecksum(data []byte) ([]byte, bool) {
+	if len(data) < snapshotChecksumLen {
+		return data, false
+	}
+	content, trailer := data[:len(data)-snapshotChecksumLen], data[len(data)-snapshotChecksumLen:]
+	var checksum pb.StoreSnapshot
+	if err := proto.Unmarshal(trailer, &checksum); err != nil || len(checksum.Checksum) != sha256.Size {
+		return data, false
+	}
+	return content, true
+}
+
+// SaveStream writes the data in the store to w, one table at a time, without
+// building a StoreSnapshot in memory. Each object is written as a create
+// StoreAction, prefixed with its length as a uvarint. The result can be
+// read back with RestoreStream.
+func (s *MemoryStore) SaveStream(tx ReadTx, w io.Writer) error {
+	var (
+		lenBuf [binary.MaxVarintLen64]byte
+		err    error
+	)
+	writeObject := func(o api.StoreObject) {
+		if err != nil {
+			return
+		}
+		var sa api.StoreAction
+		sa, err = api.NewStoreAction(o.EventCreate())
+		if err != nil {
+			return
+		}
+		var data []byte
+		data, err = proto.Marshal(&sa)
+		if err != nil {
+			return
+		}
+		n := binary.PutUvarint(lenBuf[:], uint64(len(data)))
+		if _, err = w.Write(lenBuf[:n]); err != nil {
+			return
+		}
+		_, err = w.Write(data)
+	}
+
+	for _, os := range objectStorers {
+		if findErr := IncludeDeleted(tx).find(os.Table.Name, All, nil, writeObject); findErr != nil {
+			return findErr
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreStream sets the contents of the store to the data written by
+// SaveStream, reading objects from r as they are needed. An object whose
+// length prefix exceeds MaxTransactionBytes is rejected before it is read.
+func (s *MemoryStore) RestoreStream(r io.Reader) error {
+	br := bufio.NewReader(r)
+	return s.restoreLocal(func(tx Tx) error {
+		for _, os := range objectStorers {
+			// tombstones are cleared too, since the stream carries them
+			var ids []string
+			if err := IncludeDeleted(tx).find(os.Table.Name, All, nil, func(o api.StoreObject) {
+				ids = append(ids, o.GetID())
+			}); err != nil {
+				return err
+			}
+			for _, id := range ids {
+				if err := tx.delete(os.Table.Name, id); err != nil {
+					return err
+				}
+			}
+		}
+
+		var data []byte
+		for {
+			size, err := binary.ReadUvarint(br)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			// Every object fits in a transaction, so a larger size means
+			// the stream is corrupt
+			if size > MaxTransactionBytes {
+				return fmt.Errorf("streamed store object of %d bytes exceeds the maximum of %d bytes", size, uint64(MaxTransactionBytes))
+			}
+			if uint64(cap(data)) < size {
+				data = make([]byte, size)
+			}
+			data = data[:size]
+			if _, err := io.ReadFull(br, data); err != nil {
+				return err
+			}
+
+			var sa api.StoreAction
+			if err := proto.Unmarshal(data, &sa); err != nil {
+				return errors.New("unable to unmarshal streamed store object")
+			}
+			if sa.Action != api.StoreActionKindCreate {
+				return errors.New("unexpected action in streamed store snapshot")
+			}
+			if err := applyStoreAction(tx, sa); err != nil {
+				return err
+			}
+		}
+	})
+}
+
 // WatchQueue returns the publish/subscribe queue.
 func (s *MemoryStore) WatchQueue() *watch.Queue {
 	return s.queue
@@ -840,9 +1664,25 @@ func WatchFrom(store *MemoryStore, version *api.Version, specifiers ...api.Event
 		ch, cancel := state.Watch(store.WatchQueue(), specifiers...)
 		return ch, cancel, nil
 	}
+	ch, cancel, _, err := ResumableWatch(store, version, specifiers...)
+	return ch, cancel, err
+}
 
+// ResumableWatch is like WatchFrom, but also returns the version of the store
+// the watch starts from: the channel carries the events committed after it,
+// preceded, if "version" is not nil, by the events between "version" and it.
+// A client that keeps the version of the last state.EventCommit it received,
+// or the returned version if it hasn't received any, can pass it as "version"
+// to a new ResumableWatch after a disconnect, to receive every subsequent
+// event exactly once. If the store does not support versioning, the returned
+// version is nil, and "version" must be nil too.
+func ResumableWatch(store *MemoryStore, version *api.Version, specifiers ...api.Event) (chan events.Event, func(), *api.Version, error) {
 	if store.proposer == nil {
-		return nil, nil, errors.New("store does not support versioning")
+		if version != nil {
+			return nil, nil, nil, errors.New("store does not support versioning")
+		}
+		ch, cancel := state.Watch(store.WatchQueue(), specifiers...)
+		return ch, cancel, nil, nil
 	}
 
 	var (
@@ -861,18 +1701,22 @@ func WatchFrom(store *MemoryStore, version *api.Version, specifiers ...api.Event
 	})
 	if watch != nil && err != nil {
 		cancelWatch()
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if curVersion == nil {
 		cancelWatch()
-		return nil, nil, errors.New("could not get current version from store")
+		return nil, nil, nil, errors.New("could not get current version from store")
 	}
 
-	changelist, err := store.changelistBetweenVersions(*version, *curVersion)
-	if err != nil {
-		cancelWatch()
-		return nil, nil, err
+	// There is nothing to replay when resuming from the current version.
+	var changelist []api.Event
+	if version != nil && version.Index != curVersion.Index {
+		changelist, err = store.changelistBetweenVersions(*version, *curVersion)
+		if err != nil {
+			cancelWatch()
+			return nil, nil, nil, err
+		}
 	}
 
 	ch := make(chan events.Event)
@@ -900,12 +1744,123 @@ func WatchFrom(store *MemoryStore, version *api.Version, specifiers ...api.Event
 			case <-stop:
 				return
 			case e := <-watch:
-				ch <- e
+				select {
+				case ch <- e:
+				case <-stop:
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, cancel, curVersion, nil
+}
+
+// WatchBatched is like state.Watch, but delivers the matching events in
+// batches rather than one at a time, for consumers such as metrics aggregators
+// that don't need to react to each event straight away. A batch is delivered
+// once "window" has passed since its first event, and holds the events
+// published until then, in order. Events published while the consumer isn't
+// receiving are added to the pending batch. The events of a batch not yet
+// delivered when the watch is cancelled are dropped, and the channel is
+// closed. If the underlying watch ends first, the pending batch is delivered
+// straight away before the channel is closed.
+func WatchBatched(store *MemoryStore, window time.Duration, specifiers ...api.Event) (chan []events.Event, func()) {
+	watch, cancelWatch := state.Watch(store.WatchQueue(), specifiers...)
+
+	ch := make(chan []events.Event)
+	stop := make(chan struct{})
+	cancel := func() {
+		close(stop)
+	}
+
+	go func() {
+		defer close(ch)
+		defer cancelWatch()
+
+		var (
+			batch []events.Event
+			// windowEnd fires when the pending batch is due, and out is
+			// set to ch once it is
+			windowEnd <-chan time.Time
+			out       chan []events.Event
+		)
+		for {
+			select {
+			case <-stop:
+				return
+			case e, ok := <-watch:
+				if !ok {
+					if len(batch) != 0 {
+						select {
+						case ch <- batch:
+						case <-stop:
+						}
+					}
+					return
+				}
+				if batch == nil {
+					windowEnd = time.After(window)
+				}
+				batch = append(batch, e)
+			case <-windowEnd:
+				windowEnd = nil
+				out = ch
+			case out <- batch:
+				batch = nil
+				out = nil
+			}
+		}
+	}()
+
+	return ch, cancel
+}
+
+// objectEvents matches the create, update and delete events of every object
+// type, but not commit events.
+type objectEvents struct{}
+
+func (objectEvents) Matches(e events.Event) bool {
+	if _, ok := e.(state.EventCommit); ok {
+		return false
+	}
+	_, ok := e.(api.Event)
+	return ok
+}
+
+// StreamAllEvents returns a channel carrying the create, update and delete
+// events for objects in every table, in the order they were committed. If
+// "version" is not nil, past events after "version" are replayed first, as
+// with WatchFrom. The channel is closed once ctx is cancelled.
+func StreamAllEvents(ctx context.Context, store *MemoryStore, version *api.Version) (<-chan api.Event, error) {
+	watch, cancelWatch, err := WatchFrom(store, version, objectEvents{})
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan api.Event)
+	go func() {
+		defer close(ch)
+		defer cancelWatch()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-watch:
+				if !ok {
+					return
+				}
+				select {
+				case ch <- e.(api.Event):
+				case <-ctx.Done():
+					return
+				}
 			}
 		}
 	}()
 
-	return ch, cancel, nil
+	return ch, nil
 }
 
 // touchMeta updates an object's timestamps when necessary and bumps the version
@@ -1,18 +1,25 @@
 package store
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/docker/swarmkit/api"
 	"github.com/docker/swarmkit/identity"
+	"github.com/docker/swarmkit/manager/encryption"
 	"github.com/docker/swarmkit/manager/state"
 	"github.com/docker/swarmkit/manager/state/testutils"
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
 )
 
 var (
@@ -266,770 +273,2176 @@ func TestStoreNode(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestStoreService(t *testing.T) {
-	s := NewMemoryStore(nil)
+func TestUpdateNodeFields(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
 	assert.NotNil(t, s)
 
-	s.View(func(readTx ReadTx) {
-		allServices, err := FindServices(readTx, All)
-		assert.NoError(t, err)
-		assert.Empty(t, allServices)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+
+	// Both writers start from the same version of the node
+	var stale1, stale2 *api.Node
+	s.View(func(tx ReadTx) {
+		stale1 = GetNode(tx, "id1")
+		stale2 = GetNode(tx, "id1")
 	})
 
-	setupTestStore(t, s)
+	stale1.Spec.Availability = api.NodeAvailabilityDrain
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return UpdateNodeFields(tx, "id1", &gogotypes.FieldMask{Paths: []string{"spec"}}, stale1)
+	}))
 
-	err := s.Update(func(tx Tx) error {
-		assert.Equal(t,
-			CreateService(tx, &api.Service{
-				ID: "id1",
-				Spec: api.ServiceSpec{
-					Annotations: api.Annotations{
-						Name: "name4",
-					},
-				},
-			}), ErrExist, "duplicate IDs must be rejected")
+	// A whole-object update from the stale copy conflicts
+	stale2.Certificate.Status.State = api.IssuanceStateRotate
+	require.Equal(t, ErrSequenceConflict, s.Update(func(tx Tx) error {
+		return UpdateNode(tx, stale2)
+	}))
 
-		assert.Equal(t,
-			CreateService(tx, &api.Service{
-				ID: "id4",
-				Spec: api.ServiceSpec{
-					Annotations: api.Annotations{
-						Name: "name1",
-					},
-				},
-			}), ErrNameConflict, "duplicate names must be rejected")
+	// but a masked update of a disjoint field doesn't, and keeps the first writer's change
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return UpdateNodeFields(tx, "id1", &gogotypes.FieldMask{Paths: []string{"certificate.status"}}, stale2)
+	}))
 
-		assert.Equal(t,
-			CreateService(tx, &api.Service{
-				ID: "id4",
-				Spec: api.ServiceSpec{
-					Annotations: api.Annotations{
-						Name: "NAME1",
-					},
-				},
-			}), ErrNameConflict, "duplicate check should be case insensitive")
-		return nil
+	s.View(func(tx ReadTx) {
+		node := GetNode(tx, "id1")
+		assert.Equal(t, api.NodeAvailabilityDrain, node.Spec.Availability)
+		assert.Equal(t, api.IssuanceStateRotate, node.Certificate.Status.State)
 	})
-	assert.NoError(t, err)
-
-	s.View(func(readTx ReadTx) {
-		assert.Equal(t, serviceSet[0], GetService(readTx, "id1"))
-		assert.Equal(t, serviceSet[1], GetService(readTx, "id2"))
-		assert.Equal(t, serviceSet[2], GetService(readTx, "id3"))
-
-		foundServices, err := FindServices(readTx, ByNamePrefix("name1"))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 1)
-		foundServices, err = FindServices(readTx, ByNamePrefix("NAME1"))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 1)
-		foundServices, err = FindServices(readTx, ByNamePrefix("invalid"))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 0)
-		foundServices, err = FindServices(readTx, Or(ByNamePrefix("name1"), ByNamePrefix("name2")))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 2)
-		foundServices, err = FindServices(readTx, Or(ByNamePrefix("name1"), ByNamePrefix("name2"), ByNamePrefix("name4")))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 2)
 
-		foundServices, err = FindServices(readTx, ByIDPrefix("id"))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 3)
+	// A description without TLS info keeps the node's TLS info
+	tlsInfo := &api.NodeTLSInfo{
+		TrustRoot:           []byte("root"),
+		CertIssuerSubject:   []byte("subject"),
+		CertIssuerPublicKey: []byte("key"),
+	}
+	for _, desc := range []*api.NodeDescription{
+		{Hostname: "old", TLSInfo: tlsInfo},
+		{Hostname: "new"},
+	} {
+		require.NoError(t, s.Update(func(tx Tx) error {
+			return UpdateNodeFields(tx, "id1", &gogotypes.FieldMask{Paths: []string{"description"}}, &api.Node{Description: desc})
+		}))
+	}
+	s.View(func(tx ReadTx) {
+		node := GetNode(tx, "id1")
+		assert.Equal(t, "new", node.Description.Hostname)
+		assert.Equal(t, tlsInfo, node.Description.TLSInfo)
+		assert.Equal(t, api.IssuanceStateRotate, node.Certificate.Status.State)
 	})
 
-	// Update.
-	err = s.Update(func(tx Tx) error {
-		// Regular update.
-		update := serviceSet[0].Copy()
-		update.Spec.Annotations.Labels = map[string]string{
-			"foo": "bar",
-		}
-
-		assert.NotEqual(t, update, GetService(tx, update.ID))
-		assert.NoError(t, UpdateService(tx, update))
-		assert.Equal(t, update, GetService(tx, update.ID))
-
-		// Name conflict.
-		update = GetService(tx, update.ID)
-		update.Spec.Annotations.Name = "name2"
-		assert.Equal(t, UpdateService(tx, update), ErrNameConflict, "duplicate names should be rejected")
-		update = GetService(tx, update.ID)
-		update.Spec.Annotations.Name = "NAME2"
-		assert.Equal(t, UpdateService(tx, update), ErrNameConflict, "duplicate check should be case insensitive")
-
-		// Name change.
-		update = GetService(tx, update.ID)
-		foundServices, err := FindServices(tx, ByNamePrefix("name1"))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 1)
-		foundServices, err = FindServices(tx, ByNamePrefix("name4"))
-		assert.NoError(t, err)
-		assert.Empty(t, foundServices)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.Error(t, UpdateNodeFields(tx, "id1", &gogotypes.FieldMask{Paths: []string{"meta"}}, stale1))
+		assert.Equal(t, ErrNotExist, UpdateNodeFields(tx, "id2", &gogotypes.FieldMask{Paths: []string{"spec"}}, stale1))
+		return nil
+	}))
+}
 
-		update.Spec.Annotations.Name = "name4"
-		assert.NoError(t, UpdateService(tx, update))
-		foundServices, err = FindServices(tx, ByNamePrefix("name1"))
-		assert.NoError(t, err)
-		assert.Empty(t, foundServices)
-		foundServices, err = FindServices(tx, ByNamePrefix("name4"))
-		assert.NoError(t, err)
-		assert.Len(t, foundServices, 1)
+func TestCreateOrGetNode(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
 
-		// Invalid update.
-		invalidUpdate := serviceSet[0].Copy()
-		invalidUpdate.ID = "invalid"
-		assert.Error(t, UpdateService(tx, invalidUpdate), "invalid IDs should be rejected")
+	first := &api.Node{
+		ID: "id1",
+		Spec: api.NodeSpec{
+			Annotations: api.Annotations{Name: "first"},
+		},
+	}
+	second := &api.Node{
+		ID: "id1",
+		Spec: api.NodeSpec{
+			Annotations: api.Annotations{Name: "second"},
+		},
+	}
 
+	require.NoError(t, s.Update(func(tx Tx) error {
+		node, err := CreateOrGetNode(tx, first)
+		require.NoError(t, err)
+		assert.True(t, node == first)
 		return nil
-	})
-	assert.NoError(t, err)
-
-	// Delete
-	err = s.Update(func(tx Tx) error {
-		assert.NotNil(t, GetService(tx, "id1"))
-		assert.NoError(t, DeleteService(tx, "id1"))
-		assert.Nil(t, GetService(tx, "id1"))
-		foundServices, err := FindServices(tx, ByNamePrefix("name1"))
-		assert.NoError(t, err)
-		assert.Empty(t, foundServices)
+	}))
 
-		assert.Equal(t, DeleteService(tx, "nonexistent"), ErrNotExist)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		node, err := CreateOrGetNode(tx, second)
+		require.NoError(t, err)
+		assert.Equal(t, first, node)
 		return nil
+	}))
+
+	s.View(func(tx ReadTx) {
+		nodes, err := FindNodes(tx, All)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.Equal(t, "first", nodes[0].Spec.Annotations.Name)
 	})
-	assert.NoError(t, err)
 }
 
-func TestStoreNetwork(t *testing.T) {
-	s := NewMemoryStore(nil)
+func TestUpdateNodesCAS(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
 	assert.NotNil(t, s)
 
-	s.View(func(readTx ReadTx) {
-		allNetworks, err := FindNetworks(readTx, All)
-		assert.NoError(t, err)
-		assert.Empty(t, allNetworks)
+	const numNodes = 6
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for i := 0; i < numNodes; i++ {
+			if err := CreateNode(tx, &api.Node{ID: "id" + strconv.Itoa(i)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	var nodes []*api.Node
+	s.View(func(tx ReadTx) {
+		var err error
+		nodes, err = FindNodes(tx, All)
+		require.NoError(t, err)
 	})
+	require.Len(t, nodes, numNodes)
+
+	// another writer changes every other node
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for i := 0; i < numNodes; i += 2 {
+			n := GetNode(tx, nodes[i].ID)
+			n.Spec.Availability = api.NodeAvailabilityPause
+			if err := UpdateNode(tx, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
 
-	setupTestStore(t, s)
+	var updates []NodeWithVersion
+	for _, n := range nodes {
+		update := n.Copy()
+		update.Spec.Availability = api.NodeAvailabilityDrain
+		updates = append(updates, NodeWithVersion{Node: update, Version: n.Meta.Version})
+	}
+	updates = append(updates, NodeWithVersion{Node: &api.Node{ID: "nonexistent"}})
 
-	err := s.Update(func(tx Tx) error {
-		allNetworks, err := FindNetworks(tx, All)
-		assert.NoError(t, err)
-		assert.Len(t, allNetworks, len(networkSet))
+	var results []CASResult
+	_, err := s.Batch(func(batch *Batch) error {
+		var err error
+		results, err = UpdateNodesCAS(batch, updates)
+		return err
+	})
+	require.NoError(t, err)
+	require.Len(t, results, numNodes+1)
+	assert.Equal(t, CASNotFound, results[numNodes])
 
-		assert.Error(t, CreateNetwork(tx, networkSet[0]), "duplicate IDs must be rejected")
-		return nil
+	s.View(func(tx ReadTx) {
+		for i, n := range nodes {
+			stored := GetNode(tx, n.ID)
+			if i%2 == 0 {
+				assert.Equal(t, CASConflict, results[i], n.ID)
+				assert.Equal(t, api.NodeAvailabilityPause, stored.Spec.Availability)
+			} else {
+				assert.Equal(t, CASApplied, results[i], n.ID)
+				assert.Equal(t, api.NodeAvailabilityDrain, stored.Spec.Availability)
+			}
+		}
 	})
-	assert.NoError(t, err)
+}
 
-	s.View(func(readTx ReadTx) {
-		assert.Equal(t, networkSet[0], GetNetwork(readTx, "id1"))
-		assert.Equal(t, networkSet[1], GetNetwork(readTx, "id2"))
-		assert.Equal(t, networkSet[2], GetNetwork(readTx, "id3"))
+func TestDeleteNodeCAS(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
 
-		foundNetworks, err := FindNetworks(readTx, ByName("name1"))
-		assert.NoError(t, err)
-		assert.Len(t, foundNetworks, 1)
-		foundNetworks, err = FindNetworks(readTx, ByName("name2"))
-		assert.NoError(t, err)
-		assert.Len(t, foundNetworks, 1)
-		foundNetworks, err = FindNetworks(readTx, ByName("invalid"))
-		assert.NoError(t, err)
-		assert.Len(t, foundNetworks, 0)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNode(tx, &api.Node{ID: "id1"}); err != nil {
+			return err
+		}
+		return CreateNode(tx, &api.Node{ID: "id2"})
+	}))
+
+	var read1, read2 *api.Node
+	s.View(func(tx ReadTx) {
+		read1 = GetNode(tx, "id1")
+		read2 = GetNode(tx, "id2")
 	})
 
-	err = s.Update(func(tx Tx) error {
-		// Delete
-		assert.NotNil(t, GetNetwork(tx, "id1"))
-		assert.NoError(t, DeleteNetwork(tx, "id1"))
-		assert.Nil(t, GetNetwork(tx, "id1"))
-		foundNetworks, err := FindNetworks(tx, ByName("name1"))
-		assert.NoError(t, err)
-		assert.Empty(t, foundNetworks)
+	// another writer updates id1 after it was read
+	require.NoError(t, s.Update(func(tx Tx) error {
+		n := GetNode(tx, "id1")
+		n.Spec.Availability = api.NodeAvailabilityDrain
+		return UpdateNode(tx, n)
+	}))
 
-		assert.Equal(t, DeleteNetwork(tx, "nonexistent"), ErrNotExist)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.Equal(t, ErrSequenceConflict, DeleteNodeCAS(tx, "id1", read1.Meta.Version))
+		assert.NoError(t, DeleteNodeCAS(tx, "id2", read2.Meta.Version))
+		assert.Equal(t, ErrNotExist, DeleteNodeCAS(tx, "nonexistent", api.Version{}))
 		return nil
-	})
+	}))
 
-	assert.NoError(t, err)
+	s.View(func(tx ReadTx) {
+		stored := GetNode(tx, "id1")
+		require.NotNil(t, stored)
+		assert.Equal(t, api.NodeAvailabilityDrain, stored.Spec.Availability)
+		assert.Nil(t, GetNode(tx, "id2"))
+	})
 }
 
-func TestStoreTask(t *testing.T) {
+func TestWalkNodes(t *testing.T) {
 	s := NewMemoryStore(nil)
 	assert.NotNil(t, s)
 
-	s.View(func(tx ReadTx) {
-		allTasks, err := FindTasks(tx, All)
-		assert.NoError(t, err)
-		assert.Empty(t, allTasks)
+	const numNodes = 1000
+	_, err := s.Batch(func(batch *Batch) error {
+		for i := 0; i != numNodes; i++ {
+			role := api.NodeRoleWorker
+			if i%10 == 0 {
+				role = api.NodeRoleManager
+			}
+			node := &api.Node{ID: "id" + strconv.Itoa(i), Role: role}
+			if err := batch.Update(func(tx Tx) error {
+				return CreateNode(tx, node)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
 	})
+	require.NoError(t, err)
 
-	setupTestStore(t, s)
+	s.View(func(tx ReadTx) {
+		seen := make(map[string]int)
+		require.NoError(t, WalkNodes(tx, All, func(n *api.Node) error {
+			seen[n.ID]++
+			return nil
+		}))
+		assert.Len(t, seen, numNodes)
+		for id, count := range seen {
+			assert.Equal(t, 1, count, id)
+		}
 
-	err := s.Update(func(tx Tx) error {
-		allTasks, err := FindTasks(tx, All)
-		assert.NoError(t, err)
-		assert.Len(t, allTasks, len(taskSet))
+		// selectors are honored, and nodes matched more than once are delivered once
+		seen = make(map[string]int)
+		require.NoError(t, WalkNodes(tx, Or(ByRole(api.NodeRoleManager), ByIDPrefix("id10")), func(n *api.Node) error {
+			seen[n.ID]++
+			return nil
+		}))
+		// the 100 managers include id10 and id100, so the prefix only adds id101 to id109
+		assert.Len(t, seen, numNodes/10+9)
+		for id, count := range seen {
+			assert.Equal(t, 1, count, id)
+		}
 
-		assert.Error(t, CreateTask(tx, taskSet[0]), "duplicate IDs must be rejected")
-		return nil
+		// an error from the callback stops the walk
+		walked := 0
+		stop := errors.New("stop")
+		assert.Equal(t, stop, WalkNodes(tx, All, func(n *api.Node) error {
+			walked++
+			return stop
+		}))
+		assert.Equal(t, 1, walked)
 	})
-	assert.NoError(t, err)
+}
 
-	s.View(func(readTx ReadTx) {
-		assert.Equal(t, taskSet[0], GetTask(readTx, "id1"))
-		assert.Equal(t, taskSet[1], GetTask(readTx, "id2"))
-		assert.Equal(t, taskSet[2], GetTask(readTx, "id3"))
+func TestFindNodeIDs(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
 
-		foundTasks, err := FindTasks(readTx, ByNamePrefix("name1"))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 1)
-		foundTasks, err = FindTasks(readTx, ByNamePrefix("name2"))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 2)
-		foundTasks, err = FindTasks(readTx, ByNamePrefix("invalid"))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 0)
+	const numNodes = 100
+	_, err := s.Batch(func(batch *Batch) error {
+		for i := 0; i != numNodes; i++ {
+			role := api.NodeRoleWorker
+			if i%10 == 0 {
+				role = api.NodeRoleManager
+			}
+			node := &api.Node{
+				ID:          "id" + strconv.Itoa(i),
+				Role:        role,
+				Description: &api.NodeDescription{Hostname: "host" + strconv.Itoa(i)},
+			}
+			if err := batch.Update(func(tx Tx) error {
+				return CreateNode(tx, node)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
 
-		foundTasks, err = FindTasks(readTx, ByNodeID(nodeSet[0].ID))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 1)
-		assert.Equal(t, foundTasks[0], taskSet[0])
-		foundTasks, err = FindTasks(readTx, ByNodeID("invalid"))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 0)
+	s.View(func(tx ReadTx) {
+		for _, by := range []By{
+			All,
+			ByRole(api.NodeRoleManager),
+			Or(ByRole(api.NodeRoleManager), ByIDPrefix("id1")),
+			ByIDPrefix("nonexistent"),
+		} {
+			nodes, err := FindNodes(tx, by)
+			require.NoError(t, err)
+			ids, err := FindNodeIDs(tx, by)
+			require.NoError(t, err)
+
+			expected := []string{}
+			for _, n := range nodes {
+				expected = append(expected, n.ID)
+			}
+			assert.Equal(t, expected, ids)
+		}
 
-		foundTasks, err = FindTasks(readTx, ByServiceID(serviceSet[0].ID))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 1)
-		assert.Equal(t, foundTasks[0], taskSet[1])
-		foundTasks, err = FindTasks(readTx, ByServiceID("invalid"))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 0)
+		_, err := FindNodeIDs(tx, ByServiceID("service"))
+		assert.Error(t, err)
 
-		foundTasks, err = FindTasks(readTx, ByDesiredState(api.TaskStateRunning))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 2)
-		assert.Equal(t, foundTasks[0].DesiredState, api.TaskStateRunning)
-		assert.Equal(t, foundTasks[0].DesiredState, api.TaskStateRunning)
-		foundTasks, err = FindTasks(readTx, ByDesiredState(api.TaskStateShutdown))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 1)
-		assert.Equal(t, foundTasks[0], taskSet[2])
-		foundTasks, err = FindTasks(readTx, ByDesiredState(api.TaskStatePending))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 0)
+		// the nodes aren't copied, so collecting their IDs allocates far
+		// less than finding them
+		findAllocs := testing.AllocsPerRun(10, func() {
+			FindNodes(tx, All)
+		})
+		idAllocs := testing.AllocsPerRun(10, func() {
+			FindNodeIDs(tx, All)
+		})
+		assert.True(t, idAllocs < findAllocs/2, "%v allocations to find IDs, %v to find nodes", idAllocs, findAllocs)
 	})
+}
 
-	// Update.
-	update := &api.Task{
-		ID: "id3",
-		Annotations: api.Annotations{
-			Name: "name3",
-		},
-		ServiceAnnotations: api.Annotations{
-			Name: "name3",
-		},
-	}
-	err = s.Update(func(tx Tx) error {
-		assert.NotEqual(t, update, GetTask(tx, "id3"))
-		assert.NoError(t, UpdateTask(tx, update))
-		assert.Equal(t, update, GetTask(tx, "id3"))
+func TestGetNodeByHostname(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
 
-		foundTasks, err := FindTasks(tx, ByNamePrefix("name2"))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 1)
-		foundTasks, err = FindTasks(tx, ByNamePrefix("name3"))
-		assert.NoError(t, err)
-		assert.Len(t, foundTasks, 1)
+	withHostname := func(id, hostname string) *api.Node {
+		return &api.Node{
+			ID:          id,
+			Description: &api.NodeDescription{Hostname: hostname},
+		}
+	}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for _, n := range []*api.Node{
+			withHostname("id1", "unique"),
+			withHostname("id2", "shared"),
+			withHostname("id3", "Shared"),
+		} {
+			if err := CreateNode(tx, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
 
-		invalidUpdate := *taskSet[0]
-		invalidUpdate.ID = "invalid"
-		assert.Error(t, UpdateTask(tx, &invalidUpdate), "invalid IDs should be rejected")
+	s.View(func(tx ReadTx) {
+		node, err := GetNodeByHostname(tx, "UNIQUE")
+		require.NoError(t, err)
+		assert.Equal(t, "id1", node.ID)
 
-		// Delete
-		assert.NotNil(t, GetTask(tx, "id1"))
-		assert.NoError(t, DeleteTask(tx, "id1"))
-		assert.Nil(t, GetTask(tx, "id1"))
-		foundTasks, err = FindTasks(tx, ByNamePrefix("name1"))
-		assert.NoError(t, err)
-		assert.Empty(t, foundTasks)
+		_, err = GetNodeByHostname(tx, "missing")
+		assert.Equal(t, ErrNotExist, err)
 
-		assert.Equal(t, DeleteTask(tx, "nonexistent"), ErrNotExist)
-		return nil
+		_, err = GetNodeByHostname(tx, "shared")
+		assert.Equal(t, AmbiguousHostnameError{Hostname: "shared", NodeIDs: []string{"id2", "id3"}}, err)
 	})
-	assert.NoError(t, err)
 }
 
-func TestStoreSnapshot(t *testing.T) {
-	s1 := NewMemoryStore(nil)
-	assert.NotNil(t, s1)
-
-	setupTestStore(t, s1)
-
-	s2 := NewMemoryStore(nil)
-	assert.NotNil(t, s2)
+func TestFindNodesByCertIssuer(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
 
-	copyToS2 := func(readTx ReadTx) error {
-		return s2.Update(func(tx Tx) error {
-			// Copy over new data
-			nodes, err := FindNodes(readTx, All)
-			if err != nil {
+	// Partway through a root rotation, some nodes have certificates from the
+	// new root, some from the old one, and one hasn't reported TLS info yet
+	oldIssuer := []byte("old root subject")
+	newIssuer := []byte("new\x00root subject")
+	withIssuer := func(id string, issuer []byte) *api.Node {
+		return &api.Node{
+			ID: id,
+			Description: &api.NodeDescription{
+				TLSInfo: &api.NodeTLSInfo{CertIssuerSubject: issuer},
+			},
+		}
+	}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for _, n := range []*api.Node{
+			withIssuer("old1", oldIssuer),
+			withIssuer("old2", oldIssuer),
+			withIssuer("new1", newIssuer),
+			{ID: "unreported"},
+		} {
+			if err := CreateNode(tx, n); err != nil {
 				return err
 			}
-			for _, n := range nodes {
-				if err := CreateNode(tx, n); err != nil {
-					return err
-				}
-			}
+		}
+		return nil
+	}))
 
-			tasks, err := FindTasks(readTx, All)
-			if err != nil {
-				return err
-			}
-			for _, t := range tasks {
-				if err := CreateTask(tx, t); err != nil {
-					return err
-				}
-			}
+	ids := func(nodes []*api.Node) []string {
+		var ids []string
+		for _, n := range nodes {
+			ids = append(ids, n.ID)
+		}
+		return ids
+	}
+	s.View(func(tx ReadTx) {
+		nodes, err := FindNodes(tx, ByCertIssuer(oldIssuer))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"old1", "old2"}, ids(nodes))
 
-			services, err := FindServices(readTx, All)
-			if err != nil {
-				return err
-			}
-			for _, s := range services {
-				if err := CreateService(tx, s); err != nil {
-					return err
-				}
-			}
+		nodes, err = FindNodes(tx, ByCertIssuer(newIssuer))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"new1"}, ids(nodes))
 
-			networks, err := FindNetworks(readTx, All)
-			if err != nil {
+		// a subject that is a prefix of another one doesn't match it
+		nodes, err = FindNodes(tx, ByCertIssuer([]byte("new")))
+		require.NoError(t, err)
+		assert.Empty(t, nodes)
+	})
+
+	// once a node rotates, it is no longer returned for the old issuer
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return UpdateNode(tx, withIssuer("old2", newIssuer))
+	}))
+	s.View(func(tx ReadTx) {
+		nodes, err := FindNodes(tx, ByCertIssuer(oldIssuer))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"old1"}, ids(nodes))
+	})
+}
+
+func TestFindNodesByCertExpiringBefore(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+
+	now := time.Now()
+	expiringAt := func(id string, notAfter time.Time) *api.Node {
+		ts, err := gogotypes.TimestampProto(notAfter)
+		require.NoError(t, err)
+		return &api.Node{ID: id, Certificate: api.Certificate{NotAfter: ts}}
+	}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for _, n := range []*api.Node{
+			expiringAt("week", now.Add(7*24*time.Hour)),
+			expiringAt("hour", now.Add(time.Hour)),
+			expiringAt("expired", now.Add(-time.Hour)),
+			expiringAt("day", now.Add(24*time.Hour)),
+			expiringAt("epoch", time.Unix(-1, 0)),
+			{ID: "unissued"},
+		} {
+			if err := CreateNode(tx, n); err != nil {
 				return err
 			}
-			for _, n := range networks {
-				if err := CreateNetwork(tx, n); err != nil {
-					return err
-				}
-			}
+		}
+		return nil
+	}))
 
-			return nil
-		})
+	ids := func(nodes []*api.Node) []string {
+		var ids []string
+		for _, n := range nodes {
+			ids = append(ids, n.ID)
+		}
+		return ids
 	}
+	s.View(func(tx ReadTx) {
+		// nodes are returned in order of expiry
+		nodes, err := FindNodes(tx, ByCertExpiringBefore(now.Add(2*time.Hour)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"epoch", "expired", "hour"}, ids(nodes))
 
-	// Fork
-	watcher, cancel, err := ViewAndWatch(s1, copyToS2)
-	defer cancel()
-	assert.NoError(t, err)
-
-	s2.View(func(tx2 ReadTx) {
-		assert.Equal(t, nodeSet[0], GetNode(tx2, "id1"))
-		assert.Equal(t, nodeSet[1], GetNode(tx2, "id2"))
-		assert.Equal(t, nodeSet[2], GetNode(tx2, "id3"))
+		nodes, err = FindNodes(tx, ByCertExpiringBefore(now.Add(30*24*time.Hour)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"epoch", "expired", "hour", "day", "week"}, ids(nodes))
 
-		assert.Equal(t, serviceSet[0], GetService(tx2, "id1"))
-		assert.Equal(t, serviceSet[1], GetService(tx2, "id2"))
-		assert.Equal(t, serviceSet[2], GetService(tx2, "id3"))
+		nodes, err = FindNodes(tx, ByCertExpiringBefore(time.Unix(-1, 0)))
+		require.NoError(t, err)
+		assert.Empty(t, nodes)
+	})
 
-		assert.Equal(t, taskSet[0], GetTask(tx2, "id1"))
-		assert.Equal(t, taskSet[1], GetTask(tx2, "id2"))
-		assert.Equal(t, taskSet[2], GetTask(tx2, "id3"))
+	// renewing a certificate moves the node out of the range
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return UpdateNode(tx, expiringAt("hour", now.Add(90*24*time.Hour)))
+	}))
+	s.View(func(tx ReadTx) {
+		nodes, err := FindNodes(tx, ByCertExpiringBefore(now.Add(2*time.Hour)))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"epoch", "expired"}, ids(nodes))
 	})
+}
 
-	// Create node
-	createNode := &api.Node{
-		ID: "id4",
-		Spec: api.NodeSpec{
-			Annotations: api.Annotations{
-				Name: "name4",
-			},
-		},
-	}
+func TestFindNodesModifiedSince(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
 
-	err = s1.Update(func(tx1 Tx) error {
-		assert.NoError(t, CreateNode(tx1, createNode))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for i := 1; i <= 5; i++ {
+			if err := CreateNode(tx, &api.Node{ID: "id" + strconv.Itoa(i)}); err != nil {
+				return err
+			}
+		}
 		return nil
-	})
-	assert.NoError(t, err)
-
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
+	}))
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Equal(t, createNode, GetNode(tx2, "id4"))
+	var since uint64
+	s.View(func(tx ReadTx) {
+		since = GetNode(tx, "id1").Meta.Version.Index
+		nodes, err := FindNodesModifiedSince(tx, since)
+		require.NoError(t, err)
+		assert.Empty(t, nodes)
 	})
 
-	// Update node
-	updateNode := &api.Node{
-		ID: "id3",
-		Spec: api.NodeSpec{
-			Annotations: api.Annotations{
-				Name: "name3",
-			},
-		},
+	// modify a subset of the nodes, one transaction at a time, out of ID order
+	for _, id := range []string{"id4", "id2", "id5"} {
+		require.NoError(t, s.Update(func(tx Tx) error {
+			node := GetNode(tx, id)
+			node.Spec.Availability = api.NodeAvailabilityDrain
+			return UpdateNode(tx, node)
+		}))
 	}
 
-	err = s1.Update(func(tx1 Tx) error {
-		assert.NoError(t, UpdateNode(tx1, updateNode))
-		return nil
-	})
-	assert.NoError(t, err)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateTask(tx, &api.Task{ID: "task1"})
+	}))
 
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
+	s.View(func(tx ReadTx) {
+		nodes, err := FindNodesModifiedSince(tx, since)
+		require.NoError(t, err)
+		var ids []string
+		var last uint64
+		for _, n := range nodes {
+			ids = append(ids, n.ID)
+			assert.True(t, n.Meta.Version.Index > last)
+			last = n.Meta.Version.Index
+		}
+		assert.Equal(t, []string{"id4", "id2", "id5"}, ids)
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Equal(t, updateNode, GetNode(tx2, "id3"))
+		nodes, err = FindNodesModifiedSince(tx, last)
+		require.NoError(t, err)
+		assert.Empty(t, nodes)
+
+		// the generic variant works on any table
+		objects, err := FindModifiedSince(tx, tableTask, since)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "task1", objects[0].GetID())
+		objects, err = FindModifiedSince(tx, tableTask, objects[0].GetMeta().Version.Index)
+		require.NoError(t, err)
+		assert.Empty(t, objects)
+
+		_, err = FindModifiedSince(tx, "nosuchtable", 0)
+		assert.Equal(t, UnknownTableError{Table: "nosuchtable"}, err)
 	})
+}
 
-	err = s1.Update(func(tx1 Tx) error {
-		// Delete node
-		assert.NoError(t, DeleteNode(tx1, "id1"))
+func TestCountNodesByIssuanceState(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+
+	inState := func(id string, state api.IssuanceStatus_State) *api.Node {
+		return &api.Node{ID: id, Certificate: api.Certificate{Status: api.IssuanceStatus{State: state}}}
+	}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for _, n := range []*api.Node{
+			inState("id1", api.IssuanceStateIssued),
+			inState("id2", api.IssuanceStateIssued),
+			inState("id3", api.IssuanceStateIssued),
+			inState("id4", api.IssuanceStatePending),
+			inState("id5", api.IssuanceStateRotate),
+			inState("id6", api.IssuanceStateRotate),
+		} {
+			if err := CreateNode(tx, n); err != nil {
+				return err
+			}
+		}
 		return nil
+	}))
+
+	s.View(func(tx ReadTx) {
+		assert.Equal(t, map[api.IssuanceStatus_State]int{
+			api.IssuanceStateUnknown: 0,
+			api.IssuanceStateRenew:   0,
+			api.IssuanceStatePending: 1,
+			api.IssuanceStateIssued:  3,
+			api.IssuanceStateFailed:  0,
+			api.IssuanceStateRotate:  2,
+		}, CountNodesByIssuanceState(tx))
 	})
-	assert.NoError(t, err)
+}
 
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
+func TestSetNodeMembership(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Nil(t, GetNode(tx2, "id1"))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return SetNodeMembership(tx, "id1", api.NodeMembershipAccepted)
+	}))
+	s.View(func(tx ReadTx) {
+		node := GetNode(tx, "id1")
+		assert.Equal(t, api.NodeMembershipAccepted, node.Spec.Membership)
+		require.NotNil(t, node.MembershipTransition)
+		assert.Equal(t, api.NodeMembershipPending, node.MembershipTransition.From)
+		assert.Equal(t, api.NodeMembershipAccepted, node.MembershipTransition.To)
+		assert.NotNil(t, node.MembershipTransition.Timestamp)
 	})
 
-	// Create service
-	createService := &api.Service{
-		ID: "id4",
-		Spec: api.ServiceSpec{
-			Annotations: api.Annotations{
-				Name: "name4",
-			},
-		},
-	}
+	err := s.Update(func(tx Tx) error {
+		return SetNodeMembership(tx, "id1", api.NodeMembershipPending)
+	})
+	assert.Equal(t, MembershipTransitionError{From: api.NodeMembershipAccepted, To: api.NodeMembershipPending}, err)
+	s.View(func(tx ReadTx) {
+		assert.Equal(t, api.NodeMembershipAccepted, GetNode(tx, "id1").Spec.Membership)
+	})
 
-	err = s1.Update(func(tx1 Tx) error {
-		assert.NoError(t, CreateService(tx1, createService))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.NoError(t, SetNodeMembership(tx, "id1", api.NodeMembershipAccepted))
+		assert.Equal(t, ErrNotExist, SetNodeMembership(tx, "id2", api.NodeMembershipAccepted))
 		return nil
-	})
-	assert.NoError(t, err)
+	}))
+}
 
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
+func TestTransitionNodeMembership(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Equal(t, createService, GetService(tx2, "id4"))
+	// the transition is made before the node is first written
+	node := &api.Node{ID: "id1"}
+	require.NoError(t, TransitionNodeMembership(node, api.NodeMembershipAccepted))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, node)
+	}))
+	s.View(func(tx ReadTx) {
+		node := GetNode(tx, "id1")
+		assert.Equal(t, api.NodeMembershipAccepted, node.Spec.Membership)
+		require.NotNil(t, node.MembershipTransition)
+		assert.Equal(t, api.NodeMembershipPending, node.MembershipTransition.From)
+		assert.Equal(t, api.NodeMembershipAccepted, node.MembershipTransition.To)
 	})
 
-	// Update service
-	updateService := serviceSet[2].Copy()
-	updateService.Spec.Annotations.Name = "new-name"
-	err = s1.Update(func(tx1 Tx) error {
-		assert.NotEqual(t, updateService, GetService(tx1, updateService.ID))
-		assert.NoError(t, UpdateService(tx1, updateService))
-		return nil
-	})
-	assert.NoError(t, err)
+	// an illegal transition leaves the node as it was
+	node = node.Copy()
+	transition := node.MembershipTransition
+	assert.Equal(t, MembershipTransitionError{From: api.NodeMembershipAccepted, To: api.NodeMembershipPending},
+		TransitionNodeMembership(node, api.NodeMembershipPending))
+	assert.Equal(t, api.NodeMembershipAccepted, node.Spec.Membership)
+	assert.Equal(t, transition, node.MembershipTransition)
+}
 
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
+func TestAcceptNode(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNode(tx, &api.Node{
+			ID:          "id1",
+			Certificate: api.Certificate{CSR: []byte("csr"), Status: api.IssuanceStatus{State: api.IssuanceStateFailed}},
+		}); err != nil {
+			return err
+		}
+		return CreateNode(tx, &api.Node{ID: "nocsr"})
+	}))
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Equal(t, updateService, GetService(tx2, "id3"))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return AcceptNode(tx, "id1", api.NodeRoleManager)
+	}))
+	s.View(func(tx ReadTx) {
+		node := GetNode(tx, "id1")
+		assert.Equal(t, api.NodeMembershipAccepted, node.Spec.Membership)
+		require.NotNil(t, node.MembershipTransition)
+		assert.Equal(t, api.NodeMembershipPending, node.MembershipTransition.From)
+		assert.Equal(t, api.NodeRoleManager, node.Role)
+		assert.Equal(t, api.NodeRoleManager, node.Spec.DesiredRole)
+		assert.Equal(t, api.NodeRoleManager, node.Certificate.Role)
+		assert.Equal(t, "id1", node.Certificate.CN)
+		assert.Equal(t, api.IssuanceStatePending, node.Certificate.Status.State)
 	})
 
-	err = s1.Update(func(tx1 Tx) error {
-		// Delete service
-		assert.NoError(t, DeleteService(tx1, "id1"))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.Equal(t, MembershipTransitionError{From: api.NodeMembershipAccepted, To: api.NodeMembershipAccepted}, AcceptNode(tx, "id1", api.NodeRoleWorker))
+		assert.Equal(t, ErrNoCSR, AcceptNode(tx, "nocsr", api.NodeRoleWorker))
+		assert.Equal(t, ErrNotExist, AcceptNode(tx, "id2", api.NodeRoleWorker))
+		assert.Error(t, AcceptNode(tx, "nocsr", api.NodeRole(5)))
 		return nil
+	}))
+	s.View(func(tx ReadTx) {
+		assert.Equal(t, api.NodeRoleManager, GetNode(tx, "id1").Role)
+		assert.Equal(t, api.NodeMembershipPending, GetNode(tx, "nocsr").Spec.Membership)
 	})
-	assert.NoError(t, err)
+}
 
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
+func TestMigrateNodeID(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Nil(t, GetService(tx2, "id1"))
-	})
+	setupTestStore(t, s)
 
-	// Create task
-	createTask := &api.Task{
-		ID: "id4",
-		ServiceAnnotations: api.Annotations{
-			Name: "name4",
-		},
+	watch, cancel := s.WatchQueue().Watch()
+	defer cancel()
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.Equal(t, ErrExist, MigrateNodeID(tx, "id1", "id2"))
+		assert.Equal(t, ErrNotExist, MigrateNodeID(tx, "nonexistent", "id4"))
+		return MigrateNodeID(tx, "id1", "id4")
+	}))
+
+	expectEvent := func(event interface{}) {
+		select {
+		case e := <-watch:
+			assert.IsType(t, event, e)
+		case <-time.After(time.Second):
+			t.Fatalf("no %T event", event)
+		}
 	}
+	expectEvent(api.EventCreateNode{})
+	expectEvent(api.EventUpdateTask{})
+	expectEvent(api.EventDeleteNode{})
+	expectEvent(state.EventCommit{})
 
-	err = s1.Update(func(tx1 Tx) error {
-		assert.NoError(t, CreateTask(tx1, createTask))
-		return nil
+	s.View(func(tx ReadTx) {
+		assert.Nil(t, GetNode(tx, "id1"))
+		migrated := GetNode(tx, "id4")
+		require.NotNil(t, migrated)
+		assert.Equal(t, nodeSet[0].Spec, migrated.Spec)
+		assert.Equal(t, nodeSet[0].Description, migrated.Description)
+
+		// the node's task follows it
+		tasks, err := FindTasks(tx, ByNodeID("id1"))
+		assert.NoError(t, err)
+		assert.Empty(t, tasks)
+		tasks, err = FindTasks(tx, ByNodeID("id4"))
+		assert.NoError(t, err)
+		require.Len(t, tasks, 1)
+		assert.Equal(t, taskSet[0].ID, tasks[0].ID)
 	})
-	assert.NoError(t, err)
+}
 
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
+func TestSoftDeleteNode(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+	require.NoError(t, s.EnableNodeSoftDelete(100*time.Millisecond))
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Equal(t, createTask, GetTask(tx2, "id4"))
-	})
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1", Description: &api.NodeDescription{Hostname: "host1"}})
+	}))
 
-	// Update task
-	updateTask := &api.Task{
-		ID: "id3",
-		ServiceAnnotations: api.Annotations{
-			Name: "name3",
-		},
+	watch, cancel := s.WatchQueue().Watch()
+	defer cancel()
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNode(tx, "id1")
+	}))
+
+	var deleted *api.Node
+	select {
+	case event := <-watch:
+		require.IsType(t, api.EventDeleteNode{}, event)
+		deleted = event.(api.EventDeleteNode).Node
+		assert.Equal(t, "id1", deleted.ID)
+		assert.NotNil(t, deleted.DeletedAt)
+	case <-time.After(time.Second):
+		t.Fatal("no delete event")
 	}
+	assert.IsType(t, state.EventCommit{}, <-watch)
 
-	err = s1.Update(func(tx1 Tx) error {
-		assert.NoError(t, UpdateTask(tx1, updateTask))
+	// the node is hidden by default
+	s.View(func(tx ReadTx) {
+		assert.Nil(t, GetNode(tx, "id1"))
+		nodes, err := FindNodes(tx, All)
+		assert.NoError(t, err)
+		assert.Empty(t, nodes)
+		nodes, err = FindNodes(tx, ByName("host1"))
+		assert.NoError(t, err)
+		assert.Empty(t, nodes)
+	})
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.Equal(t, ErrNotExist, UpdateNode(tx, &api.Node{ID: "id1"}))
+		assert.Equal(t, ErrNotExist, DeleteNode(tx, "id1"))
 		return nil
+	}))
+
+	// but can be seen with IncludeDeleted
+	s.View(func(tx ReadTx) {
+		node := GetNode(IncludeDeleted(tx), "id1")
+		require.NotNil(t, node)
+		assert.Equal(t, deleted.DeletedAt, node.DeletedAt)
+		nodes, err := FindNodes(IncludeDeleted(tx), All)
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 1)
 	})
-	assert.NoError(t, err)
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Equal(t, updateTask, GetTask(tx2, "id3"))
+	// the delete event replicates the tombstone, with the same deletion time
+	s2 := NewMemoryStore(nil)
+	defer s2.Close()
+	require.NoError(t, s2.EnableNodeSoftDelete(time.Hour))
+	require.NoError(t, s2.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+	sa, err := api.NewStoreAction(api.EventDeleteNode{Node: deleted})
+	require.NoError(t, err)
+	require.NoError(t, s2.ApplyStoreActions([]api.StoreAction{sa}))
+	s2.View(func(tx ReadTx) {
+		assert.Nil(t, GetNode(tx, "id1"))
+		node := GetNode(IncludeDeleted(tx), "id1")
+		require.NotNil(t, node)
+		assert.Equal(t, deleted.DeletedAt, node.DeletedAt)
 	})
 
-	err = s1.Update(func(tx1 Tx) error {
-		// Delete task
-		assert.NoError(t, DeleteTask(tx1, "id1"))
-		return nil
+	// but a store without soft-delete removes the node outright
+	s3 := NewMemoryStore(nil)
+	defer s3.Close()
+	require.NoError(t, s3.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+	require.NoError(t, s3.ApplyStoreActions([]api.StoreAction{sa}))
+	s3.View(func(tx ReadTx) {
+		assert.Nil(t, GetNode(IncludeDeleted(tx), "id1"))
 	})
-	assert.NoError(t, err)
-	assert.NoError(t, Apply(s2, <-watcher))
-	<-watcher // consume commit event
 
-	s2.View(func(tx2 ReadTx) {
-		assert.Nil(t, GetTask(tx2, "id1"))
+	// the node is purged once the retention has passed
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var node *api.Node
+		s.View(func(tx ReadTx) {
+			node = GetNode(IncludeDeleted(tx), "id1")
+		})
+		if node == nil {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "soft-deleted node was not purged")
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// purging doesn't produce another event
+	select {
+	case event := <-watch:
+		t.Fatalf("unexpected event %v", event)
+	default:
+	}
+}
+
+func TestSoftDeleteNodeRetention(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	assert.Error(t, s.EnableNodeSoftDelete(0))
+	assert.Error(t, s.EnableNodeSoftDelete(-time.Second))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNode(tx, "id1")
+	}))
+	s.View(func(tx ReadTx) {
+		assert.Nil(t, GetNode(IncludeDeleted(tx), "id1"))
+	})
+
+	// once the purge is stopped, tombstones are kept
+	s2 := NewMemoryStore(nil)
+	defer s2.Close()
+	require.NoError(t, s2.EnableNodeSoftDelete(10*time.Millisecond))
+	s2.StopPurgingDeletedNodes()
+	s2.StopPurgingDeletedNodes()
+	require.NoError(t, s2.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+	require.NoError(t, s2.Update(func(tx Tx) error {
+		return DeleteNode(tx, "id1")
+	}))
+	time.Sleep(100 * time.Millisecond)
+	s2.View(func(tx ReadTx) {
+		assert.NotNil(t, GetNode(IncludeDeleted(tx), "id1"))
 	})
 }
 
-func TestCustomIndex(t *testing.T) {
+func TestSoftDeleteNodeRecreate(t *testing.T) {
 	s := NewMemoryStore(nil)
-	assert.NotNil(t, s)
+	defer s.Close()
+	require.NoError(t, s.EnableNodeSoftDelete(time.Hour))
 
-	setupTestStore(t, s)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1", Spec: api.NodeSpec{Annotations: api.Annotations{Name: "old"}}})
+	}))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNode(tx, "id1")
+	}))
 
-	// Add a custom index entry to each node
-	err := s.Update(func(tx Tx) error {
-		allNodes, err := FindNodes(tx, All)
-		assert.NoError(t, err)
-		assert.Len(t, allNodes, len(nodeSet))
+	watch, cancel := s.WatchQueue().Watch()
+	defer cancel()
 
-		for _, n := range allNodes {
-			switch n.ID {
-			case "id2":
-				n.Spec.Annotations.Indices = []api.IndexEntry{
-					{Key: "nodesbefore", Val: "id1"},
-				}
-				assert.NoError(t, UpdateNode(tx, n))
-			case "id3":
-				n.Spec.Annotations.Indices = []api.IndexEntry{
-					{Key: "nodesbefore", Val: "id1"},
-					{Key: "nodesbefore", Val: "id2"},
-				}
-				assert.NoError(t, UpdateNode(tx, n))
-			}
-		}
+	// the soft-deleted node's ID is free again, and reusing it replaces the
+	// tombstone
+	recreated := &api.Node{ID: "id1", Spec: api.NodeSpec{Annotations: api.Annotations{Name: "new"}}}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.Nil(t, GetNode(tx, "id1"))
+		node, err := CreateOrGetNode(tx, recreated)
+		require.NoError(t, err)
+		assert.True(t, node == recreated)
 		return nil
+	}))
+	select {
+	case event := <-watch:
+		require.IsType(t, api.EventCreateNode{}, event)
+		assert.Equal(t, "new", event.(api.EventCreateNode).Node.Spec.Annotations.Name)
+	case <-time.After(time.Second):
+		t.Fatal("no create event")
+	}
+	s.View(func(tx ReadTx) {
+		node := GetNode(IncludeDeleted(tx), "id1")
+		require.NotNil(t, node)
+		assert.Nil(t, node.DeletedAt)
+		assert.Equal(t, "new", node.Spec.Annotations.Name)
 	})
-	assert.NoError(t, err)
 
-	s.View(func(readTx ReadTx) {
-		foundNodes, err := FindNodes(readTx, ByCustom("", "nodesbefore", "id2"))
-		require.NoError(t, err)
-		require.Len(t, foundNodes, 1)
-		assert.Equal(t, "id3", foundNodes[0].ID)
+	// but a live node's ID is still taken
+	require.NoError(t, s.Update(func(tx Tx) error {
+		assert.Equal(t, ErrExist, CreateNode(tx, &api.Node{ID: "id1"}))
+		return nil
+	}))
+}
 
-		foundNodes, err = FindNodes(readTx, ByCustom("", "nodesbefore", "id1"))
+func TestSoftDeleteNodePurgeReplicated(t *testing.T) {
+	proposer := &testutils.MockProposer{}
+	leader := NewMemoryStore(proposer)
+	defer leader.Close()
+	require.NoError(t, leader.EnableNodeSoftDelete(time.Hour))
+
+	// a follower that keeps tombstones, and one that doesn't
+	follower := NewMemoryStore(nil)
+	defer follower.Close()
+	require.NoError(t, follower.EnableNodeSoftDelete(time.Hour))
+	hardDeleting := NewMemoryStore(nil)
+	defer hardDeleting.Close()
+
+	replicate := func(from api.Version) {
+		changes, err := proposer.ChangesBetween(from, *proposer.GetVersion())
 		require.NoError(t, err)
-		require.Len(t, foundNodes, 2)
+		for _, change := range changes {
+			require.NoError(t, follower.ApplyStoreActions(change.StoreActions))
+			require.NoError(t, hardDeleting.ApplyStoreActions(change.StoreActions))
+		}
+	}
 
-		foundNodes, err = FindNodes(readTx, ByCustom("", "nodesbefore", "id3"))
-		require.NoError(t, err)
-		require.Len(t, foundNodes, 0)
+	require.NoError(t, leader.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+	require.NoError(t, leader.Update(func(tx Tx) error {
+		return DeleteNode(tx, "id1")
+	}))
+	replicate(api.Version{})
+	follower.View(func(tx ReadTx) {
+		assert.NotNil(t, GetNode(IncludeDeleted(tx), "id1"))
+	})
 
-		foundNodes, err = FindNodes(readTx, ByCustomPrefix("", "nodesbefore", "id"))
-		require.NoError(t, err)
-		require.Len(t, foundNodes, 2)
+	watch, cancel := follower.WatchQueue().Watch()
+	defer cancel()
 
-		foundNodes, err = FindNodes(readTx, ByCustomPrefix("", "nodesbefore", "id6"))
-		require.NoError(t, err)
-		require.Len(t, foundNodes, 0)
-	})
+	// the leader's purge is proposed, and removes the follower's tombstone
+	before := *proposer.GetVersion()
+	require.NoError(t, leader.purgeDeletedNodes(time.Now().Add(time.Hour)))
+	assert.NotEqual(t, before, *proposer.GetVersion())
+	replicate(before)
+	for _, s := range []*MemoryStore{leader, follower, hardDeleting} {
+		s.View(func(tx ReadTx) {
+			assert.Nil(t, GetNode(IncludeDeleted(tx), "id1"))
+		})
+	}
+
+	// without another event
+	select {
+	case event := <-watch:
+		t.Fatalf("unexpected event %v", event)
+	default:
+	}
 }
 
-func TestFailedTransaction(t *testing.T) {
+func TestStoreService(t *testing.T) {
 	s := NewMemoryStore(nil)
 	assert.NotNil(t, s)
 
-	// Create one node
-	err := s.Update(func(tx Tx) error {
-		n := &api.Node{
-			ID: "id1",
-			Description: &api.NodeDescription{
-				Hostname: "name1",
-			},
-		}
-
-		assert.NoError(t, CreateNode(tx, n))
-		return nil
+	s.View(func(readTx ReadTx) {
+		allServices, err := FindServices(readTx, All)
+		assert.NoError(t, err)
+		assert.Empty(t, allServices)
 	})
-	assert.NoError(t, err)
 
-	// Create a second node, but then roll back the transaction
-	err = s.Update(func(tx Tx) error {
-		n := &api.Node{
-			ID: "id2",
-			Description: &api.NodeDescription{
-				Hostname: "name2",
-			},
-		}
+	setupTestStore(t, s)
 
-		assert.NoError(t, CreateNode(tx, n))
-		return errors.New("rollback")
+	err := s.Update(func(tx Tx) error {
+		assert.Equal(t,
+			CreateService(tx, &api.Service{
+				ID: "id1",
+				Spec: api.ServiceSpec{
+					Annotations: api.Annotations{
+						Name: "name4",
+					},
+				},
+			}), ErrExist, "duplicate IDs must be rejected")
+
+		assert.Equal(t,
+			CreateService(tx, &api.Service{
+				ID: "id4",
+				Spec: api.ServiceSpec{
+					Annotations: api.Annotations{
+						Name: "name1",
+					},
+				},
+			}), ErrNameConflict, "duplicate names must be rejected")
+
+		assert.Equal(t,
+			CreateService(tx, &api.Service{
+				ID: "id4",
+				Spec: api.ServiceSpec{
+					Annotations: api.Annotations{
+						Name: "NAME1",
+					},
+				},
+			}), ErrNameConflict, "duplicate check should be case insensitive")
+		return nil
 	})
-	assert.Error(t, err)
+	assert.NoError(t, err)
 
-	s.View(func(tx ReadTx) {
-		foundNodes, err := FindNodes(tx, All)
+	s.View(func(readTx ReadTx) {
+		assert.Equal(t, serviceSet[0], GetService(readTx, "id1"))
+		assert.Equal(t, serviceSet[1], GetService(readTx, "id2"))
+		assert.Equal(t, serviceSet[2], GetService(readTx, "id3"))
+
+		foundServices, err := FindServices(readTx, ByNamePrefix("name1"))
 		assert.NoError(t, err)
-		assert.Len(t, foundNodes, 1)
-		foundNodes, err = FindNodes(tx, ByName("name1"))
+		assert.Len(t, foundServices, 1)
+		foundServices, err = FindServices(readTx, ByNamePrefix("NAME1"))
 		assert.NoError(t, err)
-		assert.Len(t, foundNodes, 1)
-		foundNodes, err = FindNodes(tx, ByName("name2"))
+		assert.Len(t, foundServices, 1)
+		foundServices, err = FindServices(readTx, ByNamePrefix("invalid"))
 		assert.NoError(t, err)
-		assert.Len(t, foundNodes, 0)
+		assert.Len(t, foundServices, 0)
+		foundServices, err = FindServices(readTx, Or(ByNamePrefix("name1"), ByNamePrefix("name2")))
+		assert.NoError(t, err)
+		assert.Len(t, foundServices, 2)
+		foundServices, err = FindServices(readTx, Or(ByNamePrefix("name1"), ByNamePrefix("name2"), ByNamePrefix("name4")))
+		assert.NoError(t, err)
+		assert.Len(t, foundServices, 2)
+
+		foundServices, err = FindServices(readTx, ByIDPrefix("id"))
+		assert.NoError(t, err)
+		assert.Len(t, foundServices, 3)
 	})
-}
 
-func TestVersion(t *testing.T) {
-	s := NewMemoryStore(&testutils.MockProposer{})
-	assert.NotNil(t, s)
+	// Update.
+	err = s.Update(func(tx Tx) error {
+		// Regular update.
+		update := serviceSet[0].Copy()
+		update.Spec.Annotations.Labels = map[string]string{
+			"foo": "bar",
+		}
 
-	var (
-		retrievedNode  *api.Node
-		retrievedNode2 *api.Node
-	)
+		assert.NotEqual(t, update, GetService(tx, update.ID))
+		assert.NoError(t, UpdateService(tx, update))
+		assert.Equal(t, update, GetService(tx, update.ID))
+
+		// Name conflict.
+		update = GetService(tx, update.ID)
+		update.Spec.Annotations.Name = "name2"
+		assert.Equal(t, UpdateService(tx, update), ErrNameConflict, "duplicate names should be rejected")
+		update = GetService(tx, update.ID)
+		update.Spec.Annotations.Name = "NAME2"
+		assert.Equal(t, UpdateService(tx, update), ErrNameConflict, "duplicate check should be case insensitive")
+
+		// Name change.
+		update = GetService(tx, update.ID)
+		foundServices, err := FindServices(tx, ByNamePrefix("name1"))
+		assert.NoError(t, err)
+		assert.Len(t, foundServices, 1)
+		foundServices, err = FindServices(tx, ByNamePrefix("name4"))
+		assert.NoError(t, err)
+		assert.Empty(t, foundServices)
+
+		update.Spec.Annotations.Name = "name4"
+		assert.NoError(t, UpdateService(tx, update))
+		foundServices, err = FindServices(tx, ByNamePrefix("name1"))
+		assert.NoError(t, err)
+		assert.Empty(t, foundServices)
+		foundServices, err = FindServices(tx, ByNamePrefix("name4"))
+		assert.NoError(t, err)
+		assert.Len(t, foundServices, 1)
+
+		// Invalid update.
+		invalidUpdate := serviceSet[0].Copy()
+		invalidUpdate.ID = "invalid"
+		assert.Error(t, UpdateService(tx, invalidUpdate), "invalid IDs should be rejected")
 
-	// Create one node
-	n := &api.Node{
-		ID: "id1",
-		Spec: api.NodeSpec{
-			Annotations: api.Annotations{
-				Name: "name1",
-			},
-		},
-	}
-	err := s.Update(func(tx Tx) error {
-		assert.NoError(t, CreateNode(tx, n))
 		return nil
 	})
 	assert.NoError(t, err)
 
-	// Update the node using an object fetched from the store.
-	n.Spec.Annotations.Name = "name2"
+	// Delete
 	err = s.Update(func(tx Tx) error {
-		assert.NoError(t, UpdateNode(tx, n))
-		retrievedNode = GetNode(tx, n.ID)
+		assert.NotNil(t, GetService(tx, "id1"))
+		assert.NoError(t, DeleteService(tx, "id1"))
+		assert.Nil(t, GetService(tx, "id1"))
+		foundServices, err := FindServices(tx, ByNamePrefix("name1"))
+		assert.NoError(t, err)
+		assert.Empty(t, foundServices)
+
+		assert.Equal(t, DeleteService(tx, "nonexistent"), ErrNotExist)
 		return nil
 	})
 	assert.NoError(t, err)
+}
 
-	// Make sure the store is updating our local copy with the version.
-	assert.Equal(t, n.Meta.Version, retrievedNode.Meta.Version)
+func TestStoreNetwork(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
 
-	// Try again, this time using the retrieved node.
-	retrievedNode.Spec.Annotations.Name = "name2"
-	err = s.Update(func(tx Tx) error {
-		assert.NoError(t, UpdateNode(tx, retrievedNode))
-		retrievedNode2 = GetNode(tx, n.ID)
-		return nil
+	s.View(func(readTx ReadTx) {
+		allNetworks, err := FindNetworks(readTx, All)
+		assert.NoError(t, err)
+		assert.Empty(t, allNetworks)
 	})
-	assert.NoError(t, err)
 
-	// Try to update retrievedNode again. This should fail because it was
-	// already used to perform an update.
-	retrievedNode.Spec.Annotations.Name = "name3"
-	err = s.Update(func(tx Tx) error {
-		assert.Equal(t, ErrSequenceConflict, UpdateNode(tx, n))
+	setupTestStore(t, s)
+
+	err := s.Update(func(tx Tx) error {
+		allNetworks, err := FindNetworks(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allNetworks, len(networkSet))
+
+		assert.Error(t, CreateNetwork(tx, networkSet[0]), "duplicate IDs must be rejected")
 		return nil
 	})
 	assert.NoError(t, err)
 
-	// But using retrievedNode2 should work, since it has the latest
-	// sequence information.
-	retrievedNode2.Spec.Annotations.Name = "name3"
+	s.View(func(readTx ReadTx) {
+		assert.Equal(t, networkSet[0], GetNetwork(readTx, "id1"))
+		assert.Equal(t, networkSet[1], GetNetwork(readTx, "id2"))
+		assert.Equal(t, networkSet[2], GetNetwork(readTx, "id3"))
+
+		foundNetworks, err := FindNetworks(readTx, ByName("name1"))
+		assert.NoError(t, err)
+		assert.Len(t, foundNetworks, 1)
+		foundNetworks, err = FindNetworks(readTx, ByName("name2"))
+		assert.NoError(t, err)
+		assert.Len(t, foundNetworks, 1)
+		foundNetworks, err = FindNetworks(readTx, ByName("invalid"))
+		assert.NoError(t, err)
+		assert.Len(t, foundNetworks, 0)
+	})
+
 	err = s.Update(func(tx Tx) error {
-		assert.NoError(t, UpdateNode(tx, retrievedNode2))
+		// Delete
+		assert.NotNil(t, GetNetwork(tx, "id1"))
+		assert.NoError(t, DeleteNetwork(tx, "id1"))
+		assert.Nil(t, GetNetwork(tx, "id1"))
+		foundNetworks, err := FindNetworks(tx, ByName("name1"))
+		assert.NoError(t, err)
+		assert.Empty(t, foundNetworks)
+
+		assert.Equal(t, DeleteNetwork(tx, "nonexistent"), ErrNotExist)
 		return nil
 	})
+
 	assert.NoError(t, err)
 }
 
-func TestTimestamps(t *testing.T) {
-	s := NewMemoryStore(&testutils.MockProposer{})
+func TestDeleteNetworkIfUnused(t *testing.T) {
+	s := NewMemoryStore(nil)
 	assert.NotNil(t, s)
 
-	var (
-		retrievedNode *api.Node
-		updatedNode   *api.Node
-	)
-
-	// Create one node
-	n := &api.Node{
-		ID: "id1",
-		Spec: api.NodeSpec{
-			Annotations: api.Annotations{
-				Name: "name1",
+	attached := []*api.NetworkAttachmentConfig{{Target: "net1"}}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNetwork(tx, &api.Network{
+			ID:   "net1",
+			Spec: api.NetworkSpec{Annotations: api.Annotations{Name: "net1"}},
+		}); err != nil {
+			return err
+		}
+		if err := CreateService(tx, &api.Service{
+			ID: "service1",
+			Spec: api.ServiceSpec{
+				Annotations: api.Annotations{Name: "service1"},
+				Task:        api.TaskSpec{Networks: attached},
 			},
-		},
-	}
+		}); err != nil {
+			return err
+		}
+		return CreateTask(tx, &api.Task{ID: "task1", Spec: api.TaskSpec{Networks: attached}})
+	}))
+
 	err := s.Update(func(tx Tx) error {
-		assert.NoError(t, CreateNode(tx, n))
-		return nil
+		return DeleteNetworkIfUnused(tx, "net1")
 	})
-	assert.NoError(t, err)
-
-	// Make sure our local copy got updated.
-	assert.NotZero(t, n.Meta.CreatedAt)
-	assert.NotZero(t, n.Meta.UpdatedAt)
-	// Since this is a new node, CreatedAt should equal UpdatedAt.
-	assert.Equal(t, n.Meta.CreatedAt, n.Meta.UpdatedAt)
+	assert.Equal(t, NetworkInUseError{
+		NetworkID: "net1",
+		References: map[string][]string{
+			tableService: {"service1"},
+			tableTask:    {"task1"},
+		},
+	}, err)
+	assert.EqualError(t, err, "network net1 is in use by service service1, task task1")
 
-	// Fetch the node from the store and make sure timestamps match.
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := DeleteService(tx, "service1"); err != nil {
+			return err
+		}
+		return DeleteTask(tx, "task1")
+	}))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNetworkIfUnused(tx, "net1")
+	}))
 	s.View(func(tx ReadTx) {
-		retrievedNode = GetNode(tx, n.ID)
+		assert.Nil(t, GetNetwork(tx, "net1"))
 	})
-	assert.Equal(t, retrievedNode.Meta.CreatedAt, n.Meta.CreatedAt)
-	assert.Equal(t, retrievedNode.Meta.UpdatedAt, n.Meta.UpdatedAt)
 
-	// Make an update.
-	retrievedNode.Spec.Annotations.Name = "name2"
 	err = s.Update(func(tx Tx) error {
-		assert.NoError(t, UpdateNode(tx, retrievedNode))
-		updatedNode = GetNode(tx, n.ID)
-		return nil
+		return DeleteNetworkIfUnused(tx, "net1")
 	})
-	assert.NoError(t, err)
-
-	// Ensure `CreatedAt` is the same after the update and `UpdatedAt` got updated.
-	assert.Equal(t, updatedNode.Meta.CreatedAt, n.Meta.CreatedAt)
-	assert.NotEqual(t, updatedNode.Meta.CreatedAt, updatedNode.Meta.UpdatedAt)
+	assert.Equal(t, ErrNotExist, err)
 }
 
-func TestBatch(t *testing.T) {
-	s := NewMemoryStore(&testutils.MockProposer{})
+func TestStoreTask(t *testing.T) {
+	s := NewMemoryStore(nil)
 	assert.NotNil(t, s)
 
-	watch, cancel := s.WatchQueue().Watch()
-	defer cancel()
-
-	// Create 405 nodes. Should get split across 3 transactions.
-	committed, err := s.Batch(func(batch *Batch) error {
-		for i := 0; i != 2*MaxChangesPerTransaction+5; i++ {
+	s.View(func(tx ReadTx) {
+		allTasks, err := FindTasks(tx, All)
+		assert.NoError(t, err)
+		assert.Empty(t, allTasks)
+	})
+
+	setupTestStore(t, s)
+
+	err := s.Update(func(tx Tx) error {
+		allTasks, err := FindTasks(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allTasks, len(taskSet))
+
+		assert.Error(t, CreateTask(tx, taskSet[0]), "duplicate IDs must be rejected")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	s.View(func(readTx ReadTx) {
+		assert.Equal(t, taskSet[0], GetTask(readTx, "id1"))
+		assert.Equal(t, taskSet[1], GetTask(readTx, "id2"))
+		assert.Equal(t, taskSet[2], GetTask(readTx, "id3"))
+
+		foundTasks, err := FindTasks(readTx, ByNamePrefix("name1"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 1)
+		foundTasks, err = FindTasks(readTx, ByNamePrefix("name2"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 2)
+		foundTasks, err = FindTasks(readTx, ByNamePrefix("invalid"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 0)
+
+		foundTasks, err = FindTasks(readTx, ByNodeID(nodeSet[0].ID))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 1)
+		assert.Equal(t, foundTasks[0], taskSet[0])
+		foundTasks, err = FindTasks(readTx, ByNodeID("invalid"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 0)
+
+		foundTasks, err = FindTasks(readTx, ByServiceID(serviceSet[0].ID))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 1)
+		assert.Equal(t, foundTasks[0], taskSet[1])
+		foundTasks, err = FindTasks(readTx, ByServiceID("invalid"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 0)
+
+		foundTasks, err = FindTasks(readTx, ByDesiredState(api.TaskStateRunning))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 2)
+		assert.Equal(t, foundTasks[0].DesiredState, api.TaskStateRunning)
+		assert.Equal(t, foundTasks[0].DesiredState, api.TaskStateRunning)
+		foundTasks, err = FindTasks(readTx, ByDesiredState(api.TaskStateShutdown))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 1)
+		assert.Equal(t, foundTasks[0], taskSet[2])
+		foundTasks, err = FindTasks(readTx, ByDesiredState(api.TaskStatePending))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 0)
+	})
+
+	// Update.
+	update := &api.Task{
+		ID: "id3",
+		Annotations: api.Annotations{
+			Name: "name3",
+		},
+		ServiceAnnotations: api.Annotations{
+			Name: "name3",
+		},
+	}
+	err = s.Update(func(tx Tx) error {
+		assert.NotEqual(t, update, GetTask(tx, "id3"))
+		assert.NoError(t, UpdateTask(tx, update))
+		assert.Equal(t, update, GetTask(tx, "id3"))
+
+		foundTasks, err := FindTasks(tx, ByNamePrefix("name2"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 1)
+		foundTasks, err = FindTasks(tx, ByNamePrefix("name3"))
+		assert.NoError(t, err)
+		assert.Len(t, foundTasks, 1)
+
+		invalidUpdate := *taskSet[0]
+		invalidUpdate.ID = "invalid"
+		assert.Error(t, UpdateTask(tx, &invalidUpdate), "invalid IDs should be rejected")
+
+		// Delete
+		assert.NotNil(t, GetTask(tx, "id1"))
+		assert.NoError(t, DeleteTask(tx, "id1"))
+		assert.Nil(t, GetTask(tx, "id1"))
+		foundTasks, err = FindTasks(tx, ByNamePrefix("name1"))
+		assert.NoError(t, err)
+		assert.Empty(t, foundTasks)
+
+		assert.Equal(t, DeleteTask(tx, "nonexistent"), ErrNotExist)
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateClusterRootCA(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	cluster := &api.Cluster{
+		ID: "id1",
+		Spec: api.ClusterSpec{
+			Annotations: api.Annotations{Name: "name1"},
+		},
+		RootCA: api.RootCA{CACert: []byte("root1")},
+	}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateCluster(tx, cluster)
+	}))
+
+	for _, invalid := range []api.RootCA{
+		{},
+		{
+			CACert:       []byte("root1"),
+			RootRotation: &api.RootRotation{CACert: []byte("root2")},
+		},
+		{
+			CACert:          []byte("root1"),
+			QueuedRotations: []*api.RootRotation{{CACert: []byte("root2"), CrossSignedCACert: []byte("cross2")}},
+		},
+		{
+			CACert:          []byte("root1"),
+			RootRotation:    &api.RootRotation{CACert: []byte("root2"), CrossSignedCACert: []byte("cross2")},
+			QueuedRotations: []*api.RootRotation{{CACert: []byte("root3")}},
+		},
+	} {
+		err := s.Update(func(tx Tx) error {
+			return UpdateClusterRootCA(tx, "id1", &invalid)
+		})
+		assert.IsType(t, InvalidRootCAError{}, err)
+	}
+	s.View(func(tx ReadTx) {
+		assert.Equal(t, cluster.RootCA, GetCluster(tx, "id1").RootCA)
+	})
+
+	valid := api.RootCA{
+		CACert:          []byte("root1"),
+		RootRotation:    &api.RootRotation{CACert: []byte("root2"), CrossSignedCACert: []byte("cross2")},
+		QueuedRotations: []*api.RootRotation{{CACert: []byte("root3"), CrossSignedCACert: []byte("cross3")}},
+	}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return UpdateClusterRootCA(tx, "id1", &valid)
+	}))
+	s.View(func(tx ReadTx) {
+		assert.Equal(t, valid, GetCluster(tx, "id1").RootCA)
+	})
+
+	// a rotation to the current root needs no cross-signed certificate
+	noop := api.RootCA{
+		CACert:       []byte("root1"),
+		RootRotation: &api.RootRotation{CACert: []byte("root1")},
+	}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return UpdateClusterRootCA(tx, "id1", &noop)
+	}))
+
+	assert.Equal(t, ErrNotExist, s.Update(func(tx Tx) error {
+		return UpdateClusterRootCA(tx, "id2", &valid)
+	}))
+}
+
+func TestStoreSnapshot(t *testing.T) {
+	s1 := NewMemoryStore(nil)
+	assert.NotNil(t, s1)
+
+	setupTestStore(t, s1)
+
+	s2 := NewMemoryStore(nil)
+	assert.NotNil(t, s2)
+
+	copyToS2 := func(readTx ReadTx) error {
+		return s2.Update(func(tx Tx) error {
+			// Copy over new data
+			nodes, err := FindNodes(readTx, All)
+			if err != nil {
+				return err
+			}
+			for _, n := range nodes {
+				if err := CreateNode(tx, n); err != nil {
+					return err
+				}
+			}
+
+			tasks, err := FindTasks(readTx, All)
+			if err != nil {
+				return err
+			}
+			for _, t := range tasks {
+				if err := CreateTask(tx, t); err != nil {
+					return err
+				}
+			}
+
+			services, err := FindServices(readTx, All)
+			if err != nil {
+				return err
+			}
+			for _, s := range services {
+				if err := CreateService(tx, s); err != nil {
+					return err
+				}
+			}
+
+			networks, err := FindNetworks(readTx, All)
+			if err != nil {
+				return err
+			}
+			for _, n := range networks {
+				if err := CreateNetwork(tx, n); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	// Fork
+	watcher, cancel, err := ViewAndWatch(s1, copyToS2)
+	defer cancel()
+	assert.NoError(t, err)
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Equal(t, nodeSet[0], GetNode(tx2, "id1"))
+		assert.Equal(t, nodeSet[1], GetNode(tx2, "id2"))
+		assert.Equal(t, nodeSet[2], GetNode(tx2, "id3"))
+
+		assert.Equal(t, serviceSet[0], GetService(tx2, "id1"))
+		assert.Equal(t, serviceSet[1], GetService(tx2, "id2"))
+		assert.Equal(t, serviceSet[2], GetService(tx2, "id3"))
+
+		assert.Equal(t, taskSet[0], GetTask(tx2, "id1"))
+		assert.Equal(t, taskSet[1], GetTask(tx2, "id2"))
+		assert.Equal(t, taskSet[2], GetTask(tx2, "id3"))
+	})
+
+	// Create node
+	createNode := &api.Node{
+		ID: "id4",
+		Spec: api.NodeSpec{
+			Annotations: api.Annotations{
+				Name: "name4",
+			},
+		},
+	}
+
+	err = s1.Update(func(tx1 Tx) error {
+		assert.NoError(t, CreateNode(tx1, createNode))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Equal(t, createNode, GetNode(tx2, "id4"))
+	})
+
+	// Update node
+	updateNode := &api.Node{
+		ID: "id3",
+		Spec: api.NodeSpec{
+			Annotations: api.Annotations{
+				Name: "name3",
+			},
+		},
+	}
+
+	err = s1.Update(func(tx1 Tx) error {
+		assert.NoError(t, UpdateNode(tx1, updateNode))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Equal(t, updateNode, GetNode(tx2, "id3"))
+	})
+
+	err = s1.Update(func(tx1 Tx) error {
+		// Delete node
+		assert.NoError(t, DeleteNode(tx1, "id1"))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Nil(t, GetNode(tx2, "id1"))
+	})
+
+	// Create service
+	createService := &api.Service{
+		ID: "id4",
+		Spec: api.ServiceSpec{
+			Annotations: api.Annotations{
+				Name: "name4",
+			},
+		},
+	}
+
+	err = s1.Update(func(tx1 Tx) error {
+		assert.NoError(t, CreateService(tx1, createService))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Equal(t, createService, GetService(tx2, "id4"))
+	})
+
+	// Update service
+	updateService := serviceSet[2].Copy()
+	updateService.Spec.Annotations.Name = "new-name"
+	err = s1.Update(func(tx1 Tx) error {
+		assert.NotEqual(t, updateService, GetService(tx1, updateService.ID))
+		assert.NoError(t, UpdateService(tx1, updateService))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Equal(t, updateService, GetService(tx2, "id3"))
+	})
+
+	err = s1.Update(func(tx1 Tx) error {
+		// Delete service
+		assert.NoError(t, DeleteService(tx1, "id1"))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Nil(t, GetService(tx2, "id1"))
+	})
+
+	// Create task
+	createTask := &api.Task{
+		ID: "id4",
+		ServiceAnnotations: api.Annotations{
+			Name: "name4",
+		},
+	}
+
+	err = s1.Update(func(tx1 Tx) error {
+		assert.NoError(t, CreateTask(tx1, createTask))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Equal(t, createTask, GetTask(tx2, "id4"))
+	})
+
+	// Update task
+	updateTask := &api.Task{
+		ID: "id3",
+		ServiceAnnotations: api.Annotations{
+			Name: "name3",
+		},
+	}
+
+	err = s1.Update(func(tx1 Tx) error {
+		assert.NoError(t, UpdateTask(tx1, updateTask))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Equal(t, updateTask, GetTask(tx2, "id3"))
+	})
+
+	err = s1.Update(func(tx1 Tx) error {
+		// Delete task
+		assert.NoError(t, DeleteTask(tx1, "id1"))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, Apply(s2, <-watcher))
+	<-watcher // consume commit event
+
+	s2.View(func(tx2 ReadTx) {
+		assert.Nil(t, GetTask(tx2, "id1"))
+	})
+}
+
+func TestCustomIndex(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+
+	setupTestStore(t, s)
+
+	// Add a custom index entry to each node
+	err := s.Update(func(tx Tx) error {
+		allNodes, err := FindNodes(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allNodes, len(nodeSet))
+
+		for _, n := range allNodes {
+			switch n.ID {
+			case "id2":
+				n.Spec.Annotations.Indices = []api.IndexEntry{
+					{Key: "nodesbefore", Val: "id1"},
+				}
+				assert.NoError(t, UpdateNode(tx, n))
+			case "id3":
+				n.Spec.Annotations.Indices = []api.IndexEntry{
+					{Key: "nodesbefore", Val: "id1"},
+					{Key: "nodesbefore", Val: "id2"},
+				}
+				assert.NoError(t, UpdateNode(tx, n))
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	s.View(func(readTx ReadTx) {
+		foundNodes, err := FindNodes(readTx, ByCustom("", "nodesbefore", "id2"))
+		require.NoError(t, err)
+		require.Len(t, foundNodes, 1)
+		assert.Equal(t, "id3", foundNodes[0].ID)
+
+		foundNodes, err = FindNodes(readTx, ByCustom("", "nodesbefore", "id1"))
+		require.NoError(t, err)
+		require.Len(t, foundNodes, 2)
+
+		foundNodes, err = FindNodes(readTx, ByCustom("", "nodesbefore", "id3"))
+		require.NoError(t, err)
+		require.Len(t, foundNodes, 0)
+
+		foundNodes, err = FindNodes(readTx, ByCustomPrefix("", "nodesbefore", "id"))
+		require.NoError(t, err)
+		require.Len(t, foundNodes, 2)
+
+		foundNodes, err = FindNodes(readTx, ByCustomPrefix("", "nodesbefore", "id6"))
+		require.NoError(t, err)
+		require.Len(t, foundNodes, 0)
+	})
+}
+
+func TestRegisteredTables(t *testing.T) {
+	tables := RegisteredTables()
+	assert.Contains(t, tables, tableNode)
+	assert.Contains(t, tables, tableNetwork)
+	assert.Len(t, tables, len(schema.Tables))
+
+	// Modifying the result must not affect the registry
+	tables[0] = "modified"
+	assert.NotContains(t, RegisteredTables(), "modified")
+}
+
+func TestSupportedSelectors(t *testing.T) {
+	assert.Equal(t, []string{
+		SelectorName,
+		SelectorNamePrefix,
+		SelectorIDPrefix,
+		SelectorRole,
+		SelectorMembership,
+		SelectorCertIssuer,
+		SelectorCertExpiringBefore,
+		SelectorCertSerial,
+		SelectorCustom,
+		SelectorCustomPrefix,
+	}, SupportedSelectors(tableNode))
+	assert.Equal(t, []string{
+		SelectorName,
+		SelectorNamePrefix,
+		SelectorIDPrefix,
+		SelectorCustom,
+		SelectorCustomPrefix,
+	}, SupportedSelectors(tableNetwork))
+	assert.Nil(t, SupportedSelectors("nonexistent"))
+
+	// The reported selectors must match what Find actually accepts
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	s.View(func(readTx ReadTx) {
+		_, err := FindNodes(readTx, ByMembership(api.NodeMembershipAccepted))
+		assert.NoError(t, err)
+		_, err = FindNetworks(readTx, ByMembership(api.NodeMembershipAccepted))
+		assert.Equal(t, ErrInvalidFindBy, err)
+		_, err = FindNetworks(readTx, Or(ByName("foo"), ByIDPrefix("id")))
+		assert.NoError(t, err)
+	})
+}
+
+// unregisterSelector undoes RegisterSelector, so that tests leave the
+// registered selectors as they found them.
+func unregisterSelector(table, kind string) {
+	os := lookupObjectStorer(table)
+	for i, s := range os.Selectors {
+		if s == kind {
+			os.Selectors = append(os.Selectors[:i:i], os.Selectors[i+1:]...)
+			break
+		}
+	}
+	delete(registeredSelectors[table], kind)
+}
+
+func TestRegisterSelector(t *testing.T) {
+	assert.Equal(t, UnknownTableError{Table: "nonexistent"}, RegisterSelector("nonexistent", "test_node", indexNodeID))
+	assert.Error(t, RegisterSelector(tableTask, "test_node", "nonexistent"))
+	assert.Error(t, RegisterSelector(tableTask, SelectorNode, indexNodeID))
+
+	require.NoError(t, RegisterSelector(tableTask, "test_node", indexNodeID))
+	defer unregisterSelector(tableTask, "test_node")
+	assert.Error(t, RegisterSelector(tableTask, "test_node", indexNodeID))
+	assert.Contains(t, SupportedSelectors(tableTask), "test_node")
+
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	setupTestStore(t, s)
+
+	s.View(func(readTx ReadTx) {
+		tasks, err := FindTasks(readTx, BySelector("test_node", nodeSet[0].ID))
+		assert.NoError(t, err)
+		assert.Equal(t, []*api.Task{taskSet[0]}, tasks)
+
+		tasks, err = FindTasks(readTx, Or(BySelector("test_node", nodeSet[0].ID), ByIDPrefix("id3")))
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 2)
+
+		// the selector is only registered for tasks
+		_, err = FindServices(readTx, BySelector("test_node", nodeSet[0].ID))
+		assert.Equal(t, ErrInvalidFindBy, err)
+	})
+}
+
+func TestFailedTransaction(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+
+	// Create one node
+	err := s.Update(func(tx Tx) error {
+		n := &api.Node{
+			ID: "id1",
+			Description: &api.NodeDescription{
+				Hostname: "name1",
+			},
+		}
+
+		assert.NoError(t, CreateNode(tx, n))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Create a second node, but then roll back the transaction
+	err = s.Update(func(tx Tx) error {
+		n := &api.Node{
+			ID: "id2",
+			Description: &api.NodeDescription{
+				Hostname: "name2",
+			},
+		}
+
+		assert.NoError(t, CreateNode(tx, n))
+		return errors.New("rollback")
+	})
+	assert.Error(t, err)
+
+	s.View(func(tx ReadTx) {
+		foundNodes, err := FindNodes(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, foundNodes, 1)
+		foundNodes, err = FindNodes(tx, ByName("name1"))
+		assert.NoError(t, err)
+		assert.Len(t, foundNodes, 1)
+		foundNodes, err = FindNodes(tx, ByName("name2"))
+		assert.NoError(t, err)
+		assert.Len(t, foundNodes, 0)
+	})
+}
+
+func TestViewSnapshotIsolation(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+
+	setupTestStore(t, s)
+
+	s.View(func(readTx ReadTx) {
+		var seen []string
+		err := readTx.find(tableNode, All, selectorChecker(tableNode), func(o api.StoreObject) {
+			if len(seen) == 0 {
+				// Delete a node that hasn't been visited yet, and wait for
+				// the deletion to commit before continuing the iteration.
+				deleted := make(chan error)
+				go func() {
+					deleted <- s.Update(func(tx Tx) error {
+						for _, n := range nodeSet {
+							if n.ID != o.GetID() {
+								return DeleteNode(tx, n.ID)
+							}
+						}
+						return nil
+					})
+				}()
+				assert.NoError(t, <-deleted)
+			}
+			seen = append(seen, o.GetID())
+		})
+		assert.NoError(t, err)
+		assert.Len(t, seen, len(nodeSet))
+
+		// The rest of the transaction still sees the original snapshot too
+		foundNodes, err := FindNodes(readTx, All)
+		assert.NoError(t, err)
+		assert.Len(t, foundNodes, len(nodeSet))
+	})
+
+	s.View(func(readTx ReadTx) {
+		foundNodes, err := FindNodes(readTx, All)
+		assert.NoError(t, err)
+		assert.Len(t, foundNodes, len(nodeSet)-1)
+	})
+}
+
+func TestViewCrossTableSnapshot(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNode(tx, &api.Node{ID: "node1"}); err != nil {
+			return err
+		}
+		return CreateCluster(tx, &api.Cluster{
+			ID:   "cluster1",
+			Spec: api.ClusterSpec{Annotations: api.Annotations{Name: "name1"}},
+		})
+	}))
+
+	// updateBoth labels the node and the cluster with generation in a single
+	// transaction
+	updateBoth := func(generation string) error {
+		return s.Update(func(tx Tx) error {
+			node := GetNode(tx, "node1")
+			node.Spec.Annotations.Labels = map[string]string{"generation": generation}
+			if err := UpdateNode(tx, node); err != nil {
+				return err
+			}
+			cluster := GetCluster(tx, "cluster1")
+			cluster.Spec.Annotations.Labels = map[string]string{"generation": generation}
+			return UpdateCluster(tx, cluster)
+		})
+	}
+
+	// an update committed between reading the node and reading the cluster
+	// is visible to neither
+	s.View(func(readTx ReadTx) {
+		nodes, err := FindNodes(readTx, All)
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+
+		updated := make(chan error)
+		go func() {
+			updated <- updateBoth("1")
+		}()
+		require.NoError(t, <-updated)
+
+		cluster := GetCluster(readTx, "cluster1")
+		assert.Equal(t, nodes[0].Spec.Annotations.Labels["generation"], cluster.Spec.Annotations.Labels["generation"])
+		assert.Empty(t, cluster.Spec.Annotations.Labels["generation"])
+	})
+	s.View(func(readTx ReadTx) {
+		assert.Equal(t, "1", GetNode(readTx, "node1").Spec.Annotations.Labels["generation"])
+		assert.Equal(t, "1", GetCluster(readTx, "cluster1").Spec.Annotations.Labels["generation"])
+	})
+
+	// no View ever sees one of the changes without the other, however the
+	// reads interleave with the commits
+	done := make(chan struct{})
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		for i := 2; ; i++ {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			assert.NoError(t, updateBoth(strconv.Itoa(i)))
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		s.View(func(readTx ReadTx) {
+			node := GetNode(readTx, "node1")
+			cluster := GetCluster(readTx, "cluster1")
+			require.Equal(t, node.Spec.Annotations.Labels["generation"], cluster.Spec.Annotations.Labels["generation"])
+		})
+	}
+	close(done)
+	<-writerDone
+}
+
+func TestVerifyIndexes(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+
+	setupTestStore(t, s)
+	assert.NoError(t, s.Update(func(tx Tx) error {
+		assert.NoError(t, UpdateNode(tx, &api.Node{
+			ID:          "id1",
+			Meta:        nodeSet[0].Meta,
+			Description: &api.NodeDescription{Hostname: "renamed"},
+		}))
+		assert.NoError(t, DeleteTask(tx, "id2"))
+		return CreateNode(tx, &api.Node{ID: "nodescription"})
+	}))
+
+	s.View(func(readTx ReadTx) {
+		discrepancies, err := VerifyIndexes(readTx)
+		assert.NoError(t, err)
+		assert.Empty(t, discrepancies)
+	})
+
+	// Modify a stored object in place, which memdb doesn't know about, so
+	// that it now belongs in the name index but has no entry there.
+	s.View(func(tx ReadTx) {
+		obj, err := tx.(readTx).memDBTx.First(tableNode, indexID, "nodescription")
+		assert.NoError(t, err)
+		obj.(*api.Node).Description = &api.NodeDescription{Hostname: "seeded"}
+	})
+
+	s.View(func(readTx ReadTx) {
+		discrepancies, err := VerifyIndexes(readTx)
+		assert.NoError(t, err)
+		assert.Equal(t, []IndexDiscrepancy{{
+			Table:  tableNode,
+			Index:  indexName,
+			ID:     "nodescription",
+			Reason: "expected 1 index entries, found 0",
+		}}, discrepancies)
+	})
+}
+
+func TestVersion(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
+
+	var (
+		retrievedNode  *api.Node
+		retrievedNode2 *api.Node
+	)
+
+	// Create one node
+	n := &api.Node{
+		ID: "id1",
+		Spec: api.NodeSpec{
+			Annotations: api.Annotations{
+				Name: "name1",
+			},
+		},
+	}
+	err := s.Update(func(tx Tx) error {
+		assert.NoError(t, CreateNode(tx, n))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Update the node using an object fetched from the store.
+	n.Spec.Annotations.Name = "name2"
+	err = s.Update(func(tx Tx) error {
+		assert.NoError(t, UpdateNode(tx, n))
+		retrievedNode = GetNode(tx, n.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Make sure the store is updating our local copy with the version.
+	assert.Equal(t, n.Meta.Version, retrievedNode.Meta.Version)
+
+	// Try again, this time using the retrieved node.
+	retrievedNode.Spec.Annotations.Name = "name2"
+	err = s.Update(func(tx Tx) error {
+		assert.NoError(t, UpdateNode(tx, retrievedNode))
+		retrievedNode2 = GetNode(tx, n.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Try to update retrievedNode again. This should fail because it was
+	// already used to perform an update.
+	retrievedNode.Spec.Annotations.Name = "name3"
+	err = s.Update(func(tx Tx) error {
+		assert.Equal(t, ErrSequenceConflict, UpdateNode(tx, n))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// But using retrievedNode2 should work, since it has the latest
+	// sequence information.
+	retrievedNode2.Spec.Annotations.Name = "name3"
+	err = s.Update(func(tx Tx) error {
+		assert.NoError(t, UpdateNode(tx, retrievedNode2))
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestTimestamps(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
+
+	var (
+		retrievedNode *api.Node
+		updatedNode   *api.Node
+	)
+
+	// Create one node
+	n := &api.Node{
+		ID: "id1",
+		Spec: api.NodeSpec{
+			Annotations: api.Annotations{
+				Name: "name1",
+			},
+		},
+	}
+	err := s.Update(func(tx Tx) error {
+		assert.NoError(t, CreateNode(tx, n))
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Make sure our local copy got updated.
+	assert.NotZero(t, n.Meta.CreatedAt)
+	assert.NotZero(t, n.Meta.UpdatedAt)
+	// Since this is a new node, CreatedAt should equal UpdatedAt.
+	assert.Equal(t, n.Meta.CreatedAt, n.Meta.UpdatedAt)
+
+	// Fetch the node from the store and make sure timestamps match.
+	s.View(func(tx ReadTx) {
+		retrievedNode = GetNode(tx, n.ID)
+	})
+	assert.Equal(t, retrievedNode.Meta.CreatedAt, n.Meta.CreatedAt)
+	assert.Equal(t, retrievedNode.Meta.UpdatedAt, n.Meta.UpdatedAt)
+
+	// Make an update.
+	retrievedNode.Spec.Annotations.Name = "name2"
+	err = s.Update(func(tx Tx) error {
+		assert.NoError(t, UpdateNode(tx, retrievedNode))
+		updatedNode = GetNode(tx, n.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Ensure `CreatedAt` is the same after the update and `UpdatedAt` got updated.
+	assert.Equal(t, updatedNode.Meta.CreatedAt, n.Meta.CreatedAt)
+	assert.NotEqual(t, updatedNode.Meta.CreatedAt, updatedNode.Meta.UpdatedAt)
+}
+
+func TestBatch(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
+
+	watch, cancel := s.WatchQueue().Watch()
+	defer cancel()
+
+	// Create 405 nodes. Should get split across 3 transactions.
+	committed, err := s.Batch(func(batch *Batch) error {
+		for i := 0; i != 2*MaxChangesPerTransaction+5; i++ {
+			n := &api.Node{
+				ID: "id" + strconv.Itoa(i),
+				Spec: api.NodeSpec{
+					Annotations: api.Annotations{
+						Name: "name" + strconv.Itoa(i),
+					},
+				},
+			}
+
+			batch.Update(func(tx Tx) error {
+				assert.NoError(t, CreateNode(tx, n))
+				return nil
+			})
+		}
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2*MaxChangesPerTransaction+5, committed)
+
+	for i := 0; i != MaxChangesPerTransaction; i++ {
+		event := <-watch
+		if _, ok := event.(api.EventCreateNode); !ok {
+			t.Fatalf("expected EventCreateNode; got %#v", event)
+		}
+	}
+	event := <-watch
+	if _, ok := event.(state.EventCommit); !ok {
+		t.Fatalf("expected EventCommit; got %#v", event)
+	}
+	for i := 0; i != MaxChangesPerTransaction; i++ {
+		event := <-watch
+		if _, ok := event.(api.EventCreateNode); !ok {
+			t.Fatalf("expected EventCreateNode; got %#v", event)
+		}
+	}
+	event = <-watch
+	if _, ok := event.(state.EventCommit); !ok {
+		t.Fatalf("expected EventCommit; got %#v", event)
+	}
+	for i := 0; i != 5; i++ {
+		event := <-watch
+		if _, ok := event.(api.EventCreateNode); !ok {
+			t.Fatalf("expected EventCreateNode; got %#v", event)
+		}
+	}
+	event = <-watch
+	if _, ok := event.(state.EventCommit); !ok {
+		t.Fatalf("expected EventCommit; got %#v", event)
+	}
+}
+
+func TestBatchFailure(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
+
+	watch, cancel := s.WatchQueue().Watch()
+	defer cancel()
+
+	// Return an error partway through a transaction.
+	committed, err := s.Batch(func(batch *Batch) error {
+		for i := 0; ; i++ {
 			n := &api.Node{
 				ID: "id" + strconv.Itoa(i),
 				Spec: api.NodeSpec{
@@ -1038,147 +2451,620 @@ func TestBatch(t *testing.T) {
 					},
 				},
 			}
-
-			batch.Update(func(tx Tx) error {
-				assert.NoError(t, CreateNode(tx, n))
-				return nil
-			})
+
+			batch.Update(func(tx Tx) error {
+				assert.NoError(t, CreateNode(tx, n))
+				return nil
+			})
+			if i == MaxChangesPerTransaction+8 {
+				return errors.New("failing the current tx")
+			}
+		}
+	})
+	assert.Error(t, err)
+	assert.Equal(t, MaxChangesPerTransaction, committed)
+
+	for i := 0; i != MaxChangesPerTransaction; i++ {
+		event := <-watch
+		if _, ok := event.(api.EventCreateNode); !ok {
+			t.Fatalf("expected EventCreateNode; got %#v", event)
+		}
+	}
+	event := <-watch
+	if _, ok := event.(state.EventCommit); !ok {
+		t.Fatalf("expected EventCommit; got %#v", event)
+	}
+
+	// Shouldn't be anything after the first transaction
+	select {
+	case <-watch:
+		t.Fatal("unexpected additional events")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestBatchTableLimit(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	assert.NotNil(t, s)
+
+	assert.Error(t, s.SetBatchLimit("nosuchtable", BatchLimit{Bytes: 1}))
+	assert.Error(t, s.SetBatchLimit(tableNode, BatchLimit{Bytes: -1}))
+	const byteLimit = 64 * 1024
+	require.NoError(t, s.SetBatchLimit(tableNode, BatchLimit{Bytes: byteLimit}))
+
+	watch, cancel := s.WatchQueue().Watch()
+	defer cancel()
+
+	// Each node is a few kilobytes, so the byte limit is reached long before
+	// MaxChangesPerTransaction. The small tasks in between don't count
+	// towards it.
+	const numNodes = 50
+	committed, err := s.Batch(func(batch *Batch) error {
+		for i := 0; i != numNodes; i++ {
+			n := &api.Node{
+				ID: "id" + strconv.Itoa(i),
+				Description: &api.NodeDescription{
+					Hostname: "name" + strconv.Itoa(i),
+					Engine: &api.EngineDescription{
+						Labels: map[string]string{"large": strings.Repeat("x", 4096)},
+					},
+				},
+			}
+			task := &api.Task{ID: "task" + strconv.Itoa(i)}
+
+			if err := batch.Update(func(tx Tx) error {
+				if err := CreateNode(tx, n); err != nil {
+					return err
+				}
+				return CreateTask(tx, task)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, numNodes, committed)
+
+	var transactions, nodes, nodeBytes, lastNodeBytes int
+	for nodes != numNodes || transactions == 0 {
+		switch event := (<-watch).(type) {
+		case api.EventCreateNode:
+			sa, err := api.NewStoreAction(event)
+			require.NoError(t, err)
+			lastNodeBytes = sa.Size()
+			nodeBytes += lastNodeBytes
+			nodes++
+		case api.EventCreateTask:
+		case state.EventCommit:
+			transactions++
+			if nodes != numNodes {
+				// every transaction but the last is committed as soon as
+				// the node changes reach the limit
+				assert.True(t, nodeBytes >= byteLimit, "transaction %d has %d bytes of node changes", transactions, nodeBytes)
+				assert.True(t, nodeBytes-lastNodeBytes < byteLimit, "transaction %d has %d bytes of node changes", transactions, nodeBytes)
+			}
+			nodeBytes = 0
+		default:
+			t.Fatalf("unexpected event %#v", event)
+		}
+	}
+	assert.True(t, transactions > 1)
+
+	// without a limit, the same changes fit in a single transaction
+	require.NoError(t, s.SetBatchLimit(tableNode, BatchLimit{}))
+	commits := 0
+	_, err = s.Batch(func(batch *Batch) error {
+		for i := 0; i != numNodes; i++ {
+			id := "id" + strconv.Itoa(i)
+			if err := batch.Update(func(tx Tx) error {
+				return DeleteNode(tx, id)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	for i := 0; i != numNodes+1; i++ {
+		if _, ok := (<-watch).(state.EventCommit); ok {
+			commits++
+		}
+	}
+	assert.Equal(t, 1, commits)
+}
+
+func TestStoreSaveRestore(t *testing.T) {
+	s1 := NewMemoryStore(nil)
+	assert.NotNil(t, s1)
+
+	setupTestStore(t, s1)
+
+	var snapshot *api.StoreSnapshot
+	s1.View(func(tx ReadTx) {
+		var err error
+		snapshot, err = s1.Save(tx)
+		assert.NoError(t, err)
+	})
+
+	s2 := NewMemoryStore(nil)
+	assert.NotNil(t, s2)
+
+	err := s2.Restore(snapshot)
+	assert.NoError(t, err)
+
+	s2.View(func(tx ReadTx) {
+		allTasks, err := FindTasks(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allTasks, len(taskSet))
+		for i := range allTasks {
+			assert.Equal(t, allTasks[i], taskSet[i])
+		}
+
+		allNodes, err := FindNodes(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allNodes, len(nodeSet))
+		for i := range allNodes {
+			assert.Equal(t, allNodes[i], nodeSet[i])
+		}
+
+		allNetworks, err := FindNetworks(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allNetworks, len(networkSet))
+		for i := range allNetworks {
+			assert.Equal(t, allNetworks[i], networkSet[i])
+		}
+
+		allServices, err := FindServices(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allServices, len(serviceSet))
+		for i := range allServices {
+			assert.Equal(t, allServices[i], serviceSet[i])
+		}
+	})
+}
+
+// roundTripCheck saves the contents of s to a snapshot, restores the snapshot
+// into a fresh store, and checks that every table holds the same objects in
+// both, so that data dropped by a table's Save or Restore is caught.
+func roundTripCheck(t *testing.T, s *MemoryStore) {
+	var (
+		snapshot *api.StoreSnapshot
+		err      error
+	)
+	s.View(func(tx ReadTx) {
+		snapshot, err = s.Save(tx)
+	})
+	require.NoError(t, err)
+
+	restored := NewMemoryStore(nil)
+	defer restored.Close()
+	require.NoError(t, restored.Restore(snapshot))
+
+	for _, table := range RegisteredTables() {
+		assert.Equal(t, tableContents(t, s, table), tableContents(t, restored, table), "table %s differs after a round trip", table)
+	}
+}
+
+// tableContents returns every object in table, including soft-deleted ones,
+// in ID order.
+func tableContents(t *testing.T, s *MemoryStore, table string) []api.StoreObject {
+	memDBTx := s.memDB.Txn(false)
+	defer memDBTx.Abort()
+
+	it, err := memDBTx.Get(table, indexID)
+	require.NoError(t, err)
+	var objects []api.StoreObject
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		objects = append(objects, obj.(api.StoreObject))
+	}
+	return objects
+}
+
+func TestStoreSaveRestoreRoundTrip(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	defer s.Close()
+	require.NoError(t, s.EnableNodeSoftDelete(time.Hour))
+	setupTestStore(t, s)
+
+	notAfter, err := gogotypes.TimestampProto(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNode(tx, &api.Node{
+			ID: "populated",
+			Spec: api.NodeSpec{
+				Annotations:  api.Annotations{Name: "populated", Labels: map[string]string{"a": "b"}},
+				Membership:   api.NodeMembershipAccepted,
+				Availability: api.NodeAvailabilityDrain,
+			},
+			Description: &api.NodeDescription{
+				Hostname: "populated",
+				Platform: &api.Platform{Architecture: "x86_64", OS: "linux"},
+				Engine:   &api.EngineDescription{EngineVersion: "17.06", Labels: map[string]string{"c": "d"}},
+				TLSInfo:  &api.NodeTLSInfo{TrustRoot: []byte("root"), CertIssuerSubject: []byte("subject"), CertIssuerPublicKey: []byte("key")},
+			},
+			Status:        api.NodeStatus{State: api.NodeStatus_READY, Addr: "10.0.0.1"},
+			ManagerStatus: &api.ManagerStatus{RaftID: 1, Addr: "10.0.0.1:4242", Leader: true},
+			Certificate: api.Certificate{
+				Role:        api.NodeRoleManager,
+				CN:          "populated",
+				Certificate: []byte("certificate"),
+				Status:      api.IssuanceStatus{State: api.IssuanceStateIssued},
+				Serial:      "abcdef",
+				NotAfter:    notAfter,
+			},
+			Role: api.NodeRoleManager,
+		}); err != nil {
+			return err
+		}
+		if err := CreateNetwork(tx, &api.Network{
+			ID: "populated",
+			Spec: api.NetworkSpec{
+				Annotations:  api.Annotations{Name: "populated", Labels: map[string]string{"a": "b"}},
+				DriverConfig: &api.Driver{Name: "overlay", Options: map[string]string{"encrypted": "true"}},
+				IPAM: &api.IPAMOptions{
+					Driver:  &api.Driver{Name: "default"},
+					Configs: []*api.IPAMConfig{{Subnet: "10.1.0.0/24", Gateway: "10.1.0.1"}},
+				},
+				Attachable: true,
+			},
+			DriverState: &api.Driver{Name: "overlay", Options: map[string]string{"vxlan": "4097"}},
+		}); err != nil {
+			return err
+		}
+		// soft-deleted nodes are kept in snapshots too
+		return DeleteNode(tx, "id3")
+	}))
+	require.Len(t, tableContents(t, s, tableNode), len(nodeSet)+1)
+	require.Len(t, tableContents(t, s, tableNetwork), len(networkSet)+1)
+
+	roundTripCheck(t, s)
+}
+
+func TestStoreSaveRestoreTable(t *testing.T) {
+	s1 := NewMemoryStore(nil)
+	assert.NotNil(t, s1)
+
+	setupTestStore(t, s1)
+
+	var snapshot proto.Message
+	s1.View(func(tx ReadTx) {
+		var err error
+		snapshot, err = s1.SaveTable(tx, tableNode)
+		assert.NoError(t, err)
+
+		_, err = s1.SaveTable(tx, "nonexistent")
+		assert.Equal(t, UnknownTableError{Table: "nonexistent"}, err)
+	})
+	require.IsType(t, &api.StoreSnapshot{}, snapshot)
+	assert.Len(t, snapshot.(*api.StoreSnapshot).Nodes, len(nodeSet))
+	assert.Empty(t, snapshot.(*api.StoreSnapshot).Networks)
+
+	s2 := NewMemoryStore(nil)
+	assert.NotNil(t, s2)
+
+	err := s2.Update(func(tx Tx) error {
+		if err := CreateNode(tx, &api.Node{ID: "existing"}); err != nil {
+			return err
+		}
+		for _, n := range networkSet {
+			if err := CreateNetwork(tx, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, UnknownTableError{Table: "nonexistent"}, s2.RestoreTable("nonexistent", snapshot))
+	require.NoError(t, s2.RestoreTable(tableNode, snapshot))
+
+	s2.View(func(tx ReadTx) {
+		// the node table is replaced
+		allNodes, err := FindNodes(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allNodes, len(nodeSet))
+		for i := range allNodes {
+			assert.Equal(t, allNodes[i], nodeSet[i])
+		}
+
+		// and the networks are untouched
+		allNetworks, err := FindNetworks(tx, All)
+		assert.NoError(t, err)
+		assert.Len(t, allNetworks, len(networkSet))
+		for i := range allNetworks {
+			assert.Equal(t, allNetworks[i], networkSet[i])
+		}
+
+		allServices, err := FindServices(tx, All)
+		assert.NoError(t, err)
+		assert.Empty(t, allServices)
+	})
+}
+
+func TestStoreRestoreSuppressEvents(t *testing.T) {
+	s1 := NewMemoryStore(nil)
+	defer s1.Close()
+	setupTestStore(t, s1)
+	require.NoError(t, s1.Update(func(tx Tx) error {
+		for i := 0; i < 1000; i++ {
+			if err := CreateNode(tx, &api.Node{ID: "bulk" + strconv.Itoa(i)}); err != nil {
+				return err
+			}
 		}
-
 		return nil
+	}))
+
+	var snapshot *api.StoreSnapshot
+	s1.View(func(tx ReadTx) {
+		var err error
+		snapshot, err = s1.Save(tx)
+		require.NoError(t, err)
 	})
-	assert.NoError(t, err)
-	assert.Equal(t, 2*MaxChangesPerTransaction+5, committed)
 
-	for i := 0; i != MaxChangesPerTransaction; i++ {
-		event := <-watch
-		if _, ok := event.(api.EventCreateNode); !ok {
-			t.Fatalf("expected EventCreateNode; got %#v", event)
-		}
-	}
-	event := <-watch
-	if _, ok := event.(state.EventCommit); !ok {
-		t.Fatalf("expected EventCommit; got %#v", event)
-	}
-	for i := 0; i != MaxChangesPerTransaction; i++ {
-		event := <-watch
-		if _, ok := event.(api.EventCreateNode); !ok {
-			t.Fatalf("expected EventCreateNode; got %#v", event)
-		}
-	}
-	event = <-watch
-	if _, ok := event.(state.EventCommit); !ok {
-		t.Fatalf("expected EventCommit; got %#v", event)
-	}
-	for i := 0; i != 5; i++ {
-		event := <-watch
-		if _, ok := event.(api.EventCreateNode); !ok {
-			t.Fatalf("expected EventCreateNode; got %#v", event)
+	// by default, each object deleted and created is published
+	s2 := NewMemoryStore(nil)
+	defer s2.Close()
+	setupTestStore(t, s2)
+	watch, cancel := s2.WatchQueue().Watch()
+	require.NoError(t, s2.Restore(snapshot))
+	published := 0
+	for event := range watch {
+		if _, ok := event.(state.EventCommit); ok {
+			break
 		}
+		published++
 	}
-	event = <-watch
-	if _, ok := event.(state.EventCommit); !ok {
-		t.Fatalf("expected EventCommit; got %#v", event)
+	assert.True(t, published > 1000)
+	cancel()
+
+	s3 := NewMemoryStore(nil)
+	defer s3.Close()
+	s3.SuppressRestoreEvents()
+	setupTestStore(t, s3)
+	watch, cancel = s3.WatchQueue().Watch()
+	defer cancel()
+	summary, summaryCancel := state.Watch(s3.WatchQueue(), state.EventStoreRestored{})
+	defer summaryCancel()
+
+	require.NoError(t, s3.Restore(snapshot))
+	assert.IsType(t, state.EventStoreRestored{}, <-watch)
+	assert.IsType(t, state.EventCommit{}, <-watch)
+	assert.IsType(t, state.EventStoreRestored{}, <-summary)
+	select {
+	case event := <-watch:
+		t.Fatalf("unexpected event %v", event)
+	case <-time.After(100 * time.Millisecond):
 	}
+	s3.View(func(tx ReadTx) {
+		nodes, err := FindNodes(tx, All)
+		require.NoError(t, err)
+		assert.Len(t, nodes, len(nodeSet)+1000)
+	})
+
+	// other transactions still publish their events
+	require.NoError(t, s3.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "after"})
+	}))
+	assert.IsType(t, api.EventCreateNode{}, <-watch)
+	assert.IsType(t, state.EventCommit{}, <-watch)
 }
 
-func TestBatchFailure(t *testing.T) {
-	s := NewMemoryStore(&testutils.MockProposer{})
-	assert.NotNil(t, s)
+func TestStoreSaveRestoreEncrypted(t *testing.T) {
+	key := encryption.GenerateSecretKey()
+	s1 := NewMemoryStoreWithSnapshotKey(nil, key)
+	assert.NotNil(t, s1)
 
-	watch, cancel := s.WatchQueue().Watch()
-	defer cancel()
+	setupTestStore(t, s1)
 
-	// Return an error partway through a transaction.
-	committed, err := s.Batch(func(batch *Batch) error {
-		for i := 0; ; i++ {
-			n := &api.Node{
-				ID: "id" + strconv.Itoa(i),
-				Spec: api.NodeSpec{
-					Annotations: api.Annotations{
-						Name: "name" + strconv.Itoa(i),
-					},
-				},
-			}
+	var (
+		snapshot   *api.StoreSnapshot
+		serialized []byte
+	)
+	s1.View(func(tx ReadTx) {
+		var err error
+		snapshot, err = s1.Save(tx)
+		assert.NoError(t, err)
+		serialized, err = s1.SaveSerialized(tx)
+		assert.NoError(t, err)
+	})
 
-			batch.Update(func(tx Tx) error {
-				assert.NoError(t, CreateNode(tx, n))
-				return nil
-			})
-			if i == MaxChangesPerTransaction+8 {
-				return errors.New("failing the current tx")
-			}
-		}
+	// The serialized snapshot must not contain any plaintext
+	plaintext, err := proto.Marshal(snapshot)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(serialized, []byte("name1")))
+
+	s2 := NewMemoryStoreWithSnapshotKey(nil, key)
+	require.NoError(t, s2.RestoreSerialized(serialized))
+
+	var restored *api.StoreSnapshot
+	s2.View(func(tx ReadTx) {
+		restored, err = s2.Save(tx)
+		assert.NoError(t, err)
 	})
-	assert.Error(t, err)
-	assert.Equal(t, MaxChangesPerTransaction, committed)
+	assert.Equal(t, snapshot, restored)
+
+	// A store with a different key can't restore the snapshot
+	s3 := NewMemoryStoreWithSnapshotKey(nil, encryption.GenerateSecretKey())
+	err = s3.RestoreSerialized(serialized)
+	assert.IsType(t, encryption.ErrCannotDecrypt{}, err)
+
+	// Neither can a store without a key
+	s4 := NewMemoryStore(nil)
+	err = s4.RestoreSerialized(serialized)
+	assert.IsType(t, encryption.ErrCannotDecrypt{}, err)
+
+	// A plaintext snapshot can still be restored into a store with a key
+	s5 := NewMemoryStoreWithSnapshotKey(nil, key)
+	require.NoError(t, s5.RestoreSerialized(plaintext))
+	s5.View(func(tx ReadTx) {
+		restored, err = s5.Save(tx)
+		assert.NoError(t, err)
+	})
+	assert.Equal(t, snapshot, restored)
+}
 
-	for i := 0; i != MaxChangesPerTransaction; i++ {
-		event := <-watch
-		if _, ok := event.(api.EventCreateNode); !ok {
-			t.Fatalf("expected EventCreateNode; got %#v", event)
-		}
-	}
-	event := <-watch
-	if _, ok := event.(state.EventCommit); !ok {
-		t.Fatalf("expected EventCommit; got %#v", event)
-	}
+func TestStoreSaveRestoreChecksum(t *testing.T) {
+	s1 := NewMemoryStore(nil)
+	assert.NotNil(t, s1)
 
-	// Shouldn't be anything after the first transaction
-	select {
-	case <-watch:
-		t.Fatal("unexpected additional events")
-	case <-time.After(50 * time.Millisecond):
+	setupTestStore(t, s1)
+
+	var (
+		snapshot   *api.StoreSnapshot
+		serialized []byte
+	)
+	s1.View(func(tx ReadTx) {
+		var err error
+		snapshot, err = s1.Save(tx)
+		assert.NoError(t, err)
+		serialized, err = s1.SaveSerialized(tx)
+		assert.NoError(t, err)
+	})
+
+	s2 := NewMemoryStore(nil)
+	require.NoError(t, s2.RestoreSerialized(serialized))
+	var restored *api.StoreSnapshot
+	s2.View(func(tx ReadTx) {
+		var err error
+		restored, err = s2.Save(tx)
+		assert.NoError(t, err)
+	})
+	assert.Equal(t, snapshot, restored)
+
+	// Corrupting the content or the checksum is detected
+	for _, i := range []int{len(serialized) / 2, len(serialized) - 1} {
+		corrupt := append([]byte(nil), serialized...)
+		corrupt[i] ^= 0xff
+		assert.Equal(t, ErrSnapshotCorrupt, NewMemoryStore(nil).RestoreSerialized(corrupt), "byte %d", i)
 	}
+
+	// A snapshot without a checksum can still be restored
+	plaintext, err := proto.Marshal(snapshot)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(serialized, plaintext))
+	s3 := NewMemoryStore(nil)
+	require.NoError(t, s3.RestoreSerialized(plaintext))
+	s3.View(func(tx ReadTx) {
+		restored, err = s3.Save(tx)
+		assert.NoError(t, err)
+	})
+	assert.Equal(t, snapshot, restored)
 }
 
-func TestStoreSaveRestore(t *testing.T) {
+func TestStoreSaveRestoreStream(t *testing.T) {
 	s1 := NewMemoryStore(nil)
 	assert.NotNil(t, s1)
 
 	setupTestStore(t, s1)
+	err := s1.Update(func(tx Tx) error {
+		for i := 0; i < 2000; i++ {
+			task := &api.Task{
+				ID:        "streamtask" + strconv.Itoa(i),
+				ServiceID: "id1",
+				NodeID:    "id1",
+				Slot:      uint64(i),
+			}
+			if err := CreateTask(tx, task); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
 
-	var snapshot *api.StoreSnapshot
+	var (
+		snapshot *api.StoreSnapshot
+		buf      bytes.Buffer
+	)
 	s1.View(func(tx ReadTx) {
 		var err error
 		snapshot, err = s1.Save(tx)
 		assert.NoError(t, err)
+		assert.NoError(t, s1.SaveStream(tx, &buf))
 	})
 
+	// Restore into a store with unrelated contents, which must be replaced
 	s2 := NewMemoryStore(nil)
 	assert.NotNil(t, s2)
+	assert.NoError(t, s2.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "stale"})
+	}))
+	assert.NoError(t, s2.RestoreStream(&buf))
 
-	err := s2.Restore(snapshot)
-	assert.NoError(t, err)
+	s3 := NewMemoryStore(nil)
+	assert.NotNil(t, s3)
+	assert.NoError(t, s3.Restore(snapshot))
 
+	var streamed, buffered *api.StoreSnapshot
 	s2.View(func(tx ReadTx) {
-		allTasks, err := FindTasks(tx, All)
+		streamed, err = s2.Save(tx)
 		assert.NoError(t, err)
-		assert.Len(t, allTasks, len(taskSet))
-		for i := range allTasks {
-			assert.Equal(t, allTasks[i], taskSet[i])
-		}
-
-		allNodes, err := FindNodes(tx, All)
+	})
+	s3.View(func(tx ReadTx) {
+		buffered, err = s3.Save(tx)
 		assert.NoError(t, err)
-		assert.Len(t, allNodes, len(nodeSet))
-		for i := range allNodes {
-			assert.Equal(t, allNodes[i], nodeSet[i])
-		}
+	})
+	assert.Len(t, streamed.Tasks, len(taskSet)+2000)
+	assert.Equal(t, snapshot, streamed)
+	assert.Equal(t, buffered, streamed)
 
-		allNetworks, err := FindNetworks(tx, All)
+	// A truncated stream is rejected, and leaves the store untouched
+	var truncated bytes.Buffer
+	s1.View(func(tx ReadTx) {
+		assert.NoError(t, s1.SaveStream(tx, &truncated))
+	})
+	truncated.Truncate(truncated.Len() - 1)
+	assert.Error(t, s2.RestoreStream(&truncated))
+	s2.View(func(tx ReadTx) {
+		afterFailure, err := s2.Save(tx)
 		assert.NoError(t, err)
-		assert.Len(t, allNetworks, len(networkSet))
-		for i := range allNetworks {
-			assert.Equal(t, allNetworks[i], networkSet[i])
-		}
+		assert.Equal(t, streamed, afterFailure)
+	})
 
-		allServices, err := FindServices(tx, All)
+	// So are length prefixes that are larger than any object, or larger
+	// than what is left of the stream
+	for _, size := range []uint64{1 << 62, MaxTransactionBytes + 1, 1024} {
+		prefix := make([]byte, binary.MaxVarintLen64)
+		corrupt := bytes.NewBuffer(prefix[:binary.PutUvarint(prefix, size)])
+		corrupt.WriteString("short")
+		assert.Error(t, s2.RestoreStream(corrupt))
+	}
+	s2.View(func(tx ReadTx) {
+		afterFailure, err := s2.Save(tx)
 		assert.NoError(t, err)
-		assert.Len(t, allServices, len(serviceSet))
-		for i := range allServices {
-			assert.Equal(t, allServices[i], serviceSet[i])
+		assert.Equal(t, streamed, afterFailure)
+	})
+}
+
+func TestStoreSaveRestoreStreamTombstones(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+	require.NoError(t, s.EnableNodeSoftDelete(time.Hour))
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNode(tx, &api.Node{ID: "live"}); err != nil {
+			return err
 		}
+		return CreateNode(tx, &api.Node{ID: "deleted"})
+	}))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNode(tx, "deleted")
+	}))
+
+	var buf bytes.Buffer
+	s.View(func(tx ReadTx) {
+		require.NoError(t, s.SaveStream(tx, &buf))
+	})
+
+	// restoring into a store that still holds the tombstone replaces it
+	require.NoError(t, s.RestoreStream(&buf))
+	s.View(func(tx ReadTx) {
+		assert.NotNil(t, GetNode(tx, "live"))
+		assert.Nil(t, GetNode(tx, "deleted"))
+		deleted := GetNode(IncludeDeleted(tx), "deleted")
+		require.NotNil(t, deleted)
+		assert.NotNil(t, deleted.DeletedAt)
 	})
 }
 
@@ -1384,6 +3270,202 @@ func TestWatchFrom(t *testing.T) {
 	}
 }
 
+func TestWatchBatched(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	batchq, cancel := WatchBatched(s, 100*time.Millisecond, api.EventCreateNode{})
+	defer cancel()
+
+	const numNodes = 100
+	for i := 0; i != numNodes; i++ {
+		require.NoError(t, s.Update(func(tx Tx) error {
+			return CreateNode(tx, &api.Node{ID: "id" + strconv.Itoa(i)})
+		}))
+	}
+
+	var received []string
+	deliveries := 0
+	for len(received) < numNodes {
+		select {
+		case batch := <-batchq:
+			deliveries++
+			for _, event := range batch {
+				received = append(received, event.(api.EventCreateNode).Node.ID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d of %d events", len(received), numNodes)
+		}
+	}
+
+	// the events arrive in order, in a few batches rather than one by one
+	for i, id := range received {
+		assert.Equal(t, "id"+strconv.Itoa(i), id)
+	}
+	assert.True(t, deliveries < numNodes/10, "%d deliveries", deliveries)
+}
+
+func TestWatchBatchedCancel(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	batchq, cancel := WatchBatched(s, time.Hour, api.EventCreateNode{})
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+
+	// the pending batch is dropped and the channel is closed, rather than
+	// delivering empty batches
+	cancel()
+	select {
+	case batch, ok := <-batchq:
+		assert.False(t, ok)
+		assert.Nil(t, batch)
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel not closed")
+	}
+}
+
+func TestCorrelationID(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	defer s.Close()
+
+	watch, cancel := state.Watch(s.WatchQueue(), state.EventCommit{})
+	defer cancel()
+	nextCommit := func() state.EventCommit {
+		select {
+		case event := <-watch:
+			return event.(state.EventCommit)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no commit event")
+		}
+		return state.EventCommit{}
+	}
+
+	ctx := WithCorrelationID(context.Background(), "request-1")
+	assert.Equal(t, "request-1", CorrelationID(ctx))
+	assert.Equal(t, "", CorrelationID(context.Background()))
+
+	// the object events are followed by the commit that tags them
+	events, cancelEvents := s.WatchQueue().Watch()
+	defer cancelEvents()
+	require.NoError(t, s.UpdateContext(ctx, func(tx Tx) error {
+		return CreateNode(tx, &api.Node{ID: "id1"})
+	}))
+	select {
+	case event := <-events:
+		require.IsType(t, api.EventCreateNode{}, event)
+		assert.Equal(t, "id1", event.(api.EventCreateNode).Node.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no create event")
+	}
+	select {
+	case event := <-events:
+		require.IsType(t, state.EventCommit{}, event)
+		assert.Equal(t, "request-1", event.(state.EventCommit).CorrelationID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no commit event")
+	}
+	cancelEvents()
+	commit := nextCommit()
+	assert.Equal(t, "request-1", commit.CorrelationID)
+	assert.NotNil(t, commit.Version)
+
+	// each of a batch's transactions carries it
+	_, err := s.BatchContext(WithCorrelationID(ctx, "request-2"), func(batch *Batch) error {
+		return batch.Update(func(tx Tx) error {
+			return CreateNode(tx, &api.Node{ID: "id2"})
+		})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "request-2", nextCommit().CorrelationID)
+
+	// transactions without one carry none
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNode(tx, "id1")
+	}))
+	assert.Equal(t, "", nextCommit().CorrelationID)
+
+	// nor do replicated transactions
+	sa, err := api.NewStoreAction(api.EventCreateNode{Node: &api.Node{ID: "id3"}})
+	require.NoError(t, err)
+	require.NoError(t, s.ApplyStoreActions([]api.StoreAction{sa}))
+	assert.Equal(t, "", nextCommit().CorrelationID)
+}
+
+func TestStreamAllEvents(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := StreamAllEvents(ctx, s, nil)
+	require.NoError(t, err)
+
+	node := &api.Node{ID: "node1"}
+	network := &api.Network{ID: "network1", Spec: api.NetworkSpec{Annotations: api.Annotations{Name: "network1"}}}
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNode(tx, node); err != nil {
+			return err
+		}
+		return CreateNetwork(tx, network)
+	}))
+	version := s.proposer.GetVersion()
+	require.NoError(t, s.Update(func(tx Tx) error {
+		node := GetNode(tx, "node1")
+		node.Status.State = api.NodeStatus_READY
+		return UpdateNode(tx, node)
+	}))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := DeleteNetwork(tx, "network1"); err != nil {
+			return err
+		}
+		return DeleteNode(tx, "node1")
+	}))
+
+	expectEvents := func(stream <-chan api.Event, expected ...string) {
+		for _, kind := range expected {
+			select {
+			case event := <-stream:
+				var actual string
+				switch event.(type) {
+				case api.EventCreateNode:
+					actual = "create node"
+				case api.EventUpdateNode:
+					actual = "update node"
+				case api.EventDeleteNode:
+					actual = "delete node"
+				case api.EventCreateNetwork:
+					actual = "create network"
+				case api.EventDeleteNetwork:
+					actual = "delete network"
+				default:
+					t.Fatalf("unexpected event %T", event)
+				}
+				assert.Equal(t, kind, actual)
+			case <-time.After(time.Second):
+				t.Fatalf("timed out waiting for %s event", kind)
+			}
+		}
+	}
+
+	expectEvents(stream, "create node", "create network", "update node", "delete network", "delete node")
+
+	// resuming from a version replays only the events after it
+	resumeCtx, resumeCancel := context.WithCancel(context.Background())
+	resumed, err := StreamAllEvents(resumeCtx, s, version)
+	require.NoError(t, err)
+	expectEvents(resumed, "update node", "delete network", "delete node")
+
+	resumeCancel()
+	select {
+	case _, ok := <-resumed:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("stream was not closed after the context was cancelled")
+	}
+}
+
 const benchmarkNumNodes = 10000
 
 func setupNodes(b *testing.B, n int) (*MemoryStore, []string) {
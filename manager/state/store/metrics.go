@@ -0,0 +1,160 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pivotal-golang/clock"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var objectCountDesc = prometheus.NewDesc(
+	"swarm_store_objects",
+	"Number of objects in each table of the store.",
+	[]string{"table"},
+	nil,
+)
+
+type objectCountCollector struct {
+	store *MemoryStore
+}
+
+// NewObjectCountCollector returns a prometheus.Collector that reports the
+// number of objects in each registered table of s as a gauge. The counts are
+// taken from the committed state of the store each time it is scraped.
+func NewObjectCountCollector(s *MemoryStore) prometheus.Collector {
+	return objectCountCollector{store: s}
+}
+
+// Describe implements prometheus.Collector.
+func (c objectCountCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- objectCountDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c objectCountCollector) Collect(ch chan<- prometheus.Metric) {
+	c.store.View(func(tx ReadTx) {
+		for _, os := range objectStorers {
+			table := os.Table.Name
+			n, err := tx.count(table, All, nil)
+			if err != nil {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(objectCountDesc, prometheus.GaugeValue, float64(n), table)
+		}
+	})
+}
+
+// TableSizeSample is the number of objects in a table at a point in time, as
+// recorded by the sampler started with EnableTableSizeSampling.
+type TableSizeSample struct {
+	Time  time.Time
+	Count int
+}
+
+// tableSizeSampler keeps the most recent samples of the size of each table in
+// ring buffers. Every table is sampled at the same time, so the buffers share
+// their position.
+type tableSizeSampler struct {
+	mu      sync.Mutex
+	samples map[string][]TableSizeSample
+	size    int
+	// next is the index in the buffers the next sample is written at, and
+	// full is set once the buffers have wrapped around.
+	next int
+	full bool
+
+	stop chan struct{}
+}
+
+func (ts *tableSizeSampler) record(now time.Time, counts map[string]int) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	for table, buf := range ts.samples {
+		buf[ts.next] = TableSizeSample{Time: now, Count: counts[table]}
+	}
+	ts.next++
+	if ts.next == ts.size {
+		ts.next = 0
+		ts.full = true
+	}
+}
+
+func (ts *tableSizeSampler) history(table string) []TableSizeSample {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	buf := ts.samples[table]
+	if !ts.full {
+		return append([]TableSizeSample(nil), buf[:ts.next]...)
+	}
+	return append(append([]TableSizeSample(nil), buf[ts.next:]...), buf[:ts.next]...)
+}
+
+// EnableTableSizeSampling records the number of objects in each registered
+// table every interval, keeping the most recent samples of each table for
+// TableSizeHistory, for capacity planning. Unlike NewObjectCountCollector, which
+// reports the current counts, it keeps their history. clockSource may be nil
+// to use the real clock. Sampling stops when the store is closed.
+// This function must be called before the store is used.
+func (s *MemoryStore) EnableTableSizeSampling(clockSource clock.Clock, interval time.Duration, samples int) error {
+	if interval <= 0 {
+		return fmt.Errorf("table size sampling interval must be positive, got %s", interval)
+	}
+	if samples <= 0 {
+		return fmt.Errorf("number of table size samples must be positive, got %d", samples)
+	}
+	if clockSource == nil {
+		clockSource = clock.NewClock()
+	}
+
+	s.tableSizes = &tableSizeSampler{
+		samples: make(map[string][]TableSizeSample),
+		size:    samples,
+		stop:    make(chan struct{}),
+	}
+	for _, os := range objectStorers {
+		s.tableSizes.samples[os.Table.Name] = make([]TableSizeSample, samples)
+	}
+	// The ticker is created before returning, so that the first sample is
+	// taken one interval from now.
+	ticker := clockSource.NewTicker(interval)
+	go s.sampleTableSizes(clockSource, ticker)
+	return nil
+}
+
+func (s *MemoryStore) sampleTableSizes(clockSource clock.Clock, ticker clock.Ticker) {
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C():
+			counts := make(map[string]int)
+			s.View(func(tx ReadTx) {
+				for _, os := range objectStorers {
+					if n, err := tx.count(os.Table.Name, All, nil); err == nil {
+						counts[os.Table.Name] = n
+					}
+				}
+			})
+			s.tableSizes.record(clockSource.Now(), counts)
+		case <-s.tableSizes.stop:
+			return
+		}
+	}
+}
+
+// TableSizeHistory returns the samples of the number of objects in table
+// recorded since EnableTableSizeSampling was called, oldest first. It returns
+// no samples if sampling isn't enabled.
+func (s *MemoryStore) TableSizeHistory(table string) ([]TableSizeSample, error) {
+	if lookupObjectStorer(table) == nil {
+		return nil, fmt.Errorf("unknown table %q", table)
+	}
+	if s.tableSizes == nil {
+		return nil, nil
+	}
+	return s.tableSizes.history(table), nil
+}
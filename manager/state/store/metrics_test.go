@@ -0,0 +1,130 @@
+package store
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/testutils"
+	"github.com/pivotal-golang/clock/fakeclock"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectObjectCounts(t *testing.T, c prometheus.Collector) map[string]float64 {
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+
+	counts := make(map[string]float64)
+	for m := range ch {
+		var metric dto.Metric
+		require.NoError(t, m.Write(&metric))
+		require.Len(t, metric.Label, 1)
+		assert.Equal(t, "table", metric.Label[0].GetName())
+		counts[metric.Label[0].GetValue()] = metric.Gauge.GetValue()
+	}
+	return counts
+}
+
+func TestObjectCountCollector(t *testing.T) {
+	s := NewMemoryStore(nil)
+	assert.NotNil(t, s)
+	defer s.Close()
+
+	c := NewObjectCountCollector(s)
+	descs := make(chan *prometheus.Desc, 1)
+	c.Describe(descs)
+	assert.Equal(t, objectCountDesc, <-descs)
+
+	counts := collectObjectCounts(t, c)
+	assert.Len(t, counts, len(objectStorers))
+	for table, count := range counts {
+		assert.Equal(t, float64(0), count, table)
+	}
+
+	const numNodes = 5
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for i := 0; i < numNodes; i++ {
+			if err := CreateNode(tx, &api.Node{ID: "id" + strconv.Itoa(i)}); err != nil {
+				return err
+			}
+		}
+		return CreateNetwork(tx, &api.Network{
+			ID:   "net1",
+			Spec: api.NetworkSpec{Annotations: api.Annotations{Name: "net1"}},
+		})
+	}))
+
+	counts = collectObjectCounts(t, c)
+	assert.Equal(t, float64(numNodes), counts[tableNode])
+	assert.Equal(t, float64(1), counts[tableNetwork])
+	assert.Equal(t, float64(0), counts[tableService])
+
+	// the counts follow deletions
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNode(tx, "id0")
+	}))
+	assert.Equal(t, float64(numNodes-1), collectObjectCounts(t, c)[tableNode])
+}
+
+func TestTableSizeHistory(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	history, err := s.TableSizeHistory(tableNode)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	start := time.Now()
+	clockSource := fakeclock.NewFakeClock(start)
+	require.Error(t, s.EnableTableSizeSampling(clockSource, 0, 3))
+	require.NoError(t, s.EnableTableSizeSampling(clockSource, time.Minute, 3))
+
+	// sample waits for the sample taken at the given time to be recorded
+	sample := func(at time.Time) {
+		require.NoError(t, testutils.PollFuncWithTimeout(nil, func() error {
+			history, err := s.TableSizeHistory(tableNode)
+			if err != nil {
+				return err
+			}
+			if len(history) == 0 || !history[len(history)-1].Time.Equal(at) {
+				return errors.New("sample not recorded yet")
+			}
+			return nil
+		}, 5*time.Second))
+	}
+
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, s.Update(func(tx Tx) error {
+			return CreateNode(tx, &api.Node{ID: "id" + strconv.Itoa(i)})
+		}))
+		clockSource.Increment(time.Minute)
+		sample(start.Add(time.Duration(i) * time.Minute))
+	}
+
+	// only the most recent samples are kept, oldest first
+	history, err = s.TableSizeHistory(tableNode)
+	require.NoError(t, err)
+	assert.Equal(t, []TableSizeSample{
+		{Time: start.Add(2 * time.Minute), Count: 2},
+		{Time: start.Add(3 * time.Minute), Count: 3},
+		{Time: start.Add(4 * time.Minute), Count: 4},
+	}, history)
+
+	history, err = s.TableSizeHistory(tableNetwork)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	for _, sample := range history {
+		assert.Equal(t, 0, sample.Count)
+	}
+
+	_, err = s.TableSizeHistory("nonexistent")
+	assert.Error(t, err)
+}
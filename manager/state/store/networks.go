@@ -1,6 +1,8 @@
 package store
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/docker/swarmkit/api"
@@ -31,6 +33,7 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{SelectorName, SelectorNamePrefix, SelectorIDPrefix, SelectorCustom, SelectorCustomPrefix},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Networks, err = FindNetworks(tx, All)
@@ -101,6 +104,63 @@ func DeleteNetwork(tx Tx, id string) error {
 	return tx.delete(tableNetwork, id)
 }
 
+// NetworkInUseError is returned by DeleteNetworkIfUnused when objects still
+// reference the network.
+type NetworkInUseError struct {
+	NetworkID string
+	// References maps the name of each table with objects referencing the
+	// network to the IDs of those objects.
+	References map[string][]string
+}
+
+func (e NetworkInUseError) Error() string {
+	tables := make([]string, 0, len(e.References))
+	for table := range e.References {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	var refs []string
+	for _, table := range tables {
+		for _, id := range e.References[table] {
+			refs = append(refs, table+" "+id)
+		}
+	}
+	return fmt.Sprintf("network %s is in use by %s", e.NetworkID, strings.Join(refs, ", "))
+}
+
+// DeleteNetworkIfUnused removes a network from the store, unless objects in
+// any table that supports the ByReferencedNetworkID selector still reference
+// it. Unlike the checks the control API makes, objects are considered to
+// reference the network regardless of their state.
+// Returns ErrNotExist if the network doesn't exist, and a NetworkInUseError
+// if it is referenced.
+func DeleteNetworkIfUnused(tx Tx, id string) error {
+	if GetNetwork(tx, id) == nil {
+		return ErrNotExist
+	}
+
+	refs := make(map[string][]string)
+	for _, os := range objectStorers {
+		table := os.Table.Name
+		checkType := selectorChecker(table)
+		by := ByReferencedNetworkID(id)
+		if checkType(by) != nil {
+			continue
+		}
+		err := tx.find(table, by, checkType, func(o api.StoreObject) {
+			refs[table] = append(refs[table], o.GetID())
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if len(refs) != 0 {
+		return NetworkInUseError{NetworkID: id, References: refs}
+	}
+
+	return DeleteNetwork(tx, id)
+}
+
 // GetNetwork looks up a network by ID.
 // Returns nil if the network doesn't exist.
 func GetNetwork(tx ReadTx, id string) *api.Network {
@@ -113,20 +173,11 @@ func GetNetwork(tx ReadTx, id string) *api.Network {
 
 // FindNetworks selects a set of networks and returns them.
 func FindNetworks(tx ReadTx, by By) ([]*api.Network, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	networkList := []*api.Network{}
 	appendResult := func(o api.StoreObject) {
 		networkList = append(networkList, o.(*api.Network))
 	}
 
-	err := tx.find(tableNetwork, by, checkType, appendResult)
+	err := tx.find(tableNetwork, by, selectorChecker(tableNetwork), appendResult)
 	return networkList, err
 }
@@ -1,11 +1,18 @@
 package store
 
 import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/cloudflare/cfssl/helpers"
 	"github.com/docker/swarmkit/api"
+	gogotypes "github.com/gogo/protobuf/types"
 	memdb "github.com/hashicorp/go-memdb"
+	"github.com/pkg/errors"
 )
 
 const tableNode = "node"
@@ -34,6 +41,21 @@ func init() {
 					Name:    indexMembership,
 					Indexer: nodeIndexerByMembership{},
 				},
+				indexCertIssuer: {
+					Name:         indexCertIssuer,
+					AllowMissing: true,
+					Indexer:      nodeIndexerByCertIssuer{},
+				},
+				indexCertExpiry: {
+					Name:         indexCertExpiry,
+					AllowMissing: true,
+					Indexer:      nodeIndexerByCertExpiry{},
+				},
+				indexCertSerial: {
+					Name:         indexCertSerial,
+					AllowMissing: true,
+					Indexer:      nodeIndexerByCertSerial{},
+				},
 				indexCustom: {
 					Name:         indexCustom,
 					Indexer:      api.NodeCustomIndexer{},
@@ -41,18 +63,19 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{SelectorName, SelectorNamePrefix, SelectorIDPrefix, SelectorRole, SelectorMembership, SelectorCertIssuer, SelectorCertExpiringBefore, SelectorCertSerial, SelectorCustom, SelectorCustomPrefix},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
-			snapshot.Nodes, err = FindNodes(tx, All)
+			snapshot.Nodes, err = FindNodes(IncludeDeleted(tx), All)
 			return err
 		},
 		Restore: func(tx Tx, snapshot *api.StoreSnapshot) error {
-			nodes, err := FindNodes(tx, All)
+			nodes, err := FindNodes(IncludeDeleted(tx), All)
 			if err != nil {
 				return err
 			}
 			for _, n := range nodes {
-				if err := DeleteNode(tx, n.ID); err != nil {
+				if err := tx.delete(tableNode, n.ID); err != nil {
 					return err
 				}
 			}
@@ -73,7 +96,23 @@ func init() {
 				case api.StoreActionKindUpdate:
 					return UpdateNode(tx, obj)
 				case api.StoreActionKindRemove:
-					return DeleteNode(tx, obj.ID)
+					if obj.DeletedAt != nil {
+						// a removed tombstone is a purge, which
+						// stores that didn't keep the tombstone
+						// have nothing to do for
+						switch existing := GetNode(IncludeDeleted(tx), obj.ID); {
+						case existing == nil:
+							return nil
+						case existing.DeletedAt != nil:
+							return tx.purge(tableNode, obj.ID)
+						}
+					}
+					// keep the replicated tombstone only if this
+					// store retains deleted nodes itself
+					if obj.DeletedAt != nil && tx.softDeletesNodes() {
+						return tombstoneNode(tx, obj.ID, obj.DeletedAt)
+					}
+					return tx.delete(tableNode, obj.ID)
 				}
 			}
 			return errUnknownStoreAction
@@ -87,19 +126,318 @@ func CreateNode(tx Tx, n *api.Node) error {
 	return tx.create(tableNode, n)
 }
 
+// CreateOrGetNode adds n to the store, unless a node with the same ID
+// already exists, in which case the existing node is returned and n is
+// ignored. Otherwise n is returned, once created. Unlike checking with
+// GetNode before calling CreateNode, this can't race with another writer,
+// because both happen in the same transaction.
+func CreateOrGetNode(tx Tx, n *api.Node) (*api.Node, error) {
+	if existing := GetNode(tx, n.ID); existing != nil {
+		return existing, nil
+	}
+	if err := CreateNode(tx, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 // UpdateNode updates an existing node in the store.
 // Returns ErrNotExist if the node doesn't exist.
 func UpdateNode(tx Tx, n *api.Node) error {
 	return tx.update(tableNode, n)
 }
 
-// DeleteNode removes a node from the store.
+// UpdateNodeFields copies the fields of n named by the paths in mask onto the
+// stored node with the given ID, leaving its other fields unchanged. Because
+// the update is applied to the current version of the node, it does not
+// conflict with writers that changed other fields in the meantime.
+// Supported paths are "spec", "description", "status", "manager_status",
+// "attachment", "certificate", "certificate.status" and "role". A description
+// without TLS info keeps the stored node's TLS info.
+// Returns ErrNotExist if the node doesn't exist.
+func UpdateNodeFields(tx Tx, id string, mask *gogotypes.FieldMask, n *api.Node) error {
+	current := GetNode(tx, id)
+	if current == nil {
+		return ErrNotExist
+	}
+	for _, path := range mask.Paths {
+		switch path {
+		case "spec":
+			current.Spec = *n.Spec.Copy()
+		case "description":
+			desc := n.Description.Copy()
+			if desc != nil && desc.TLSInfo == nil && current.Description != nil {
+				desc.TLSInfo = current.Description.TLSInfo
+			}
+			current.Description = desc
+		case "status":
+			current.Status = *n.Status.Copy()
+		case "manager_status":
+			current.ManagerStatus = n.ManagerStatus.Copy()
+		case "attachment":
+			current.Attachment = n.Attachment.Copy()
+		case "certificate":
+			current.Certificate = *n.Certificate.Copy()
+		case "certificate.status":
+			current.Certificate.Status = *n.Certificate.Status.Copy()
+		case "role":
+			current.Role = n.Role
+		default:
+			return errors.Errorf("unsupported node field mask path %q", path)
+		}
+	}
+	return UpdateNode(tx, current)
+}
+
+// NodeWithVersion is an update for UpdateNodesCAS. Version is the version
+// of the stored node that Node was derived from.
+type NodeWithVersion struct {
+	Node    *api.Node
+	Version api.Version
+}
+
+// CASResult is the outcome of one update in UpdateNodesCAS.
+type CASResult int
+
+const (
+	// CASApplied means the update was applied.
+	CASApplied CASResult = iota
+	// CASConflict means the node had changed since the update's version,
+	// so the update was not applied.
+	CASConflict
+	// CASNotFound means the node no longer exists.
+	CASNotFound
+)
+
+func (r CASResult) String() string {
+	switch r {
+	case CASApplied:
+		return "applied"
+	case CASConflict:
+		return "conflict"
+	case CASNotFound:
+		return "not found"
+	}
+	return fmt.Sprintf("CASResult(%d)", int(r))
+}
+
+// UpdateNodesCAS applies each update in the batch only if the stored node is
+// still at the update's version, and returns the outcome of each update, in
+// the same order. Conflicting updates are skipped without affecting the
+// others, so the caller can read the current nodes and retry just those.
+// An error is returned if an update that matched its version fails.
+func UpdateNodesCAS(batch *Batch, updates []NodeWithVersion) ([]CASResult, error) {
+	results := make([]CASResult, len(updates))
+	for i, update := range updates {
+		i, update := i, update
+		err := batch.Update(func(tx Tx) error {
+			current := GetNode(tx, update.Node.ID)
+			if current == nil {
+				results[i] = CASNotFound
+				return nil
+			}
+			if current.Meta.Version != update.Version {
+				results[i] = CASConflict
+				return nil
+			}
+			n := update.Node.Copy()
+			n.Meta.Version = current.Meta.Version
+			results[i] = CASApplied
+			return UpdateNode(tx, n)
+		})
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// legalMembershipTransitions lists the memberships a node may move to from
+// each membership.
+var legalMembershipTransitions = map[api.NodeSpec_Membership][]api.NodeSpec_Membership{
+	api.NodeMembershipPending: {api.NodeMembershipAccepted},
+}
+
+// MembershipTransitionError is returned by SetNodeMembership when a node
+// cannot move from its current membership to the requested one.
+type MembershipTransitionError struct {
+	From, To api.NodeSpec_Membership
+}
+
+func (e MembershipTransitionError) Error() string {
+	return fmt.Sprintf("illegal node membership transition from %s to %s", e.From, e.To)
+}
+
+// SetNodeMembership changes the membership of the node with the given ID,
+// and records the transition in the node's MembershipTransition. Setting
+// the membership the node already has is a no-op.
+// Returns ErrNotExist if the node doesn't exist, and a
+// MembershipTransitionError if the transition isn't allowed.
+func SetNodeMembership(tx Tx, id string, membership api.NodeSpec_Membership) error {
+	n := GetNode(tx, id)
+	if n == nil {
+		return ErrNotExist
+	}
+	if n.Spec.Membership == membership {
+		return nil
+	}
+	if err := TransitionNodeMembership(n, membership); err != nil {
+		return err
+	}
+	return UpdateNode(tx, n)
+}
+
+// TransitionNodeMembership moves n to membership, and records the transition
+// in its MembershipTransition, without writing n to the store. It is for
+// callers that create or update the node themselves, so that the node is
+// written once. It returns a MembershipTransitionError if the transition
+// isn't allowed.
+func TransitionNodeMembership(n *api.Node, membership api.NodeSpec_Membership) error {
+	legal := false
+	for _, to := range legalMembershipTransitions[n.Spec.Membership] {
+		if to == membership {
+			legal = true
+			break
+		}
+	}
+	if !legal {
+		return MembershipTransitionError{From: n.Spec.Membership, To: membership}
+	}
+
+	timestamp, err := gogotypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	n.MembershipTransition = &api.MembershipTransition{
+		From:      n.Spec.Membership,
+		To:        membership,
+		Timestamp: timestamp,
+	}
+	n.Spec.Membership = membership
+	return nil
+}
+
+// ErrNoCSR is returned by AcceptNode when the node has no certificate signing
+// request to issue it a certificate for.
+var ErrNoCSR = errors.New("node has no certificate signing request")
+
+// AcceptNode accepts the pending node with the given ID into the cluster
+// with the given role, in a single update: the node's membership, role and
+// desired role are set, and its certificate is given the role and moved to
+// the pending state, so that the CA server signs the node's CSR for the role
+// once the transaction is committed.
+// Returns ErrNotExist if the node doesn't exist, a MembershipTransitionError
+// if the node isn't pending, including if it has already been accepted, and
+// ErrNoCSR if the node has no CSR.
+func AcceptNode(tx Tx, id string, role api.NodeRole) error {
+	if _, ok := api.NodeRole_name[int32(role)]; !ok {
+		return fmt.Errorf("invalid node role %d", role)
+	}
+	n := GetNode(tx, id)
+	if n == nil {
+		return ErrNotExist
+	}
+	if n.Spec.Membership != api.NodeMembershipPending {
+		return MembershipTransitionError{From: n.Spec.Membership, To: api.NodeMembershipAccepted}
+	}
+	if len(n.Certificate.CSR) == 0 {
+		return ErrNoCSR
+	}
+	if err := TransitionNodeMembership(n, api.NodeMembershipAccepted); err != nil {
+		return err
+	}
+
+	n.Role = role
+	n.Spec.DesiredRole = role
+	n.Certificate.Role = role
+	if n.Certificate.CN == "" {
+		n.Certificate.CN = n.ID
+	}
+	n.Certificate.Status = api.IssuanceStatus{State: api.IssuanceStatePending}
+	return UpdateNode(tx, n)
+}
+
+// DeleteNode removes a node from the store. If the store has soft-delete
+// enabled, the node is instead marked as deleted, and kept as a tombstone
+// until it is purged. Either way, an EventDeleteNode is produced.
 // Returns ErrNotExist if the node doesn't exist.
 func DeleteNode(tx Tx, id string) error {
-	return tx.delete(tableNode, id)
+	if !tx.softDeletesNodes() {
+		return tx.delete(tableNode, id)
+	}
+	deletedAt, err := gogotypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	return tombstoneNode(tx, id, deletedAt)
+}
+
+// DeleteNodeCAS deletes a node like DeleteNode, but only if the stored node is
+// still at expectedVersion, so that a caller working from a stale copy of the
+// node doesn't delete it after another writer has updated it.
+// Returns ErrNotExist if the node doesn't exist, and ErrSequenceConflict if its
+// version differs from expectedVersion.
+func DeleteNodeCAS(tx Tx, id string, expectedVersion api.Version) error {
+	n := GetNode(tx, id)
+	if n == nil {
+		return ErrNotExist
+	}
+	if n.Meta.Version != expectedVersion {
+		return ErrSequenceConflict
+	}
+	return DeleteNode(tx, id)
+}
+
+// MigrateNodeID moves the node with ID oldID to newID, for data migrations
+// that need to change a node's ID. The node is recreated under newID with the
+// same contents, the tasks assigned to it are reassigned to newID, and the node
+// under oldID is deleted, so an EventCreateNode, an EventUpdateTask for each
+// reassigned task, and an EventDeleteNode are produced. The node's certificate
+// still names oldID until it is renewed.
+// Returns ErrNotExist if there is no node with ID oldID, and ErrExist if newID
+// is already taken, including by a soft-deleted node.
+func MigrateNodeID(tx Tx, oldID, newID string) error {
+	n := GetNode(tx, oldID)
+	if n == nil {
+		return ErrNotExist
+	}
+	if GetNode(IncludeDeleted(tx), newID) != nil {
+		return ErrExist
+	}
+
+	n.ID = newID
+	if err := CreateNode(tx, n); err != nil {
+		return err
+	}
+
+	tasks, err := FindTasks(tx, ByNodeID(oldID))
+	if err != nil {
+		return err
+	}
+	for _, t := range tasks {
+		t.NodeID = newID
+		if err := UpdateTask(tx, t); err != nil {
+			return err
+		}
+	}
+
+	return DeleteNode(tx, oldID)
+}
+
+// tombstoneNode marks the node with the given ID as deleted at the given
+// time. The deletion time is carried in the delete event, so that it is the
+// same on every member of the cluster.
+func tombstoneNode(tx Tx, id string, deletedAt *gogotypes.Timestamp) error {
+	n := GetNode(tx, id)
+	if n == nil {
+		return ErrNotExist
+	}
+	n.DeletedAt = deletedAt
+	return tx.tombstone(tableNode, n)
 }
 
-// GetNode looks up a node by ID.
+// GetNode looks up a node by ID. Soft-deleted nodes are only returned if tx
+// comes from IncludeDeleted.
 // Returns nil if the node doesn't exist.
 func GetNode(tx ReadTx, id string) *api.Node {
 	n := tx.get(tableNode, id)
@@ -109,26 +447,156 @@ func GetNode(tx ReadTx, id string) *api.Node {
 	return n.(*api.Node)
 }
 
-// FindNodes selects a set of nodes and returns them.
-func FindNodes(tx ReadTx, by By) ([]*api.Node, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byRole, byMembership, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
+// CertificateInfo describes the certificate a node was issued, as returned by
+// LookupCertificateBySerial.
+type CertificateInfo struct {
+	NodeID string
+	// Role is the node's current role.
+	Role api.NodeRole
+	// Subject and Issuer are the certificate's subject and issuer names.
+	// Subject is only known for the node's current certificate.
+	Subject string
+	Issuer  string
+	// NotAfter is when the certificate expires, which may be in the past.
+	NotAfter time.Time
+}
+
+// LookupCertificateBySerial looks up the certificate with the given serial
+// number, in hex, and returns the node it was issued to and the CA that signed
+// it. Colons between the bytes of the serial are ignored. Expired
+// certificates are found like any other. Besides each node's current
+// certificate, the certificates recorded in its issuance history are known,
+// including while the node renews its certificate.
+// Returns ErrNotExist if no node has the certificate.
+func LookupCertificateBySerial(tx ReadTx, serial string) (*CertificateInfo, error) {
+	serial = strings.ToLower(strings.Replace(serial, ":", "", -1))
+	// Serials are recorded without leading zeros
+	serial = strings.TrimLeft(serial, "0")
+	nodes, err := FindNodes(tx, ByCertSerial(serial))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, ErrNotExist
+	}
+	n := nodes[0]
+	info := &CertificateInfo{
+		NodeID: n.ID,
+		Role:   n.Certificate.Role,
+	}
+
+	// A node's current certificate is cleared while it renews it
+	if n.Certificate.Serial == serial && len(n.Certificate.Certificate) != 0 {
+		certs, err := helpers.ParseCertificatesPEM(n.Certificate.Certificate)
+		if err != nil || len(certs) == 0 {
+			return nil, errors.Errorf("failed to parse the certificate of node %s", n.ID)
+		}
+		info.Subject = certs[0].Subject.String()
+		info.Issuer = certs[0].Issuer.String()
+		info.NotAfter = certs[0].NotAfter
+		return info, nil
+	}
+
+	notAfter := n.Certificate.NotAfter
+	for i := len(n.Certificate.History) - 1; i >= 0; i-- {
+		if record := n.Certificate.History[i]; record.Serial == serial {
+			info.Issuer = record.Issuer
+			notAfter = record.NotAfter
+			break
+		}
+	}
+	if notAfter != nil {
+		if info.NotAfter, err = gogotypes.TimestampFromProto(notAfter); err != nil {
+			return nil, err
 		}
 	}
+	return info, nil
+}
+
+// AmbiguousHostnameError is returned by GetNodeByHostname when more than one
+// node has the requested hostname.
+type AmbiguousHostnameError struct {
+	Hostname string
+	NodeIDs  []string
+}
+
+func (e AmbiguousHostnameError) Error() string {
+	return fmt.Sprintf("hostname %q matches multiple nodes: %s", e.Hostname, strings.Join(e.NodeIDs, ", "))
+}
 
+// GetNodeByHostname looks up the node with the given hostname, using the
+// hostname index. Hostnames are matched case-insensitively.
+// Returns ErrNotExist if no node has the hostname, and an
+// AmbiguousHostnameError if several do.
+func GetNodeByHostname(tx ReadTx, hostname string) (*api.Node, error) {
+	nodes, err := FindNodes(tx, ByName(hostname))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 0:
+		return nil, ErrNotExist
+	case 1:
+		return nodes[0], nil
+	}
+	ids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		ids = append(ids, n.ID)
+	}
+	return nil, AmbiguousHostnameError{Hostname: hostname, NodeIDs: ids}
+}
+
+// FindNodes selects a set of nodes and returns them.
+func FindNodes(tx ReadTx, by By) ([]*api.Node, error) {
 	nodeList := []*api.Node{}
 	appendResult := func(o api.StoreObject) {
 		nodeList = append(nodeList, o.(*api.Node))
 	}
 
-	err := tx.find(tableNode, by, checkType, appendResult)
+	err := tx.find(tableNode, by, selectorChecker(tableNode), appendResult)
 	return nodeList, err
 }
 
+// FindNodeIDs selects a set of nodes like FindNodes, but returns only their
+// IDs, without copying the nodes, for callers such as bulk deletions that
+// don't need the rest of each node.
+func FindNodeIDs(tx ReadTx, by By) ([]string, error) {
+	return tx.findIDs(tableNode, by, selectorChecker(tableNode))
+}
+
+// FindNodesModifiedSince returns the nodes that were created or last updated
+// at a version index greater than index, in the order of the version index
+// they were last modified at.
+func FindNodesModifiedSince(tx ReadTx, index uint64) ([]*api.Node, error) {
+	return FindNodes(tx, ByModifiedSince(index))
+}
+
+// WalkNodes selects a set of nodes and calls cb for each of them, without
+// collecting them into a slice, so that they can be streamed to a client.
+// If cb returns an error, the walk stops and the error is returned.
+func WalkNodes(tx ReadTx, by By, cb func(*api.Node) error) error {
+	return tx.walk(tableNode, by, selectorChecker(tableNode), func(o api.StoreObject) error {
+		return cb(o.(*api.Node))
+	})
+}
+
+// CountNodesByIssuanceState returns the number of nodes in each certificate
+// issuance state. Every state is present in the result, including those no
+// node is in.
+func CountNodesByIssuanceState(tx ReadTx) map[api.IssuanceStatus_State]int {
+	counts := make(map[api.IssuanceStatus_State]int, len(api.IssuanceStatus_State_name))
+	for state := range api.IssuanceStatus_State_name {
+		counts[api.IssuanceStatus_State(state)] = 0
+	}
+	// walking all nodes with a callback that never fails can't return an
+	// error
+	_ = WalkNodes(tx, All, func(n *api.Node) error {
+		counts[n.Certificate.Status.State]++
+		return nil
+	})
+	return counts
+}
+
 type nodeIndexerByHostname struct{}
 
 func (ni nodeIndexerByHostname) FromArgs(args ...interface{}) ([]byte, error) {
@@ -174,3 +642,109 @@ func (ni nodeIndexerByMembership) FromObject(obj interface{}) (bool, []byte, err
 	// Add the null character as a terminator
 	return true, []byte(strconv.FormatInt(int64(n.Spec.Membership), 10) + "\x00"), nil
 }
+
+type nodeIndexerByCertIssuer struct{}
+
+func (ni nodeIndexerByCertIssuer) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ni nodeIndexerByCertIssuer) FromObject(obj interface{}) (bool, []byte, error) {
+	n := obj.(*api.Node)
+
+	if n.Description == nil || n.Description.TLSInfo == nil || len(n.Description.TLSInfo.CertIssuerSubject) == 0 {
+		return false, nil, nil
+	}
+	// The subject is raw DER, so hex-encode it to keep the null terminator
+	// unambiguous
+	return true, []byte(hex.EncodeToString(n.Description.TLSInfo.CertIssuerSubject) + "\x00"), nil
+}
+
+type nodeIndexerByCertExpiry struct{}
+
+func (ni nodeIndexerByCertExpiry) FromArgs(args ...interface{}) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("must provide only a single argument")
+	}
+	t, ok := args[0].(time.Time)
+	if !ok {
+		return nil, fmt.Errorf("argument must be a time.Time: %#v", args[0])
+	}
+	ts, err := gogotypes.TimestampProto(t)
+	if err != nil {
+		return nil, err
+	}
+	return certExpiryKey(ts), nil
+}
+
+func (ni nodeIndexerByCertExpiry) FromObject(obj interface{}) (bool, []byte, error) {
+	n := obj.(*api.Node)
+
+	if n.Certificate.NotAfter == nil {
+		return false, nil, nil
+	}
+	return true, certExpiryKey(n.Certificate.NotAfter), nil
+}
+
+type nodeIndexerByCertSerial struct{}
+
+func (ni nodeIndexerByCertSerial) FromArgs(args ...interface{}) ([]byte, error) {
+	return fromArgs(args...)
+}
+
+func (ni nodeIndexerByCertSerial) FromObject(obj interface{}) (bool, [][]byte, error) {
+	n := obj.(*api.Node)
+
+	// The current certificate is usually the last in the history as well
+	seen := make(map[string]struct{})
+	var serials [][]byte
+	add := func(serial string) {
+		if _, ok := seen[serial]; ok || serial == "" {
+			return
+		}
+		seen[serial] = struct{}{}
+		// Add the null character as a terminator
+		serials = append(serials, []byte(serial+"\x00"))
+	}
+	add(n.Certificate.Serial)
+	for _, record := range n.Certificate.History {
+		add(record.Serial)
+	}
+	return len(serials) != 0, serials, nil
+}
+
+// certExpiryKey encodes a timestamp so that the index keys sort in time
+// order. The keys have a fixed length, so no terminator is needed.
+func certExpiryKey(ts *gogotypes.Timestamp) []byte {
+	key := make([]byte, 12)
+	// Flip the sign bit so that times before the epoch sort first
+	binary.BigEndian.PutUint64(key, uint64(ts.Seconds)^(1<<63))
+	binary.BigEndian.PutUint32(key[8:], uint32(ts.Nanos))
+	return key
+}
+
+// certExpiringBeforeIterator walks the cert expiry index, which is ordered by
+// expiry time, and stops at the first node whose certificate expires at or
+// after the cutoff, so only the matching nodes are visited.
+type certExpiringBeforeIterator struct {
+	it     memdb.ResultIterator
+	before time.Time
+	done   bool
+}
+
+func (i *certExpiringBeforeIterator) Next() interface{} {
+	if i.done {
+		return nil
+	}
+	obj := i.it.Next()
+	if obj == nil {
+		i.done = true
+		return nil
+	}
+	notAfter, err := gogotypes.TimestampFromProto(obj.(*api.Node).Certificate.NotAfter)
+	if err != nil || !notAfter.Before(i.before) {
+		i.done = true
+		return nil
+	}
+	return obj
+}
@@ -8,7 +8,11 @@ import (
 // ObjectStoreConfig provides the necessary methods to store a particular object
 // type inside MemoryStore.
 type ObjectStoreConfig struct {
-	Table            *memdb.TableSchema
+	Table *memdb.TableSchema
+	// Selectors lists the kinds of By selectors (see SelectorName and
+	// friends) that Find accepts for this table. All and Or are always
+	// accepted and need not be listed.
+	Selectors        []string
 	Save             func(ReadTx, *api.StoreSnapshot) error
 	Restore          func(Tx, *api.StoreSnapshot) error
 	ApplyStoreAction func(Tx, api.StoreAction) error
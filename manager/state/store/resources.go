@@ -34,6 +34,7 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{SelectorIDPrefix, SelectorName, SelectorKind, SelectorCustom, SelectorCustomPrefix},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Resources, err = FindResources(tx, All)
@@ -130,21 +131,12 @@ func GetResource(tx ReadTx, id string) *api.Resource {
 
 // FindResources selects a set of resource objects and returns them.
 func FindResources(tx ReadTx, by By) ([]*api.Resource, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byIDPrefix, byName, byKind, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	resourceList := []*api.Resource{}
 	appendResult := func(o api.StoreObject) {
 		resourceList = append(resourceList, o.(resourceEntry).Resource)
 	}
 
-	err := tx.find(tableResource, by, checkType, appendResult)
+	err := tx.find(tableResource, by, selectorChecker(tableResource), appendResult)
 	return resourceList, err
 }
 
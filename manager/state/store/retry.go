@@ -0,0 +1,42 @@
+package store
+
+import (
+	"time"
+
+	"github.com/docker/go-events"
+	"github.com/pkg/errors"
+)
+
+// retryBackoff is the backoff between the attempts of UpdateWithRetry.
+var retryBackoff = events.ExponentialBackoffConfig{
+	Base:   10 * time.Millisecond,
+	Factor: 10 * time.Millisecond,
+	Max:    time.Second,
+}
+
+// UpdateWithRetry runs cb in a read/write transaction, like Update. If the
+// transaction fails with ErrSequenceConflict, because another writer updated
+// an object cb was writing, it waits with an exponential backoff and runs cb
+// again in a new transaction, up to attempts times in all. cb should read the
+// objects it updates from the transaction it is given, so that each attempt
+// works from fresh state. Any other error is returned immediately. If every
+// attempt conflicts, the returned error wraps ErrSequenceConflict. cb is
+// always run at least once.
+func UpdateWithRetry(s *MemoryStore, attempts int, cb func(Tx) error) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := events.NewExponentialBackoff(retryBackoff)
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			time.Sleep(backoff.Proceed(nil))
+		}
+		err = s.Update(cb)
+		if err != ErrSequenceConflict {
+			return err
+		}
+		backoff.Failure(nil, err)
+	}
+	return errors.Wrapf(err, "update failed after %d attempts", attempts)
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/docker/swarmkit/manager/state/testutils"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateWithRetry(t *testing.T) {
+	s := NewMemoryStore(&testutils.MockProposer{})
+	defer s.Close()
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return CreateNode(tx, &api.Node{
+			ID: "id1",
+			Spec: api.NodeSpec{
+				Annotations: api.Annotations{Labels: map[string]string{}},
+			},
+		})
+	}))
+
+	// cached is the caller's copy of the node, which goes stale when the
+	// concurrent writer below updates the node
+	var cached *api.Node
+	s.View(func(tx ReadTx) {
+		cached = GetNode(tx, "id1")
+	})
+
+	firstWrite := make(chan struct{})
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, s.Update(func(tx Tx) error {
+				node := GetNode(tx, "id1")
+				node.Spec.Annotations.Labels["writer"] = strconv.Itoa(i)
+				return UpdateNode(tx, node)
+			}))
+			if i == 0 {
+				close(firstWrite)
+			}
+		}
+	}()
+	<-firstWrite
+
+	attempts := 0
+	err := UpdateWithRetry(s, 10, func(tx Tx) error {
+		attempts++
+		if cached == nil {
+			cached = GetNode(tx, "id1")
+		}
+		cached.Spec.Annotations.Labels["retrier"] = "done"
+		err := UpdateNode(tx, cached)
+		if err == ErrSequenceConflict {
+			cached = nil
+		}
+		return err
+	})
+	require.NoError(t, err)
+	assert.True(t, attempts > 1)
+	<-writerDone
+
+	s.View(func(tx ReadTx) {
+		node := GetNode(tx, "id1")
+		assert.Equal(t, "done", node.Spec.Annotations.Labels["retrier"])
+		assert.Equal(t, "4", node.Spec.Annotations.Labels["writer"])
+	})
+
+	// it gives up after the given number of attempts
+	attempts = 0
+	err = UpdateWithRetry(s, 3, func(tx Tx) error {
+		attempts++
+		return ErrSequenceConflict
+	})
+	assert.Equal(t, ErrSequenceConflict, errors.Cause(err))
+	assert.Contains(t, err.Error(), "after 3 attempts")
+	assert.Equal(t, 3, attempts)
+
+	// other errors are not retried
+	attempts = 0
+	err = UpdateWithRetry(s, 3, func(tx Tx) error {
+		attempts++
+		return ErrNotExist
+	})
+	assert.Equal(t, ErrNotExist, err)
+	assert.Equal(t, 1, attempts)
+}
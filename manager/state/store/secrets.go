@@ -31,6 +31,7 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{SelectorName, SelectorNamePrefix, SelectorIDPrefix, SelectorCustom, SelectorCustomPrefix},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Secrets, err = FindSecrets(tx, All)
@@ -113,20 +114,11 @@ func GetSecret(tx ReadTx, id string) *api.Secret {
 
 // FindSecrets selects a set of secrets and returns them.
 func FindSecrets(tx ReadTx, by By) ([]*api.Secret, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	secretList := []*api.Secret{}
 	appendResult := func(o api.StoreObject) {
 		secretList = append(secretList, o.(*api.Secret))
 	}
 
-	err := tx.find(tableSecret, by, checkType, appendResult)
+	err := tx.find(tableSecret, by, selectorChecker(tableSecret), appendResult)
 	return secretList, err
 }
@@ -52,6 +52,17 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{
+			SelectorName,
+			SelectorNamePrefix,
+			SelectorIDPrefix,
+			SelectorRuntime,
+			SelectorReferencedNetwork,
+			SelectorReferencedSecret,
+			SelectorReferencedConfig,
+			SelectorCustom,
+			SelectorCustomPrefix,
+		},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Services, err = FindServices(tx, All)
@@ -134,21 +145,12 @@ func GetService(tx ReadTx, id string) *api.Service {
 
 // FindServices selects a set of services and returns them.
 func FindServices(tx ReadTx, by By) ([]*api.Service, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byRuntime, byReferencedNetworkID, byReferencedSecretID, byReferencedConfigID, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	serviceList := []*api.Service{}
 	appendResult := func(o api.StoreObject) {
 		serviceList = append(serviceList, o.(*api.Service))
 	}
 
-	err := tx.find(tableService, by, checkType, appendResult)
+	err := tx.find(tableService, by, selectorChecker(tableService), appendResult)
 	return serviceList, err
 }
 
@@ -76,6 +76,22 @@ func init() {
 				},
 			},
 		},
+		Selectors: []string{
+			SelectorName,
+			SelectorNamePrefix,
+			SelectorIDPrefix,
+			SelectorRuntime,
+			SelectorDesiredState,
+			SelectorTaskState,
+			SelectorNode,
+			SelectorService,
+			SelectorSlot,
+			SelectorReferencedNetwork,
+			SelectorReferencedSecret,
+			SelectorReferencedConfig,
+			SelectorCustom,
+			SelectorCustomPrefix,
+		},
 		Save: func(tx ReadTx, snapshot *api.StoreSnapshot) error {
 			var err error
 			snapshot.Tasks, err = FindTasks(tx, All)
@@ -146,21 +162,12 @@ func GetTask(tx ReadTx, id string) *api.Task {
 
 // FindTasks selects a set of tasks and returns them.
 func FindTasks(tx ReadTx, by By) ([]*api.Task, error) {
-	checkType := func(by By) error {
-		switch by.(type) {
-		case byName, byNamePrefix, byIDPrefix, byRuntime, byDesiredState, byTaskState, byNode, byService, bySlot, byReferencedNetworkID, byReferencedSecretID, byReferencedConfigID, byCustom, byCustomPrefix:
-			return nil
-		default:
-			return ErrInvalidFindBy
-		}
-	}
-
 	taskList := []*api.Task{}
 	appendResult := func(o api.StoreObject) {
 		taskList = append(taskList, o.(*api.Task))
 	}
 
-	err := tx.find(tableTask, by, checkType, appendResult)
+	err := tx.find(tableTask, by, selectorChecker(tableTask), appendResult)
 	return taskList, err
 }
 
@@ -0,0 +1,133 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/docker/swarmkit/api"
+	memdb "github.com/hashicorp/go-memdb"
+)
+
+// IndexDiscrepancy describes an object whose entries in a secondary index
+// don't agree with the object stored under its ID.
+type IndexDiscrepancy struct {
+	Table string
+	Index string
+	ID    string
+	// Reason describes the inconsistency.
+	Reason string
+}
+
+func (d IndexDiscrepancy) String() string {
+	return fmt.Sprintf("%s/%s: object %s: %s", d.Table, d.Index, d.ID, d.Reason)
+}
+
+// VerifyIndexes walks the primary ID index of every table and checks that
+// each object is reachable through every secondary index it should be in,
+// and that secondary indexes hold no entries for objects that have been
+// deleted or replaced. It returns the inconsistencies found, if any. This is
+// a debugging aid for catching indexer bugs, and reads every object in the
+// store.
+func VerifyIndexes(tx ReadTx) ([]IndexDiscrepancy, error) {
+	var discrepancies []IndexDiscrepancy
+	for _, os := range objectStorers {
+		d, err := tx.verifyIndexes(os.Table.Name)
+		if err != nil {
+			return nil, err
+		}
+		discrepancies = append(discrepancies, d...)
+	}
+	return discrepancies, nil
+}
+
+func (tx readTx) verifyIndexes(table string) ([]IndexDiscrepancy, error) {
+	primary := make(map[string]api.StoreObject)
+	it, err := tx.memDBTx.Get(table, indexID)
+	if err != nil {
+		return nil, err
+	}
+	for obj := it.Next(); obj != nil; obj = it.Next() {
+		o := obj.(api.StoreObject)
+		primary[o.GetID()] = o
+	}
+
+	tableSchema := schema.Tables[table]
+	indexNames := make([]string, 0, len(tableSchema.Indexes))
+	for name := range tableSchema.Indexes {
+		if name != indexID {
+			indexNames = append(indexNames, name)
+		}
+	}
+	sort.Strings(indexNames)
+
+	var discrepancies []IndexDiscrepancy
+	for _, name := range indexNames {
+		indexSchema := tableSchema.Indexes[name]
+		report := func(id, reason string) {
+			discrepancies = append(discrepancies, IndexDiscrepancy{Table: table, Index: name, ID: id, Reason: reason})
+		}
+
+		// Count the entries in this index for each object, flagging any
+		// entry that doesn't point at the object's current version.
+		found := make(map[string]int)
+		it, err := tx.memDBTx.Get(table, name)
+		if err != nil {
+			return nil, err
+		}
+		for obj := it.Next(); obj != nil; obj = it.Next() {
+			o := obj.(api.StoreObject)
+			id := o.GetID()
+			switch current, ok := primary[id]; {
+			case !ok:
+				report(id, "index entry for an object that does not exist")
+			case current != o:
+				report(id, "index entry for a stale version of the object")
+			default:
+				found[id]++
+			}
+		}
+
+		for id, o := range primary {
+			expected, err := indexEntryCount(indexSchema, o)
+			if err != nil {
+				report(id, err.Error())
+				continue
+			}
+			if found[id] != expected {
+				report(id, fmt.Sprintf("expected %d index entries, found %d", expected, found[id]))
+			}
+		}
+	}
+	return discrepancies, nil
+}
+
+// indexEntryCount returns the number of distinct entries an object should
+// have in the given index.
+func indexEntryCount(indexSchema *memdb.IndexSchema, o api.StoreObject) (int, error) {
+	var (
+		ok   bool
+		vals [][]byte
+		err  error
+	)
+	switch indexer := indexSchema.Indexer.(type) {
+	case memdb.SingleIndexer:
+		var val []byte
+		ok, val, err = indexer.FromObject(o)
+		vals = [][]byte{val}
+	case memdb.MultiIndexer:
+		ok, vals, err = indexer.FromObject(o)
+	default:
+		return 0, fmt.Errorf("unsupported indexer type %T", indexSchema.Indexer)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to build index value: %v", err)
+	}
+	if !ok {
+		return 0, nil
+	}
+	distinct := make(map[string]struct{})
+	for _, val := range vals {
+		distinct[string(val)] = struct{}{}
+	}
+	return len(distinct), nil
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"github.com/docker/swarmkit/api"
+)
+
+// MaterializedView is a structure derived from the contents of the store, such
+// as a count of the nodes in each issuance state, that is kept up to date
+// incrementally as changes are committed, so that frequent queries can read it
+// instead of scanning the store.
+//
+// The store never calls a view's methods concurrently, and calls them with its
+// update lock held, so the view sees every committed change exactly once, in
+// commit order, before the change's events are published to watchers. The view
+// must guard its own reads against these calls.
+type MaterializedView interface {
+	// Reset rebuilds the view from scratch from the contents of the store.
+	Reset(tx ReadTx)
+	// Apply updates the view with a change that has been committed.
+	Apply(change api.Event)
+}
+
+// RegisterMaterializedView resets view from the current contents of the store,
+// and then applies every change committed to the store to it, until the
+// returned function is called.
+func (s *MemoryStore) RegisterMaterializedView(view MaterializedView) (unregister func()) {
+	s.updateLock.Lock()
+	defer s.updateLock.Unlock()
+
+	s.View(view.Reset)
+	// registered is compared by pointer, since the view itself may not be
+	// comparable
+	registered := &registeredView{view: view}
+	s.views = append(s.views, registered)
+
+	return func() {
+		s.updateLock.Lock()
+		defer s.updateLock.Unlock()
+
+		for i, v := range s.views {
+			if v == registered {
+				s.views = append(s.views[:i:i], s.views[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+type registeredView struct {
+	view MaterializedView
+}
+
+// applyToViews applies the committed changes to the registered views. It must
+// be called with the update lock held.
+func (s *MemoryStore) applyToViews(changelist []api.Event) {
+	for _, registered := range s.views {
+		for _, c := range changelist {
+			registered.view.Apply(c)
+		}
+	}
+}
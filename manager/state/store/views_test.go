@@ -0,0 +1,147 @@
+package store
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/docker/swarmkit/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// issuanceStateCounts counts the accepted member nodes in each issuance state.
+type issuanceStateCounts struct {
+	mu     sync.Mutex
+	counts map[api.IssuanceStatus_State]int
+}
+
+func (v *issuanceStateCounts) add(node *api.Node, delta int) {
+	if node == nil || node.Spec.Membership != api.NodeMembershipAccepted {
+		return
+	}
+	v.counts[node.Certificate.Status.State] += delta
+	if v.counts[node.Certificate.Status.State] == 0 {
+		delete(v.counts, node.Certificate.Status.State)
+	}
+}
+
+func (v *issuanceStateCounts) Reset(tx ReadTx) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.counts = make(map[api.IssuanceStatus_State]int)
+	nodes, _ := FindNodes(tx, All)
+	for _, node := range nodes {
+		v.add(node, 1)
+	}
+}
+
+func (v *issuanceStateCounts) Apply(change api.Event) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	switch c := change.(type) {
+	case api.EventCreateNode:
+		v.add(c.Node, 1)
+	case api.EventUpdateNode:
+		v.add(c.OldNode, -1)
+		v.add(c.Node, 1)
+	case api.EventDeleteNode:
+		v.add(c.Node, -1)
+	}
+}
+
+func (v *issuanceStateCounts) get() map[api.IssuanceStatus_State]int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	counts := make(map[api.IssuanceStatus_State]int)
+	for state, count := range v.counts {
+		counts[state] = count
+	}
+	return counts
+}
+
+func TestMaterializedView(t *testing.T) {
+	s := NewMemoryStore(nil)
+	defer s.Close()
+
+	newNode := func(id string, membership api.NodeSpec_Membership, state api.IssuanceStatus_State) *api.Node {
+		return &api.Node{
+			ID:          id,
+			Spec:        api.NodeSpec{Membership: membership},
+			Certificate: api.Certificate{Status: api.IssuanceStatus{State: state}},
+		}
+	}
+	scan := func() map[api.IssuanceStatus_State]int {
+		fresh := &issuanceStateCounts{}
+		s.View(fresh.Reset)
+		return fresh.get()
+	}
+
+	// nodes that exist before the view is registered are counted too
+	require.NoError(t, s.Update(func(tx Tx) error {
+		if err := CreateNode(tx, newNode("existing", api.NodeMembershipAccepted, api.IssuanceStateIssued)); err != nil {
+			return err
+		}
+		return CreateNode(tx, newNode("pending", api.NodeMembershipPending, api.IssuanceStatePending))
+	}))
+
+	view := &issuanceStateCounts{}
+	unregister := s.RegisterMaterializedView(view)
+	assert.Equal(t, map[api.IssuanceStatus_State]int{api.IssuanceStateIssued: 1}, view.get())
+
+	require.NoError(t, s.Update(func(tx Tx) error {
+		for i := 0; i < 5; i++ {
+			if err := CreateNode(tx, newNode("node"+strconv.Itoa(i), api.NodeMembershipAccepted, api.IssuanceStateIssued)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, s.Update(func(tx Tx) error {
+		node := GetNode(tx, "node0")
+		node.Certificate.Status.State = api.IssuanceStateRotate
+		if err := UpdateNode(tx, node); err != nil {
+			return err
+		}
+		node = GetNode(tx, "pending")
+		node.Spec.Membership = api.NodeMembershipAccepted
+		return UpdateNode(tx, node)
+	}))
+	// a failed transaction doesn't change the view
+	require.Error(t, s.Update(func(tx Tx) error {
+		node := GetNode(tx, "node1")
+		node.Certificate.Status.State = api.IssuanceStateFailed
+		if err := UpdateNode(tx, node); err != nil {
+			return err
+		}
+		return ErrNotExist
+	}))
+	_, err := s.Batch(func(batch *Batch) error {
+		for _, id := range []string{"node2", "node3"} {
+			id := id
+			if err := batch.Update(func(tx Tx) error {
+				return DeleteNode(tx, id)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	expected := map[api.IssuanceStatus_State]int{
+		api.IssuanceStateIssued:  3,
+		api.IssuanceStateRotate:  1,
+		api.IssuanceStatePending: 1,
+	}
+	assert.Equal(t, expected, scan())
+	assert.Equal(t, expected, view.get())
+
+	// once unregistered, the view is no longer updated
+	unregister()
+	require.NoError(t, s.Update(func(tx Tx) error {
+		return DeleteNode(tx, "node4")
+	}))
+	assert.Equal(t, expected, view.get())
+	assert.NotEqual(t, expected, scan())
+}
@@ -9,6 +9,10 @@ import (
 // EventCommit delineates a transaction boundary.
 type EventCommit struct {
 	Version *api.Version
+	// CorrelationID is the correlation ID the transaction was tagged
+	// with, if any. It applies to all the object events published since
+	// the previous commit, which don't carry it themselves.
+	CorrelationID string
 }
 
 // Matches returns true if this event is a commit event.
@@ -17,6 +21,19 @@ func (e EventCommit) Matches(watchEvent events.Event) bool {
 	return ok
 }
 
+// EventStoreRestored is published once when the store is restored from a
+// snapshot, in place of an event for each object deleted and created, if the
+// store suppresses restore events.
+type EventStoreRestored struct {
+	Version *api.Version
+}
+
+// Matches returns true if this event is a store restored event.
+func (e EventStoreRestored) Matches(watchEvent events.Event) bool {
+	_, ok := watchEvent.(EventStoreRestored)
+	return ok
+}
+
 // TaskCheckStateGreaterThan is a TaskCheckFunc for checking task state.
 func TaskCheckStateGreaterThan(t1, t2 *api.Task) bool {
 	return t2.Status.State > t1.Status.State
@@ -11,9 +11,11 @@ import (
 
 // Watch starts a stream that returns any changes to objects that match
 // the specified selectors. When the stream begins, it immediately sends
-// an empty message back to the client. It is important to wait for
+// a message with no events back to the client. It is important to wait for
 // this message before taking any actions that depend on an established
-// stream of changes for consistency.
+// stream of changes for consistency. Every message carries a version that
+// can be passed as ResumeFrom to resume the stream after a disconnect,
+// without missing or repeating changes.
 func (s *Server) Watch(request *api.WatchRequest, stream api.Store_WatchServer) error {
 	ctx := stream.Context()
 
@@ -23,14 +25,16 @@ func (s *Server) Watch(request *api.WatchRequest, stream api.Store_WatchServer)
 	}
 
 	watchArgs = append(watchArgs, state.EventCommit{})
-	watch, cancel, err := store.WatchFrom(s.store, request.ResumeFrom, watchArgs...)
+	watch, cancel, version, err := store.ResumableWatch(s.store, request.ResumeFrom, watchArgs...)
 	if err != nil {
 		return err
 	}
 	defer cancel()
 
-	// TODO(aaronl): Send current version in this WatchMessage?
-	if err := stream.Send(&api.WatchMessage{}); err != nil {
+	// The first message carries the version the stream starts from, so
+	// that a client which is disconnected before receiving any change can
+	// still resume from it.
+	if err := stream.Send(&api.WatchMessage{Version: version}); err != nil {
 		return err
 	}
 
@@ -30,7 +30,8 @@ func TestWatch(t *testing.T) {
 	// Should receive an initial message that indicates the watch is ready
 	msg, err := watch.Recv()
 	assert.NoError(t, err)
-	assert.Equal(t, &api.WatchMessage{}, msg)
+	assert.Empty(t, msg.Events)
+	assert.NotNil(t, msg.Version)
 
 	createNode(t, ts, "id1", api.NodeRoleManager, api.NodeMembershipAccepted, api.NodeStatus_READY)
 	msg, err = watch.Recv()
@@ -82,7 +83,8 @@ func TestWatch(t *testing.T) {
 	// Should receive an initial message that indicates the watch is ready
 	msg, err = watch.Recv()
 	assert.NoError(t, err)
-	assert.Equal(t, &api.WatchMessage{}, msg)
+	assert.Empty(t, msg.Events)
+	assert.NotNil(t, msg.Version)
 
 	createNode(t, ts, "id2", api.NodeRoleManager, api.NodeMembershipAccepted, api.NodeStatus_READY)
 	msg, err = watch.Recv()
@@ -180,7 +182,8 @@ func TestWatchMultipleActions(t *testing.T) {
 	// Should receive an initial message that indicates the watch is ready
 	msg, err := watch.Recv()
 	assert.NoError(t, err)
-	assert.Equal(t, &api.WatchMessage{}, msg)
+	assert.Empty(t, msg.Events)
+	assert.NotNil(t, msg.Version)
 
 	createNode(t, ts, "id1", api.NodeRoleManager, api.NodeMembershipAccepted, api.NodeStatus_READY)
 	msg, err = watch.Recv()
@@ -233,7 +236,8 @@ func TestWatchIncludeOldObject(t *testing.T) {
 	// Should receive an initial message that indicates the watch is ready
 	msg, err := watch.Recv()
 	assert.NoError(t, err)
-	assert.Equal(t, &api.WatchMessage{}, msg)
+	assert.Empty(t, msg.Events)
+	assert.NotNil(t, msg.Version)
 
 	createNode(t, ts, "id1", api.NodeRoleManager, api.NodeMembershipAccepted, api.NodeStatus_READY)
 
@@ -282,7 +286,8 @@ func TestWatchResumeFrom(t *testing.T) {
 	// Should receive an initial message that indicates the watch is ready
 	msg, err := watch.Recv()
 	assert.NoError(t, err)
-	assert.Equal(t, &api.WatchMessage{}, msg)
+	assert.Empty(t, msg.Events)
+	assert.NotNil(t, msg.Version)
 
 	msg, err = watch.Recv()
 	assert.NoError(t, err)
@@ -303,3 +308,83 @@ func TestWatchResumeFrom(t *testing.T) {
 
 	watch.CloseSend()
 }
+
+func TestWatchResumeAfterDisconnect(t *testing.T) {
+	ts := newTestServer(t)
+	defer ts.Stop()
+
+	request := &api.WatchRequest{
+		Entries: []*api.WatchRequest_WatchEntry{
+			{
+				Kind:   "node",
+				Action: api.WatchActionKindCreate | api.WatchActionKindUpdate,
+			},
+		},
+	}
+	// startWatch starts a watch resuming from cursor, and returns it along
+	// with the version carried by its initial message
+	startWatch := func(ctx context.Context, cursor *api.Version) (api.Store_WatchClient, *api.Version) {
+		request.ResumeFrom = cursor
+		watch, err := ts.Client.Watch(ctx, request)
+		require.NoError(t, err)
+		msg, err := watch.Recv()
+		require.NoError(t, err)
+		require.Empty(t, msg.Events)
+		require.NotNil(t, msg.Version)
+		return watch, msg.Version
+	}
+	// receive receives the next message, and returns the ID of the node in
+	// it along with its version
+	receive := func(watch api.Store_WatchClient) (string, *api.Version) {
+		msg, err := watch.Recv()
+		require.NoError(t, err)
+		require.Len(t, msg.Events, 1)
+		require.NotNil(t, msg.Events[0].Object.GetNode())
+		return msg.Events[0].Object.GetNode().ID, msg.Version
+	}
+
+	// a client disconnected before receiving any change resumes from the
+	// version of the initial message
+	ctx, cancel := context.WithCancel(context.Background())
+	_, cursor := startWatch(ctx, nil)
+	cancel()
+	createNode(t, ts, "id1", api.NodeRoleWorker, api.NodeMembershipAccepted, api.NodeStatus_READY)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	watch, _ := startWatch(ctx, cursor)
+	id, cursor := receive(watch)
+	require.Equal(t, "id1", id)
+	createNode(t, ts, "id2", api.NodeRoleWorker, api.NodeMembershipAccepted, api.NodeStatus_READY)
+	id, cursor = receive(watch)
+	require.Equal(t, "id2", id)
+
+	// disconnect mid-stream, and miss some changes
+	cancel()
+	createNode(t, ts, "id3", api.NodeRoleWorker, api.NodeMembershipAccepted, api.NodeStatus_READY)
+	require.NoError(t, ts.Store.Update(func(tx store.Tx) error {
+		node := store.GetNode(tx, "id1")
+		node.Status.State = api.NodeStatus_DOWN
+		return store.UpdateNode(tx, node)
+	}))
+
+	// resuming from the cursor gives exactly the missed changes, followed
+	// by the live ones
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	watch, _ = startWatch(ctx, cursor)
+	createNode(t, ts, "id4", api.NodeRoleWorker, api.NodeMembershipAccepted, api.NodeStatus_READY)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		id, cursor = receive(watch)
+		ids = append(ids, id)
+	}
+	require.Equal(t, []string{"id3", "id1", "id4"}, ids)
+
+	// and the last cursor has nothing left to replay
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	watch, _ = startWatch(ctx, cursor)
+	createNode(t, ts, "id5", api.NodeRoleWorker, api.NodeMembershipAccepted, api.NodeStatus_READY)
+	id, _ = receive(watch)
+	require.Equal(t, "id5", id)
+}
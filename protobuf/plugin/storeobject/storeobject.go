@@ -11,9 +11,10 @@ import (
 // FIXME(aaronl): Look at fields inside the descriptor instead of
 // special-casing based on name.
 var typesWithNoSpec = map[string]struct{}{
-	"Task":      {},
-	"Resource":  {},
-	"Extension": {},
+	"Task":        {},
+	"Resource":    {},
+	"Extension":   {},
+	"ApprovedKey": {},
 }
 
 type storeObjectGen struct {